@@ -0,0 +1,428 @@
+package tuple
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"slices"
+)
+
+// The binary encoding of a tuple is the concatenation of the binary encodings of its values, in order:
+//
+//   - Booleans are encoded as a single byte, 0 for false and 1 for true.
+//   - Fixed-size numbers are encoded using the byte order, with int, uint and uintptr encoded as 64 bit numbers
+//     and floating-point and complex numbers encoded using their IEEE 754 binary representation.
+//   - Strings and byte slices are encoded as their uvarint length followed by their bytes.
+//   - Nested tuples are encoded using their own binary encoding.
+//
+// Values of other kinds, including pointers to tuples, are not supported and fail to marshal.
+//
+// A slice of tuples is encoded as its uvarint length followed by the binary encodings of its tuples.
+
+// binaryOrderAppender is implemented by tuples, enabling the binary encoding of nested tuples.
+type binaryOrderAppender interface {
+	AppendBinaryOrder(b []byte, order binary.AppendByteOrder) ([]byte, error)
+}
+
+// binaryDecoder is implemented by tuple pointers, enabling the binary decoding of nested tuples and slices of tuples.
+type binaryDecoder interface {
+	// decodeBinary decodes the tuple from the beginning of data, and returns the rest of the data.
+	decodeBinary(data []byte, order binary.ByteOrder) ([]byte, error)
+}
+
+// appendBinaryValue appends the binary encoding of a single tuple value to b.
+// Common types are handled without reflection or allocations, and other types are handled by their kinds.
+func appendBinaryValue[T any](b []byte, order binary.AppendByteOrder, v T) ([]byte, error) {
+	switch v := any(v).(type) {
+	case bool:
+		return appendBinaryBool(b, v), nil
+	case int8:
+		return append(b, byte(v)), nil
+	case int16:
+		return order.AppendUint16(b, uint16(v)), nil
+	case int32:
+		return order.AppendUint32(b, uint32(v)), nil
+	case int64:
+		return order.AppendUint64(b, uint64(v)), nil
+	case int:
+		return order.AppendUint64(b, uint64(v)), nil
+	case uint8:
+		return append(b, v), nil
+	case uint16:
+		return order.AppendUint16(b, v), nil
+	case uint32:
+		return order.AppendUint32(b, v), nil
+	case uint64:
+		return order.AppendUint64(b, v), nil
+	case uint:
+		return order.AppendUint64(b, uint64(v)), nil
+	case float32:
+		return order.AppendUint32(b, math.Float32bits(v)), nil
+	case float64:
+		return order.AppendUint64(b, math.Float64bits(v)), nil
+	case string:
+		return append(binary.AppendUvarint(b, uint64(len(v))), v...), nil
+	case []byte:
+		return append(binary.AppendUvarint(b, uint64(len(v))), v...), nil
+	}
+
+	// Other types are converted to interfaces separately, as calling their methods through the interfaces
+	// makes them escape to the heap, which would make the conversion above allocate for common types as well.
+	// Pointers to tuples implement binaryOrderAppender through their value receivers, but can't be decoded,
+	// so they are left to fail as unsupported kinds.
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Pointer {
+		if appender, ok := any(v).(binaryOrderAppender); ok {
+			return appender.AppendBinaryOrder(b, order)
+		}
+	}
+
+	return appendBinaryReflect(b, order, value)
+}
+
+// appendBinaryReflect appends the binary encoding of a value of a boolean, numeric, string or byte slice kind to b.
+func appendBinaryReflect(b []byte, order binary.AppendByteOrder, v reflect.Value) ([]byte, error) {
+	switch v.Kind() {
+	case reflect.Bool:
+		return appendBinaryBool(b, v.Bool()), nil
+	case reflect.Int8, reflect.Uint8:
+		return append(b, byte(binaryUint(v))), nil
+	case reflect.Int16, reflect.Uint16:
+		return order.AppendUint16(b, uint16(binaryUint(v))), nil
+	case reflect.Int32, reflect.Uint32:
+		return order.AppendUint32(b, uint32(binaryUint(v))), nil
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64, reflect.Uintptr:
+		return order.AppendUint64(b, binaryUint(v)), nil
+	case reflect.Float32:
+		return order.AppendUint32(b, math.Float32bits(float32(v.Float()))), nil
+	case reflect.Float64:
+		return order.AppendUint64(b, math.Float64bits(v.Float())), nil
+	case reflect.Complex64:
+		c := v.Complex()
+		b = order.AppendUint32(b, math.Float32bits(float32(real(c))))
+		return order.AppendUint32(b, math.Float32bits(float32(imag(c)))), nil
+	case reflect.Complex128:
+		c := v.Complex()
+		b = order.AppendUint64(b, math.Float64bits(real(c)))
+		return order.AppendUint64(b, math.Float64bits(imag(c))), nil
+	case reflect.String:
+		return append(binary.AppendUvarint(b, uint64(v.Len())), v.String()...), nil
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return append(binary.AppendUvarint(b, uint64(v.Len())), v.Bytes()...), nil
+		}
+	case reflect.Invalid:
+		return nil, errors.New("unable to marshal nil value to binary")
+	}
+
+	return nil, fmt.Errorf("unable to marshal value of type %s to binary", v.Type())
+}
+
+// appendBinaryBool appends the binary encoding of a boolean to b.
+func appendBinaryBool(b []byte, v bool) []byte {
+	if v {
+		return append(b, 1)
+	}
+
+	return append(b, 0)
+}
+
+// binaryUint returns the bits of an integer value as an unsigned integer.
+func binaryUint(v reflect.Value) uint64 {
+	if v.CanInt() {
+		return uint64(v.Int())
+	}
+
+	return v.Uint()
+}
+
+// decodeBinaryValue decodes a single tuple value from the beginning of data into the value pointed by ptr,
+// and returns the rest of the data.
+// Common types are handled without reflection or allocations, except for strings,
+// and other types are handled by their kinds.
+func decodeBinaryValue[T any](data []byte, order binary.ByteOrder, ptr *T) ([]byte, error) {
+	switch p := any(ptr).(type) {
+	case *bool:
+		return decodeBinaryBool(data, p)
+	case *int8:
+		if len(data) < 1 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		*p = int8(data[0])
+		return data[1:], nil
+	case *int16:
+		if len(data) < 2 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		*p = int16(order.Uint16(data))
+		return data[2:], nil
+	case *int32:
+		if len(data) < 4 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		*p = int32(order.Uint32(data))
+		return data[4:], nil
+	case *int64:
+		if len(data) < 8 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		*p = int64(order.Uint64(data))
+		return data[8:], nil
+	case *int:
+		if len(data) < 8 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		*p = int(order.Uint64(data))
+		return data[8:], nil
+	case *uint8:
+		if len(data) < 1 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		*p = data[0]
+		return data[1:], nil
+	case *uint16:
+		if len(data) < 2 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		*p = order.Uint16(data)
+		return data[2:], nil
+	case *uint32:
+		if len(data) < 4 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		*p = order.Uint32(data)
+		return data[4:], nil
+	case *uint64:
+		if len(data) < 8 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		*p = order.Uint64(data)
+		return data[8:], nil
+	case *uint:
+		if len(data) < 8 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		*p = uint(order.Uint64(data))
+		return data[8:], nil
+	case *float32:
+		if len(data) < 4 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		*p = math.Float32frombits(order.Uint32(data))
+		return data[4:], nil
+	case *float64:
+		if len(data) < 8 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		*p = math.Float64frombits(order.Uint64(data))
+		return data[8:], nil
+	case *string:
+		bytes, rest, err := decodeBinaryBytes(data)
+		if err != nil {
+			return nil, err
+		}
+		*p = string(bytes)
+		return rest, nil
+	case *[]byte:
+		bytes, rest, err := decodeBinaryBytes(data)
+		if err != nil {
+			return nil, err
+		}
+		// Reusing the existing capacity avoids allocations when decoding into previously decoded tuples.
+		*p = append((*p)[:0], bytes...)
+		return rest, nil
+	case binaryDecoder:
+		return p.decodeBinary(data, order)
+	}
+
+	return decodeBinaryReflect(data, order, reflect.ValueOf(ptr).Elem())
+}
+
+// decodeBinaryReflect decodes a value of a boolean, numeric, string or byte slice kind from the beginning of data,
+// and returns the rest of the data.
+func decodeBinaryReflect(data []byte, order binary.ByteOrder, v reflect.Value) ([]byte, error) {
+	size := 0
+	switch v.Kind() {
+	case reflect.Bool:
+		var b bool
+		rest, err := decodeBinaryBool(data, &b)
+		if err != nil {
+			return nil, err
+		}
+		v.SetBool(b)
+		return rest, nil
+	case reflect.String:
+		bytes, rest, err := decodeBinaryBytes(data)
+		if err != nil {
+			return nil, err
+		}
+		v.SetString(string(bytes))
+		return rest, nil
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.Uint8 {
+			return nil, fmt.Errorf("unable to unmarshal binary to value of type %s", v.Type())
+		}
+		bytes, rest, err := decodeBinaryBytes(data)
+		if err != nil {
+			return nil, err
+		}
+		v.SetBytes(append(v.Bytes()[:0], bytes...))
+		return rest, nil
+	case reflect.Int8, reflect.Uint8:
+		size = 1
+	case reflect.Int16, reflect.Uint16:
+		size = 2
+	case reflect.Int32, reflect.Uint32, reflect.Float32:
+		size = 4
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64, reflect.Uintptr, reflect.Float64, reflect.Complex64:
+		size = 8
+	case reflect.Complex128:
+		size = 16
+	default:
+		return nil, fmt.Errorf("unable to unmarshal binary to value of type %s", v.Type())
+	}
+
+	if len(data) < size {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	var bits uint64
+	switch size {
+	case 1:
+		bits = uint64(data[0])
+	case 2:
+		bits = uint64(order.Uint16(data))
+	case 4:
+		bits = uint64(order.Uint32(data))
+	case 8:
+		bits = order.Uint64(data)
+	}
+
+	switch v.Kind() {
+	case reflect.Int8:
+		v.SetInt(int64(int8(bits)))
+	case reflect.Int16:
+		v.SetInt(int64(int16(bits)))
+	case reflect.Int32:
+		v.SetInt(int64(int32(bits)))
+	case reflect.Int, reflect.Int64:
+		v.SetInt(int64(bits))
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint, reflect.Uint64, reflect.Uintptr:
+		v.SetUint(bits)
+	case reflect.Float32:
+		v.SetFloat(float64(math.Float32frombits(uint32(bits))))
+	case reflect.Float64:
+		v.SetFloat(math.Float64frombits(bits))
+	case reflect.Complex64:
+		v.SetComplex(complex(
+			float64(math.Float32frombits(order.Uint32(data))),
+			float64(math.Float32frombits(order.Uint32(data[4:]))),
+		))
+	case reflect.Complex128:
+		v.SetComplex(complex(
+			math.Float64frombits(order.Uint64(data)),
+			math.Float64frombits(order.Uint64(data[8:])),
+		))
+	}
+
+	return data[size:], nil
+}
+
+// decodeBinaryBool decodes a boolean from the beginning of data, and returns the rest of the data.
+func decodeBinaryBool(data []byte, ptr *bool) ([]byte, error) {
+	if len(data) < 1 {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	switch data[0] {
+	case 0:
+		*ptr = false
+	case 1:
+		*ptr = true
+	default:
+		return nil, fmt.Errorf("invalid binary boolean value %d", data[0])
+	}
+
+	return data[1:], nil
+}
+
+// decodeBinaryBytes decodes a length prefixed byte sequence from the beginning of data, and returns it along with the rest of the data.
+// The returned byte sequence shares the memory of data.
+func decodeBinaryBytes(data []byte) ([]byte, []byte, error) {
+	length, size := binary.Uvarint(data)
+	if size <= 0 {
+		return nil, nil, errors.New("invalid binary length prefix")
+	}
+
+	data = data[size:]
+	if uint64(len(data)) < length {
+		return nil, nil, io.ErrUnexpectedEOF
+	}
+
+	return data[:length], data[length:], nil
+}
+
+// unmarshalBinary decodes a tuple from data using decoder, failing if data holds trailing bytes.
+func unmarshalBinary(data []byte, order binary.ByteOrder, decoder binaryDecoder) error {
+	rest, err := decoder.decodeBinary(data, order)
+	if err != nil {
+		return err
+	}
+
+	if len(rest) > 0 {
+		return fmt.Errorf("binary data has %d trailing bytes", len(rest))
+	}
+
+	return nil
+}
+
+// appendBinarySlice appends the binary encoding of a slice of tuples to b.
+func appendBinarySlice[T binaryOrderAppender](b []byte, order binary.AppendByteOrder, tuples []T) ([]byte, error) {
+	b = binary.AppendUvarint(b, uint64(len(tuples)))
+	for index, t := range tuples {
+		var err error
+		if b, err = t.AppendBinaryOrder(b, order); err != nil {
+			return nil, fmt.Errorf("tuple at index %d failed to marshal: %w", index, err)
+		}
+	}
+
+	return b, nil
+}
+
+// unmarshalBinarySlice decodes a slice of tuples from data and appends them to dst.
+// Tuples are decoded in place, so decoding into a dst with enough capacity doesn't allocate
+// unless the tuples hold strings, or byte slices longer than the ones previously held in that capacity.
+func unmarshalBinarySlice[T any, P interface {
+	*T
+	binaryDecoder
+}](data []byte, order binary.ByteOrder, dst []T) ([]T, error) {
+	count, size := binary.Uvarint(data)
+	if size <= 0 {
+		return nil, errors.New("invalid binary length prefix")
+	}
+	data = data[size:]
+
+	// Each of the tuple values is encoded into at least a single byte.
+	if count > uint64(len(data)) {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	dst = slices.Grow(dst, int(count))
+	for index := 0; index < int(count); index++ {
+		dst = dst[:len(dst)+1]
+
+		var err error
+		if data, err = P(&dst[len(dst)-1]).decodeBinary(data, order); err != nil {
+			return nil, fmt.Errorf("tuple at index %d failed to unmarshal: %w", index, err)
+		}
+	}
+
+	if len(data) > 0 {
+		return nil, fmt.Errorf("binary data has %d trailing bytes", len(data))
+	}
+
+	return dst, nil
+}
@@ -0,0 +1,221 @@
+package tuple
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type binaryInt int16
+
+type binaryBytes []byte
+
+func Test_appendBinaryValue_decodeBinaryValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		want  []byte
+	}{
+		{name: "false", value: false, want: []byte{0}},
+		{name: "true", value: true, want: []byte{1}},
+		{name: "int8", value: int8(-2), want: []byte{0xfe}},
+		{name: "int16", value: int16(-2), want: []byte{0xff, 0xfe}},
+		{name: "int32", value: int32(1), want: []byte{0, 0, 0, 1}},
+		{name: "int64", value: int64(1), want: []byte{0, 0, 0, 0, 0, 0, 0, 1}},
+		{name: "int", value: -1, want: []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+		{name: "uint8", value: uint8(2), want: []byte{2}},
+		{name: "uint16", value: uint16(0x0102), want: []byte{1, 2}},
+		{name: "uint32", value: uint32(0x01020304), want: []byte{1, 2, 3, 4}},
+		{name: "uint64", value: uint64(1), want: []byte{0, 0, 0, 0, 0, 0, 0, 1}},
+		{name: "uint", value: uint(1), want: []byte{0, 0, 0, 0, 0, 0, 0, 1}},
+		{name: "uintptr", value: uintptr(1), want: []byte{0, 0, 0, 0, 0, 0, 0, 1}},
+		{name: "float32", value: float32(1), want: []byte{0x3f, 0x80, 0, 0}},
+		{name: "float64", value: float64(1), want: []byte{0x3f, 0xf0, 0, 0, 0, 0, 0, 0}},
+		{name: "complex64", value: complex64(complex(1, 1)), want: []byte{0x3f, 0x80, 0, 0, 0x3f, 0x80, 0, 0}},
+		{name: "complex128", value: complex(1, 0), want: []byte{0x3f, 0xf0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{name: "empty string", value: "", want: []byte{0}},
+		{name: "string", value: "foo", want: []byte{3, 'f', 'o', 'o'}},
+		{name: "bytes", value: []byte("foo"), want: []byte{3, 'f', 'o', 'o'}},
+		{name: "named integer", value: binaryInt(-2), want: []byte{0xff, 0xfe}},
+		{name: "named bytes", value: binaryBytes("foo"), want: []byte{3, 'f', 'o', 'o'}},
+		{name: "nested tuple", value: New2(true, "a"), want: []byte{1, 1, 'a'}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := appendBinaryValue(nil, binary.BigEndian, tt.value)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+
+			ptr := reflect.New(reflect.TypeOf(tt.value))
+			rest, err := decodeBinaryValueOf(t, append(got, 0xaa), ptr)
+			require.NoError(t, err)
+			require.Equal(t, []byte{0xaa}, rest)
+			require.Equal(t, tt.value, ptr.Elem().Interface())
+		})
+	}
+}
+
+// decodeBinaryValueOf decodes data into the value pointed by ptr, instantiating decodeBinaryValue with the type of the value
+// the same way generated code does, falling back to decodeBinaryReflect for other types.
+func decodeBinaryValueOf(t *testing.T, data []byte, ptr reflect.Value) ([]byte, error) {
+	t.Helper()
+
+	switch p := ptr.Interface().(type) {
+	case *bool:
+		return decodeBinaryValue(data, binary.BigEndian, p)
+	case *int8:
+		return decodeBinaryValue(data, binary.BigEndian, p)
+	case *int16:
+		return decodeBinaryValue(data, binary.BigEndian, p)
+	case *int32:
+		return decodeBinaryValue(data, binary.BigEndian, p)
+	case *int64:
+		return decodeBinaryValue(data, binary.BigEndian, p)
+	case *int:
+		return decodeBinaryValue(data, binary.BigEndian, p)
+	case *uint8:
+		return decodeBinaryValue(data, binary.BigEndian, p)
+	case *uint16:
+		return decodeBinaryValue(data, binary.BigEndian, p)
+	case *uint32:
+		return decodeBinaryValue(data, binary.BigEndian, p)
+	case *uint64:
+		return decodeBinaryValue(data, binary.BigEndian, p)
+	case *uint:
+		return decodeBinaryValue(data, binary.BigEndian, p)
+	case *float32:
+		return decodeBinaryValue(data, binary.BigEndian, p)
+	case *float64:
+		return decodeBinaryValue(data, binary.BigEndian, p)
+	case *string:
+		return decodeBinaryValue(data, binary.BigEndian, p)
+	case *[]byte:
+		return decodeBinaryValue(data, binary.BigEndian, p)
+	case *T2[bool, string]:
+		return decodeBinaryValue(data, binary.BigEndian, p)
+	}
+
+	return decodeBinaryReflect(data, binary.BigEndian, ptr.Elem())
+}
+
+func Test_appendBinaryValue_Unsupported(t *testing.T) {
+	_, err := appendBinaryValue(nil, binary.LittleEndian, []int{1})
+	require.EqualError(t, err, "unable to marshal value of type []int to binary")
+
+	_, err = appendBinaryValue[any](nil, binary.LittleEndian, nil)
+	require.EqualError(t, err, "unable to marshal nil value to binary")
+
+	_, err = appendBinaryValue(nil, binary.LittleEndian, New1([]int{1}))
+	require.Error(t, err)
+
+	_, err = appendBinaryValue(nil, binary.LittleEndian, &T2[int, int]{V1: 1, V2: 2})
+	require.EqualError(t, err, "unable to marshal value of type *tuple.T2[int,int] to binary")
+
+	_, err = appendBinaryValue(nil, binary.LittleEndian, (*T2[int, int])(nil))
+	require.EqualError(t, err, "unable to marshal value of type *tuple.T2[int,int] to binary")
+
+	_, err = appendBinaryValue[any](nil, binary.LittleEndian, &Pair[int, int]{})
+	require.EqualError(t, err, "unable to marshal value of type *tuple.Pair[int,int] to binary")
+}
+
+func Test_MarshalBinary_TuplePointers(t *testing.T) {
+	_, err := New2(&T2[int, int]{V1: 1, V2: 2}, 1).MarshalBinary()
+	require.EqualError(t, err, "value at index 0 failed to marshal: unable to marshal value of type *tuple.T2[int,int] to binary")
+
+	require.NotPanics(t, func() {
+		_, err = New2((*T2[int, int])(nil), 1).MarshalBinary()
+	})
+	require.EqualError(t, err, "value at index 0 failed to marshal: unable to marshal value of type *tuple.T2[int,int] to binary")
+
+	var unmarshalled T2[*T2[int, int], int]
+	err = unmarshalled.UnmarshalBinary([]byte{1, 0, 0, 0, 0, 0, 0, 0})
+	require.Error(t, err)
+}
+
+func Test_decodeBinaryValue_Invalid(t *testing.T) {
+	var b bool
+	_, err := decodeBinaryValue([]byte{2}, binary.LittleEndian, &b)
+	require.EqualError(t, err, "invalid binary boolean value 2")
+
+	var i int64
+	_, err = decodeBinaryValue([]byte{1, 2, 3}, binary.LittleEndian, &i)
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	var named binaryInt
+	_, err = decodeBinaryValue([]byte{1}, binary.LittleEndian, &named)
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	var s string
+	_, err = decodeBinaryValue([]byte{5, 'a'}, binary.LittleEndian, &s)
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	_, err = decodeBinaryValue([]byte{0x80}, binary.LittleEndian, &s)
+	require.EqualError(t, err, "invalid binary length prefix")
+
+	var slice []int
+	_, err = decodeBinaryValue([]byte{0}, binary.LittleEndian, &slice)
+	require.EqualError(t, err, "unable to unmarshal binary to value of type []int")
+}
+
+func Test_decodeBinaryBytes(t *testing.T) {
+	bytes, rest, err := decodeBinaryBytes([]byte{2, 'a', 'b', 'c'})
+	require.NoError(t, err)
+	require.Equal(t, []byte("ab"), bytes)
+	require.Equal(t, []byte("c"), rest)
+}
+
+func Test_unmarshalBinarySlice_Invalid(t *testing.T) {
+	_, err := unmarshalBinarySlice[T1[int8]](nil, binary.LittleEndian, nil)
+	require.EqualError(t, err, "invalid binary length prefix")
+
+	_, err = unmarshalBinarySlice[T1[int8]]([]byte{math.MaxInt8, 1}, binary.LittleEndian, nil)
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	_, err = unmarshalBinarySlice[T1[int16]]([]byte{2, 1, 2, 3}, binary.LittleEndian, nil)
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	require.EqualError(t, err, "tuple at index 1 failed to unmarshal: value at index 0 failed to unmarshal: unexpected EOF")
+
+	_, err = unmarshalBinarySlice[T1[int8]]([]byte{1, 1, 2}, binary.LittleEndian, nil)
+	require.EqualError(t, err, "binary data has 1 trailing bytes")
+}
+
+func Test_BinarySlice_Allocations(t *testing.T) {
+	tups := []T3[int64, float64, bool]{New3(int64(1), 1.5, true), New3(int64(2), 2.5, false), New3(int64(3), 3.5, true)}
+	buf, err := AppendBinarySlice3(nil, binary.LittleEndian, tups)
+	require.NoError(t, err)
+
+	dst := make([]T3[int64, float64, bool], 0, len(tups))
+	allocs := testing.AllocsPerRun(100, func() {
+		buf, err = AppendBinarySlice3(buf[:0], binary.LittleEndian, tups)
+		if err != nil {
+			panic(err)
+		}
+
+		dst, err = UnmarshalBinarySlice3(buf, binary.LittleEndian, dst[:0])
+		if err != nil {
+			panic(err)
+		}
+	})
+	require.Zero(t, allocs)
+	require.Equal(t, tups, dst)
+
+	bytesTups := []T2[uint16, []byte]{New2(uint16(1), []byte("foo")), New2(uint16(2), []byte("bar"))}
+	buf, err = AppendBinarySlice2(nil, binary.BigEndian, bytesTups)
+	require.NoError(t, err)
+
+	bytesDst, err := UnmarshalBinarySlice2[uint16, []byte](buf, binary.BigEndian, nil)
+	require.NoError(t, err)
+	allocs = testing.AllocsPerRun(100, func() {
+		bytesDst, err = UnmarshalBinarySlice2(buf, binary.BigEndian, bytesDst[:0])
+		if err != nil {
+			panic(err)
+		}
+	})
+	require.Zero(t, allocs)
+	require.Equal(t, bytesTups, bytesDst)
+}
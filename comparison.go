@@ -1,7 +1,8 @@
 package tuple
 
 import (
-	"golang.org/x/exp/constraints"
+	"cmp"
+	"reflect"
 )
 
 // OrderedComparisonResult represents the result of a tuple ordered comparison.
@@ -46,6 +47,18 @@ func (result OrderedComparisonResult) GreaterOrEqual() bool {
 	return result >= 0
 }
 
+// Reverse returns the opposite comparison result, as if the host and guest were swapped.
+func (result OrderedComparisonResult) Reverse() OrderedComparisonResult {
+	if result.LT() {
+		return 1
+	}
+	if result.GT() {
+		return -1
+	}
+
+	return 0
+}
+
 // EQ is short for Equal and returns whether the compared values are equal.
 func (result OrderedComparisonResult) EQ() bool {
 	return result.Equal()
@@ -85,13 +98,41 @@ func multiCompare(predicates ...func() OrderedComparisonResult) OrderedCompariso
 }
 
 // compareOrdered returns the comparison result between the host and guest values provided they match the Ordered constraint.
-func compareOrdered[T constraints.Ordered](host, guest T) OrderedComparisonResult {
-	if host < guest {
-		return -1
+// Floating point values are compared the same way as cmp.Compare does: NaN values are considered less than any non-NaN value,
+// and equal to each other.
+func compareOrdered[T cmp.Ordered](host, guest T) OrderedComparisonResult {
+	return OrderedComparisonResult(cmp.Compare(host, guest))
+}
+
+// CompareOrdered returns an integer comparing two values that match the Ordered constraint.
+// The result is a negative number when a < b, a positive number when a > b, and zero when a == b.
+// CompareOrdered can be used as an element comparison function of the Comparator functions.
+func CompareOrdered[T cmp.Ordered](a, b T) int {
+	return int(compareOrdered(a, b))
+}
+
+// CompareComparable returns an integer comparing two values that match the Comparable constraint.
+// The result is a negative number when a < b, a positive number when a > b, and zero when a == b.
+// CompareComparable can be used as an element comparison function of the Comparator functions.
+func CompareComparable[T Comparable[T]](a, b T) int {
+	return int(a.CompareTo(b))
+}
+
+// equalValues returns whether the host and guest values are equal.
+// equalValues tests equality using the Equalable constraint if the values implement it, then the Comparable constraint,
+// then the "==" operator if the values are comparable, and otherwise falls back to reflect.DeepEqual.
+func equalValues[T any](host, guest T) bool {
+	if equalable, ok := any(host).(Equalable[T]); ok {
+		return equalable.Equal(guest)
 	}
-	if host > guest {
-		return 1
+	if comparable, ok := any(host).(Comparable[T]); ok {
+		return comparable.CompareTo(guest).EQ()
 	}
 
-	return 0
+	// Checking the dynamic value rather than the type avoids panics on interface values holding incomparable values.
+	if reflect.ValueOf(&host).Elem().Comparable() && reflect.ValueOf(&guest).Elem().Comparable() {
+		return any(host) == any(guest)
+	}
+
+	return reflect.DeepEqual(host, guest)
 }
@@ -1,5 +1,14 @@
 package tuple
 
+import (
+	"cmp"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
 // approximationHelper is a helper type for testing type approximation.
 type approximationHelper string
 
@@ -20,3 +29,72 @@ func (i intEqualable) Equal(other intEqualable) bool {
 func (s stringComparable) CompareTo(other stringComparable) OrderedComparisonResult {
 	return compareOrdered(s, other)
 }
+
+func Test_compareOrdered(t *testing.T) {
+	require.Equal(t, OrderedComparisonResult(-1), compareOrdered(1, 2))
+	require.Equal(t, OrderedComparisonResult(1), compareOrdered("b", "a"))
+	require.Equal(t, OrderedComparisonResult(0), compareOrdered(1.5, 1.5))
+}
+
+func Test_compareOrdered_NaN(t *testing.T) {
+	nan := math.NaN()
+	require.Equal(t, OrderedComparisonResult(0), compareOrdered(nan, nan))
+	require.Equal(t, OrderedComparisonResult(-1), compareOrdered(nan, math.Inf(-1)))
+	require.Equal(t, OrderedComparisonResult(1), compareOrdered(math.Inf(-1), nan))
+}
+
+func TestCompareOrdered(t *testing.T) {
+	require.Equal(t, -1, CompareOrdered(1, 2))
+	require.Equal(t, 1, CompareOrdered("b", "a"))
+	require.Equal(t, 0, CompareOrdered(1.5, 1.5))
+}
+
+func TestCompareComparable(t *testing.T) {
+	require.Equal(t, -1, CompareComparable(stringComparable("a"), stringComparable("b")))
+	require.Equal(t, 1, CompareComparable(stringComparable("b"), stringComparable("a")))
+	require.Equal(t, 0, CompareComparable(stringComparable("a"), stringComparable("a")))
+}
+
+func TestComparator_MixedElements(t *testing.T) {
+	tups := []T3[string, stringComparable, int]{
+		New3("b", stringComparable("a"), 1),
+		New3("a", stringComparable("b"), 2),
+		New3("a", stringComparable("b"), 1),
+		New3("a", stringComparable("a"), 3),
+	}
+
+	slices.SortFunc(tups, Comparator3(cmp.Compare[string], CompareComparable[stringComparable], CompareOrdered[int]))
+	require.Equal(t, []T3[string, stringComparable, int]{
+		New3("a", stringComparable("a"), 3),
+		New3("a", stringComparable("b"), 1),
+		New3("a", stringComparable("b"), 2),
+		New3("b", stringComparable("a"), 1),
+	}, tups)
+}
+
+func TestOrderedComparisonResult_Reverse(t *testing.T) {
+	require.Equal(t, OrderedComparisonResult(1), OrderedComparisonResult(-5).Reverse())
+	require.Equal(t, OrderedComparisonResult(-1), OrderedComparisonResult(3).Reverse())
+	require.Equal(t, OrderedComparisonResult(0), OrderedComparisonResult(0).Reverse())
+}
+
+func Test_equalValues(t *testing.T) {
+	require.True(t, equalValues(1, 1))
+	require.False(t, equalValues(1, 2))
+	require.True(t, equalValues(intEqualable(1), intEqualable(1)))
+	require.False(t, equalValues(intEqualable(1), intEqualable(2)))
+	require.True(t, equalValues(stringComparable("a"), stringComparable("a")))
+	require.False(t, equalValues(stringComparable("a"), stringComparable("b")))
+	require.True(t, equalValues([]int{1, 2}, []int{1, 2}))
+	require.False(t, equalValues([]int{1, 2}, []int{1, 3}))
+	require.True(t, equalValues(map[string]int{"a": 1}, map[string]int{"a": 1}))
+	require.False(t, equalValues(math.NaN(), math.NaN()))
+}
+
+func Test_equalValues_Interface(t *testing.T) {
+	require.True(t, equalValues[any](nil, nil))
+	require.False(t, equalValues[any](nil, 1))
+	require.True(t, equalValues[any]([]int{1}, []int{1}))
+	require.False(t, equalValues[any]([]int{1}, 1))
+	require.True(t, equalValues[any](1, 1))
+}
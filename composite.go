@@ -0,0 +1,222 @@
+package tuple
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+// compositeMarshaler is implemented by tuples, enabling marshalling nested tuples as nested composite values.
+type compositeMarshaler interface {
+	MarshalComposite() ([]byte, error)
+}
+
+// compositeUnmarshaler is implemented by tuple pointers, enabling unmarshalling nested tuples from nested composite values.
+type compositeUnmarshaler interface {
+	UnmarshalComposite(data []byte) error
+}
+
+// marshalComposite marshals the values into a PostgreSQL composite literal, such as (1,"foo bar",).
+func marshalComposite(values []any) ([]byte, error) {
+	var b strings.Builder
+	b.WriteByte('(')
+	for index, value := range values {
+		if index > 0 {
+			b.WriteByte(',')
+		}
+
+		field, err := marshalCompositeValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("value at index %d failed to marshal: %w", index, err)
+		}
+
+		// NULL values are represented by an empty field.
+		if field != nil {
+			b.WriteString(quoteCompositeField(*field))
+		}
+	}
+	b.WriteByte(')')
+
+	return []byte(b.String()), nil
+}
+
+// unmarshalComposite unmarshals a PostgreSQL composite literal into the values pointed by ptrs in the same order.
+func unmarshalComposite(data []byte, ptrs []any) error {
+	fields, err := splitComposite(string(data))
+	if err != nil {
+		return fmt.Errorf("unable to unmarshal composite for tuple: %w", err)
+	}
+
+	if len(fields) != len(ptrs) {
+		return &LengthMismatchError{Source: "composite fields", Expected: len(ptrs), Actual: len(fields), count: true}
+	}
+
+	for index, field := range fields {
+		if err := unmarshalCompositeValue(field, ptrs[index]); err != nil {
+			var value string
+			if field != nil {
+				value = *field
+			}
+			return &ElementUnmarshalError{Index: index, Value: value, Err: err, location: locationFieldIndex}
+		}
+	}
+
+	return nil
+}
+
+// marshalCompositeValue returns the unquoted composite field of a single tuple value, or nil if the value is NULL.
+// Nil values and pointers are NULL, and non-nil pointers are marshalled the same way as the values they point to.
+// Nested tuples are marshalled as nested composite values, and other values are marshalled the same way as MarshalText does.
+func marshalCompositeValue(v any) (*string, error) {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() || (rv.Kind() == reflect.Pointer && rv.IsNil()) {
+		return nil, nil
+	}
+
+	var field string
+	var err error
+	switch marshaler := v.(type) {
+	case compositeMarshaler:
+		var data []byte
+		data, err = marshaler.MarshalComposite()
+		field = string(data)
+	case encoding.TextMarshaler:
+		field, err = marshalTextValue(v)
+	default:
+		if rv.Kind() == reflect.Pointer {
+			return marshalCompositeValue(rv.Elem().Interface())
+		}
+		field, err = marshalTextValue(v)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &field, nil
+}
+
+// unmarshalCompositeValue unmarshals a single unquoted composite field into the value pointed by ptr.
+// NULL fields, represented by nil, can only be unmarshalled into pointers, interfaces, slices and maps, which are set to nil.
+func unmarshalCompositeValue(field *string, ptr any) error {
+	rv := reflect.ValueOf(ptr).Elem()
+	if field == nil {
+		switch rv.Kind() {
+		case reflect.Pointer, reflect.Interface, reflect.Slice, reflect.Map:
+			rv.Set(reflect.Zero(rv.Type()))
+			return nil
+		}
+		return fmt.Errorf("unable to unmarshal NULL into value of type %s", rv.Type())
+	}
+
+	switch unmarshaler := ptr.(type) {
+	case compositeUnmarshaler:
+		return unmarshaler.UnmarshalComposite([]byte(*field))
+	case encoding.TextUnmarshaler:
+		return unmarshalTextValue(*field, ptr)
+	}
+
+	switch {
+	case rv.Kind() == reflect.Pointer:
+		value := reflect.New(rv.Type().Elem())
+		if err := unmarshalCompositeValue(field, value.Interface()); err != nil {
+			return err
+		}
+		rv.Set(value)
+		return nil
+	case rv.Kind() == reflect.Interface && rv.NumMethod() == 0:
+		rv.Set(reflect.ValueOf(*field))
+		return nil
+	}
+
+	return unmarshalTextValue(*field, ptr)
+}
+
+// quoteCompositeField quotes a composite field the same way PostgreSQL does.
+// Fields that are empty, or hold whitespace or any of the characters "\(), are surrounded by double quotes,
+// and double quotes and backslashes within them are doubled.
+func quoteCompositeField(field string) string {
+	needsQuotes := field == "" || strings.IndexFunc(field, func(r rune) bool {
+		return strings.ContainsRune(`"\(),`, r) || unicode.IsSpace(r)
+	}) >= 0
+	if !needsQuotes {
+		return field
+	}
+
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range field {
+		if r == '"' || r == '\\' {
+			b.WriteRune(r)
+		}
+		b.WriteRune(r)
+	}
+	b.WriteByte('"')
+
+	return b.String()
+}
+
+// splitComposite splits a PostgreSQL composite literal into its unquoted fields, with nil fields representing NULL.
+// Within double quotes, doubled double quotes represent a single double quote.
+// A backslash represents the character following it, both within and outside of double quotes.
+func splitComposite(s string) ([]*string, error) {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "(") {
+		return nil, errors.New("malformed composite literal: missing left parenthesis")
+	}
+
+	var fields []*string
+	i := 1
+	for {
+		var b strings.Builder
+		present := false
+		quoted := false
+		for ; i < len(s); i++ {
+			c := s[i]
+			if !quoted && (c == ',' || c == ')') {
+				break
+			}
+
+			present = true
+			switch {
+			case c == '\\':
+				i++
+				if i == len(s) {
+					return nil, errors.New("malformed composite literal: unexpected end of input")
+				}
+				b.WriteByte(s[i])
+			case c == '"' && quoted && i+1 < len(s) && s[i+1] == '"':
+				i++
+				b.WriteByte('"')
+			case c == '"':
+				quoted = !quoted
+			default:
+				b.WriteByte(c)
+			}
+		}
+
+		if i == len(s) {
+			return nil, errors.New("malformed composite literal: unexpected end of input")
+		}
+
+		if present {
+			field := b.String()
+			fields = append(fields, &field)
+		} else {
+			fields = append(fields, nil)
+		}
+
+		if s[i] == ')' {
+			break
+		}
+		i++
+	}
+
+	if i != len(s)-1 {
+		return nil, errors.New("malformed composite literal: junk after right parenthesis")
+	}
+
+	return fields, nil
+}
@@ -0,0 +1,114 @@
+package tuple
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_quoteCompositeField(t *testing.T) {
+	tests := []struct {
+		field string
+		want  string
+	}{
+		{field: "foo", want: `foo`},
+		{field: "", want: `""`},
+		{field: "foo bar", want: `"foo bar"`},
+		{field: "a,b", want: `"a,b"`},
+		{field: "(1,2)", want: `"(1,2)"`},
+		{field: `say "hi"`, want: `"say ""hi"""`},
+		{field: `back\slash`, want: `"back\\slash"`},
+		{field: "tab\t", want: "\"tab\t\""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			require.Equal(t, tt.want, quoteCompositeField(tt.field))
+		})
+	}
+}
+
+func Test_splitComposite(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    []*string
+		wantErr bool
+	}{
+		{name: "single null", s: `()`, want: []*string{nil}},
+		{name: "unquoted", s: `(1,foo)`, want: []*string{ptr("1"), ptr("foo")}},
+		{name: "nulls", s: `(1,,)`, want: []*string{ptr("1"), nil, nil}},
+		{name: "empty string", s: `("",)`, want: []*string{ptr(""), nil}},
+		{name: "quoted", s: `(1,"foo bar")`, want: []*string{ptr("1"), ptr("foo bar")}},
+		{name: "doubled quotes", s: `("say ""hi""")`, want: []*string{ptr(`say "hi"`)}},
+		{name: "backslash within quotes", s: `("a\\b\"c")`, want: []*string{ptr(`a\b"c`)}},
+		{name: "backslash outside quotes", s: `(a\,b)`, want: []*string{ptr("a,b")}},
+		{name: "mixed quoting", s: `(a"b c"d)`, want: []*string{ptr("ab cd")}},
+		{name: "nested composite", s: `(1,"(2,""x y"")")`, want: []*string{ptr("1"), ptr(`(2,"x y")`)}},
+		{name: "surrounding whitespace", s: " (1,2) \n", want: []*string{ptr("1"), ptr("2")}},
+		{name: "empty", s: ``, wantErr: true},
+		{name: "missing left parenthesis", s: `1,2)`, wantErr: true},
+		{name: "missing right parenthesis", s: `(1,2`, wantErr: true},
+		{name: "unterminated quotes", s: `("1,2)`, wantErr: true},
+		{name: "dangling backslash", s: `(1\`, wantErr: true},
+		{name: "junk after right parenthesis", s: `(1,2)3`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := splitComposite(tt.s)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_marshalComposite(t *testing.T) {
+	got, err := marshalComposite([]any{1, "foo bar", nil, (*int)(nil), ptr(2.5), true, net.IPv4(127, 0, 0, 1), New2("x y", 3)})
+	require.NoError(t, err)
+	require.Equal(t, `(1,"foo bar",,,2.5,true,127.0.0.1,"(""x y"",3)")`, string(got))
+
+	_, err = marshalComposite([]any{[]int{1}})
+	require.Error(t, err)
+}
+
+func Test_unmarshalComposite(t *testing.T) {
+	var id int
+	var name string
+	var nullable *int
+	var rating *float64
+	var active bool
+	var ip net.IP
+	var iface any
+	var nested T2[string, int]
+
+	ptrs := []any{&id, &name, &nullable, &rating, &active, &ip, &iface, &nested}
+	err := unmarshalComposite([]byte(`(1,"foo bar",,2.5,t,127.0.0.1,baz,"(""x y"",3)")`), ptrs)
+	require.NoError(t, err)
+	require.Equal(t, 1, id)
+	require.Equal(t, "foo bar", name)
+	require.Nil(t, nullable)
+	require.Equal(t, ptr(2.5), rating)
+	require.True(t, active)
+	require.Equal(t, net.IPv4(127, 0, 0, 1), ip)
+	require.Equal(t, "baz", iface)
+	require.Equal(t, New2("x y", 3), nested)
+
+	err = unmarshalComposite([]byte(`(,2)`), []any{&id, &nullable})
+	var elementErr *ElementUnmarshalError
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, 0, elementErr.Index)
+
+	err = unmarshalComposite([]byte(`(1,2)`), []any{&id})
+	require.EqualError(t, err, "composite fields count 2 must match number of tuple values 1")
+
+	err = unmarshalComposite([]byte(`(foo)`), []any{&id})
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, "foo", elementErr.Value)
+}
@@ -0,0 +1,293 @@
+package tuple
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// ElementConversionError is returned when a value a tuple is created from has a type that can be converted to the type
+// of the matching tuple value, but the conversion of the value itself fails.
+// For example, when a numeric value overflows the numeric type of the tuple value.
+type ElementConversionError struct {
+	// Source describes the values the tuple is created from, such as "slice".
+	Source string
+	// Index is the index of the value within the source.
+	Index int
+	// Value is the value that failed to convert.
+	Value any
+	// Expected is the type of the tuple value.
+	Expected reflect.Type
+	// Err is the underlying conversion error.
+	Err error
+}
+
+// Error returns the error message.
+func (e *ElementConversionError) Error() string {
+	return fmt.Sprintf("value %v at %s index %d failed to convert to type %s: %v", e.Value, e.Source, e.Index, e.Expected, e.Err)
+}
+
+// Unwrap returns the underlying conversion error.
+func (e *ElementConversionError) Unwrap() error {
+	return e.Err
+}
+
+var (
+	jsonNumberType      = reflect.TypeOf(json.Number(""))
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// convertSliceValue converts the value at the given slice index into the value pointed by ptr.
+// A *ElementTypeError is returned if the value type can not be converted to the type of the pointed value,
+// and a *ElementConversionError is returned if the conversion of the value fails.
+func convertSliceValue[T any](index int, value any, ptr *T) error {
+	target := reflect.ValueOf(ptr).Elem()
+	converted, err := convertValue(value, target)
+	if err != nil {
+		return &ElementConversionError{Source: "slice", Index: index, Value: value, Expected: target.Type(), Err: err}
+	}
+	if !converted {
+		return newElementTypeError[T]("slice", index, value)
+	}
+
+	return nil
+}
+
+// convertValue converts value into the settable target.
+// The returned bool reports whether the value type can be converted to the target type at all.
+func convertValue(value any, target reflect.Value) (bool, error) {
+	if value == nil {
+		switch target.Kind() {
+		case reflect.Pointer, reflect.Interface, reflect.Slice, reflect.Map, reflect.Chan, reflect.Func:
+			target.Set(reflect.Zero(target.Type()))
+			return true, nil
+		}
+		return false, nil
+	}
+
+	source := reflect.ValueOf(value)
+	if source.Type() == target.Type() {
+		target.Set(source)
+		return true, nil
+	}
+
+	// Text unmarshalers take precedence over assignability, since types such as net.IP are assignable from byte slices.
+	text, isText := textValue(source)
+	if isText && reflect.PointerTo(target.Type()).Implements(textUnmarshalerType) {
+		return true, target.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(text))
+	}
+
+	if source.Type().AssignableTo(target.Type()) {
+		target.Set(source)
+		return true, nil
+	}
+
+	if source.Type() == jsonNumberType && isNumericKind(target.Kind()) {
+		return true, convertNumberText(source.String(), target)
+	}
+
+	switch {
+	case isNumericKind(source.Kind()) && isNumericKind(target.Kind()):
+		return true, convertNumber(source, target)
+	case isText && target.Kind() == reflect.String:
+		target.SetString(text)
+		return true, nil
+	case isText && isBytesType(target.Type()):
+		target.SetBytes([]byte(text))
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// textValue returns the text held by a string or a byte slice value.
+func textValue(v reflect.Value) (string, bool) {
+	switch {
+	case v.Kind() == reflect.String:
+		return v.String(), true
+	case isBytesType(v.Type()):
+		return string(v.Bytes()), true
+	}
+
+	return "", false
+}
+
+// isBytesType returns whether the type is a slice of bytes.
+func isBytesType(t reflect.Type) bool {
+	return t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8
+}
+
+// isNumericKind returns whether the kind is an integer or a floating-point number kind.
+func isNumericKind(kind reflect.Kind) bool {
+	return isIntKind(kind) || isUintKind(kind) || kind == reflect.Float32 || kind == reflect.Float64
+}
+
+// isIntKind returns whether the kind is a signed integer kind.
+func isIntKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+
+	return false
+}
+
+// isUintKind returns whether the kind is an unsigned integer kind.
+func isUintKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+
+	return false
+}
+
+// convertNumberText parses the number text into the numeric target, failing if it doesn't fit the target type.
+// Integer targets accept floating-point text, such as 1e3 or 1.0, if it holds a whole number,
+// the same way as convertNumber converts floating-point values.
+func convertNumberText(text string, target reflect.Value) error {
+	bits := target.Type().Bits()
+	switch kind := target.Kind(); {
+	case isIntKind(kind):
+		n, err := strconv.ParseInt(text, 10, bits)
+		if err != nil {
+			return convertFloatText(text, err, target)
+		}
+		target.SetInt(n)
+	case isUintKind(kind):
+		n, err := strconv.ParseUint(text, 10, bits)
+		if err != nil {
+			return convertFloatText(text, err, target)
+		}
+		target.SetUint(n)
+	default:
+		f, err := strconv.ParseFloat(text, bits)
+		if err != nil {
+			return err
+		}
+		target.SetFloat(f)
+	}
+
+	return nil
+}
+
+// convertFloatText parses the number text as a floating-point number and converts it into the integer target,
+// returning intErr, the error of parsing the text as an integer, if the text is not a floating-point number either.
+func convertFloatText(text string, intErr error, target reflect.Value) error {
+	f, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return intErr
+	}
+
+	return convertNumber(reflect.ValueOf(f), target)
+}
+
+// convertNumber converts the numeric source into the numeric target, failing if the source value doesn't fit the target type.
+// Floating-point values are converted to integers only if they hold whole numbers,
+// and integers are converted to floating-point numbers only if they are represented exactly.
+func convertNumber(source, target reflect.Value) error {
+	switch kind := target.Kind(); {
+	case isIntKind(kind):
+		n, err := numberToInt64(source)
+		if err != nil {
+			return err
+		}
+		if target.OverflowInt(n) {
+			return fmt.Errorf("value overflows type %s", target.Type())
+		}
+		target.SetInt(n)
+	case isUintKind(kind):
+		n, err := numberToUint64(source)
+		if err != nil {
+			return err
+		}
+		if target.OverflowUint(n) {
+			return fmt.Errorf("value overflows type %s", target.Type())
+		}
+		target.SetUint(n)
+	default:
+		f, err := numberToFloat64(source, target.Type().Bits())
+		if err != nil {
+			return err
+		}
+		if target.OverflowFloat(f) {
+			return fmt.Errorf("value overflows type %s", target.Type())
+		}
+		target.SetFloat(f)
+	}
+
+	return nil
+}
+
+// numberToInt64 converts a numeric value to int64, failing if the value doesn't fit.
+func numberToInt64(v reflect.Value) (int64, error) {
+	switch kind := v.Kind(); {
+	case isIntKind(kind):
+		return v.Int(), nil
+	case isUintKind(kind):
+		if v.Uint() > math.MaxInt64 {
+			return 0, fmt.Errorf("value overflows type int64")
+		}
+		return int64(v.Uint()), nil
+	default:
+		f := v.Float()
+		if f != math.Trunc(f) {
+			return 0, fmt.Errorf("value is not a whole number")
+		}
+		if f < math.MinInt64 || f >= math.MaxInt64 {
+			return 0, fmt.Errorf("value overflows type int64")
+		}
+		return int64(f), nil
+	}
+}
+
+// numberToUint64 converts a numeric value to uint64, failing if the value doesn't fit.
+func numberToUint64(v reflect.Value) (uint64, error) {
+	switch kind := v.Kind(); {
+	case isIntKind(kind):
+		if v.Int() < 0 {
+			return 0, fmt.Errorf("negative value overflows type uint64")
+		}
+		return uint64(v.Int()), nil
+	case isUintKind(kind):
+		return v.Uint(), nil
+	default:
+		f := v.Float()
+		if f != math.Trunc(f) {
+			return 0, fmt.Errorf("value is not a whole number")
+		}
+		if f < 0 || f >= math.MaxUint64 {
+			return 0, fmt.Errorf("value overflows type uint64")
+		}
+		return uint64(f), nil
+	}
+}
+
+// numberToFloat64 converts a numeric value to float64, failing if an integer value
+// is not represented exactly by a floating-point number of the given bit size.
+func numberToFloat64(v reflect.Value, bits int) (float64, error) {
+	var f float64
+	var exact bool
+	switch kind := v.Kind(); {
+	case isIntKind(kind):
+		f = float64(v.Int())
+		exact = f < math.MaxInt64 && int64(f) == v.Int()
+	case isUintKind(kind):
+		f = float64(v.Uint())
+		exact = f < math.MaxUint64 && uint64(f) == v.Uint()
+	default:
+		return v.Float(), nil
+	}
+
+	if bits == 32 && float64(float32(f)) != f {
+		exact = false
+	}
+	if !exact {
+		return 0, fmt.Errorf("value is not represented exactly by a %d-bit floating-point number", bits)
+	}
+
+	return f, nil
+}
@@ -0,0 +1,117 @@
+package tuple
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type convertName string
+
+func Test_convertValue(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       any
+		target      any
+		want        any
+		wantInvalid bool
+		wantErr     bool
+	}{
+		{name: "same type", value: 5, target: new(int), want: 5},
+		{name: "interface target", value: 5, target: new(any), want: 5},
+		{name: "nil to pointer", value: nil, target: new(*int), want: (*int)(nil)},
+		{name: "nil to slice", value: nil, target: new([]int), want: []int(nil)},
+		{name: "nil to int", value: nil, target: new(int), wantInvalid: true},
+		{name: "float to int", value: float64(42), target: new(int), want: 42},
+		{name: "negative float to int8", value: float64(-128), target: new(int8), want: int8(-128)},
+		{name: "fractional float to int", value: 1.5, target: new(int), wantErr: true},
+		{name: "nan to int", value: math.NaN(), target: new(int), wantErr: true},
+		{name: "infinity to int", value: math.Inf(1), target: new(int64), wantErr: true},
+		{name: "large float to int64", value: float64(math.MaxInt64), target: new(int64), wantErr: true},
+		{name: "float overflows int8", value: float64(128), target: new(int8), wantErr: true},
+		{name: "int to int8", value: 127, target: new(int8), want: int8(127)},
+		{name: "int overflows int8", value: 128, target: new(int8), wantErr: true},
+		{name: "int to uint", value: 5, target: new(uint), want: uint(5)},
+		{name: "negative int to uint", value: -1, target: new(uint), wantErr: true},
+		{name: "negative float to uint", value: float64(-1), target: new(uint32), wantErr: true},
+		{name: "large uint to int64", value: uint64(math.MaxUint64), target: new(int64), wantErr: true},
+		{name: "uint overflows uint8", value: uint(256), target: new(uint8), wantErr: true},
+		{name: "int to float64", value: 5, target: new(float64), want: float64(5)},
+		{name: "inexact int to float64", value: int64(1<<53 + 1), target: new(float64), wantErr: true},
+		{name: "inexact int to float32", value: int64(1<<24 + 1), target: new(float32), wantErr: true},
+		{name: "float64 to float32", value: 1.5, target: new(float32), want: float32(1.5)},
+		{name: "float64 overflows float32", value: math.MaxFloat64, target: new(float32), wantErr: true},
+		{name: "named numeric type", value: float64(5), target: new(time32), want: time32(5)},
+		{name: "json number to int", value: json.Number("42"), target: new(int), want: 42},
+		{name: "json number to uint8", value: json.Number("255"), target: new(uint8), want: uint8(255)},
+		{name: "json number overflows uint8", value: json.Number("256"), target: new(uint8), wantErr: true},
+		{name: "fractional json number to int", value: json.Number("1.5"), target: new(int), wantErr: true},
+		{name: "exponent json number to int", value: json.Number("1e3"), target: new(int), want: 1000},
+		{name: "whole decimal json number to int", value: json.Number("1.0"), target: new(int), want: 1},
+		{name: "exponent json number to uint16", value: json.Number("1e3"), target: new(uint16), want: uint16(1000)},
+		{name: "exponent json number overflows int8", value: json.Number("1e3"), target: new(int8), wantErr: true},
+		{name: "negative exponent json number to uint", value: json.Number("-1e3"), target: new(uint), wantErr: true},
+		{name: "invalid json number to int", value: json.Number("x"), target: new(int), wantErr: true},
+		{name: "json number to float", value: json.Number("1.5"), target: new(float64), want: 1.5},
+		{name: "json number to string", value: json.Number("1.5"), target: new(string), want: "1.5"},
+		{name: "string to bytes", value: "foo", target: new([]byte), want: []byte("foo")},
+		{name: "bytes to string", value: []byte("foo"), target: new(string), want: "foo"},
+		{name: "string to named string", value: "foo", target: new(convertName), want: convertName("foo")},
+		{name: "string to text unmarshaler", value: "127.0.0.1", target: new(net.IP), want: net.IPv4(127, 0, 0, 1)},
+		{name: "bytes to text unmarshaler", value: []byte("127.0.0.1"), target: new(net.IP), want: net.IPv4(127, 0, 0, 1)},
+		{name: "invalid text to text unmarshaler", value: "foo", target: new(net.IP), wantErr: true},
+		{name: "string to int", value: "5", target: new(int), wantInvalid: true},
+		{name: "bool to int", value: true, target: new(int), wantInvalid: true},
+		{name: "int to string", value: 5, target: new(string), wantInvalid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := reflect.ValueOf(tt.target).Elem()
+			converted, err := convertValue(tt.value, target)
+			if tt.wantInvalid {
+				require.False(t, converted)
+				return
+			}
+
+			require.True(t, converted)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, target.Interface())
+		})
+	}
+}
+
+type time32 int32
+
+func Test_convertSliceValue(t *testing.T) {
+	var v int8
+	require.NoError(t, convertSliceValue(1, float64(5), &v))
+	require.Equal(t, int8(5), v)
+
+	err := convertSliceValue(1, "5", &v)
+	var typeErr *ElementTypeError
+	require.ErrorAs(t, err, &typeErr)
+	require.EqualError(t, err, "value at slice index 1 expected to have type int8 but has type string")
+
+	err = convertSliceValue(1, float64(500), &v)
+	var conversionErr *ElementConversionError
+	require.ErrorAs(t, err, &conversionErr)
+	require.Equal(t, &ElementConversionError{
+		Source:   "slice",
+		Index:    1,
+		Value:    float64(500),
+		Expected: reflect.TypeOf(int8(0)),
+		Err:      errors.Unwrap(err),
+	}, conversionErr)
+	require.EqualError(t, err, "value 500 at slice index 1 failed to convert to type int8: value overflows type int8")
+}
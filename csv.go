@@ -0,0 +1,175 @@
+package tuple
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// CSVOptions configures the way tuples are read from and written to CSV records, using encoding/csv.
+type CSVOptions struct {
+	// Comma is the field delimiter. If zero, a comma is used.
+	Comma rune
+
+	// Header holds the column names of the CSV records.
+	// If not empty, WriteCSV functions write it as the first record, and ReadCSV functions require the first record to match it.
+	Header []string
+
+	// SkipHeader makes ReadCSV functions skip the first record without checking it, when Header is empty.
+	SkipHeader bool
+}
+
+// CSVError is returned when a CSV record fails to convert to a tuple, or a tuple fails to convert to a CSV record.
+// Errors returned by encoding/csv while parsing the records are returned as *csv.ParseError instead.
+type CSVError struct {
+	// Line is the line number of the record, starting at 1.
+	Line int
+	// Column is the number of the field within the record, starting at 1, or 0 if the error refers to the whole record.
+	Column int
+	// Err is the underlying error.
+	Err error
+}
+
+// Error returns the error message.
+func (e *CSVError) Error() string {
+	if e.Column == 0 {
+		return fmt.Sprintf("csv record on line %d: %v", e.Line, e.Err)
+	}
+
+	return fmt.Sprintf("csv record on line %d, column %d: %v", e.Line, e.Column, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *CSVError) Unwrap() error {
+	return e.Err
+}
+
+// newCSVReader returns a csv.Reader reading records of the given number of fields, and reads the header according to opts.
+func newCSVReader(r io.Reader, opts CSVOptions, fields int) (*csv.Reader, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = fields
+	reader.ReuseRecord = true
+	if opts.Comma != 0 {
+		reader.Comma = opts.Comma
+	}
+
+	if len(opts.Header) == 0 && !opts.SkipHeader {
+		return reader, nil
+	}
+
+	if len(opts.Header) > 0 && len(opts.Header) != fields {
+		return nil, &LengthMismatchError{Source: "csv header", Expected: fields, Actual: len(opts.Header), count: true}
+	}
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("csv header is missing")
+		}
+		return nil, err
+	}
+
+	if len(opts.Header) > 0 && !slices.Equal(header, opts.Header) {
+		line, _ := reader.FieldPos(0)
+		return nil, &CSVError{Line: line, Err: fmt.Errorf("header %q must match %q", header, opts.Header)}
+	}
+
+	return reader, nil
+}
+
+// readCSVRecord reads the next record of reader into the values pointed by ptrs in the same order.
+// io.EOF is returned once there are no more records.
+func readCSVRecord(reader *csv.Reader, ptrs []any) error {
+	record, err := reader.Read()
+	if err != nil {
+		return err
+	}
+
+	for index, field := range record {
+		if err := unmarshalTextValue(field, ptrs[index]); err != nil {
+			line, _ := reader.FieldPos(index)
+			return &CSVError{
+				Line:   line,
+				Column: index + 1,
+				Err:    &ElementUnmarshalError{Index: index, Value: field, Err: err, location: locationIndex},
+			}
+		}
+	}
+
+	return nil
+}
+
+// readCSV reads all the records of r into tuples, with ptrs returning pointers to the values of a tuple.
+func readCSV[T any](r io.Reader, opts CSVOptions, ptrs func(t *T) []any) ([]T, error) {
+	var t T
+	reader, err := newCSVReader(r, opts, len(ptrs(&t)))
+	if err != nil {
+		return nil, err
+	}
+
+	var result []T
+	for {
+		var t T
+		if err := readCSVRecord(reader, ptrs(&t)); err != nil {
+			if errors.Is(err, io.EOF) {
+				return result, nil
+			}
+			return nil, err
+		}
+
+		result = append(result, t)
+	}
+}
+
+// writeCSV writes the tuples into w as CSV records, with values returning the values of a tuple.
+func writeCSV[T any](w io.Writer, tuples []T, opts CSVOptions, values func(t T) []any) error {
+	writer := csv.NewWriter(w)
+	if opts.Comma != 0 {
+		writer.Comma = opts.Comma
+	}
+
+	var t T
+	fields := len(values(t))
+	line := 1
+	if len(opts.Header) > 0 {
+		if len(opts.Header) != fields {
+			return &LengthMismatchError{Source: "csv header", Expected: fields, Actual: len(opts.Header), count: true}
+		}
+		if err := writer.Write(opts.Header); err != nil {
+			return err
+		}
+		line += csvRecordLines(opts.Header)
+	}
+
+	record := make([]string, fields)
+	for _, t := range tuples {
+		for index, value := range values(t) {
+			field, err := marshalTextValue(value)
+			if err != nil {
+				return &CSVError{Line: line, Column: index + 1, Err: fmt.Errorf("value at index %d failed to marshal: %w", index, err)}
+			}
+			record[index] = field
+		}
+
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+		line += csvRecordLines(record)
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// csvRecordLines returns the number of lines a record spans, as fields holding newlines span multiple lines.
+func csvRecordLines(record []string) int {
+	lines := 1
+	for _, field := range record {
+		lines += strings.Count(field, "\n")
+	}
+
+	return lines
+}
@@ -0,0 +1,46 @@
+//go:build go1.23
+
+package tuple
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"iter"
+)
+
+// readCSVSeq returns an iterator over the records of r converted into tuples, with ptrs returning pointers to the values of a tuple.
+// Records that fail to parse or convert are yielded along with their errors, and the iteration continues with the next record.
+// Other errors, such as read errors, are yielded once and stop the iteration.
+func readCSVSeq[T any](r io.Reader, opts CSVOptions, ptrs func(t *T) []any) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		reader, err := newCSVReader(r, opts, len(ptrs(&zero)))
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+
+		for {
+			var t T
+			err := readCSVRecord(reader, ptrs(&t))
+			if errors.Is(err, io.EOF) {
+				return
+			}
+
+			var parseErr *csv.ParseError
+			var csvErr *CSVError
+			if err != nil && !errors.As(err, &parseErr) && !errors.As(err, &csvErr) {
+				yield(zero, err)
+				return
+			}
+
+			if err != nil {
+				t = zero
+			}
+			if !yield(t, err) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,75 @@
+//go:build go1.23
+
+package tuple
+
+import (
+	"encoding/csv"
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_readCSVSeq(t *testing.T) {
+	ptrs := func(t *T2[string, int]) []any {
+		return []any{&t.V1, &t.V2}
+	}
+
+	var got []T2[string, int]
+	var errs []error
+	for tup, err := range readCSVSeq(strings.NewReader("a,1\nb,x\nc\nd,4\n"), CSVOptions{}, ptrs) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		got = append(got, tup)
+	}
+
+	require.Equal(t, []T2[string, int]{New2("a", 1), New2("d", 4)}, got)
+	require.Len(t, errs, 2)
+
+	var csvErr *CSVError
+	require.ErrorAs(t, errs[0], &csvErr)
+	require.Equal(t, 2, csvErr.Line)
+	require.Equal(t, 2, csvErr.Column)
+
+	var parseErr *csv.ParseError
+	require.ErrorAs(t, errs[1], &parseErr)
+	require.Equal(t, 3, parseErr.Line)
+}
+
+func Test_readCSVSeq_Stop(t *testing.T) {
+	ptrs := func(t *T1[string]) []any {
+		return []any{&t.V1}
+	}
+
+	count := 0
+	for range readCSVSeq(strings.NewReader("a\nb\nc\n"), CSVOptions{}, ptrs) {
+		count++
+		if count == 2 {
+			break
+		}
+	}
+	require.Equal(t, 2, count)
+}
+
+func Test_readCSVSeq_ReadError(t *testing.T) {
+	ptrs := func(t *T1[string]) []any {
+		return []any{&t.V1}
+	}
+
+	readErr := errors.New("read failed")
+	var errs []error
+	for _, err := range readCSVSeq(iotest.ErrReader(readErr), CSVOptions{}, ptrs) {
+		errs = append(errs, err)
+	}
+	require.Equal(t, []error{readErr}, errs)
+
+	errs = nil
+	for _, err := range readCSVSeq(strings.NewReader("a\n"), CSVOptions{Header: []string{"b"}}, ptrs) {
+		errs = append(errs, err)
+	}
+	require.Len(t, errs, 1)
+}
@@ -0,0 +1,76 @@
+package tuple
+
+import (
+	"encoding/csv"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSVError_Error(t *testing.T) {
+	inner := errors.New("inner")
+
+	err := &CSVError{Line: 2, Column: 3, Err: inner}
+	require.EqualError(t, err, "csv record on line 2, column 3: inner")
+	require.ErrorIs(t, err, inner)
+
+	err = &CSVError{Line: 2, Err: inner}
+	require.EqualError(t, err, "csv record on line 2: inner")
+}
+
+func Test_newCSVReader(t *testing.T) {
+	_, err := newCSVReader(strings.NewReader(""), CSVOptions{Header: []string{"a"}}, 2)
+	require.EqualError(t, err, "csv header count 1 must match number of tuple values 2")
+
+	_, err = newCSVReader(strings.NewReader(""), CSVOptions{SkipHeader: true}, 2)
+	require.EqualError(t, err, "csv header is missing")
+
+	_, err = newCSVReader(strings.NewReader("a,c\n"), CSVOptions{Header: []string{"a", "b"}}, 2)
+	require.EqualError(t, err, `csv record on line 1: header ["a" "c"] must match ["a" "b"]`)
+
+	reader, err := newCSVReader(strings.NewReader("a;b\n1;2\n"), CSVOptions{Header: []string{"a", "b"}, Comma: ';'}, 2)
+	require.NoError(t, err)
+	record, err := reader.Read()
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "2"}, record)
+}
+
+func Test_readCSV_Lines(t *testing.T) {
+	ptrs := func(t *T2[string, int]) []any {
+		return []any{&t.V1, &t.V2}
+	}
+
+	_, err := readCSV(strings.NewReader("\"multi\nline\",1\nfoo,bar\n"), CSVOptions{}, ptrs)
+	var csvErr *CSVError
+	require.ErrorAs(t, err, &csvErr)
+	require.Equal(t, 3, csvErr.Line)
+	require.Equal(t, 2, csvErr.Column)
+	require.EqualError(t, err, `csv record on line 3, column 2: value "bar" at index 1 failed to unmarshal: strconv.ParseInt: parsing "bar": invalid syntax`)
+
+	_, err = readCSV(strings.NewReader("foo,1\nbar\n"), CSVOptions{}, ptrs)
+	var parseErr *csv.ParseError
+	require.ErrorAs(t, err, &parseErr)
+	require.ErrorIs(t, err, csv.ErrFieldCount)
+	require.Equal(t, 2, parseErr.Line)
+}
+
+func Test_writeCSV_Lines(t *testing.T) {
+	tups := []T2[string, any]{
+		New2[string, any]("multi\nline", 1),
+		New2[string, any]("foo", []int{}),
+	}
+
+	var buf strings.Builder
+	err := writeCSV(&buf, tups, CSVOptions{Header: []string{"a", "b"}}, T2[string, any].Slice)
+	var csvErr *CSVError
+	require.ErrorAs(t, err, &csvErr)
+	require.Equal(t, 4, csvErr.Line)
+	require.Equal(t, 2, csvErr.Column)
+}
+
+func Test_csvRecordLines(t *testing.T) {
+	require.Equal(t, 1, csvRecordLines([]string{"a", "b"}))
+	require.Equal(t, 3, csvRecordLines([]string{"a\nb", "c\nd"}))
+}
@@ -8,27 +8,130 @@
 // * Slice    returns a slice of the tuple values.
 // * String   returns the string representation of the tuple.
 // * GoString returns a Go-syntax representation of the tuple.
+// * Equal    returns whether the tuple is equal to another tuple, supporting elements of any type.
+// * Hash     returns a hash of the tuple values using a maphash.Seed.
+// * Swap     returns a tuple holding the tuple values in swapped order (T2 and Pair only).
+// * All      returns an iterator over the index and value of each of the tuple values (Go 1.23+).
+//
+// Tuples implement the json.Marshaler, json.Unmarshaler, encoding.TextMarshaler and encoding.TextUnmarshaler interfaces,
+// as well as the gob.GobEncoder and gob.GobDecoder interfaces.
+//
+// The gob wire format of a tuple is a single gob stream holding the number of tuple values as an int,
+// followed by a bool for each of the tuple values reporting whether it is present, followed by the value itself if it is.
+// Nil pointers, interfaces, slices, maps, channels and functions are not present and decoded as nil.
+//
+// Tuples implement the encoding.BinaryMarshaler and encoding.BinaryUnmarshaler interfaces using a compact binary encoding
+// for tuples of booleans, fixed-size numbers, strings, byte slices and nested tuples. The AppendBinaryOrder and
+// UnmarshalBinaryOrder methods use a given byte order, and the AppendBinarySliceN and UnmarshalBinarySliceN functions
+// encode and decode slices of tuples.
+//
+// Tuples can be marshalled into and unmarshalled from PostgreSQL composite literals, such as (1,"foo bar",),
+// using the MarshalComposite and UnmarshalComposite methods. NULL fields are mapped to nil pointers.
+//
+// Tuples implement the xml.Marshaler and xml.Unmarshaler interfaces, marshalling tuples as sequences of child elements
+// named v1 to vN, where nil values are marked by a nil="true" attribute. The MarshalXMLLabeled and UnmarshalXMLLabeled methods name the child elements using labels instead.
+// Tuples can also be marshalled into JSON objects keyed by labels, using the MarshalJSONLabeled and UnmarshalJSONLabeled
+// methods with labels created by the WithLabels function, or as struct fields using the Labeled type.
+// The UnmarshalJSONWith method unmarshals tuples from JSON arrays using JSONDecodeOptions, allowing shorter arrays,
+// rejecting null values, and applying the DisallowUnknownFields and UseNumber decoder options to the tuple values.
+// The options also apply to nested tuples, including tuples within slices, arrays, maps, pointers and struct fields.
 //
 // Tuple creation functions:
 //
-// * New<N>        creates a new tuple holding N generic values.
-// * FromArray<N>  returns a tuple from an array of length N.
+// * New<N>          creates a new tuple holding N generic values.
+// * FromArray<N>    returns a tuple from an array of length N.
 //    If any of the values can not be converted to the generic type, an error is returned.
-// * FromArray<N>X returns a tuple from an array of length N.
+// * FromArray<N>X   returns a tuple from an array of length N.
 //    If any of the values can not be converted to the generic type, the function panics.
-// * FromSlice<N>  returns a tuple from a slice of length N.
+// * FromSlice<N>    returns a tuple from a slice of length N.
 //    If the length of the slice doesn't match, or any of the values can not be converted to the generic type, an error is returned.
-// * FromSlice<N>X returns a tuple from a slice of length N.
+// * FromSlice<N>X   returns a tuple from a slice of length N.
 //    If the length of the slice doesn't match, or any of the values can not be converted to the generic type, the function panics.
+// * ConvertSlice<N> returns a tuple from a slice of length N, converting the values to the generic types when needed.
+//    Numeric values are converted between numeric types if they fit, json.Number values are parsed into numbers,
+//    strings and byte slices are converted to each other, and unmarshalled into encoding.TextUnmarshaler types.
+// * Parse<N>        returns a tuple from its string representation, as returned by the String method.
+//    If the string fails to parse, a *ParseError holding the offset of the failure is returned.
+//
+// Failures to create tuples from slices, arrays and unmarshalled values are reported using the LengthMismatchError,
+// ElementTypeError, ElementConversionError and ElementUnmarshalError types, which can be inspected using errors.As.
+//
+// Tuple transformation functions:
+//
+// * Map<N>At<I>   returns a new tuple with a function applied to the value at position I.
+// * Map<N>        returns a new tuple with a function applied to each of the tuple values.
+// * Append<N>     returns a tuple of length N+1 holding the tuple values followed by a new value.
+// * Prepend<N>    returns a tuple of length N+1 holding a new value followed by the tuple values.
+// * Concat<N>_<M> returns a tuple of length N+M holding the values of both tuples.
+// * Take<N>_<K>   returns a tuple holding the first K values of the tuple.
+// * Drop<N>_<K>   returns a tuple holding the tuple values without the first K values.
+// * Split<N>_<K>  returns a pair of tuples holding the first K values and the rest of the values of the tuple.
+// * Reverse<N>    returns a tuple holding the tuple values in reverse order.
+//
+// Tuple slice functions:
+//
+// * Zip<N>       returns a slice of tuples holding the values of N slices, truncated to the shortest slice.
+// * Zip<N>Strict returns a slice of tuples holding the values of N slices.
+//    If the lengths of the slices don't match, an error is returned.
+// * Unzip<N>     returns N slices holding the values of a slice of tuples.
+//
+// Tuple iterator functions (Go 1.23+):
+//
+// * ZipSeq<N>     returns an iterator over tuples holding the values of N iterators.
+// * ToSeq2        converts an iterator over T2 tuples into an iterator over pairs of values.
+// * FromSeq2      converts an iterator over pairs of values into an iterator over T2 tuples.
+// * ReadCSVSeq<N> returns an iterator over CSV records converted into tuples, yielding the errors of failing records.
+//
+// Tuple database functions:
+//
+// * ScanRow<N>     scans the current row of *sql.Rows into a tuple.
+//    If the number of columns doesn't match the number of tuple values, an error is returned.
+// * CollectRows<N> scans all the rows of *sql.Rows into a slice of tuples, and closes the rows.
+//
+// The JSONColumn type implements the driver.Valuer and sql.Scanner interfaces, storing tuples as JSON array columns.
+//
+// Tuple CSV functions:
+//
+// * ReadCSV<N>  reads all the CSV records of an io.Reader into a slice of tuples.
+//    If a record fails to convert, a *CSVError holding its line and column numbers is returned.
+// * WriteCSV<N> writes a slice of tuples into an io.Writer as CSV records.
+//
+// Tuple function adapters:
+//
+// * Apply<N>    calls a function with the tuple values as arguments.
+// * Tupled<N>   converts a function of N arguments into a function accepting a tuple.
+// * Untupled<N> converts a function accepting a tuple into a function of N arguments.
 //
 // Tuple comparison functions:
 //
 // * Equal<N> returns whether the host tuple is equal to the other tuple.
+// * EqualFunc<N> returns whether the host tuple is equal to the other tuple using an equality function per element.
 // * Compare<N> returns whether the host tuple is semantically less than, equal to, or greater than the guest tuple.
 // * LessThan<N> returns whether the host tuple is semantically less than the guest tuple.
 // * LessOrEqual<N> returns whether the host tuple is semantically less than or equal to the guest tuple.
 // * GreaterThan<N> returns whether the host tuple is semantically greater than the guest tuple.
 // * GreaterOrEqual<N> returns whether the host tuple is semantically greater than or equal to the guest tuple.
+// * CmpFunc<N> returns a comparison function of tuples that can be used with slices.SortFunc and similar functions.
+// * Comparator<N> returns a comparison function of tuples built from a comparison function per element.
+// * OrderBy<N> returns a comparison function of tuples that sorts each element by the given sort order (Asc or Desc).
+//
+// Pair is a tuple of 2 values, commonly used to hold a key and its matching value.
+// Pairs support the methods of T2 tuples, as well as the Key and Value accessors.
+//
+// Pair functions:
+//
+// * NewPair            creates a new pair holding a key and a value.
+// * PairFromT2         returns a pair holding the values of a T2 tuple.
+// * PairsFromMap       returns a slice of pairs holding the keys and values of a map.
+// * SortedPairsFromMap returns a slice of pairs holding the keys and values of a map, sorted by their keys.
+// * PairsToMap         returns a map holding the keys and values of a slice of pairs.
+// * EqualPair, ComparePair, LessThanPair, LessOrEqualPair, GreaterThanPair and GreaterOrEqualPair
+//    compare pairs the same way as the matching T2 comparison functions.
+//
+// Tuple hash functions:
+//
+// * HashValue   returns the hash of a single value, used to hash the tuple elements.
+// * HashFunc<N> returns a hash function of tuples built from a hash function per element.
 //
 // Tuple comparison functions may have an "C" or "E" suffix as overload with additional supported type constraints.
 // Comparison functions ending with "C" accept the "Comparable" constraint.
@@ -0,0 +1,138 @@
+package tuple
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// LengthMismatchError is returned when the number of values a tuple is created from doesn't match the number of tuple values,
+// or when the lengths of slices zipped into tuples don't match.
+type LengthMismatchError struct {
+	// Source describes the values the tuple is created from, such as "slice" or "unmarshalled json array".
+	Source string
+	// Expected is the number of tuple values, or the length of the first slice when zipping slices.
+	Expected int
+	// Actual is the number of values the tuple is created from.
+	Actual int
+	// AllowShorter reports whether fewer values than Expected are allowed, in which case Actual exceeds Expected.
+	AllowShorter bool
+
+	// count reports whether the values are measured by count rather than by length in the error message.
+	count bool
+	// expectedSource describes the values Expected is measured by in the error message, if not the tuple values.
+	expectedSource string
+}
+
+// Error returns the error message.
+func (e *LengthMismatchError) Error() string {
+	measure := "length"
+	if e.count {
+		measure = "count"
+	}
+
+	if e.expectedSource != "" {
+		return fmt.Sprintf("%s %s %d must match %s %s %d", e.Source, measure, e.Actual, e.expectedSource, measure, e.Expected)
+	}
+
+	if e.AllowShorter {
+		return fmt.Sprintf("%s %s %d must not exceed number of tuple values %d", e.Source, measure, e.Actual, e.Expected)
+	}
+
+	return fmt.Sprintf("%s %s %d must match number of tuple values %d", e.Source, measure, e.Actual, e.Expected)
+}
+
+// ElementTypeError is returned when a value a tuple is created from can not be converted to the type of the matching tuple value.
+type ElementTypeError struct {
+	// Source describes the values the tuple is created from, such as "slice" or "array".
+	Source string
+	// Index is the index of the value within the source.
+	Index int
+	// Expected is the type of the tuple value.
+	Expected reflect.Type
+	// Actual is the type of the value, or nil if the value is nil.
+	Actual reflect.Type
+}
+
+// newElementTypeError returns an ElementTypeError for a value that can not be converted to T.
+func newElementTypeError[T any](source string, index int, value any) *ElementTypeError {
+	return &ElementTypeError{
+		Source:   source,
+		Index:    index,
+		Expected: typeOf[T](),
+		Actual:   reflect.TypeOf(value),
+	}
+}
+
+// Error returns the error message.
+func (e *ElementTypeError) Error() string {
+	actual := "<nil>"
+	if e.Actual != nil {
+		actual = e.Actual.String()
+	}
+
+	return fmt.Sprintf("value at %s index %d expected to have type %s but has type %s", e.Source, e.Index, e.Expected, actual)
+}
+
+// ElementUnmarshalError is returned when a tuple value fails to unmarshal.
+type ElementUnmarshalError struct {
+	// Index is the index of the tuple value.
+	Index int
+	// Label is the label of the tuple value, if unmarshalled from an object keyed by labels or from a named xml element.
+	Label string
+	// Value is the marshalled value that failed to unmarshal, if available.
+	Value string
+	// Err is the underlying unmarshal error.
+	Err error
+
+	// location describes where the value is located in the unmarshalled data.
+	location elementLocation
+}
+
+// elementLocation describes where a tuple value is located in the unmarshalled data, used in error messages.
+type elementLocation int
+
+const (
+	// locationIndex locates a value by its index within the unmarshalled values, such as text or CSV values.
+	locationIndex elementLocation = iota
+	// locationSliceIndex locates a value by its index within an unmarshalled JSON array.
+	locationSliceIndex
+	// locationFieldIndex locates a value by its index within the fields of an unmarshalled composite literal.
+	locationFieldIndex
+	// locationLabel locates a value by its label within an unmarshalled JSON object.
+	locationLabel
+	// locationXMLElement locates a value by the name and index of its unmarshalled xml element.
+	locationXMLElement
+)
+
+// String returns the description of the location used in error messages.
+func (l elementLocation) String() string {
+	switch l {
+	case locationSliceIndex:
+		return "slice index"
+	case locationFieldIndex:
+		return "field index"
+	case locationLabel:
+		return "label"
+	case locationXMLElement:
+		return "xml element"
+	default:
+		return "index"
+	}
+}
+
+// Error returns the error message.
+func (e *ElementUnmarshalError) Error() string {
+	switch e.location {
+	case locationXMLElement:
+		return fmt.Sprintf("xml element %q at index %d failed to unmarshal: %v", e.Label, e.Index, e.Err)
+	case locationLabel:
+		return fmt.Sprintf("value %q with label %q failed to unmarshal: %v", e.Value, e.Label, e.Err)
+	default:
+		return fmt.Sprintf("value %q at %s %d failed to unmarshal: %v", e.Value, e.location, e.Index, e.Err)
+	}
+}
+
+// Unwrap returns the underlying unmarshal error.
+func (e *ElementUnmarshalError) Unwrap() error {
+	return e.Err
+}
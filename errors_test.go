@@ -0,0 +1,86 @@
+package tuple
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLengthMismatchError_Error(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *LengthMismatchError
+		want string
+	}{
+		{
+			name: "length",
+			err:  &LengthMismatchError{Source: "slice", Expected: 2, Actual: 3},
+			want: "slice length 3 must match number of tuple values 2",
+		},
+		{
+			name: "count",
+			err:  &LengthMismatchError{Source: "labels", Expected: 2, Actual: 3, count: true},
+			want: "labels count 3 must match number of tuple values 2",
+		},
+		{
+			name: "expected source",
+			err:  &LengthMismatchError{Source: "slice 2", Expected: 2, Actual: 3, expectedSource: "slice 1"},
+			want: "slice 2 length 3 must match slice 1 length 2",
+		},
+		{
+			name: "allow shorter",
+			err:  &LengthMismatchError{Source: "unmarshalled json array", Expected: 2, Actual: 3, AllowShorter: true},
+			want: "unmarshalled json array length 3 must not exceed number of tuple values 2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.EqualError(t, tt.err, tt.want)
+		})
+	}
+}
+
+func TestElementTypeError_Error(t *testing.T) {
+	err := newElementTypeError[string]("slice", 1, 5)
+	require.Equal(t, &ElementTypeError{Source: "slice", Index: 1, Expected: reflect.TypeOf(""), Actual: reflect.TypeOf(0)}, err)
+	require.EqualError(t, err, "value at slice index 1 expected to have type string but has type int")
+
+	err = newElementTypeError[error]("array", 0, nil)
+	require.EqualError(t, err, "value at array index 0 expected to have type error but has type <nil>")
+}
+
+func TestElementUnmarshalError(t *testing.T) {
+	inner := errors.New("inner")
+
+	err := &ElementUnmarshalError{Index: 1, Value: "foo", Err: inner, location: locationSliceIndex}
+	require.EqualError(t, err, `value "foo" at slice index 1 failed to unmarshal: inner`)
+	require.ErrorIs(t, err, inner)
+
+	err = &ElementUnmarshalError{Index: 1, Label: "name", Value: "foo", Err: inner, location: locationLabel}
+	require.EqualError(t, err, `value "foo" with label "name" failed to unmarshal: inner`)
+	require.ErrorIs(t, err, inner)
+
+	err = &ElementUnmarshalError{Index: 1, Label: "v2", Err: inner, location: locationXMLElement}
+	require.EqualError(t, err, `xml element "v2" at index 1 failed to unmarshal: inner`)
+
+	err = &ElementUnmarshalError{Index: 2, Value: "foo", Err: inner}
+	require.EqualError(t, err, `value "foo" at index 2 failed to unmarshal: inner`)
+}
+
+func TestErrors_As(t *testing.T) {
+	var lengthErr *LengthMismatchError
+	_, err := Parse2[int, int]("[1]")
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, 2, lengthErr.Expected)
+	require.Equal(t, 1, lengthErr.Actual)
+
+	var elementErr *ElementUnmarshalError
+	var tup T2[int, string]
+	err = tup.UnmarshalJSONLabeled([]byte(`{"id":"1","name":"x"}`), WithLabels("id", "name"))
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, "id", elementErr.Label)
+	require.Equal(t, 0, elementErr.Index)
+}
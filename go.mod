@@ -1,11 +1,8 @@
 module github.com/barweiss/go-tuple
 
-go 1.18
+go 1.21
 
-require (
-	github.com/stretchr/testify v1.8.4
-	golang.org/x/exp v0.0.0-20220314205449-43aec2f8a4e7
-)
+require github.com/stretchr/testify v1.8.4
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
@@ -0,0 +1,87 @@
+package tuple
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"reflect"
+)
+
+// The gob wire format of a tuple is a single gob stream holding, in order:
+//
+//  1. The number of tuple values, encoded as an int.
+//  2. For each of the tuple values, a bool reporting whether the value is present, followed by the value itself if it is.
+//
+// A value is not present if it is a nil pointer, interface, slice, map, channel or function.
+// Absent values are decoded as nil, whereas present pointers are decoded as non-nil, so pointers to zero values
+// round trip as is, unlike gob encoded struct fields.
+//
+// Values are encoded through pointers to them, so interface values are encoded along with their concrete types.
+// As with any gob encoded interface value, the concrete types must be registered using gob.Register.
+// Nested tuples are encoded using their own GobEncode methods.
+
+// gobEncodeValues encodes the values pointed by ptrs in the tuple gob wire format.
+func gobEncodeValues(ptrs []any) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := gob.NewEncoder(&buf)
+	if err := encoder.Encode(len(ptrs)); err != nil {
+		return nil, fmt.Errorf("unable to encode tuple values count: %w", err)
+	}
+
+	for index, ptr := range ptrs {
+		present := !isNilValue(reflect.ValueOf(ptr).Elem())
+		if err := encoder.Encode(present); err != nil {
+			return nil, fmt.Errorf("value at index %d failed to encode: %w", index, err)
+		}
+		if !present {
+			continue
+		}
+
+		if err := encoder.Encode(ptr); err != nil {
+			return nil, fmt.Errorf("value at index %d failed to encode: %w", index, err)
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// gobDecodeValues decodes the values in the tuple gob wire format into the values pointed by ptrs in the same order.
+func gobDecodeValues(data []byte, ptrs []any) error {
+	decoder := gob.NewDecoder(bytes.NewReader(data))
+
+	var count int
+	if err := decoder.Decode(&count); err != nil {
+		return fmt.Errorf("unable to decode tuple values count: %w", err)
+	}
+	if count != len(ptrs) {
+		return &LengthMismatchError{Source: "gob encoded values", Expected: len(ptrs), Actual: count, count: true}
+	}
+
+	for index, ptr := range ptrs {
+		var present bool
+		if err := decoder.Decode(&present); err != nil {
+			return fmt.Errorf("value at index %d failed to decode: %w", index, err)
+		}
+		if !present {
+			value := reflect.ValueOf(ptr).Elem()
+			value.Set(reflect.Zero(value.Type()))
+			continue
+		}
+
+		if err := decoder.Decode(ptr); err != nil {
+			return fmt.Errorf("value at index %d failed to decode: %w", index, err)
+		}
+	}
+
+	return nil
+}
+
+// isNilValue returns whether the value is a nil pointer, interface, slice, map, channel or function.
+func isNilValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Slice, reflect.Map, reflect.Chan, reflect.Func:
+		return v.IsNil()
+	}
+
+	return false
+}
@@ -0,0 +1,71 @@
+package tuple
+
+import (
+	"bytes"
+	"encoding/gob"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// requireGobRoundTrip requires the value to be equal to itself after being gob encoded and decoded.
+func requireGobRoundTrip[T any](t *testing.T, value T) {
+	t.Helper()
+
+	var buf bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buf).Encode(value))
+
+	var decoded T
+	require.NoError(t, gob.NewDecoder(&buf).Decode(&decoded))
+	require.Equal(t, value, decoded)
+}
+
+func Test_gobEncodeValues_gobDecodeValues(t *testing.T) {
+	zero := 0
+	var iface any = "foo"
+	var nilPtr *int
+	slice := []int{1, 2}
+
+	encoded, err := gobEncodeValues([]any{&zero, &iface, &nilPtr, &slice})
+	require.NoError(t, err)
+
+	decodedZero := 5
+	var decodedIface any
+	decodedNilPtr := &zero
+	var decodedSlice []int
+	err = gobDecodeValues(encoded, []any{&decodedZero, &decodedIface, &decodedNilPtr, &decodedSlice})
+	require.NoError(t, err)
+	require.Equal(t, 0, decodedZero)
+	require.Equal(t, "foo", decodedIface)
+	require.Nil(t, decodedNilPtr)
+	require.Equal(t, []int{1, 2}, decodedSlice)
+
+	err = gobDecodeValues(encoded, []any{&decodedZero})
+	require.EqualError(t, err, "gob encoded values count 4 must match number of tuple values 1")
+}
+
+func Test_gobEncodeValues_Unregistered(t *testing.T) {
+	type unregistered struct {
+		Name string
+	}
+
+	var iface any = unregistered{Name: "foo"}
+	_, err := gobEncodeValues([]any{&iface})
+	require.Error(t, err)
+}
+
+func Test_isNilValue(t *testing.T) {
+	var nilPtr *int
+	var nilIface any
+	var nilSlice []int
+	var nilMap map[int]int
+
+	for _, value := range []any{&nilPtr, &nilIface, &nilSlice, &nilMap} {
+		require.True(t, isNilValue(reflect.ValueOf(value).Elem()))
+	}
+
+	for _, value := range []any{ptr(0), ptr(ptr(0)), ptr[any](0), ptr([]int{}), ptr(map[int]int{})} {
+		require.False(t, isNilValue(reflect.ValueOf(value).Elem()))
+	}
+}
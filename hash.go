@@ -0,0 +1,140 @@
+package tuple
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/maphash"
+	"math"
+	"reflect"
+)
+
+// Hasher is an interface for complex tuple elements that can be hashed.
+// All tuple types implement the Hasher interface, so tuples can be nested in hashed tuples.
+type Hasher interface {
+	Hash(seed maphash.Seed) uint64
+}
+
+// HashValue returns the hash of the value v using the given seed.
+// Equal values return equal hashes for the same seed.
+// Supported values are booleans, integers, floating point and complex numbers, strings, byte slices,
+// and values implementing the Hasher interface. Named types of these kinds are supported as well.
+// HashValue panics if the value is not supported.
+// HashValue can be used as an element hash function of the HashFunc functions.
+func HashValue[T any](seed maphash.Seed, v T) uint64 {
+	var h maphash.Hash
+	h.SetSeed(seed)
+	if err := writeHash(&h, seed, v); err != nil {
+		panic(err)
+	}
+	return h.Sum64()
+}
+
+// writeHash writes a representation of the value v to the hash h, or returns an error if the value is not supported.
+// Variable length values are prefixed by their length, so that writing multiple values is unambiguous.
+func writeHash(h *maphash.Hash, seed maphash.Seed, v any) error {
+	// Nil pointers to Hasher types, such as tuples, implement Hasher through their value receivers,
+	// which would panic when called.
+	value := reflect.ValueOf(v)
+	if !value.IsValid() {
+		return fmt.Errorf("unable to hash nil value")
+	}
+	if value.Kind() == reflect.Pointer && value.IsNil() {
+		return fmt.Errorf("unable to hash nil value of type %s", value.Type())
+	}
+
+	switch v := v.(type) {
+	case Hasher:
+		writeHashUint64(h, v.Hash(seed))
+	case string:
+		writeHashString(h, v)
+	case []byte:
+		writeHashBytes(h, v)
+	case int:
+		writeHashUint64(h, uint64(v))
+	case int64:
+		writeHashUint64(h, uint64(v))
+	case uint64:
+		writeHashUint64(h, v)
+	case bool:
+		writeHashBool(h, v)
+	default:
+		return writeHashReflect(h, value)
+	}
+
+	return nil
+}
+
+// writeHashElement writes a representation of the tuple element v to the hash h.
+// Unsupported values are represented by their type only, so that equal values still write equal representations.
+func writeHashElement(h *maphash.Hash, seed maphash.Seed, v any) {
+	if err := writeHash(h, seed, v); err != nil {
+		writeHashString(h, fmt.Sprintf("%T", v))
+	}
+}
+
+// writeHashReflect writes a representation of the value v to the hash h according to its kind,
+// or returns an error if the kind is not supported.
+func writeHashReflect(h *maphash.Hash, v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Bool:
+		writeHashBool(h, v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeHashUint64(h, uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeHashUint64(h, v.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeHashFloat(h, v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		writeHashFloat(h, real(c))
+		writeHashFloat(h, imag(c))
+	case reflect.String:
+		writeHashString(h, v.String())
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.Uint8 {
+			return fmt.Errorf("unable to hash value of type %s", v.Type())
+		}
+		writeHashBytes(h, v.Bytes())
+	default:
+		return fmt.Errorf("unable to hash value of type %s", v.Type())
+	}
+
+	return nil
+}
+
+// writeHashUint64 writes the value v to the hash h.
+func writeHashUint64(h *maphash.Hash, v uint64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], v)
+	_, _ = h.Write(buf[:])
+}
+
+// writeHashBool writes the value v to the hash h.
+func writeHashBool(h *maphash.Hash, v bool) {
+	if v {
+		_ = h.WriteByte(1)
+	} else {
+		_ = h.WriteByte(0)
+	}
+}
+
+// writeHashFloat writes the value v to the hash h.
+// Positive and negative zero are equal, and therefore written the same.
+func writeHashFloat(h *maphash.Hash, v float64) {
+	if v == 0 {
+		v = 0
+	}
+	writeHashUint64(h, math.Float64bits(v))
+}
+
+// writeHashString writes the value v to the hash h, prefixed by its length.
+func writeHashString(h *maphash.Hash, v string) {
+	writeHashUint64(h, uint64(len(v)))
+	_, _ = h.WriteString(v)
+}
+
+// writeHashBytes writes the value v to the hash h, prefixed by its length.
+func writeHashBytes(h *maphash.Hash, v []byte) {
+	writeHashUint64(h, uint64(len(v)))
+	_, _ = h.Write(v)
+}
@@ -0,0 +1,88 @@
+package tuple
+
+import (
+	"hash/maphash"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// hasherHelper is a helper type for testing the Hasher interface.
+type hasherHelper struct {
+	id    int
+	cache []int
+}
+
+// Assert implementation.
+var _ Hasher = hasherHelper{}
+
+func (h hasherHelper) Hash(seed maphash.Seed) uint64 {
+	return HashValue(seed, h.id)
+}
+
+func TestHashValue(t *testing.T) {
+	seed := maphash.MakeSeed()
+
+	tests := []struct {
+		name      string
+		a, b      any
+		wantEqual bool
+	}{
+		{name: "equal ints", a: 5, b: 5, wantEqual: true},
+		{name: "different ints", a: 5, b: 6, wantEqual: false},
+		{name: "equal int8", a: int8(-5), b: int8(-5), wantEqual: true},
+		{name: "equal uints", a: uint16(5), b: uint16(5), wantEqual: true},
+		{name: "equal bools", a: true, b: true, wantEqual: true},
+		{name: "different bools", a: true, b: false, wantEqual: false},
+		{name: "equal floats", a: 1.5, b: 1.5, wantEqual: true},
+		{name: "signed zeros", a: 0.0, b: math.Copysign(0, -1), wantEqual: true},
+		{name: "equal complex numbers", a: 1 + 2i, b: 1 + 2i, wantEqual: true},
+		{name: "different complex numbers", a: 1 + 2i, b: 2 + 1i, wantEqual: false},
+		{name: "equal strings", a: "foo", b: "foo", wantEqual: true},
+		{name: "different strings", a: "foo", b: "bar", wantEqual: false},
+		{name: "named strings", a: approximationHelper("foo"), b: approximationHelper("foo"), wantEqual: true},
+		{name: "equal byte slices", a: []byte("foo"), b: []byte("foo"), wantEqual: true},
+		{name: "hashers", a: hasherHelper{id: 1, cache: []int{1}}, b: hasherHelper{id: 1}, wantEqual: true},
+		{name: "different hashers", a: hasherHelper{id: 1}, b: hasherHelper{id: 2}, wantEqual: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := HashValue(seed, tt.a)
+			b := HashValue(seed, tt.b)
+			if tt.wantEqual {
+				require.Equal(t, a, b)
+			} else {
+				require.NotEqual(t, a, b)
+			}
+		})
+	}
+}
+
+func TestHashValue_Unsupported(t *testing.T) {
+	seed := maphash.MakeSeed()
+
+	require.Panics(t, func() { HashValue(seed, map[string]int{}) })
+	require.Panics(t, func() { HashValue(seed, []int{1}) })
+	require.Panics(t, func() { HashValue[any](seed, nil) })
+	require.Panics(t, func() { HashValue(seed, (*T2[int, int])(nil)) })
+}
+
+func TestHash_NilPointer(t *testing.T) {
+	seed := maphash.MakeSeed()
+
+	var tup T1[*T2[int, int]]
+	require.NotPanics(t, func() { tup.Hash(seed) })
+	require.Equal(t, tup.Hash(seed), T1[*T2[int, int]]{}.Hash(seed))
+
+	nested := New1[Hasher]((*T2[int, int])(nil))
+	require.NotPanics(t, func() { nested.Hash(seed) })
+	require.Equal(t, tup.Hash(seed), nested.Hash(seed))
+}
+
+func TestHash_Unambiguous(t *testing.T) {
+	seed := maphash.MakeSeed()
+	require.NotEqual(t, New2("ab", "c").Hash(seed), New2("a", "bc").Hash(seed))
+	require.NotEqual(t, New2([]byte("ab"), []byte("c")).Hash(seed), New2([]byte("a"), []byte("bc")).Hash(seed))
+}
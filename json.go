@@ -0,0 +1,315 @@
+package tuple
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// JSONDecodeOptions configures the way tuples are unmarshalled from JSON arrays using the UnmarshalJSONWith methods.
+// The zero value matches the behavior of the UnmarshalJSON methods.
+// The options are applied to nested tuples as well, including tuples within slices, arrays, maps, pointers and exported struct fields.
+type JSONDecodeOptions struct {
+	// AllowShorter allows JSON arrays shorter than the number of tuple values.
+	// The trailing tuple values missing from the array are set to their zero values.
+	AllowShorter bool
+
+	// DisallowNull fails unmarshalling JSON arrays holding null values.
+	// By default, null values are unmarshalled the same way as encoding/json does, usually leaving the tuple value unchanged.
+	DisallowNull bool
+
+	// DisallowUnknownFields fails unmarshalling objects holding keys that don't match any non-ignored, exported struct field.
+	// See json.Decoder.DisallowUnknownFields.
+	DisallowUnknownFields bool
+
+	// UseNumber unmarshals numbers into interface values as json.Number instead of float64.
+	// See json.Decoder.UseNumber.
+	UseNumber bool
+}
+
+// jsonOptionsUnmarshaler is implemented by types that can be unmarshalled from JSON using decode options.
+// It is used to apply the options to nested tuples.
+type jsonOptionsUnmarshaler interface {
+	UnmarshalJSONWith(data []byte, opts JSONDecodeOptions) error
+}
+
+var (
+	jsonOptionsUnmarshalerType = reflect.TypeOf((*jsonOptionsUnmarshaler)(nil)).Elem()
+	jsonUnmarshalerType        = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	jsonRawMessageType         = reflect.TypeOf(json.RawMessage(nil))
+)
+
+// unmarshalJSONArray unmarshals a JSON array into the values pointed by ptrs in the same order, using the given options.
+func unmarshalJSONArray(data []byte, opts JSONDecodeOptions, ptrs []any) error {
+	// Working with json.RawMessage instead of any enables custom struct support.
+	var slice []json.RawMessage
+	if err := json.Unmarshal(data, &slice); err != nil {
+		return fmt.Errorf("unable to unmarshal json array for tuple: %w", err)
+	}
+
+	if opts.AllowShorter {
+		if len(slice) > len(ptrs) {
+			return &LengthMismatchError{Source: "unmarshalled json array", Expected: len(ptrs), Actual: len(slice), AllowShorter: true}
+		}
+	} else if len(slice) != len(ptrs) {
+		return &LengthMismatchError{Source: "unmarshalled json array", Expected: len(ptrs), Actual: len(slice)}
+	}
+
+	for index, ptr := range ptrs {
+		if index >= len(slice) {
+			value := reflect.ValueOf(ptr).Elem()
+			value.Set(reflect.Zero(value.Type()))
+			continue
+		}
+
+		if err := unmarshalJSONValue(slice[index], opts, ptr); err != nil {
+			return &ElementUnmarshalError{Index: index, Value: string(slice[index]), Err: err, location: locationSliceIndex}
+		}
+	}
+
+	return nil
+}
+
+// unmarshalJSONValue unmarshals a single JSON value into the value pointed by ptr using the given options.
+func unmarshalJSONValue(data json.RawMessage, opts JSONDecodeOptions, ptr any) error {
+	if opts.DisallowNull && bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return fmt.Errorf("null values are not allowed")
+	}
+
+	return decodeJSONValue(data, opts, reflect.ValueOf(ptr).Elem())
+}
+
+// decodeJSONValue unmarshals a single JSON value into the addressable value v using the given options.
+// Unlike encoding/json, tuples nested within slices, arrays, maps, pointers and struct fields are unmarshalled
+// using the options as well.
+func decodeJSONValue(data json.RawMessage, opts JSONDecodeOptions, v reflect.Value) error {
+	if unmarshaler, ok := v.Addr().Interface().(jsonOptionsUnmarshaler); ok {
+		return unmarshaler.UnmarshalJSONWith(data, opts)
+	}
+
+	// Zero options match the behavior of encoding/json, so there's no need to walk the value.
+	if opts == (JSONDecodeOptions{}) {
+		return json.Unmarshal(data, v.Addr().Interface())
+	}
+
+	if !jsonHoldsTuples(v.Type(), map[reflect.Type]bool{}) {
+		return decodeJSONWithDecoder(data, opts, v.Addr().Interface())
+	}
+
+	isNull := bytes.Equal(bytes.TrimSpace(data), []byte("null"))
+	switch v.Kind() {
+	case reflect.Pointer:
+		if isNull {
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return decodeJSONValue(data, opts, v.Elem())
+	case reflect.Slice, reflect.Array:
+		return decodeJSONList(data, opts, v, isNull)
+	case reflect.Map:
+		return decodeJSONMap(data, opts, v, isNull)
+	case reflect.Struct:
+		return decodeJSONStruct(data, opts, v)
+	}
+
+	return decodeJSONWithDecoder(data, opts, v.Addr().Interface())
+}
+
+// decodeJSONList unmarshals a JSON array into the slice or array v, unmarshalling each of the items using the given options.
+// Like encoding/json, null leaves arrays unchanged, and extra JSON array items are ignored by arrays.
+func decodeJSONList(data json.RawMessage, opts JSONDecodeOptions, v reflect.Value, isNull bool) error {
+	if isNull {
+		if v.Kind() == reflect.Slice {
+			v.Set(reflect.Zero(v.Type()))
+		}
+		return nil
+	}
+
+	var items []json.RawMessage
+	if err := decodeJSONWithDecoder(data, opts, &items); err != nil {
+		return err
+	}
+
+	if v.Kind() == reflect.Slice {
+		v.Set(reflect.MakeSlice(v.Type(), len(items), len(items)))
+	}
+
+	for i := 0; i < v.Len(); i++ {
+		if i >= len(items) {
+			v.Index(i).Set(reflect.Zero(v.Type().Elem()))
+			continue
+		}
+
+		if err := decodeJSONValue(items[i], opts, v.Index(i)); err != nil {
+			return fmt.Errorf("item at index %d failed to unmarshal: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// decodeJSONMap unmarshals a JSON object into the map v, unmarshalling each of the values using the given options.
+// Like encoding/json, the keys are converted by encoding/json, and the entries are added to an existing map.
+func decodeJSONMap(data json.RawMessage, opts JSONDecodeOptions, v reflect.Value, isNull bool) error {
+	if isNull {
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+
+	entries := reflect.New(reflect.MapOf(v.Type().Key(), jsonRawMessageType))
+	if err := decodeJSONWithDecoder(data, opts, entries.Interface()); err != nil {
+		return err
+	}
+
+	if v.IsNil() {
+		v.Set(reflect.MakeMap(v.Type()))
+	}
+
+	iter := entries.Elem().MapRange()
+	for iter.Next() {
+		value := reflect.New(v.Type().Elem()).Elem()
+		if err := decodeJSONValue(iter.Value().Interface().(json.RawMessage), opts, value); err != nil {
+			return fmt.Errorf("value with key %v failed to unmarshal: %w", iter.Key(), err)
+		}
+		v.SetMapIndex(iter.Key(), value)
+	}
+
+	return nil
+}
+
+// decodeJSONStruct unmarshals a JSON object into the struct v, unmarshalling the fields holding tuples using the given options.
+// The object is unmarshalled by encoding/json into a shadow struct holding the fields holding tuples as raw JSON values,
+// which keeps the field matching of encoding/json.
+func decodeJSONStruct(data json.RawMessage, opts JSONDecodeOptions, v reflect.Value) error {
+	shadow := reflect.New(jsonShadowType(v.Type())).Elem()
+	copyToJSONShadow(v, shadow)
+
+	if err := decodeJSONWithDecoder(data, opts, shadow.Addr().Interface()); err != nil {
+		return err
+	}
+
+	return copyFromJSONShadow(shadow, opts, v)
+}
+
+// jsonShadowType returns the shadow struct type of the struct type t, used by decodeJSONStruct.
+// The shadow struct holds the exported fields of t with the same names and tags, where fields holding tuples
+// are replaced by json.RawMessage fields, and embedded structs holding tuples are replaced by their shadow structs.
+func jsonShadowType(t reflect.Type) reflect.Type {
+	fields := make([]reflect.StructField, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		shadowField := reflect.StructField{Name: field.Name, Type: field.Type, Tag: field.Tag, Anonymous: field.Anonymous}
+		if jsonHoldsTuples(field.Type, map[reflect.Type]bool{}) {
+			if field.Anonymous && field.Type.Kind() == reflect.Struct {
+				shadowField.Type = jsonShadowType(field.Type)
+			} else {
+				shadowField.Type = jsonRawMessageType
+			}
+		}
+		fields = append(fields, shadowField)
+	}
+
+	return reflect.StructOf(fields)
+}
+
+// copyToJSONShadow copies the fields of the struct v that don't hold tuples into the shadow struct,
+// so that fields missing from the unmarshalled object are left unchanged.
+func copyToJSONShadow(v, shadow reflect.Value) {
+	for i := 0; i < shadow.NumField(); i++ {
+		field := shadow.Type().Field(i)
+		source := v.FieldByName(field.Name)
+		switch {
+		case field.Type == source.Type():
+			shadow.Field(i).Set(source)
+		case field.Type != jsonRawMessageType:
+			copyToJSONShadow(source, shadow.Field(i))
+		}
+	}
+}
+
+// copyFromJSONShadow copies the fields of the unmarshalled shadow struct into the struct v,
+// unmarshalling the raw JSON values of the fields holding tuples using the given options.
+func copyFromJSONShadow(shadow reflect.Value, opts JSONDecodeOptions, v reflect.Value) error {
+	for i := 0; i < shadow.NumField(); i++ {
+		field := shadow.Type().Field(i)
+		target := v.FieldByName(field.Name)
+		switch {
+		case field.Type == target.Type():
+			target.Set(shadow.Field(i))
+		case field.Type == jsonRawMessageType:
+			raw := shadow.Field(i).Interface().(json.RawMessage)
+			if raw == nil {
+				continue
+			}
+			if err := decodeJSONValue(raw, opts, target); err != nil {
+				return fmt.Errorf("field %s failed to unmarshal: %w", field.Name, err)
+			}
+		default:
+			if err := copyFromJSONShadow(shadow.Field(i), opts, target); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// jsonHoldsTuples returns whether values of type t hold tuples within slices, arrays, maps, pointers or exported struct fields,
+// which encoding/json would unmarshal without the decode options.
+// Types implementing json.Unmarshaler or encoding.TextUnmarshaler, other than tuples, are unmarshalled by their own methods
+// and therefore don't hold tuples.
+// Structs embedding unexported types are not walked, as their promoted fields can't be shadowed.
+func jsonHoldsTuples(t reflect.Type, visited map[reflect.Type]bool) bool {
+	if t.Implements(jsonOptionsUnmarshalerType) || reflect.PointerTo(t).Implements(jsonOptionsUnmarshalerType) {
+		return true
+	}
+	if visited[t] || reflect.PointerTo(t).Implements(jsonUnmarshalerType) || reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		return false
+	}
+	visited[t] = true
+
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map:
+		return jsonHoldsTuples(t.Elem(), visited)
+	case reflect.Struct:
+		holds := false
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.Anonymous && !field.IsExported() {
+				return false
+			}
+			if field.IsExported() && jsonHoldsTuples(field.Type, visited) {
+				holds = true
+			}
+		}
+		return holds
+	}
+
+	return false
+}
+
+// decodeJSONWithDecoder unmarshals a single JSON value into the value pointed by ptr,
+// applying the DisallowUnknownFields and UseNumber options to the decoder.
+func decodeJSONWithDecoder(data json.RawMessage, opts JSONDecodeOptions, ptr any) error {
+	if !opts.DisallowUnknownFields && !opts.UseNumber {
+		return json.Unmarshal(data, ptr)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if opts.DisallowUnknownFields {
+		decoder.DisallowUnknownFields()
+	}
+	if opts.UseNumber {
+		decoder.UseNumber()
+	}
+
+	return decoder.Decode(ptr)
+}
@@ -0,0 +1,225 @@
+package tuple
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_unmarshalJSONArray(t *testing.T) {
+	type custom struct {
+		Name string `json:"name"`
+	}
+
+	tests := []struct {
+		name       string
+		data       string
+		opts       JSONDecodeOptions
+		wantNumber any
+		wantCustom custom
+		wantErr    bool
+	}{
+		{
+			name:       "default options",
+			data:       `[1, {"name": "foo"}]`,
+			wantNumber: float64(1),
+			wantCustom: custom{Name: "foo"},
+		},
+		{
+			name:    "default options shorter array",
+			data:    `[1]`,
+			wantErr: true,
+		},
+		{
+			name:       "allow shorter",
+			data:       `[1]`,
+			opts:       JSONDecodeOptions{AllowShorter: true},
+			wantNumber: float64(1),
+		},
+		{
+			name:    "allow shorter longer array",
+			data:    `[1, {}, 2]`,
+			opts:    JSONDecodeOptions{AllowShorter: true},
+			wantErr: true,
+		},
+		{
+			name:       "default options null",
+			data:       `[null, null]`,
+			wantNumber: nil,
+			wantCustom: custom{Name: "unchanged"},
+		},
+		{
+			name:    "disallow null",
+			data:    `[1, null]`,
+			opts:    JSONDecodeOptions{DisallowNull: true},
+			wantErr: true,
+		},
+		{
+			name:       "default options unknown field",
+			data:       `[1, {"name": "foo", "age": 5}]`,
+			wantNumber: float64(1),
+			wantCustom: custom{Name: "foo"},
+		},
+		{
+			name:    "disallow unknown fields",
+			data:    `[1, {"name": "foo", "age": 5}]`,
+			opts:    JSONDecodeOptions{DisallowUnknownFields: true},
+			wantErr: true,
+		},
+		{
+			name:       "use number",
+			data:       `[1, {"name": "foo"}]`,
+			opts:       JSONDecodeOptions{UseNumber: true},
+			wantNumber: json.Number("1"),
+			wantCustom: custom{Name: "foo"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var number any = "unchanged"
+			c := custom{Name: "unchanged"}
+			err := unmarshalJSONArray([]byte(tt.data), tt.opts, []any{&number, &c})
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantNumber, number)
+			require.Equal(t, tt.wantCustom, c)
+		})
+	}
+}
+
+func Test_unmarshalJSONArray_Nested(t *testing.T) {
+	type custom struct {
+		Name string `json:"name"`
+	}
+
+	var nested T2[any, custom]
+	opts := JSONDecodeOptions{UseNumber: true, DisallowUnknownFields: true}
+
+	err := unmarshalJSONArray([]byte(`[[1, {"name": "foo"}]]`), opts, []any{&nested})
+	require.NoError(t, err)
+	require.Equal(t, New2[any](json.Number("1"), custom{Name: "foo"}), nested)
+
+	err = unmarshalJSONArray([]byte(`[[1, {"name": "foo", "age": 5}]]`), opts, []any{&nested})
+	require.Error(t, err)
+
+	err = unmarshalJSONArray([]byte(`[[1]]`), JSONDecodeOptions{AllowShorter: true}, []any{&nested})
+	require.NoError(t, err)
+	require.Equal(t, New2[any](float64(1), custom{}), nested)
+}
+
+func Test_unmarshalJSONArray_NestedInContainers(t *testing.T) {
+	opts := JSONDecodeOptions{UseNumber: true, DisallowUnknownFields: true}
+
+	var slice []T1[any]
+	err := unmarshalJSONArray([]byte(`[[[1], [2]]]`), opts, []any{&slice})
+	require.NoError(t, err)
+	require.Equal(t, []T1[any]{New1[any](json.Number("1")), New1[any](json.Number("2"))}, slice)
+
+	array := [3]T1[any]{New1[any]("unchanged"), New1[any]("unchanged"), New1[any]("unchanged")}
+	err = unmarshalJSONArray([]byte(`[[[1]]]`), opts, []any{&array})
+	require.NoError(t, err)
+	require.Equal(t, [3]T1[any]{New1[any](json.Number("1"))}, array)
+
+	object := map[int]T1[any]{2: New1[any]("kept")}
+	err = unmarshalJSONArray([]byte(`[{"1": [1]}]`), opts, []any{&object})
+	require.NoError(t, err)
+	require.Equal(t, map[int]T1[any]{1: New1[any](json.Number("1")), 2: New1[any]("kept")}, object)
+
+	var pointer *[]*T1[any]
+	err = unmarshalJSONArray([]byte(`[[[1], null]]`), opts, []any{&pointer})
+	require.NoError(t, err)
+	require.Equal(t, &[]*T1[any]{ptr(New1[any](json.Number("1"))), nil}, pointer)
+
+	err = unmarshalJSONArray([]byte(`[null]`), opts, []any{&pointer})
+	require.NoError(t, err)
+	require.Nil(t, pointer)
+
+	err = unmarshalJSONArray([]byte(`[[[1], [2, 3]]]`), opts, []any{&slice})
+	require.EqualError(t, err, `value "[[1], [2, 3]]" at slice index 0 failed to unmarshal: item at index 1 failed to unmarshal: `+
+		"unmarshalled json array length 2 must match number of tuple values 1")
+
+	err = unmarshalJSONArray([]byte(`[[[1], [null]]]`), JSONDecodeOptions{DisallowNull: true}, []any{&slice})
+	require.ErrorContains(t, err, "null values are not allowed")
+}
+
+func Test_unmarshalJSONArray_NestedInStructs(t *testing.T) {
+	type Embedded struct {
+		Point T2[any, int] `json:"point"`
+	}
+	type Record struct {
+		Embedded
+		Name   string         `json:"name"`
+		Tags   []T1[any]      `json:"tags,omitempty"`
+		Nested *Record        `json:"nested"`
+		Ignore T1[any]        `json:"-"`
+		Counts map[string]int `json:"counts"`
+		hidden T1[any]
+	}
+
+	opts := JSONDecodeOptions{UseNumber: true}
+	record := Record{Name: "unchanged", Ignore: New1[any]("ignored"), hidden: New1[any]("hidden")}
+	err := unmarshalJSONArray([]byte(`[{"point": [1, 2], "tags": [[3]], "nested": {"name": "inner", "tags": [[4]]}, "counts": {"a": 5}}]`),
+		opts, []any{&record})
+	require.NoError(t, err)
+	require.Equal(t, Record{
+		Embedded: Embedded{Point: New2[any](json.Number("1"), 2)},
+		Name:     "unchanged",
+		Tags:     []T1[any]{New1[any](json.Number("3"))},
+		Nested:   &Record{Name: "inner", Tags: []T1[any]{New1[any](json.Number("4"))}},
+		Ignore:   New1[any]("ignored"),
+		Counts:   map[string]int{"a": 5},
+		hidden:   New1[any]("hidden"),
+	}, record)
+
+	opts.DisallowUnknownFields = true
+	err = unmarshalJSONArray([]byte(`[{"tags": [[3]], "unknown": 1}]`), opts, []any{&record})
+	require.ErrorContains(t, err, `unknown field "unknown"`)
+
+	err = unmarshalJSONArray([]byte(`[{"nested": {"tags": [[3, 4]]}}]`), opts, []any{&record})
+	require.ErrorContains(t, err, "field Nested failed to unmarshal: field Tags failed to unmarshal: item at index 0 failed to unmarshal")
+}
+
+func Test_jsonHoldsTuples(t *testing.T) {
+	type node struct {
+		Children []node
+		Value    T1[int]
+	}
+	type unexported struct {
+		value T1[int]
+	}
+	type embedsUnexported struct {
+		unexported
+		Value T1[int]
+	}
+
+	holds := func(v any) bool {
+		return jsonHoldsTuples(reflect.TypeOf(v), map[reflect.Type]bool{})
+	}
+
+	require.True(t, holds(T1[int]{}))
+	require.True(t, holds(&T1[int]{}))
+	require.True(t, holds([]T1[int]{}))
+	require.True(t, holds([2]*T1[int]{}))
+	require.True(t, holds(map[string]T1[int]{}))
+	require.True(t, holds(node{}))
+	require.True(t, holds(Pair[int, int]{}))
+	require.False(t, holds([]int{}))
+	require.False(t, holds(unexported{}))
+	require.False(t, holds(embedsUnexported{}))
+	require.False(t, holds(Labeled[T1[int]]{}))
+	require.False(t, holds(json.RawMessage{}))
+}
+
+func TestUnmarshalJSONWith_NestedInContainers(t *testing.T) {
+	var tup T1[[]T2[any, int]]
+	err := tup.UnmarshalJSONWith([]byte(`[[[1, 2], [3.5, 4]]]`), JSONDecodeOptions{UseNumber: true})
+	require.NoError(t, err)
+	require.Equal(t, New1([]T2[any, int]{New2[any](json.Number("1"), 2), New2[any](json.Number("3.5"), 4)}), tup)
+}
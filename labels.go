@@ -0,0 +1,169 @@
+package tuple
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Labels holds the labels of tuple values, used to encode tuples as objects keyed by the labels
+// instead of arrays.
+// Labels are created using the WithLabels function.
+type Labels struct {
+	names  []string
+	strict bool
+}
+
+// WithLabels returns labels holding the given names, one for each of the tuple values by order.
+func WithLabels(names ...string) Labels {
+	return Labels{names: names}
+}
+
+// Strict returns a copy of the labels that fails unmarshalling objects with missing or unknown keys.
+// By default, missing keys leave the matching tuple values unchanged, and unknown keys are ignored.
+func (l Labels) Strict() Labels {
+	l.strict = true
+	return l
+}
+
+// Names returns the names of the labels.
+func (l Labels) Names() []string {
+	return append([]string(nil), l.names...)
+}
+
+// IsStrict returns whether the labels fail unmarshalling objects with missing or unknown keys.
+func (l Labels) IsStrict() bool {
+	return l.strict
+}
+
+// jsonLabeledMarshaler is implemented by tuples, marshalling them into JSON objects keyed by labels.
+type jsonLabeledMarshaler interface {
+	MarshalJSONLabeled(labels Labels) ([]byte, error)
+}
+
+// jsonLabeledUnmarshaler is implemented by tuple pointers, unmarshalling them from JSON objects keyed by labels.
+type jsonLabeledUnmarshaler interface {
+	UnmarshalJSONLabeled(data []byte, labels Labels) error
+}
+
+// Labeled holds a tuple along with its labels, marshalling the tuple as a JSON object keyed by the labels.
+// Labeled implements the json.Marshaler and json.Unmarshaler interfaces, so labeled tuples can be used
+// as fields of structs marshalled by the encoding/json package.
+// Since the labels are not part of the JSON object, they must be set before unmarshalling, such as by NewLabeled.
+type Labeled[T jsonLabeledMarshaler] struct {
+	V      T
+	Labels Labels
+}
+
+// NewLabeled returns a Labeled holding the given tuple and labels.
+func NewLabeled[T jsonLabeledMarshaler](v T, labels Labels) Labeled[T] {
+	return Labeled[T]{V: v, Labels: labels}
+}
+
+// MarshalJSON marshals the tuple into a JSON object keyed by the labels, implementing the json.Marshaler interface.
+func (l Labeled[T]) MarshalJSON() ([]byte, error) {
+	return l.V.MarshalJSONLabeled(l.Labels)
+}
+
+// UnmarshalJSON unmarshals the tuple from a JSON object keyed by the labels, implementing the json.Unmarshaler interface.
+func (l *Labeled[T]) UnmarshalJSON(data []byte) error {
+	unmarshaler, ok := any(&l.V).(jsonLabeledUnmarshaler)
+	if !ok {
+		return fmt.Errorf("unable to unmarshal labeled json into value of type %s", typeName[T]())
+	}
+
+	return unmarshaler.UnmarshalJSONLabeled(data, l.Labels)
+}
+
+// validate returns an error if the labels don't match a tuple of the given length, or hold duplicate names.
+func (l Labels) validate(length int) error {
+	if len(l.names) != length {
+		return &LengthMismatchError{Source: "labels", Expected: length, Actual: len(l.names), count: true}
+	}
+
+	seen := make(map[string]struct{}, len(l.names))
+	for _, name := range l.names {
+		if _, ok := seen[name]; ok {
+			return fmt.Errorf("label %q is used more than once", name)
+		}
+		seen[name] = struct{}{}
+	}
+
+	return nil
+}
+
+// marshalJSONLabeled marshals the values into a JSON object, keyed by the labels in the same order.
+func marshalJSONLabeled(labels Labels, values []any) ([]byte, error) {
+	if err := labels.validate(len(values)); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, value := range values {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		key, err := json.Marshal(labels.names[i])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+
+		marshalled, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("value with label %q failed to marshal: %w", labels.names[i], err)
+		}
+		buf.Write(marshalled)
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// unmarshalJSONLabeled unmarshals a JSON object keyed by the labels into the values pointed by ptrs in the same order.
+func unmarshalJSONLabeled(data []byte, labels Labels, ptrs []any) error {
+	if err := labels.validate(len(ptrs)); err != nil {
+		return err
+	}
+
+	// Working with json.RawMessage instead of any enables custom struct support.
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		return fmt.Errorf("unable to unmarshal json object for tuple: %w", err)
+	}
+
+	for i, name := range labels.names {
+		value, ok := object[name]
+		if !ok {
+			if labels.strict {
+				return fmt.Errorf("json object is missing a value with label %q", name)
+			}
+			continue
+		}
+
+		if err := json.Unmarshal(value, ptrs[i]); err != nil {
+			return &ElementUnmarshalError{Index: i, Label: name, Value: string(value), Err: err, location: locationLabel}
+		}
+		delete(object, name)
+	}
+
+	if labels.strict && len(object) > 0 {
+		keys := make([]string, 0, len(object))
+		for key := range object {
+			keys = append(keys, fmt.Sprintf("%q", key))
+		}
+		sort.Strings(keys)
+
+		if len(keys) == 1 {
+			return fmt.Errorf("json object has an unknown key %s", keys[0])
+		}
+		return fmt.Errorf("json object has unknown keys %s", strings.Join(keys, ", "))
+	}
+
+	return nil
+}
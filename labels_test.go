@@ -0,0 +1,108 @@
+package tuple
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithLabels(t *testing.T) {
+	labels := WithLabels("id", "name")
+	require.Equal(t, []string{"id", "name"}, labels.Names())
+	require.False(t, labels.IsStrict())
+	require.True(t, labels.Strict().IsStrict())
+	require.False(t, labels.IsStrict())
+}
+
+func TestLabels_validate(t *testing.T) {
+	require.NoError(t, WithLabels("id", "name").validate(2))
+	require.Error(t, WithLabels("id").validate(2))
+	require.Error(t, WithLabels("id", "name", "age").validate(2))
+	require.Error(t, WithLabels("id", "id").validate(2))
+}
+
+func Test_marshalJSONLabeled(t *testing.T) {
+	got, err := marshalJSONLabeled(WithLabels("id", "name"), []any{1, "x"})
+	require.NoError(t, err)
+	require.Equal(t, `{"id":1,"name":"x"}`, string(got))
+
+	_, err = marshalJSONLabeled(WithLabels("id", "name"), []any{1, make(chan int)})
+	require.Error(t, err)
+}
+
+func Test_unmarshalJSONLabeled(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       string
+		labels     Labels
+		wantID     int
+		wantName   string
+		wantErr    bool
+		wantErrMsg string
+	}{
+		{name: "all keys", data: `{"id":1,"name":"x"}`, labels: WithLabels("id", "name"), wantID: 1, wantName: "x"},
+		{name: "reordered keys", data: `{"name":"x","id":1}`, labels: WithLabels("id", "name"), wantID: 1, wantName: "x"},
+		{name: "missing key", data: `{"id":1}`, labels: WithLabels("id", "name"), wantID: 1, wantName: "unchanged"},
+		{name: "unknown key", data: `{"id":1,"name":"x","age":5}`, labels: WithLabels("id", "name"), wantID: 1, wantName: "x"},
+		{name: "strict missing key", data: `{"id":1}`, labels: WithLabels("id", "name").Strict(), wantErr: true},
+		{name: "strict unknown key", data: `{"id":1,"name":"x","age":5}`, labels: WithLabels("id", "name").Strict(), wantErr: true, wantErrMsg: `json object has an unknown key "age"`},
+		{name: "strict unknown keys", data: `{"id":1,"name":"x","b":5,"a":6,"c":7}`, labels: WithLabels("id", "name").Strict(), wantErr: true, wantErrMsg: `json object has unknown keys "a", "b", "c"`},
+		{name: "strict all keys", data: `{"id":1,"name":"x"}`, labels: WithLabels("id", "name").Strict(), wantID: 1, wantName: "x"},
+		{name: "invalid value", data: `{"id":"1","name":"x"}`, labels: WithLabels("id", "name"), wantErr: true},
+		{name: "array", data: `[1,"x"]`, labels: WithLabels("id", "name"), wantErr: true},
+		{name: "invalid labels", data: `{"id":1}`, labels: WithLabels("id"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := 0
+			name := "unchanged"
+			err := unmarshalJSONLabeled([]byte(tt.data), tt.labels, []any{&id, &name})
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.wantErrMsg != "" {
+					require.EqualError(t, err, tt.wantErrMsg)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantID, id)
+			require.Equal(t, tt.wantName, name)
+		})
+	}
+}
+
+func TestLabeled_MarshalJSON_UnmarshalJSON(t *testing.T) {
+	type request struct {
+		Point Labeled[T2[int, int]]       `json:"point"`
+		Owner Labeled[Pair[string, bool]] `json:"owner"`
+	}
+
+	labels := WithLabels("x", "y")
+	req := request{
+		Point: NewLabeled(New2(1, 2), labels),
+		Owner: NewLabeled(NewPair("foo", true), WithLabels("name", "admin")),
+	}
+
+	marshalled, err := json.Marshal(req)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"point":{"x":1,"y":2},"owner":{"name":"foo","admin":true}}`, string(marshalled))
+
+	unmarshalled := request{
+		Point: NewLabeled(T2[int, int]{}, labels.Strict()),
+		Owner: NewLabeled(Pair[string, bool]{}, WithLabels("name", "admin")),
+	}
+	err = json.Unmarshal(marshalled, &unmarshalled)
+	require.NoError(t, err)
+	require.Equal(t, req.Point.V, unmarshalled.Point.V)
+	require.Equal(t, req.Owner.V, unmarshalled.Owner.V)
+
+	err = json.Unmarshal([]byte(`{"point":{"x":1,"z":2}}`), &unmarshalled)
+	require.Error(t, err)
+
+	var unlabeled Labeled[T2[int, int]]
+	err = json.Unmarshal([]byte(`{"x":1,"y":2}`), &unlabeled)
+	require.Error(t, err)
+}
@@ -0,0 +1,120 @@
+package tuple
+
+import (
+	"cmp"
+)
+
+// nilPlacement represents where nil elements are placed when sorting tuples.
+type nilPlacement int
+
+const (
+	// nilsDefault places nil elements as if they are less than any non-nil element.
+	nilsDefault nilPlacement = iota
+	// nilsFirst places nil elements before non-nil elements, regardless of the sort direction.
+	nilsFirst
+	// nilsLast places nil elements after non-nil elements, regardless of the sort direction.
+	nilsLast
+)
+
+// SortOrder describes the order by which a tuple element is sorted.
+// Use the Asc and Desc values to describe the sort direction, and the NilsFirst and NilsLast methods
+// to control where nil elements are placed.
+type SortOrder struct {
+	descending bool
+	nils       nilPlacement
+}
+
+var (
+	// Asc sorts elements in ascending order.
+	// Unless specified otherwise, nil elements are placed before non-nil elements.
+	Asc = SortOrder{}
+
+	// Desc sorts elements in descending order.
+	// Unless specified otherwise, nil elements are placed after non-nil elements.
+	Desc = SortOrder{descending: true}
+)
+
+// NilsFirst returns a sort order with the same direction, which places nil elements before non-nil elements.
+func (order SortOrder) NilsFirst() SortOrder {
+	order.nils = nilsFirst
+	return order
+}
+
+// NilsLast returns a sort order with the same direction, which places nil elements after non-nil elements.
+func (order SortOrder) NilsLast() SortOrder {
+	order.nils = nilsLast
+	return order
+}
+
+// IsDescending returns whether the sort order is descending.
+func (order SortOrder) IsDescending() bool {
+	return order.descending
+}
+
+// apply returns the comparison result of two non-nil elements according to the sort direction.
+func (order SortOrder) apply(result OrderedComparisonResult) OrderedComparisonResult {
+	if order.descending {
+		return result.Reverse()
+	}
+
+	return result
+}
+
+// compareNils returns the comparison result of two elements, where at least one of them is nil,
+// according to the nil placement of the sort order.
+func (order SortOrder) compareNils(hostNil, guestNil bool) OrderedComparisonResult {
+	if hostNil && guestNil {
+		return 0
+	}
+
+	switch order.nils {
+	case nilsFirst:
+		if hostNil {
+			return -1
+		}
+		return 1
+	case nilsLast:
+		if hostNil {
+			return 1
+		}
+		return -1
+	default:
+		if hostNil {
+			return order.apply(-1)
+		}
+		return order.apply(1)
+	}
+}
+
+// OrderFunc returns an element comparison function that compares elements using the compare function
+// according to the sort direction of the given order.
+// The returned function can be used as an element comparison function of the Comparator functions.
+func OrderFunc[T any](order SortOrder, compare func(a, b T) int) func(a, b T) int {
+	return func(a, b T) int {
+		return int(order.apply(OrderedComparisonResult(compare(a, b))))
+	}
+}
+
+// OrderPtrFunc returns an element comparison function of pointers, which compares the pointed values using the compare function
+// according to the sort direction of the given order, and places nil pointers according to the nil placement of the given order.
+// The returned function can be used as an element comparison function of the Comparator functions.
+func OrderPtrFunc[T any](order SortOrder, compare func(a, b T) int) func(a, b *T) int {
+	return func(a, b *T) int {
+		if a == nil || b == nil {
+			return int(order.compareNils(a == nil, b == nil))
+		}
+
+		return int(order.apply(OrderedComparisonResult(compare(*a, *b))))
+	}
+}
+
+// OrderedBy returns an element comparison function of values that match the Ordered constraint, according to the given order.
+func OrderedBy[T cmp.Ordered](order SortOrder) func(a, b T) int {
+	return OrderFunc(order, CompareOrdered[T])
+}
+
+// OrderedPtrBy returns an element comparison function of pointers to values that match the Ordered constraint,
+// according to the given order.
+func OrderedPtrBy[T cmp.Ordered](order SortOrder) func(a, b *T) int {
+	return OrderPtrFunc(order, CompareOrdered[T])
+}
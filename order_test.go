@@ -0,0 +1,93 @@
+package tuple
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestSortOrder(t *testing.T) {
+	require.False(t, Asc.IsDescending())
+	require.True(t, Desc.IsDescending())
+	require.True(t, Desc.NilsFirst().IsDescending())
+	require.False(t, Asc.NilsLast().IsDescending())
+}
+
+func TestOrderFunc(t *testing.T) {
+	asc := OrderFunc(Asc, cmp.Compare[int])
+	desc := OrderFunc(Desc, cmp.Compare[int])
+
+	require.Equal(t, -1, asc(1, 2))
+	require.Equal(t, 1, desc(1, 2))
+	require.Equal(t, 0, desc(1, 1))
+}
+
+func TestOrderPtrFunc(t *testing.T) {
+	tests := []struct {
+		name  string
+		order SortOrder
+		host  *int
+		guest *int
+		want  int
+	}{
+		{name: "asc values", order: Asc, host: ptr(1), guest: ptr(2), want: -1},
+		{name: "desc values", order: Desc, host: ptr(1), guest: ptr(2), want: 1},
+		{name: "both nil", order: Asc, host: nil, guest: nil, want: 0},
+		{name: "asc nil host", order: Asc, host: nil, guest: ptr(1), want: -1},
+		{name: "asc nil guest", order: Asc, host: ptr(1), guest: nil, want: 1},
+		{name: "desc nil host", order: Desc, host: nil, guest: ptr(1), want: 1},
+		{name: "desc nil guest", order: Desc, host: ptr(1), guest: nil, want: -1},
+		{name: "asc nils last", order: Asc.NilsLast(), host: nil, guest: ptr(1), want: 1},
+		{name: "desc nils first", order: Desc.NilsFirst(), host: nil, guest: ptr(1), want: -1},
+		{name: "desc nils first guest", order: Desc.NilsFirst(), host: ptr(1), guest: nil, want: 1},
+		{name: "asc nils first", order: Asc.NilsFirst(), host: nil, guest: ptr(1), want: -1},
+		{name: "desc nils last", order: Desc.NilsLast(), host: nil, guest: ptr(1), want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, OrderPtrFunc(tt.order, cmp.Compare[int])(tt.host, tt.guest))
+			require.Equal(t, tt.want, OrderedPtrBy[int](tt.order)(tt.host, tt.guest))
+		})
+	}
+}
+
+func TestOrderBy_MultiColumn(t *testing.T) {
+	rows := []T2[string, int]{
+		New2("bob", 30),
+		New2("alice", 25),
+		New2("bob", 40),
+		New2("alice", 35),
+	}
+
+	slices.SortFunc(rows, OrderBy2[string, int](Asc, Desc))
+	require.Equal(t, []T2[string, int]{
+		New2("alice", 35),
+		New2("alice", 25),
+		New2("bob", 40),
+		New2("bob", 30),
+	}, rows)
+}
+
+func TestOrderBy_Pointers(t *testing.T) {
+	rows := []T2[string, *int]{
+		New2("bob", ptr(30)),
+		New2("bob", (*int)(nil)),
+		New2("alice", ptr(25)),
+		New2("bob", ptr(40)),
+	}
+
+	slices.SortFunc(rows, Comparator2(OrderedBy[string](Asc), OrderedPtrBy[int](Desc.NilsFirst())))
+	require.Equal(t, []T2[string, *int]{
+		New2("alice", ptr(25)),
+		New2("bob", (*int)(nil)),
+		New2("bob", ptr(40)),
+		New2("bob", ptr(30)),
+	}, rows)
+}
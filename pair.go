@@ -1,4 +1,323 @@
 package tuple
 
+import (
+	"cmp"
+	"encoding/binary"
+	"encoding/xml"
+	"hash/maphash"
+	"sort"
+)
+
 // Pair is a generic type that holds two values.
+// Pair is most commonly used to hold a key and its matching value, such as map entries.
 type Pair[Ty1 any, Ty2 any] T2[Ty1, Ty2]
+
+// NewPair creates a new pair holding the given key and value.
+func NewPair[Ty1, Ty2 any](key Ty1, value Ty2) Pair[Ty1, Ty2] {
+	return Pair[Ty1, Ty2]{
+		V1: key,
+		V2: value,
+	}
+}
+
+// PairFromT2 returns a pair holding the values of the given tuple.
+func PairFromT2[Ty1, Ty2 any](t T2[Ty1, Ty2]) Pair[Ty1, Ty2] {
+	return Pair[Ty1, Ty2](t)
+}
+
+// T2 returns a tuple holding the pair values.
+func (p Pair[Ty1, Ty2]) T2() T2[Ty1, Ty2] {
+	return T2[Ty1, Ty2](p)
+}
+
+// Key returns the first value held by the pair.
+func (p Pair[Ty1, Ty2]) Key() Ty1 {
+	return p.V1
+}
+
+// Value returns the second value held by the pair.
+func (p Pair[Ty1, Ty2]) Value() Ty2 {
+	return p.V2
+}
+
+// Len returns the number of values held by the pair.
+func (p Pair[Ty1, Ty2]) Len() int {
+	return 2
+}
+
+// Values returns the values held by the pair.
+func (p Pair[Ty1, Ty2]) Values() (Ty1, Ty2) {
+	return p.V1, p.V2
+}
+
+// Swap returns a new pair holding the pair values in swapped order.
+func (p Pair[Ty1, Ty2]) Swap() Pair[Ty2, Ty1] {
+	return Pair[Ty2, Ty1]{
+		V1: p.V2,
+		V2: p.V1,
+	}
+}
+
+// Array returns an array of the pair values.
+func (p Pair[Ty1, Ty2]) Array() [2]any {
+	return p.T2().Array()
+}
+
+// Slice returns a slice of the pair values.
+func (p Pair[Ty1, Ty2]) Slice() []any {
+	return p.T2().Slice()
+}
+
+// String returns the string representation of the pair.
+func (p Pair[Ty1, Ty2]) String() string {
+	return tupString(p.Slice())
+}
+
+// GoString returns a Go-syntax representation of the pair.
+func (p Pair[Ty1, Ty2]) GoString() string {
+	return namedTupGoString("Pair", p.Slice())
+}
+
+// Equal returns whether the pair is equal to the guest pair.
+// The pair values are tested for equality the same way as the T2 Equal method.
+func (p Pair[Ty1, Ty2]) Equal(guest Pair[Ty1, Ty2]) bool {
+	return p.T2().Equal(guest.T2())
+}
+
+// Hash returns a hash of the pair values using the given seed.
+// The pair values are hashed the same way as the T2 Hash method.
+func (p Pair[Ty1, Ty2]) Hash(seed maphash.Seed) uint64 {
+	return p.T2().Hash(seed)
+}
+
+// MarshalJSON marshals the pair into a JSON array.
+func (p Pair[Ty1, Ty2]) MarshalJSON() ([]byte, error) {
+	return p.T2().MarshalJSON()
+}
+
+// UnmarshalJSON unmarshals the pair from a JSON array.
+func (p *Pair[Ty1, Ty2]) UnmarshalJSON(data []byte) error {
+	return (*T2[Ty1, Ty2])(p).UnmarshalJSON(data)
+}
+
+// UnmarshalJSONWith unmarshals the pair from a JSON array using the given decode options.
+func (p *Pair[Ty1, Ty2]) UnmarshalJSONWith(data []byte, opts JSONDecodeOptions) error {
+	return (*T2[Ty1, Ty2])(p).UnmarshalJSONWith(data, opts)
+}
+
+// MarshalJSONLabeled marshals the pair into a JSON object, where each of the pair values is keyed by its matching label.
+func (p Pair[Ty1, Ty2]) MarshalJSONLabeled(labels Labels) ([]byte, error) {
+	return p.T2().MarshalJSONLabeled(labels)
+}
+
+// UnmarshalJSONLabeled unmarshals the pair from a JSON object, where each of the pair values is keyed by its matching label.
+func (p *Pair[Ty1, Ty2]) UnmarshalJSONLabeled(data []byte, labels Labels) error {
+	return (*T2[Ty1, Ty2])(p).UnmarshalJSONLabeled(data, labels)
+}
+
+// MarshalText marshals the pair into a text of comma separated values.
+// The pair values are marshalled the same way as the T2 MarshalText method.
+func (p Pair[Ty1, Ty2]) MarshalText() ([]byte, error) {
+	return p.T2().MarshalText()
+}
+
+// UnmarshalText unmarshals the pair from a text of comma separated values.
+func (p *Pair[Ty1, Ty2]) UnmarshalText(data []byte) error {
+	return (*T2[Ty1, Ty2])(p).UnmarshalText(data)
+}
+
+// GobEncode encodes the pair for encoding/gob in the same wire format as the T2 GobEncode method.
+func (p Pair[Ty1, Ty2]) GobEncode() ([]byte, error) {
+	return p.T2().GobEncode()
+}
+
+// GobDecode decodes the pair from encoding/gob, as encoded by GobEncode.
+func (p *Pair[Ty1, Ty2]) GobDecode(data []byte) error {
+	return (*T2[Ty1, Ty2])(p).GobDecode(data)
+}
+
+// MarshalComposite marshals the pair into a PostgreSQL composite literal, the same way as the T2 MarshalComposite method.
+func (p Pair[Ty1, Ty2]) MarshalComposite() ([]byte, error) {
+	return p.T2().MarshalComposite()
+}
+
+// UnmarshalComposite unmarshals the pair from a PostgreSQL composite literal.
+func (p *Pair[Ty1, Ty2]) UnmarshalComposite(data []byte) error {
+	return (*T2[Ty1, Ty2])(p).UnmarshalComposite(data)
+}
+
+// MarshalXML marshals the pair into a sequence of xml child elements, the same way as the T2 MarshalXML method.
+func (p Pair[Ty1, Ty2]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return p.T2().MarshalXML(e, start)
+}
+
+// UnmarshalXML unmarshals the pair from a sequence of xml child elements named v1 and v2.
+func (p *Pair[Ty1, Ty2]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return (*T2[Ty1, Ty2])(p).UnmarshalXML(d, start)
+}
+
+// MarshalXMLLabeled marshals the pair into a sequence of xml child elements, named by the labels matching each of the pair values.
+func (p Pair[Ty1, Ty2]) MarshalXMLLabeled(e *xml.Encoder, start xml.StartElement, labels Labels) error {
+	return p.T2().MarshalXMLLabeled(e, start, labels)
+}
+
+// UnmarshalXMLLabeled unmarshals the pair from a sequence of xml child elements, named by the labels matching each of the pair values.
+func (p *Pair[Ty1, Ty2]) UnmarshalXMLLabeled(d *xml.Decoder, start xml.StartElement, labels Labels) error {
+	return (*T2[Ty1, Ty2])(p).UnmarshalXMLLabeled(d, start, labels)
+}
+
+// EqualPair returns whether the host pair is equal to the other pair.
+// All pair elements of the host and guest parameters must match the "comparable" built-in constraint.
+// To test equality of pairs that hold custom Equalable values, use the EqualPairE function.
+// To test equality of pairs that hold custom Comparable values, use the EqualPairC function.
+func EqualPair[Ty1, Ty2 comparable](host, guest Pair[Ty1, Ty2]) bool {
+	return Equal2(host.T2(), guest.T2())
+}
+
+// EqualPairE returns whether the host pair is semantically equal to the guest pair.
+// All pair elements of the host and guest parameters must match the Equalable constraint.
+// To test equality of pairs that hold built-in "comparable" values, use the EqualPair function.
+// To test equality of pairs that hold custom Comparable values, use the EqualPairC function.
+func EqualPairE[Ty1 Equalable[Ty1], Ty2 Equalable[Ty2]](host, guest Pair[Ty1, Ty2]) bool {
+	return Equal2E(host.T2(), guest.T2())
+}
+
+// EqualPairC returns whether the host pair is semantically equal to the guest pair.
+// All pair elements of the host and guest parameters must match the Comparable constraint.
+// To test equality of pairs that hold built-in "comparable" values, use the EqualPair function.
+// To test equality of pairs that hold custom Equalable values, use the EqualPairE function.
+func EqualPairC[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2]](host, guest Pair[Ty1, Ty2]) bool {
+	return Equal2C(host.T2(), guest.T2())
+}
+
+// ComparePair returns whether the host pair is semantically less than, equal to, or greater than the guest pair.
+// All pair elements of the host and guest parameters must match the "Ordered" constraint.
+// To compare pairs that hold custom comparable values, use the ComparePairC function.
+func ComparePair[Ty1, Ty2 cmp.Ordered](host, guest Pair[Ty1, Ty2]) OrderedComparisonResult {
+	return Compare2(host.T2(), guest.T2())
+}
+
+// ComparePairC returns whether the host pair is semantically less than, equal to, or greater than the guest pair.
+// All pair elements of the host and guest parameters must match the Comparable constraint.
+// To compare pairs that hold built-in "Ordered" values, use the ComparePair function.
+func ComparePairC[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2]](host, guest Pair[Ty1, Ty2]) OrderedComparisonResult {
+	return Compare2C(host.T2(), guest.T2())
+}
+
+// LessThanPair returns whether the host pair is semantically less than the guest pair.
+// All pair elements of the host and guest parameters must match the "Ordered" constraint.
+// To compare pairs that hold custom comparable values, use the LessThanPairC function.
+func LessThanPair[Ty1, Ty2 cmp.Ordered](host, guest Pair[Ty1, Ty2]) bool {
+	return ComparePair(host, guest).LT()
+}
+
+// LessThanPairC returns whether the host pair is semantically less than the guest pair.
+// All pair elements of the host and guest parameters must match the Comparable constraint.
+// To compare pairs that hold built-in "Ordered" values, use the LessThanPair function.
+func LessThanPairC[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2]](host, guest Pair[Ty1, Ty2]) bool {
+	return ComparePairC(host, guest).LT()
+}
+
+// LessOrEqualPair returns whether the host pair is semantically less than or equal to the guest pair.
+// All pair elements of the host and guest parameters must match the "Ordered" constraint.
+// To compare pairs that hold custom comparable values, use the LessOrEqualPairC function.
+func LessOrEqualPair[Ty1, Ty2 cmp.Ordered](host, guest Pair[Ty1, Ty2]) bool {
+	return ComparePair(host, guest).LE()
+}
+
+// LessOrEqualPairC returns whether the host pair is semantically less than or equal to the guest pair.
+// All pair elements of the host and guest parameters must match the Comparable constraint.
+// To compare pairs that hold built-in "Ordered" values, use the LessOrEqualPair function.
+func LessOrEqualPairC[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2]](host, guest Pair[Ty1, Ty2]) bool {
+	return ComparePairC(host, guest).LE()
+}
+
+// GreaterThanPair returns whether the host pair is semantically greater than the guest pair.
+// All pair elements of the host and guest parameters must match the "Ordered" constraint.
+// To compare pairs that hold custom comparable values, use the GreaterThanPairC function.
+func GreaterThanPair[Ty1, Ty2 cmp.Ordered](host, guest Pair[Ty1, Ty2]) bool {
+	return ComparePair(host, guest).GT()
+}
+
+// GreaterThanPairC returns whether the host pair is semantically greater than the guest pair.
+// All pair elements of the host and guest parameters must match the Comparable constraint.
+// To compare pairs that hold built-in "Ordered" values, use the GreaterThanPair function.
+func GreaterThanPairC[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2]](host, guest Pair[Ty1, Ty2]) bool {
+	return ComparePairC(host, guest).GT()
+}
+
+// GreaterOrEqualPair returns whether the host pair is semantically greater than or equal to the guest pair.
+// All pair elements of the host and guest parameters must match the "Ordered" constraint.
+// To compare pairs that hold custom comparable values, use the GreaterOrEqualPairC function.
+func GreaterOrEqualPair[Ty1, Ty2 cmp.Ordered](host, guest Pair[Ty1, Ty2]) bool {
+	return ComparePair(host, guest).GE()
+}
+
+// GreaterOrEqualPairC returns whether the host pair is semantically greater than or equal to the guest pair.
+// All pair elements of the host and guest parameters must match the Comparable constraint.
+// To compare pairs that hold built-in "Ordered" values, use the GreaterOrEqualPair function.
+func GreaterOrEqualPairC[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2]](host, guest Pair[Ty1, Ty2]) bool {
+	return ComparePairC(host, guest).GE()
+}
+
+// PairsFromMap returns a slice of pairs holding the keys and values of the given map.
+// The order of the returned pairs is unspecified. To get the pairs sorted by their keys, use the SortedPairsFromMap function.
+func PairsFromMap[Ty1 comparable, Ty2 any](m map[Ty1]Ty2) []Pair[Ty1, Ty2] {
+	pairs := make([]Pair[Ty1, Ty2], 0, len(m))
+	for key, value := range m {
+		pairs = append(pairs, NewPair(key, value))
+	}
+
+	return pairs
+}
+
+// SortedPairsFromMap returns a slice of pairs holding the keys and values of the given map, sorted by their keys.
+func SortedPairsFromMap[Ty1 cmp.Ordered, Ty2 any](m map[Ty1]Ty2) []Pair[Ty1, Ty2] {
+	pairs := PairsFromMap(m)
+	sort.Slice(pairs, func(i, j int) bool {
+		return compareOrdered(pairs[i].V1, pairs[j].V1).LT()
+	})
+
+	return pairs
+}
+
+// PairsToMap returns a map holding the keys and values of the given pairs.
+// If multiple pairs hold the same key, the value of the last of them is kept.
+func PairsToMap[Ty1 comparable, Ty2 any](pairs []Pair[Ty1, Ty2]) map[Ty1]Ty2 {
+	m := make(map[Ty1]Ty2, len(pairs))
+	for _, pair := range pairs {
+		m[pair.V1] = pair.V2
+	}
+
+	return m
+}
+
+// MarshalBinary marshals the pair into the compact binary encoding, the same way as the T2 MarshalBinary method.
+func (p Pair[Ty1, Ty2]) MarshalBinary() ([]byte, error) {
+	return p.T2().MarshalBinary()
+}
+
+// AppendBinary appends the compact binary encoding of the pair to b using little-endian byte order.
+func (p Pair[Ty1, Ty2]) AppendBinary(b []byte) ([]byte, error) {
+	return p.T2().AppendBinary(b)
+}
+
+// AppendBinaryOrder appends the compact binary encoding of the pair to b using the given byte order.
+func (p Pair[Ty1, Ty2]) AppendBinaryOrder(b []byte, order binary.AppendByteOrder) ([]byte, error) {
+	return p.T2().AppendBinaryOrder(b, order)
+}
+
+// UnmarshalBinary unmarshals the pair from the compact binary encoding using little-endian byte order.
+func (p *Pair[Ty1, Ty2]) UnmarshalBinary(data []byte) error {
+	return (*T2[Ty1, Ty2])(p).UnmarshalBinary(data)
+}
+
+// UnmarshalBinaryOrder unmarshals the pair from the compact binary encoding using the given byte order.
+func (p *Pair[Ty1, Ty2]) UnmarshalBinaryOrder(data []byte, order binary.ByteOrder) error {
+	return (*T2[Ty1, Ty2])(p).UnmarshalBinaryOrder(data, order)
+}
+
+// decodeBinary decodes the pair from the beginning of data using the given byte order, and returns the rest of the data.
+func (p *Pair[Ty1, Ty2]) decodeBinary(data []byte, order binary.ByteOrder) ([]byte, error) {
+	return (*T2[Ty1, Ty2])(p).decodeBinary(data, order)
+}
@@ -0,0 +1,220 @@
+package tuple
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"encoding/xml"
+	"hash/maphash"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPair(t *testing.T) {
+	pair := NewPair("key", 5)
+	require.Equal(t, Pair[string, int]{V1: "key", V2: 5}, pair)
+	require.Equal(t, "key", pair.Key())
+	require.Equal(t, 5, pair.Value())
+}
+
+func TestPair_T2(t *testing.T) {
+	pair := NewPair("key", 5)
+	require.Equal(t, New2("key", 5), pair.T2())
+	require.Equal(t, pair, PairFromT2(New2("key", 5)))
+}
+
+func TestPair_Values(t *testing.T) {
+	pair := NewPair("key", 5)
+	key, value := pair.Values()
+	require.Equal(t, 2, pair.Len())
+	require.Equal(t, "key", key)
+	require.Equal(t, 5, value)
+	require.Equal(t, [2]any{"key", 5}, pair.Array())
+	require.Equal(t, []any{"key", 5}, pair.Slice())
+}
+
+func TestPair_Swap(t *testing.T) {
+	pair := Pair[int, string]{V1: 1, V2: "2"}
+	require.Equal(t, Pair[string, int]{V1: "2", V2: 1}, pair.Swap())
+}
+
+func TestPair_String(t *testing.T) {
+	pair := NewPair("key", 5)
+	require.Equal(t, `["key" 5]`, pair.String())
+	require.Equal(t, `tuple.Pair[string, int]{V1: "key", V2: 5}`, pair.GoString())
+}
+
+func TestPair_Marshal_Unmarshal(t *testing.T) {
+	pair := NewPair("key", 5)
+
+	marshalled, err := json.Marshal(pair)
+	require.NoError(t, err)
+	require.Equal(t, `["key",5]`, string(marshalled))
+
+	var unmarshalled Pair[string, int]
+	err = json.Unmarshal(marshalled, &unmarshalled)
+	require.NoError(t, err)
+	require.Equal(t, pair, unmarshalled)
+
+	err = json.Unmarshal([]byte(`["key"]`), &unmarshalled)
+	require.Error(t, err)
+}
+
+func TestPair_Compare(t *testing.T) {
+	lesser := NewPair("a", 1)
+	greater := NewPair("a", 2)
+
+	require.True(t, EqualPair(lesser, lesser))
+	require.False(t, EqualPair(lesser, greater))
+	require.Equal(t, OrderedComparisonResult(-1), ComparePair(lesser, greater))
+	require.True(t, LessThanPair(lesser, greater))
+	require.True(t, LessOrEqualPair(lesser, lesser))
+	require.True(t, GreaterThanPair(greater, lesser))
+	require.True(t, GreaterOrEqualPair(greater, greater))
+}
+
+func TestPair_CompareC(t *testing.T) {
+	lesser := NewPair(stringComparable("a"), stringComparable("1"))
+	greater := NewPair(stringComparable("a"), stringComparable("2"))
+
+	require.True(t, EqualPairC(lesser, lesser))
+	require.False(t, EqualPairC(lesser, greater))
+	require.Equal(t, OrderedComparisonResult(1), ComparePairC(greater, lesser))
+	require.True(t, LessThanPairC(lesser, greater))
+	require.True(t, LessOrEqualPairC(lesser, lesser))
+	require.True(t, GreaterThanPairC(greater, lesser))
+	require.True(t, GreaterOrEqualPairC(greater, greater))
+}
+
+func TestPair_EqualE(t *testing.T) {
+	a := NewPair(intEqualable(1), intEqualable(2))
+	b := NewPair(intEqualable(1), intEqualable(3))
+
+	require.True(t, EqualPairE(a, a))
+	require.False(t, EqualPairE(a, b))
+}
+
+func TestPairsFromMap(t *testing.T) {
+	pairs := PairsFromMap(map[string]int{"b": 2, "a": 1})
+	require.ElementsMatch(t, []Pair[string, int]{NewPair("a", 1), NewPair("b", 2)}, pairs)
+}
+
+func TestSortedPairsFromMap(t *testing.T) {
+	pairs := SortedPairsFromMap(map[string]int{"c": 3, "b": 2, "a": 1})
+	require.Equal(t, []Pair[string, int]{NewPair("a", 1), NewPair("b", 2), NewPair("c", 3)}, pairs)
+}
+
+func TestPairsToMap(t *testing.T) {
+	m := PairsToMap([]Pair[string, int]{NewPair("a", 1), NewPair("b", 2), NewPair("a", 3)})
+	require.Equal(t, map[string]int{"a": 3, "b": 2}, m)
+}
+
+func TestPair_Equal(t *testing.T) {
+	a := NewPair("a", []int{1, 2})
+	b := NewPair("a", []int{1, 3})
+
+	require.True(t, a.Equal(NewPair("a", []int{1, 2})))
+	require.False(t, a.Equal(b))
+}
+
+func TestPair_Hash(t *testing.T) {
+	seed := maphash.MakeSeed()
+	pair := NewPair("key", 5)
+
+	require.Equal(t, pair.Hash(seed), pair.T2().Hash(seed))
+	require.NotEqual(t, pair.Hash(seed), NewPair("key", 6).Hash(seed))
+}
+
+func TestPair_MarshalText_UnmarshalText(t *testing.T) {
+	pair := NewPair("key,1", 5)
+
+	marshalled, err := pair.MarshalText()
+	require.NoError(t, err)
+	require.Equal(t, `key\,1,5`, string(marshalled))
+
+	var unmarshalled Pair[string, int]
+	err = unmarshalled.UnmarshalText(marshalled)
+	require.NoError(t, err)
+	require.Equal(t, pair, unmarshalled)
+}
+
+func TestPair_MarshalJSONLabeled_UnmarshalJSONLabeled(t *testing.T) {
+	pair := NewPair("key", 5)
+	labels := WithLabels("key", "value")
+
+	marshalled, err := pair.MarshalJSONLabeled(labels)
+	require.NoError(t, err)
+	require.Equal(t, `{"key":"key","value":5}`, string(marshalled))
+
+	var unmarshalled Pair[string, int]
+	err = unmarshalled.UnmarshalJSONLabeled(marshalled, labels.Strict())
+	require.NoError(t, err)
+	require.Equal(t, pair, unmarshalled)
+}
+
+func TestPair_UnmarshalJSONWith(t *testing.T) {
+	var pair Pair[string, int]
+	err := pair.UnmarshalJSONWith([]byte(`["key"]`), JSONDecodeOptions{AllowShorter: true})
+	require.NoError(t, err)
+	require.Equal(t, NewPair("key", 0), pair)
+
+	err = pair.UnmarshalJSONWith([]byte(`["key", null]`), JSONDecodeOptions{DisallowNull: true})
+	require.Error(t, err)
+}
+
+func TestPair_GobEncode_GobDecode(t *testing.T) {
+	requireGobRoundTrip(t, NewPair("key", ptr(0)))
+	requireGobRoundTrip(t, Pair[string, *int]{V1: "key"})
+}
+
+func TestPair_MarshalComposite_UnmarshalComposite(t *testing.T) {
+	pair := NewPair("key value", (*int)(nil))
+
+	marshalled, err := pair.MarshalComposite()
+	require.NoError(t, err)
+	require.Equal(t, `("key value",)`, string(marshalled))
+
+	var unmarshalled Pair[string, *int]
+	err = unmarshalled.UnmarshalComposite(marshalled)
+	require.NoError(t, err)
+	require.Equal(t, pair, unmarshalled)
+}
+
+func TestPair_MarshalXML_UnmarshalXML(t *testing.T) {
+	pair := NewPair("key", 5)
+
+	marshalled, err := xml.Marshal(pair)
+	require.NoError(t, err)
+	require.Equal(t, `<Pair><v1>key</v1><v2>5</v2></Pair>`, string(marshalled))
+
+	var unmarshalled Pair[string, int]
+	err = xml.Unmarshal(marshalled, &unmarshalled)
+	require.NoError(t, err)
+	require.Equal(t, pair, unmarshalled)
+}
+
+func TestPair_MarshalBinary_UnmarshalBinary(t *testing.T) {
+	pair := NewPair("key", int32(5))
+
+	marshalled, err := pair.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, []byte{3, 'k', 'e', 'y', 5, 0, 0, 0}, marshalled)
+
+	marshalled, err = pair.AppendBinaryOrder(nil, binary.BigEndian)
+	require.NoError(t, err)
+	require.Equal(t, []byte{3, 'k', 'e', 'y', 0, 0, 0, 5}, marshalled)
+
+	var unmarshalled Pair[string, int32]
+	err = unmarshalled.UnmarshalBinaryOrder(marshalled, binary.BigEndian)
+	require.NoError(t, err)
+	require.Equal(t, pair, unmarshalled)
+
+	nested := New2(true, pair)
+	marshalled, err = nested.MarshalBinary()
+	require.NoError(t, err)
+
+	var unmarshalledNested T2[bool, Pair[string, int32]]
+	err = unmarshalledNested.UnmarshalBinary(marshalled)
+	require.NoError(t, err)
+	require.Equal(t, nested, unmarshalledNested)
+}
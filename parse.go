@@ -0,0 +1,293 @@
+package tuple
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ParseError is returned when a tuple string representation fails to parse.
+type ParseError struct {
+	// Offset is the byte offset within the parsed string at which the error occurred.
+	Offset int
+	// Err is the underlying error.
+	Err error
+}
+
+// Error returns the error message.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("failed to parse tuple at offset %d: %v", e.Offset, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// parseNode is a parsed value out of a tuple string representation.
+// A node is either a literal holding the raw text of a value, or a composite holding the nodes of a nested tuple.
+type parseNode struct {
+	offset    int
+	text      string
+	composite bool
+	children  []parseNode
+}
+
+// parser parses the string representation of tuples, as returned by the String method.
+type parser struct {
+	input  string
+	offset int
+}
+
+// parseTuple parses the string representation of a tuple, as returned by the String method,
+// into the tuple pointed by ptr.
+func parseTuple(s string, ptr any) error {
+	p := &parser{input: s}
+
+	p.skipSpaces()
+	node, err := p.parseList('[', ']', false)
+	if err != nil {
+		return err
+	}
+
+	p.skipSpaces()
+	if p.offset != len(p.input) {
+		return p.errorf("unexpected trailing characters %q", p.input[p.offset:])
+	}
+
+	return assignNode(node, reflect.ValueOf(ptr).Elem())
+}
+
+// errorf returns a ParseError at the current offset.
+func (p *parser) errorf(format string, args ...any) error {
+	return &ParseError{Offset: p.offset, Err: fmt.Errorf(format, args...)}
+}
+
+// skipSpaces advances the parser past any whitespace characters.
+func (p *parser) skipSpaces() {
+	for p.offset < len(p.input) && strings.IndexByte(" \t\r\n", p.input[p.offset]) >= 0 {
+		p.offset++
+	}
+}
+
+// consume advances the parser past the expected character, or returns an error if the next character doesn't match.
+func (p *parser) consume(expected byte) error {
+	if p.offset >= len(p.input) {
+		return p.errorf("expected %q but reached end of input", expected)
+	}
+	if p.input[p.offset] != expected {
+		return p.errorf("expected %q but found %q", expected, p.input[p.offset])
+	}
+
+	p.offset++
+	return nil
+}
+
+// parseList parses a list of values enclosed by the open and close characters.
+// Values of tuples returned by String are separated by spaces, and values of tuples returned by GoString
+// are separated by commas and labeled by their field names.
+func (p *parser) parseList(open, close byte, labeled bool) (parseNode, error) {
+	node := parseNode{offset: p.offset, composite: true}
+	if err := p.consume(open); err != nil {
+		return parseNode{}, err
+	}
+
+	for {
+		p.skipSpaces()
+		if p.offset < len(p.input) && p.input[p.offset] == close {
+			p.offset++
+			return node, nil
+		}
+
+		if labeled && len(node.children) > 0 {
+			if err := p.consume(','); err != nil {
+				return parseNode{}, err
+			}
+			p.skipSpaces()
+		}
+
+		if labeled {
+			label := fmt.Sprintf("V%d:", len(node.children)+1)
+			if !strings.HasPrefix(p.input[p.offset:], label) {
+				return parseNode{}, p.errorf("expected field label %q", label)
+			}
+			p.offset += len(label)
+			p.skipSpaces()
+		}
+
+		child, err := p.parseValue()
+		if err != nil {
+			return parseNode{}, err
+		}
+		node.children = append(node.children, child)
+	}
+}
+
+// parseValue parses a single value, which is either a literal or a nested tuple.
+func (p *parser) parseValue() (parseNode, error) {
+	if p.offset >= len(p.input) {
+		return parseNode{}, p.errorf("expected value but reached end of input")
+	}
+
+	switch p.input[p.offset] {
+	case '"', '`':
+		return p.parseQuoted()
+	case '[', ']', '{', '}', ',':
+		return parseNode{}, p.errorf("unexpected character %q", p.input[p.offset])
+	}
+
+	if strings.HasPrefix(p.input[p.offset:], "tuple.") {
+		return p.parseNested()
+	}
+
+	start := p.offset
+	for p.offset < len(p.input) && strings.IndexByte(" \t\r\n[]{},", p.input[p.offset]) < 0 {
+		p.offset++
+	}
+
+	return parseNode{offset: start, text: p.input[start:p.offset]}, nil
+}
+
+// parseQuoted parses a quoted string literal, keeping its quotes.
+func (p *parser) parseQuoted() (parseNode, error) {
+	start := p.offset
+	quote := p.input[p.offset]
+	p.offset++
+	for p.offset < len(p.input) {
+		switch p.input[p.offset] {
+		case '\\':
+			if quote == '"' {
+				p.offset++
+			}
+		case quote:
+			p.offset++
+			return parseNode{offset: start, text: p.input[start:p.offset]}, nil
+		}
+		p.offset++
+	}
+
+	return parseNode{}, &ParseError{Offset: start, Err: errors.New("unterminated string literal")}
+}
+
+// parseNested parses a nested tuple in the Go-syntax representation returned by GoString,
+// such as tuple.T2[string, int]{V1: "a", V2: 5}.
+func (p *parser) parseNested() (parseNode, error) {
+	start := p.offset
+	p.offset += len("tuple.")
+	for p.offset < len(p.input) && p.input[p.offset] != '[' {
+		p.offset++
+	}
+
+	// Skip the type parameters list, which may hold nested brackets.
+	depth := 0
+	for ; p.offset < len(p.input); p.offset++ {
+		if p.input[p.offset] == '[' {
+			depth++
+		} else if p.input[p.offset] == ']' {
+			depth--
+			if depth == 0 {
+				p.offset++
+				break
+			}
+		}
+	}
+	if depth != 0 {
+		return parseNode{}, &ParseError{Offset: start, Err: errors.New("unterminated type parameters list")}
+	}
+
+	node, err := p.parseList('{', '}', true)
+	if err != nil {
+		return parseNode{}, err
+	}
+
+	node.offset = start
+	return node, nil
+}
+
+// isTupleType returns whether t is a tuple type, that is a struct type whose fields are exactly V1 to VN.
+// Other struct types are not parsed, as their fields may be unexported.
+func isTupleType(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct || t.NumField() == 0 {
+		return false
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).Name != fmt.Sprintf("V%d", i+1) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// assignNode assigns the value of the parsed node into the value v.
+func assignNode(node parseNode, v reflect.Value) error {
+	nodeErr := func(err error) error {
+		return &ParseError{Offset: node.offset, Err: err}
+	}
+
+	if isTupleType(v.Type()) {
+		if !node.composite {
+			return nodeErr(fmt.Errorf("expected tuple of type %s but found %q", v.Type(), node.text))
+		}
+		if len(node.children) != v.NumField() {
+			return nodeErr(&LengthMismatchError{Source: "values", Expected: v.NumField(), Actual: len(node.children), count: true})
+		}
+
+		for i, child := range node.children {
+			if err := assignNode(child, v.Field(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if node.composite {
+		return nodeErr(fmt.Errorf("expected value of type %s but found a tuple", v.Type()))
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		b, err := strconv.ParseBool(node.text)
+		if err != nil {
+			return nodeErr(err)
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(node.text, 0, v.Type().Bits())
+		if err != nil {
+			return nodeErr(err)
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, err := strconv.ParseUint(node.text, 0, v.Type().Bits())
+		if err != nil {
+			return nodeErr(err)
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(node.text, v.Type().Bits())
+		if err != nil {
+			return nodeErr(err)
+		}
+		v.SetFloat(f)
+	case reflect.Complex64, reflect.Complex128:
+		c, err := strconv.ParseComplex(node.text, v.Type().Bits())
+		if err != nil {
+			return nodeErr(err)
+		}
+		v.SetComplex(c)
+	case reflect.String:
+		s, err := strconv.Unquote(node.text)
+		if err != nil {
+			return nodeErr(fmt.Errorf("invalid string literal %s: %w", node.text, err))
+		}
+		v.SetString(s)
+	default:
+		return nodeErr(fmt.Errorf("unable to parse value of type %s", v.Type()))
+	}
+
+	return nil
+}
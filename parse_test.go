@@ -0,0 +1,135 @@
+package tuple
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseTuple(t *testing.T) {
+	type mixed = T9[string, int, int8, uint, uint8, float64, bool, complex128, approximationHelper]
+	want := New9("hello \"world\"", -5, int8(3), uint(7), uint8('a'), 1.5, true, 1+2i, approximationHelper("x"))
+
+	var got mixed
+	err := parseTuple(want.String(), &got)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+func Test_parseTuple_Nested(t *testing.T) {
+	want := New3(New2("a", 1), NewPair(New1(true), "b"), 5)
+
+	var got T3[T2[string, int], Pair[T1[bool], string], int]
+	err := parseTuple(want.String(), &got)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+func Test_parseTuple_SpecialFloats(t *testing.T) {
+	var got T3[float64, float64, float32]
+	err := parseTuple(New3(math.Inf(1), math.Inf(-1), float32(0.1)).String(), &got)
+	require.NoError(t, err)
+	require.Equal(t, New3(math.Inf(1), math.Inf(-1), float32(0.1)), got)
+
+	var nan T1[float64]
+	err = parseTuple(New1(math.NaN()).String(), &nan)
+	require.NoError(t, err)
+	require.True(t, math.IsNaN(nan.V1))
+}
+
+func Test_parseTuple_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		wantOffset int
+	}{
+		{name: "empty input", input: ``, wantOffset: 0},
+		{name: "missing open bracket", input: `"a" 5]`, wantOffset: 0},
+		{name: "missing close bracket", input: `["a" 5`, wantOffset: 6},
+		{name: "too few values", input: `["a"]`, wantOffset: 0},
+		{name: "too many values", input: `["a" 5 6]`, wantOffset: 0},
+		{name: "invalid int", input: `["a" five]`, wantOffset: 5},
+		{name: "unquoted string", input: `[a 5]`, wantOffset: 1},
+		{name: "unterminated string", input: `  ["a 5]`, wantOffset: 3},
+		{name: "int overflow", input: `["a" 99999999999999999999]`, wantOffset: 5},
+		{name: "trailing characters", input: `["a" 5] x`, wantOffset: 8},
+		{name: "unexpected tuple", input: `[tuple.T1[string]{V1: "a"} 5]`, wantOffset: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T2[string, int]
+			err := parseTuple(tt.input, &got)
+			require.Error(t, err)
+
+			var parseErr *ParseError
+			require.True(t, errors.As(err, &parseErr))
+			require.Equal(t, tt.wantOffset, parseErr.Offset)
+		})
+	}
+}
+
+func Test_parseTuple_NestedErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		wantOffset int
+	}{
+		{name: "missing label", input: `[tuple.T1[string]{"a"}]`, wantOffset: 18},
+		{name: "wrong label", input: `[tuple.T1[string]{V2: "a"}]`, wantOffset: 18},
+		{name: "missing comma", input: `[tuple.T2[string, string]{V1: "a" V2: "b"}]`, wantOffset: 34},
+		{name: "unterminated type list", input: `[tuple.T1[string`, wantOffset: 1},
+		{name: "expected tuple", input: `["a"]`, wantOffset: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T1[T2[string, string]]
+			err := parseTuple(tt.input, &got)
+			require.Error(t, err)
+
+			var parseErr *ParseError
+			require.True(t, errors.As(err, &parseErr))
+			require.Equal(t, tt.wantOffset, parseErr.Offset)
+		})
+	}
+}
+
+func Test_parseTuple_UnsupportedType(t *testing.T) {
+	var got T1[[]int]
+	err := parseTuple(`[[]int{1}]`, &got)
+	require.Error(t, err)
+}
+
+func Test_parseTuple_NonTupleStruct(t *testing.T) {
+	type unexported struct{ a int }
+	type exported struct{ A, B int }
+
+	var got1 T1[unexported]
+	err := parseTuple(`[{1}]`, &got1)
+	var parseErr *ParseError
+	require.ErrorAs(t, err, &parseErr)
+	require.Equal(t, 1, parseErr.Offset)
+
+	err = parseTuple(`[tuple.T1[int]{V1: 1}]`, &got1)
+	require.ErrorAs(t, err, &parseErr)
+	require.EqualError(t, err, "failed to parse tuple at offset 1: expected value of type tuple.unexported but found a tuple")
+
+	var got2 T1[exported]
+	err = parseTuple(`[tuple.T2[int, int]{V1: 1, V2: 2}]`, &got2)
+	require.ErrorAs(t, err, &parseErr)
+}
+
+func Test_isTupleType(t *testing.T) {
+	require.True(t, isTupleType(reflect.TypeOf(T1[int]{})))
+	require.True(t, isTupleType(reflect.TypeOf(T9[int, int, int, int, int, int, int, int, int]{})))
+	require.True(t, isTupleType(reflect.TypeOf(Pair[string, int]{})))
+	require.True(t, isTupleType(reflect.TypeOf(struct{ V1, V2 int }{})))
+	require.False(t, isTupleType(reflect.TypeOf(struct{ a int }{})))
+	require.False(t, isTupleType(reflect.TypeOf(struct{ V2 int }{})))
+	require.False(t, isTupleType(reflect.TypeOf(struct{}{})))
+	require.False(t, isTupleType(reflect.TypeOf(0)))
+}
@@ -15,6 +15,7 @@ import (
 type templateContext struct {
 	Indexes             []int
 	Len                 int
+	MaxLen              int
 	GenericTypesForward string
 }
 
@@ -28,6 +29,21 @@ var funcMap = template.FuncMap{
 	"inc": func(value int) int {
 		return value + 1
 	},
+	"add": func(a, b int) int {
+		return a + b
+	},
+	"sub": func(a, b int) int {
+		return a - b
+	},
+	"seq": genSeq,
+	"reverse": func(indexes []int) []int {
+		reversed := make([]int, len(indexes))
+		for index, typeIndex := range indexes {
+			reversed[len(indexes)-index-1] = typeIndex
+		}
+
+		return reversed
+	},
 	"typeRef": func(indexes []int, suffix ...string) string {
 		if len(suffix) > 1 {
 			panic(fmt.Errorf("typeRef accepts at most 1 suffix argument"))
@@ -40,6 +56,19 @@ var funcMap = template.FuncMap{
 
 		return fmt.Sprintf("T%d%s[%s]", len(indexes), typeNameSuffix, genTypesForward(indexes))
 	},
+	"typeRefReplace": func(indexes []int, position int, typ string) string {
+		types := make([]string, len(indexes))
+		for index, typeIndex := range indexes {
+			if typeIndex == position {
+				types[index] = typ
+			} else {
+				types[index] = fmt.Sprintf("Ty%d", typeIndex)
+			}
+		}
+
+		return fmt.Sprintf("T%d[%s]", len(indexes), strings.Join(types, ", "))
+	},
+	"prefixedTypes":                     genPrefixedTypes,
 	"genericTypesDecl":                  genTypesDecl,
 	"genericTypesDeclGenericConstraint": genTypesDeclGenericConstraint,
 	"buildSingleTypedOverload": func(indexes []int, typ string) string {
@@ -58,7 +87,14 @@ var codeTplContent string
 //go:embed tuple_test.tpl
 var testTplContent string
 
+//go:embed tuple_iter.tpl
+var iterCodeTplContent string
+
+//go:embed tuple_iter_test.tpl
+var iterTestTplContent string
+
 // main generates the tuple code and test files by executing the template engine for the "tuple.tpl" and "tuple_test.tpl" files.
+// The "tuple_iter.tpl" and "tuple_iter_test.tpl" files generate the Go 1.23+ iterator integration code and test files.
 func main() {
 	outputDir := os.Args[1]
 
@@ -72,6 +108,16 @@ func main() {
 		panic(err)
 	}
 
+	iterCodeTpl, err := template.New("tuple_iter").Funcs(funcMap).Parse(iterCodeTplContent)
+	if err != nil {
+		panic(err)
+	}
+
+	iterTestTpl, err := template.New("tuple_iter_test").Funcs(funcMap).Parse(iterTestTplContent)
+	if err != nil {
+		panic(err)
+	}
+
 	for tupleLength := minTupleLength; tupleLength <= maxTupleLength; tupleLength++ {
 		indexes := make([]int, tupleLength)
 		for index := range indexes {
@@ -81,6 +127,7 @@ func main() {
 		context := templateContext{
 			Indexes:             indexes,
 			Len:                 tupleLength,
+			MaxLen:              maxTupleLength,
 			GenericTypesForward: genTypesForward(indexes),
 		}
 
@@ -96,6 +143,14 @@ func main() {
 				fullPath: path.Join(outputDir, fmt.Sprintf("tuple%d_test.go", tupleLength)),
 				tpl:      testTpl,
 			},
+			{
+				fullPath: path.Join(outputDir, fmt.Sprintf("tuple%d_iter.go", tupleLength)),
+				tpl:      iterCodeTpl,
+			},
+			{
+				fullPath: path.Join(outputDir, fmt.Sprintf("tuple%d_iter_test.go", tupleLength)),
+				tpl:      iterTestTpl,
+			},
 		}
 
 		for _, file := range filesToGenerate {
@@ -162,3 +217,25 @@ func genTypesForward(indexes []int) string {
 
 	return strings.Join(sep, ", ")
 }
+
+// genPrefixedTypes generates a comma separated list of type names made of the given prefix and element indexes.
+// For example, the prefix "R" and the indexes [1, 2] generate the list "R1, R2".
+func genPrefixedTypes(indexes []int, prefix string) string {
+	sep := make([]string, len(indexes))
+	for index, typeIndex := range indexes {
+		sep[index] = fmt.Sprintf("%s%d", prefix, typeIndex)
+	}
+
+	return strings.Join(sep, ", ")
+}
+
+// genSeq generates the sequence of integers between from and to (inclusive).
+// The sequence is empty if from is greater than to.
+func genSeq(from, to int) []int {
+	var seq []int
+	for i := from; i <= to; i++ {
+		seq = append(seq, i)
+	}
+
+	return seq
+}
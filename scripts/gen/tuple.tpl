@@ -1,10 +1,14 @@
 package tuple
 
 import (
+	"cmp"
+	"database/sql"
+	"encoding/binary"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
-
-	"golang.org/x/exp/constraints"
+	"hash/maphash"
+	"io"
 )
 
 {{/* $typeRef can be used when the context of dot changes. */}}
@@ -75,7 +79,7 @@ func FromArray{{.Len}}[{{genericTypesDecl .Indexes "any"}}](arr [{{.Len}}]any) (
 	{{range $index, $num := .Indexes -}}
 	v{{$num}}, ok := arr[{{$index}}].(Ty{{$num}})
 	if !ok {
-		return {{$typeRef}}{}, fmt.Errorf("value at array index {{$index}} expected to have type %s but has type %T", typeName[Ty{{$num}}](), arr[{{$index}}])
+		return {{$typeRef}}{}, newElementTypeError[Ty{{$num}}]("array", {{$index}}, arr[{{$index}}])
 	}
 	{{end}}
 	return New{{.Len}}(
@@ -96,13 +100,13 @@ func FromArray{{.Len}}X[{{genericTypesDecl .Indexes "any"}}](arr [{{.Len}}]any)
 // If the length of the slice doesn't match, or any of the values can not be converted to the generic type, an error is returned.
 func FromSlice{{.Len}}[{{genericTypesDecl .Indexes "any"}}](values []any) ({{$typeRef}}, error) {
 	if len(values) != {{.Len}} {
-		return {{$typeRef}}{}, fmt.Errorf("slice length %d must match number of tuple values {{.Len}}", len(values))
+		return {{$typeRef}}{}, &LengthMismatchError{Source: "slice", Expected: {{.Len}}, Actual: len(values)}
 	}
 
 	{{range $index, $num := .Indexes -}}
 	v{{$num}}, ok := values[{{$index}}].(Ty{{$num}})
 	if !ok {
-		return {{$typeRef}}{}, fmt.Errorf("value at slice index {{$index}} expected to have type %s but has type %T", typeName[Ty{{$num}}](), values[{{$index}}])
+		return {{$typeRef}}{}, newElementTypeError[Ty{{$num}}]("slice", {{$index}}, values[{{$index}}])
 	}
 	{{end}}
 	return New{{.Len}}(
@@ -117,7 +121,7 @@ func FromSlice{{.Len}}[{{genericTypesDecl .Indexes "any"}}](values []any) ({{$ty
 // If the length of the slice doesn't match, or any of the values can not be converted to the generic type, the function panics.
 func FromSlice{{.Len}}X[{{genericTypesDecl .Indexes "any"}}](values []any) {{$typeRef}} {
 	if len(values) != {{.Len}} {
-		panic(fmt.Errorf("slice length %d must match number of tuple values {{.Len}}", len(values)))
+		panic(&LengthMismatchError{Source: "slice", Expected: {{.Len}}, Actual: len(values)})
 	}
 
 	{{range $index, $num := .Indexes -}}
@@ -131,11 +135,311 @@ func FromSlice{{.Len}}X[{{genericTypesDecl .Indexes "any"}}](values []any) {{$ty
 	)
 }
 
+// ConvertSlice{{.Len}} returns a tuple from a slice of length {{.Len}}, converting the values to the generic types when needed.
+// Unlike FromSlice{{.Len}}, numeric values are converted between numeric types as long as they fit the generic type,
+// json.Number values are parsed into numeric types, strings and byte slices are converted to each other,
+// and strings and byte slices are unmarshalled into types implementing encoding.TextUnmarshaler.
+// This enables creating tuples from slices decoded by encoding/json, which hold float64 values for numbers.
+// If the length of the slice doesn't match, a *LengthMismatchError is returned.
+// If any of the values can not be converted to the generic type, a *ElementTypeError is returned.
+// If the conversion of any of the values fails, for example when it overflows the generic type, a *ElementConversionError is returned.
+func ConvertSlice{{.Len}}[{{genericTypesDecl .Indexes "any"}}](values []any) ({{$typeRef}}, error) {
+	if len(values) != {{.Len}} {
+		return {{$typeRef}}{}, &LengthMismatchError{Source: "slice", Expected: {{.Len}}, Actual: len(values)}
+	}
+
+	var t {{$typeRef}}
+	{{range $index, $num := .Indexes -}}
+	if err := convertSliceValue({{$index}}, values[{{$index}}], &t.V{{$num}}); err != nil {
+		return {{$typeRef}}{}, err
+	}
+	{{end}}
+	return t, nil
+}
+
+// ScanRow{{.Len}} scans the current row of rows into a tuple, with each of the columns scanned into the matching tuple value.
+// If the number of columns doesn't match the number of tuple values, a *LengthMismatchError is returned.
+func ScanRow{{.Len}}[{{genericTypesDecl .Indexes "any"}}](rows *sql.Rows) ({{$typeRef}}, error) {
+	var t {{$typeRef}}
+	if err := scanRow(rows, []any{
+		{{range .Indexes -}}
+		&t.V{{.}},
+		{{end}}
+	}); err != nil {
+		return {{$typeRef}}{}, err
+	}
+
+	return t, nil
+}
+
+// CollectRows{{.Len}} scans all the rows into a slice of tuples using ScanRow{{.Len}}, and closes the rows.
+func CollectRows{{.Len}}[{{genericTypesDecl .Indexes "any"}}](rows *sql.Rows) ([]{{$typeRef}}, error) {
+	return collectRows(rows, ScanRow{{.Len}}[{{.GenericTypesForward}}])
+}
+
+// ReadCSV{{.Len}} reads all the CSV records of r into tuples, with each of the fields converted to the matching tuple value.
+// Fields are converted using the UnmarshalText method of values implementing encoding.TextUnmarshaler,
+// and otherwise must be of boolean, numeric or string kinds.
+// If a record fails to convert, a *CSVError holding its line and column numbers is returned.
+// If a record fails to parse, including records with a number of fields that doesn't match, a *csv.ParseError is returned.
+func ReadCSV{{.Len}}[{{genericTypesDecl .Indexes "any"}}](r io.Reader, opts CSVOptions) ([]{{$typeRef}}, error) {
+	return readCSV(r, opts, func(t *{{$typeRef}}) []any {
+		return []any{
+			{{range .Indexes -}}
+			&t.V{{.}},
+			{{end}}
+		}
+	})
+}
+
+// WriteCSV{{.Len}} writes the tuples into w as CSV records, with each of the tuple values converted to the matching field.
+// Values are converted the same way as MarshalText does.
+// If a tuple fails to convert, a *CSVError holding the line and column numbers of its record is returned.
+func WriteCSV{{.Len}}[{{genericTypesDecl .Indexes "any"}}](w io.Writer, tuples []{{$typeRef}}, opts CSVOptions) error {
+	return writeCSV(w, tuples, opts, {{$typeRef}}.Slice)
+}
+
+// Parse{{.Len}} returns a tuple from its string representation, as returned by the String method.
+// Supported values are booleans, numbers, quoted strings and nested tuples.
+// If the string fails to parse, a *ParseError holding the offset of the failure is returned.
+func Parse{{.Len}}[{{genericTypesDecl .Indexes "any"}}](s string) ({{$typeRef}}, error) {
+	var t {{$typeRef}}
+	if err := parseTuple(s, &t); err != nil {
+		return {{$typeRef}}{}, err
+	}
+
+	return t, nil
+}
+
+{{range $index, $num := .Indexes -}}
+// Map{{$.Len}}At{{$num}} returns a new tuple with the function f applied to the value at position {{$num}} of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map{{$.Len}}At{{$num}}[{{$.GenericTypesForward}}, R any](t {{$typeRef}}, f func(Ty{{$num}}) R) {{typeRefReplace $.Indexes $num "R"}} {
+	return {{typeRefReplace $.Indexes $num "R"}}{
+		{{range $.Indexes -}}
+		{{if eq . $num -}}
+		V{{.}}: f(t.V{{.}}),
+		{{- else -}}
+		V{{.}}: t.V{{.}},
+		{{- end}}
+		{{end}}
+	}
+}
+
+{{end -}}
+
+// Map{{.Len}} returns a new tuple with each of the functions applied to the tuple value at the matching position.
+func Map{{.Len}}[{{.GenericTypesForward}}, {{prefixedTypes .Indexes "R"}} any](t {{$typeRef}},
+	{{- range $index, $num := .Indexes -}}
+	{{- if gt $index 0}},{{end}} f{{$num}} func(Ty{{$num}}) R{{$num}}
+	{{- end -}}
+) T{{.Len}}[{{prefixedTypes .Indexes "R"}}] {
+	return T{{.Len}}[{{prefixedTypes .Indexes "R"}}]{
+		{{range .Indexes -}}
+		V{{.}}: f{{.}}(t.V{{.}}),
+		{{end}}
+	}
+}
+
+{{if lt .Len .MaxLen -}}
+{{$nextIndexes := seq 1 (inc .Len)}}
+// Append{{.Len}} returns a new tuple holding the tuple values followed by the value v.
+func Append{{.Len}}[{{genericTypesDecl $nextIndexes "any"}}](t {{$typeRef}}, v Ty{{inc .Len}}) {{typeRef $nextIndexes}} {
+	return {{typeRef $nextIndexes}}{
+		{{range .Indexes -}}
+		V{{.}}: t.V{{.}},
+		{{end -}}
+		V{{inc .Len}}: v,
+	}
+}
+
+// Prepend{{.Len}} returns a new tuple holding the value v followed by the tuple values.
+func Prepend{{.Len}}[{{genericTypesDecl $nextIndexes "any"}}](t {{typeRef (seq 2 (inc .Len))}}, v Ty1) {{typeRef $nextIndexes}} {
+	return {{typeRef $nextIndexes}}{
+		V1: v,
+		{{range .Indexes -}}
+		V{{inc .}}: t.V{{.}},
+		{{end}}
+	}
+}
+
+{{range $otherLen := seq 1 (sub .MaxLen .Len) -}}
+{{$concatIndexes := seq 1 (add $.Len $otherLen)}}
+// Concat{{$.Len}}_{{$otherLen}} returns a new tuple holding the values of t1 followed by the values of t2.
+func Concat{{$.Len}}_{{$otherLen}}[{{genericTypesDecl $concatIndexes "any"}}](t1 {{$typeRef}}, t2 {{typeRef (seq (inc $.Len) (add $.Len $otherLen))}}) {{typeRef $concatIndexes}} {
+	return {{typeRef $concatIndexes}}{
+		{{range $.Indexes -}}
+		V{{.}}: t1.V{{.}},
+		{{end -}}
+		{{range seq 1 $otherLen -}}
+		V{{add $.Len .}}: t2.V{{.}},
+		{{end}}
+	}
+}
+
+{{end -}}
+{{end -}}
+
+{{range $count := seq 1 (sub .Len 1) -}}
+{{$headIndexes := seq 1 $count}}
+{{- $tailIndexes := seq (inc $count) $.Len}}
+// Take{{$.Len}}_{{$count}} returns a new tuple holding the first {{$count}} values of the tuple.
+func Take{{$.Len}}_{{$count}}[{{genericTypesDecl $.Indexes "any"}}](t {{$typeRef}}) {{typeRef $headIndexes}} {
+	return {{typeRef $headIndexes}}{
+		{{range $headIndexes -}}
+		V{{.}}: t.V{{.}},
+		{{end}}
+	}
+}
+
+// Drop{{$.Len}}_{{$count}} returns a new tuple holding the values of the tuple without the first {{$count}} values.
+func Drop{{$.Len}}_{{$count}}[{{genericTypesDecl $.Indexes "any"}}](t {{$typeRef}}) {{typeRef $tailIndexes}} {
+	return {{typeRef $tailIndexes}}{
+		{{range $index, $num := $tailIndexes -}}
+		V{{inc $index}}: t.V{{$num}},
+		{{end}}
+	}
+}
+
+// Split{{$.Len}}_{{$count}} splits the tuple into a tuple of its first {{$count}} values and a tuple of the rest of its values.
+func Split{{$.Len}}_{{$count}}[{{genericTypesDecl $.Indexes "any"}}](t {{$typeRef}}) T2[{{typeRef $headIndexes}}, {{typeRef $tailIndexes}}] {
+	return T2[{{typeRef $headIndexes}}, {{typeRef $tailIndexes}}]{
+		V1: Take{{$.Len}}_{{$count}}(t),
+		V2: Drop{{$.Len}}_{{$count}}(t),
+	}
+}
+
+{{end -}}
+
+{{if gt .Len 1 -}}
+{{$reversedIndexes := reverse .Indexes}}
+// Reverse{{.Len}} returns a new tuple holding the tuple values in reverse order.
+func Reverse{{.Len}}[{{genericTypesDecl .Indexes "any"}}](t {{$typeRef}}) {{typeRef $reversedIndexes}} {
+	return {{typeRef $reversedIndexes}}{
+		{{range $index, $num := $reversedIndexes -}}
+		V{{inc $index}}: t.V{{$num}},
+		{{end}}
+	}
+}
+
+{{end -}}
+{{if eq .Len 2 -}}
+// Swap returns a new tuple holding the tuple values in swapped order.
+func (t {{$typeRef}}) Swap() T2[Ty2, Ty1] {
+	return Reverse2(t)
+}
+
+{{end -}}
+// Apply{{.Len}} calls the function f with the tuple values as arguments and returns its result.
+func Apply{{.Len}}[{{.GenericTypesForward}}, R any](t {{$typeRef}}, f func({{.GenericTypesForward}}) R) R {
+	return f(t.Values())
+}
+
+// Tupled{{.Len}} returns a function that accepts a tuple and calls f with the tuple values as arguments.
+func Tupled{{.Len}}[{{.GenericTypesForward}}, R any](f func({{.GenericTypesForward}}) R) func({{$typeRef}}) R {
+	return func(t {{$typeRef}}) R {
+		return f(t.Values())
+	}
+}
+
+// Untupled{{.Len}} returns a function that accepts {{.Len}} arguments and calls g with a tuple holding them.
+func Untupled{{.Len}}[{{.GenericTypesForward}}, R any](g func({{$typeRef}}) R) func({{.GenericTypesForward}}) R {
+	return func(
+		{{- range $index, $num := .Indexes -}}
+		{{- if gt $index 0}}, {{end -}}
+		v{{$num}} Ty{{$num}}
+		{{- end -}}
+	) R {
+		return g(New{{.Len}}(
+			{{- range $index, $num := .Indexes -}}
+			{{- if gt $index 0}}, {{end -}}
+			v{{$num}}
+			{{- end -}}
+		))
+	}
+}
+
+// Zip{{.Len}} returns a slice of tuples, where each tuple holds the values at the same index of each of the given slices.
+// If the slices have different lengths, the returned slice is truncated to the length of the shortest slice.
+// To require all slices to have the same length, use the Zip{{.Len}}Strict function.
+func Zip{{.Len}}[{{genericTypesDecl .Indexes "any"}}](
+	{{- range $index, $num := .Indexes -}}
+	{{- if gt $index 0}}, {{end -}}
+	s{{$num}} []Ty{{$num}}
+	{{- end -}}
+) []{{$typeRef}} {
+	length := minLen(
+		{{- range $index, $num := .Indexes -}}
+		{{- if gt $index 0}}, {{end -}}
+		len(s{{$num}})
+		{{- end -}}
+	)
+
+	zipped := make([]{{$typeRef}}, length)
+	for i := range zipped {
+		zipped[i] = {{$typeRef}}{
+			{{range .Indexes -}}
+			V{{.}}: s{{.}}[i],
+			{{end}}
+		}
+	}
+
+	return zipped
+}
+
+// Zip{{.Len}}Strict returns a slice of tuples, where each tuple holds the values at the same index of each of the given slices.
+// If the slices have different lengths, an error is returned.
+// To truncate the result to the length of the shortest slice instead, use the Zip{{.Len}} function.
+func Zip{{.Len}}Strict[{{genericTypesDecl .Indexes "any"}}](
+	{{- range $index, $num := .Indexes -}}
+	{{- if gt $index 0}}, {{end -}}
+	s{{$num}} []Ty{{$num}}
+	{{- end -}}
+) ([]{{$typeRef}}, error) {
+	{{- range $index, $num := .Indexes}}
+	{{- if gt $index 0}}
+	if len(s{{$num}}) != len(s1) {
+		return nil, &LengthMismatchError{Source: "slice {{$num}}", Expected: len(s1), Actual: len(s{{$num}}), expectedSource: "slice 1"}
+	}
+	{{- end}}
+	{{- end}}
+	{{if gt .Len 1}}
+	{{end -}}
+	return Zip{{.Len}}(
+		{{- range $index, $num := .Indexes -}}
+		{{- if gt $index 0}}, {{end -}}
+		s{{$num}}
+		{{- end -}}
+	), nil
+}
+
+// Unzip{{.Len}} returns {{.Len}} slices, where each slice holds the tuple values at the matching position of each of the given tuples.
+func Unzip{{.Len}}[{{genericTypesDecl .Indexes "any"}}](ts []{{$typeRef}}) (
+	{{- range $index, $num := .Indexes -}}
+	{{- if gt $index 0}}, {{end -}}
+	[]Ty{{$num}}
+	{{- end -}}
+) {
+	{{range .Indexes -}}
+	s{{.}} := make([]Ty{{.}}, len(ts))
+	{{end -}}
+	for i, t := range ts {
+		{{range .Indexes -}}
+		s{{.}}[i] = t.V{{.}}
+		{{end -}}
+	}
+
+	return {{range $index, $num := .Indexes -}}
+		{{- if gt $index 0}}, {{end -}}
+		s{{$num}}
+	{{- end}}
+}
+
 // Equal{{.Len}} returns whether the host tuple is equal to the other tuple.
 // All tuple elements of the host and guest parameters must match the "comparable" built-in constraint.
 // To test equality of tuples that hold custom Equalable values, use the Equal{{.Len}}E function.
 // To test equality of tuples that hold custom Comparable values, use the Equal{{.Len}}C function.
-// Otherwise, use Equal or reflect.DeepEqual to test tuples of any types.
+// Otherwise, use the Equal method or the EqualFunc{{.Len}} function to test tuples of any types.
 func Equal{{.Len}}[{{genericTypesDecl .Indexes "comparable"}}](host, guest T{{.Len}}[{{.GenericTypesForward}}]) bool {
 	return {{range $index, $num := .Indexes}}{{if gt $index 0}} && {{end}}host.V{{$num}} == guest.V{{$num}}{{end}}
 }
@@ -144,7 +448,7 @@ func Equal{{.Len}}[{{genericTypesDecl .Indexes "comparable"}}](host, guest T{{.L
 // All tuple elements of the host and guest parameters must match the Equalable constraint.
 // To test equality of tuples that hold built-in "comparable" values, use the Equal{{.Len}} function.
 // To test equality of tuples that hold custom Comparable values, use the Equal{{.Len}}C function.
-// Otherwise, use Equal or reflect.DeepEqual to test tuples of any types.
+// Otherwise, use the Equal method or the EqualFunc{{.Len}} function to test tuples of any types.
 func Equal{{.Len}}E[{{genericTypesDeclGenericConstraint .Indexes "Equalable"}}](host, guest T{{.Len}}[{{.GenericTypesForward}}]) bool {
 	return {{range $index, $num := .Indexes}}{{if gt $index 0}} && {{end}}host.V{{$num}}.Equal(guest.V{{$num}}){{end}}
 }
@@ -153,15 +457,32 @@ func Equal{{.Len}}E[{{genericTypesDeclGenericConstraint .Indexes "Equalable"}}](
 // All tuple elements of the host and guest parameters must match the Comparable constraint.
 // To test equality of tuples that hold built-in "comparable" values, use the Equal{{.Len}} function.
 // To test equality of tuples that hold custom Equalable values, use the Equal{{.Len}}E function.
-// Otherwise, use Equal or reflect.DeepEqual to test tuples of any types.
+// Otherwise, use the Equal method or the EqualFunc{{.Len}} function to test tuples of any types.
 func Equal{{.Len}}C[{{genericTypesDeclGenericConstraint .Indexes "Comparable"}}](host, guest T{{.Len}}[{{.GenericTypesForward}}]) bool {
 	return {{range $index, $num := .Indexes}}{{if gt $index 0}} && {{end}}host.V{{$num}}.CompareTo(guest.V{{$num}}).EQ(){{end}}
 }
 
+// EqualFunc{{.Len}} returns whether the host tuple is equal to the guest tuple, using the given equality function for each element.
+// EqualFunc{{.Len}} short-circuits once one of the elements is not equal, and the rest of the equality functions are not called.
+func EqualFunc{{.Len}}[{{genericTypesDecl .Indexes "any"}}](host, guest {{$typeRef}},
+	{{- range $index, $num := .Indexes -}}
+	{{- if gt $index 0}},{{end}} eq{{$num}} func(a, b Ty{{$num}}) bool
+	{{- end -}}
+) bool {
+	return {{range $index, $num := .Indexes}}{{if gt $index 0}} && {{end}}eq{{$num}}(host.V{{$num}}, guest.V{{$num}}){{end}}
+}
+
+// Equal returns whether the tuple is equal to the guest tuple.
+// Each element is tested using the Equalable constraint if it is implemented, then the Comparable constraint,
+// then the "==" operator if the element is comparable, and otherwise using reflect.DeepEqual.
+func (t {{$typeRef}}) Equal(guest {{$typeRef}}) bool {
+	return {{range $index, $num := .Indexes}}{{if gt $index 0}} && {{end}}equalValues(t.V{{$num}}, guest.V{{$num}}){{end}}
+}
+
 // Compare{{.Len}} returns whether the host tuple is semantically less than, equal to, or greater than the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the Compare{{.Len}}C function.
-func Compare{{.Len}}[{{genericTypesDecl .Indexes "constraints.Ordered"}}](host, guest T{{.Len}}[{{.GenericTypesForward}}]) OrderedComparisonResult {
+func Compare{{.Len}}[{{genericTypesDecl .Indexes "cmp.Ordered"}}](host, guest T{{.Len}}[{{.GenericTypesForward}}]) OrderedComparisonResult {
 	return multiCompare({{range .Indexes}}
 		func () OrderedComparisonResult { return compareOrdered(host.V{{.}}, guest.V{{.}}) },
 	{{end}})
@@ -176,10 +497,66 @@ func Compare{{.Len}}C[{{genericTypesDeclGenericConstraint .Indexes "Comparable"}
 	{{end}})
 }
 
+// CmpFunc{{.Len}} returns a comparison function of tuples, which returns a negative number when a < b, a positive number when a > b,
+// and zero when a == b. The returned function can be used with slices.SortFunc, slices.BinarySearchFunc and similar functions.
+// All tuple elements must match the "Ordered" constraint.
+// To compare tuples that hold custom comparable values, use the CmpFunc{{.Len}}C function.
+func CmpFunc{{.Len}}[{{genericTypesDecl .Indexes "cmp.Ordered"}}]() func(a, b T{{.Len}}[{{.GenericTypesForward}}]) int {
+	return func(a, b T{{.Len}}[{{.GenericTypesForward}}]) int {
+		return int(Compare{{.Len}}(a, b))
+	}
+}
+
+// CmpFunc{{.Len}}C returns a comparison function of tuples, which returns a negative number when a < b, a positive number when a > b,
+// and zero when a == b. The returned function can be used with slices.SortFunc, slices.BinarySearchFunc and similar functions.
+// All tuple elements must match the Comparable constraint.
+// To compare tuples that hold built-in "Ordered" values, use the CmpFunc{{.Len}} function.
+func CmpFunc{{.Len}}C[{{genericTypesDeclGenericConstraint .Indexes "Comparable"}}]() func(a, b T{{.Len}}[{{.GenericTypesForward}}]) int {
+	return func(a, b T{{.Len}}[{{.GenericTypesForward}}]) int {
+		return int(Compare{{.Len}}C(a, b))
+	}
+}
+
+// Comparator{{.Len}} returns a comparison function of tuples, which compares the tuple elements by order using the given
+// element comparison functions. Each element comparison function must return a negative number when a < b, a positive number
+// when a > b, and zero when a == b, such as cmp.Compare, CompareOrdered and CompareComparable.
+// The returned function short-circuits once one of the elements is not equal, and the rest of the element comparison functions
+// are not called.
+func Comparator{{.Len}}[{{genericTypesDecl .Indexes "any"}}](
+	{{- range $index, $num := .Indexes -}}
+	{{- if gt $index 0}}, {{end -}}
+	c{{$num}} func(a, b Ty{{$num}}) int
+	{{- end -}}
+) func(a, b T{{.Len}}[{{.GenericTypesForward}}]) int {
+	return func(a, b T{{.Len}}[{{.GenericTypesForward}}]) int {
+		return int(multiCompare({{range .Indexes}}
+			func() OrderedComparisonResult { return OrderedComparisonResult(c{{.}}(a.V{{.}}, b.V{{.}})) },
+		{{end}}))
+	}
+}
+
+// OrderBy{{.Len}} returns a comparison function of tuples, which compares the tuple elements by order according to the
+// sort order given for each element, such as OrderBy{{.Len}}(Asc, Desc, ...).
+// All tuple elements must match the "Ordered" constraint.
+// To order tuples that hold other element types, such as pointers, use the Comparator{{.Len}} function with
+// the OrderFunc and OrderPtrFunc element comparison functions.
+func OrderBy{{.Len}}[{{genericTypesDecl .Indexes "cmp.Ordered"}}](
+	{{- range $index, $num := .Indexes -}}
+	{{- if gt $index 0}}, {{end -}}
+	o{{$num}}
+	{{- end}} SortOrder) func(a, b T{{.Len}}[{{.GenericTypesForward}}]) int {
+	return Comparator{{.Len}}(
+		{{- range $index, $num := .Indexes -}}
+		{{- if gt $index 0}}, {{end -}}
+		OrderedBy[Ty{{$num}}](o{{$num}})
+		{{- end -}}
+	)
+}
+
 // LessThan{{.Len}} returns whether the host tuple is semantically less than the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the LessThan{{.Len}}C function.
-func LessThan{{.Len}}[{{genericTypesDecl .Indexes "constraints.Ordered"}}](host, guest T{{.Len}}[{{.GenericTypesForward}}]) bool {
+func LessThan{{.Len}}[{{genericTypesDecl .Indexes "cmp.Ordered"}}](host, guest T{{.Len}}[{{.GenericTypesForward}}]) bool {
 	return Compare{{.Len}}(host, guest).LT()
 }
 
@@ -193,7 +570,7 @@ func LessThan{{.Len}}C[{{genericTypesDeclGenericConstraint .Indexes "Comparable"
 // LessOrEqual{{.Len}} returns whether the host tuple is semantically less than or equal to the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the LessOrEqual{{.Len}}C function.
-func LessOrEqual{{.Len}}[{{genericTypesDecl .Indexes "constraints.Ordered"}}](host, guest T{{.Len}}[{{.GenericTypesForward}}]) bool {
+func LessOrEqual{{.Len}}[{{genericTypesDecl .Indexes "cmp.Ordered"}}](host, guest T{{.Len}}[{{.GenericTypesForward}}]) bool {
 	return Compare{{.Len}}(host, guest).LE()
 }
 
@@ -207,7 +584,7 @@ func LessOrEqual{{.Len}}C[{{genericTypesDeclGenericConstraint .Indexes "Comparab
 // GreaterThan{{.Len}} returns whether the host tuple is semantically greater than the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the GreaterThan{{.Len}}C function.
-func GreaterThan{{.Len}}[{{genericTypesDecl .Indexes "constraints.Ordered"}}](host, guest T{{.Len}}[{{.GenericTypesForward}}]) bool {
+func GreaterThan{{.Len}}[{{genericTypesDecl .Indexes "cmp.Ordered"}}](host, guest T{{.Len}}[{{.GenericTypesForward}}]) bool {
 	return Compare{{.Len}}(host, guest).GT()
 }
 
@@ -221,7 +598,7 @@ func GreaterThan{{.Len}}C[{{genericTypesDeclGenericConstraint .Indexes "Comparab
 // GreaterOrEqual{{.Len}} returns whether the host tuple is semantically greater than or equal to the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the GreaterOrEqual{{.Len}}C function.
-func GreaterOrEqual{{.Len}}[{{genericTypesDecl .Indexes "constraints.Ordered"}}](host, guest T{{.Len}}[{{.GenericTypesForward}}]) bool {
+func GreaterOrEqual{{.Len}}[{{genericTypesDecl .Indexes "cmp.Ordered"}}](host, guest T{{.Len}}[{{.GenericTypesForward}}]) bool {
 	return Compare{{.Len}}(host, guest).GE()
 }
 
@@ -232,6 +609,38 @@ func GreaterOrEqual{{.Len}}C[{{genericTypesDeclGenericConstraint .Indexes "Compa
 	return Compare{{.Len}}C(host, guest).GE()
 }
 
+// Hash returns a hash of the tuple values using the given seed.
+// Equal tuples return equal hashes for the same seed.
+// Elements supported by the HashValue function are hashed by their values. Other elements, such as slices, maps,
+// functions, nil pointers and nil interfaces, are hashed by their type only, so tuples differing only in these elements collide.
+// To hash tuples holding other element types by their values, use the HashFunc{{.Len}} function.
+func (t {{$typeRef}}) Hash(seed maphash.Seed) uint64 {
+	var h maphash.Hash
+	h.SetSeed(seed)
+	{{range .Indexes -}}
+	writeHashElement(&h, seed, t.V{{.}})
+	{{end -}}
+	return h.Sum64()
+}
+
+// HashFunc{{.Len}} returns a hash function of tuples, which hashes the tuple values using the given element hash functions.
+// Each element hash function must return equal hashes for equal values and the same seed, such as HashValue.
+func HashFunc{{.Len}}[{{genericTypesDecl .Indexes "any"}}](
+	{{- range $index, $num := .Indexes -}}
+	{{- if gt $index 0}}, {{end -}}
+	h{{$num}} func(seed maphash.Seed, v Ty{{$num}}) uint64
+	{{- end -}}
+) func(seed maphash.Seed, t {{$typeRef}}) uint64 {
+	return func(seed maphash.Seed, t {{$typeRef}}) uint64 {
+		var h maphash.Hash
+		h.SetSeed(seed)
+		{{range .Indexes -}}
+		writeHashUint64(&h, h{{.}}(seed, t.V{{.}}))
+		{{end -}}
+		return h.Sum64()
+	}
+}
+
 // MarshalJSON marshals the tuple into a JSON array.
 func (t {{$typeRef}}) MarshalJSON() ([]byte, error) {
 	return json.Marshal(t.Slice())
@@ -239,21 +648,200 @@ func (t {{$typeRef}}) MarshalJSON() ([]byte, error) {
 
 // MarshalJSON unmarshals the tuple from a JSON array.
 func (t *{{$typeRef}}) UnmarshalJSON(data []byte) error {
-	// Working with json.RawMessage instead of any enables custom struct support.
-	var slice []json.RawMessage
-	if err := json.Unmarshal(data, &slice); err != nil {
-		return fmt.Errorf("unable to unmarshal json array for tuple: %w", err)
+	return t.UnmarshalJSONWith(data, JSONDecodeOptions{})
+}
+
+// UnmarshalJSONWith unmarshals the tuple from a JSON array using the given decode options.
+// The options are applied to nested tuples as well, including tuples within slices, arrays, maps, pointers and struct fields.
+func (t *{{$typeRef}}) UnmarshalJSONWith(data []byte, opts JSONDecodeOptions) error {
+	return unmarshalJSONArray(data, opts, []any{
+		{{range .Indexes -}}
+		&t.V{{.}},
+		{{end}}
+	})
+}
+
+// MarshalJSONLabeled marshals the tuple into a JSON object, where each of the tuple values is keyed by its matching label.
+// The number of labels must match the number of tuple values.
+func (t {{$typeRef}}) MarshalJSONLabeled(labels Labels) ([]byte, error) {
+	return marshalJSONLabeled(labels, t.Slice())
+}
+
+// UnmarshalJSONLabeled unmarshals the tuple from a JSON object, where each of the tuple values is keyed by its matching label.
+// The number of labels must match the number of tuple values.
+// Unless the labels are strict, missing keys leave the matching tuple values unchanged, and unknown keys are ignored.
+func (t *{{$typeRef}}) UnmarshalJSONLabeled(data []byte, labels Labels) error {
+	return unmarshalJSONLabeled(data, labels, []any{
+		{{range .Indexes -}}
+		&t.V{{.}},
+		{{end}}
+	})
+}
+
+// MarshalText marshals the tuple into a text of comma separated values.
+// Commas and backslashes within the values are escaped by a backslash.
+// Values implementing encoding.TextMarshaler are marshalled using their MarshalText method.
+// Otherwise, the values must be of boolean, numeric or string kinds.
+func (t {{$typeRef}}) MarshalText() ([]byte, error) {
+	var values [{{.Len}}]string
+	var err error
+	{{range $index, $num := .Indexes -}}
+	if values[{{$index}}], err = marshalTextValue(t.V{{$num}}); err != nil {
+		return nil, fmt.Errorf("value at index {{$index}} failed to marshal: %w", err)
+	}
+	{{end}}
+	return []byte(joinText(values[:])), nil
+}
+
+// UnmarshalText unmarshals the tuple from a text of comma separated values, as created by MarshalText.
+func (t *{{$typeRef}}) UnmarshalText(data []byte) error {
+	values, err := splitText(string(data))
+	if err != nil {
+		return fmt.Errorf("unable to unmarshal text for tuple: %w", err)
 	}
 
-	if len(slice) != {{.Len}} {
-		return fmt.Errorf("unmarshalled json array length %d must match number of tuple values {{.Len}}", len(slice))
+	if len(values) != {{.Len}} {
+		return &LengthMismatchError{Source: "unmarshalled text values", Expected: {{.Len}}, Actual: len(values), count: true}
 	}
 
 	{{- range $index, $num := .Indexes}}
-	if err := json.Unmarshal(slice[{{$index}}], &t.V{{.}}); err != nil {
-		return fmt.Errorf("value %q at slice index {{$index}} failed to unmarshal: %w", string(slice[{{$index}}]), err)
+	if err := unmarshalTextValue(values[{{$index}}], &t.V{{$num}}); err != nil {
+		return &ElementUnmarshalError{Index: {{$index}}, Value: values[{{$index}}], Err: err, location: locationIndex}
 	}
 	{{end -}}
 
 	return nil
 }
+
+// GobEncode encodes the tuple for encoding/gob, implementing the gob.GobEncoder interface.
+// Nil pointer and interface values are preserved as nil, and pointers to zero values are preserved as non-nil.
+// See the package documentation for the wire format.
+func (t {{$typeRef}}) GobEncode() ([]byte, error) {
+	return gobEncodeValues([]any{
+		{{range .Indexes -}}
+		&t.V{{.}},
+		{{end}}
+	})
+}
+
+// GobDecode decodes the tuple from encoding/gob, as encoded by GobEncode, implementing the gob.GobDecoder interface.
+// If the number of encoded values doesn't match, a *LengthMismatchError is returned.
+func (t *{{$typeRef}}) GobDecode(data []byte) error {
+	return gobDecodeValues(data, []any{
+		{{range .Indexes -}}
+		&t.V{{.}},
+		{{end}}
+	})
+}
+
+// MarshalComposite marshals the tuple into a PostgreSQL composite literal, such as (1,"foo bar",).
+// Values are quoted and escaped the same way PostgreSQL does, and nil values and pointers are marshalled as NULL.
+// Nested tuples are marshalled as nested composite values, and other values are marshalled the same way as MarshalText does.
+func (t {{$typeRef}}) MarshalComposite() ([]byte, error) {
+	return marshalComposite(t.Slice())
+}
+
+// UnmarshalComposite unmarshals the tuple from a PostgreSQL composite literal, as created by MarshalComposite or by PostgreSQL.
+// NULL values can only be unmarshalled into pointers, interfaces, slices and maps, which are set to nil.
+// If the number of composite fields doesn't match, a *LengthMismatchError is returned.
+func (t *{{$typeRef}}) UnmarshalComposite(data []byte) error {
+	return unmarshalComposite(data, []any{
+		{{range .Indexes -}}
+		&t.V{{.}},
+		{{end}}
+	})
+}
+
+// MarshalXML marshals the tuple into a sequence of xml child elements named v1 to v{{.Len}}, implementing the xml.Marshaler interface.
+// Nil values are marshalled as empty child elements with a nil="true" attribute,
+// and slice values are marshalled as child elements holding an item element for each of the slice items.
+// Use MarshalXMLLabeled to name the child elements differently.
+func (t {{$typeRef}}) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return t.MarshalXMLLabeled(e, start, indexLabels({{.Len}}))
+}
+
+// UnmarshalXML unmarshals the tuple from a sequence of xml child elements named v1 to v{{.Len}}, implementing the xml.Unmarshaler interface.
+// The number of child elements must match the number of tuple values, the same way as UnmarshalJSON requires.
+func (t *{{$typeRef}}) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return t.UnmarshalXMLLabeled(d, start, indexLabels({{.Len}}))
+}
+
+// MarshalXMLLabeled marshals the tuple into a sequence of xml child elements, named by the labels matching each of the tuple values.
+// The number of labels must match the number of tuple values.
+func (t {{$typeRef}}) MarshalXMLLabeled(e *xml.Encoder, start xml.StartElement, labels Labels) error {
+	return marshalXML(e, start, labels, t.Slice())
+}
+
+// UnmarshalXMLLabeled unmarshals the tuple from a sequence of xml child elements, named by the labels matching each of the tuple values.
+// The child elements must be named by the labels in the same order, and their number must match the number of tuple values.
+// If the number of child elements doesn't match, a *LengthMismatchError is returned.
+func (t *{{$typeRef}}) UnmarshalXMLLabeled(d *xml.Decoder, start xml.StartElement, labels Labels) error {
+	return unmarshalXML(d, start, labels, []any{
+		{{range .Indexes -}}
+		&t.V{{.}},
+		{{end}}
+	})
+}
+
+// MarshalBinary marshals the tuple into its binary encoding using little-endian byte order,
+// implementing the encoding.BinaryMarshaler interface.
+// The tuple values must be of boolean, fixed-size numeric, string or byte slice kinds, or nested tuples.
+// See the package documentation for the binary encoding.
+func (t {{$typeRef}}) MarshalBinary() ([]byte, error) {
+	return t.AppendBinary(nil)
+}
+
+// AppendBinary appends the binary encoding of the tuple to b using little-endian byte order,
+// the same way as MarshalBinary does.
+func (t {{$typeRef}}) AppendBinary(b []byte) ([]byte, error) {
+	return t.AppendBinaryOrder(b, binary.LittleEndian)
+}
+
+// AppendBinaryOrder appends the binary encoding of the tuple to b using the given byte order.
+// Values of common types are encoded without allocations, other than the ones needed to grow b.
+func (t {{$typeRef}}) AppendBinaryOrder(b []byte, order binary.AppendByteOrder) ([]byte, error) {
+	var err error
+	{{range $index, $num := .Indexes -}}
+	if b, err = appendBinaryValue(b, order, t.V{{$num}}); err != nil {
+		return nil, fmt.Errorf("value at index {{$index}} failed to marshal: %w", err)
+	}
+	{{end}}
+	return b, nil
+}
+
+// UnmarshalBinary unmarshals the tuple from its binary encoding using little-endian byte order,
+// implementing the encoding.BinaryUnmarshaler interface.
+func (t *{{$typeRef}}) UnmarshalBinary(data []byte) error {
+	return t.UnmarshalBinaryOrder(data, binary.LittleEndian)
+}
+
+// UnmarshalBinaryOrder unmarshals the tuple from its binary encoding using the given byte order.
+// The data must hold exactly the binary encoding of the tuple.
+func (t *{{$typeRef}}) UnmarshalBinaryOrder(data []byte, order binary.ByteOrder) error {
+	return unmarshalBinary(data, order, t)
+}
+
+// decodeBinary decodes the tuple from the beginning of data using the given byte order, and returns the rest of the data.
+func (t *{{$typeRef}}) decodeBinary(data []byte, order binary.ByteOrder) ([]byte, error) {
+	var err error
+	{{range $index, $num := .Indexes -}}
+	if data, err = decodeBinaryValue(data, order, &t.V{{$num}}); err != nil {
+		return nil, fmt.Errorf("value at index {{$index}} failed to unmarshal: %w", err)
+	}
+	{{end}}
+	return data, nil
+}
+
+// AppendBinarySlice{{.Len}} appends the binary encoding of a slice of tuples to b using the given byte order.
+// The slice is encoded as its length followed by the binary encoding of each of the tuples.
+func AppendBinarySlice{{.Len}}[{{genericTypesDecl .Indexes "any"}}](b []byte, order binary.AppendByteOrder, tuples []{{$typeRef}}) ([]byte, error) {
+	return appendBinarySlice(b, order, tuples)
+}
+
+// UnmarshalBinarySlice{{.Len}} unmarshals a slice of tuples from its binary encoding using the given byte order,
+// as encoded by AppendBinarySlice{{.Len}}, and appends the tuples to dst.
+// Tuples are decoded in place, so decoding into a dst with enough capacity, such as a previously decoded slice truncated to zero length,
+// doesn't allocate unless the tuples hold strings, or byte slices longer than the ones previously held in that capacity.
+func UnmarshalBinarySlice{{.Len}}[{{genericTypesDecl .Indexes "any"}}](data []byte, order binary.ByteOrder, dst []{{$typeRef}}) ([]{{$typeRef}}, error) {
+	return unmarshalBinarySlice(data, order, dst)
+}
@@ -0,0 +1,101 @@
+//go:build go1.23
+
+package tuple
+
+import (
+	"io"
+	"iter"
+)
+
+{{/* $typeRef can be used when the context of dot changes. */}}
+{{$typeRef := typeRef .Indexes}}
+
+// All returns an iterator over the index and value of each of the tuple values.
+func (t {{$typeRef}}) All() iter.Seq2[int, any] {
+	return func(yield func(int, any) bool) {
+		{{range $index, $num := .Indexes -}}
+		{{- if gt $index 0}}
+		{{end -}}
+		if !yield({{$index}}, t.V{{$num}}) {
+			return
+		}
+		{{- end}}
+	}
+}
+
+// ZipSeq{{.Len}} returns an iterator over tuples, where each tuple holds the next value of each of the given iterators.
+// The returned iterator stops once any of the given iterators is exhausted.
+func ZipSeq{{.Len}}[{{genericTypesDecl .Indexes "any"}}](
+	{{- range $index, $num := .Indexes -}}
+	{{- if gt $index 0}}, {{end -}}
+	s{{$num}} iter.Seq[Ty{{$num}}]
+	{{- end -}}
+) iter.Seq[{{$typeRef}}] {
+	return func(yield func({{$typeRef}}) bool) {
+		{{- range $index, $num := .Indexes}}
+		{{- if gt $index 0}}
+		next{{$num}}, stop{{$num}} := iter.Pull(s{{$num}})
+		defer stop{{$num}}()
+		{{- end}}
+		{{- end}}
+		{{- if gt .Len 1}}
+		{{end}}
+		for v1 := range s1 {
+			{{- range $index, $num := .Indexes}}
+			{{- if gt $index 0}}
+			v{{$num}}, ok := next{{$num}}()
+			if !ok {
+				return
+			}
+			{{- end}}
+			{{- end}}
+			{{- if gt .Len 1}}
+			{{end}}
+			if !yield(New{{.Len}}(
+				{{- range $index, $num := .Indexes -}}
+				{{- if gt $index 0}}, {{end -}}
+				v{{$num}}
+				{{- end -}}
+			)) {
+				return
+			}
+		}
+	}
+}
+{{- if eq .Len 2}}
+
+// ToSeq2 converts an iterator over tuples of 2 values into an iterator over pairs of values.
+func ToSeq2[Ty1, Ty2 any](seq iter.Seq[{{$typeRef}}]) iter.Seq2[Ty1, Ty2] {
+	return func(yield func(Ty1, Ty2) bool) {
+		for t := range seq {
+			if !yield(t.V1, t.V2) {
+				return
+			}
+		}
+	}
+}
+
+// FromSeq2 converts an iterator over pairs of values into an iterator over tuples of 2 values.
+func FromSeq2[Ty1, Ty2 any](seq iter.Seq2[Ty1, Ty2]) iter.Seq[{{$typeRef}}] {
+	return func(yield func({{$typeRef}}) bool) {
+		for v1, v2 := range seq {
+			if !yield(New2(v1, v2)) {
+				return
+			}
+		}
+	}
+}
+{{- end}}
+
+// ReadCSVSeq{{.Len}} returns an iterator over the CSV records of r converted into tuples, the same way as ReadCSV{{.Len}} does.
+// Records that fail to parse or convert are yielded along with their errors, and the iteration continues with the next record.
+// Other errors, such as read errors, are yielded once and stop the iteration.
+func ReadCSVSeq{{.Len}}[{{genericTypesDecl .Indexes "any"}}](r io.Reader, opts CSVOptions) iter.Seq2[{{$typeRef}}, error] {
+	return readCSVSeq(r, opts, func(t *{{$typeRef}}) []any {
+		return []any{
+			{{range .Indexes -}}
+			&t.V{{.}},
+			{{end}}
+		}
+	})
+}
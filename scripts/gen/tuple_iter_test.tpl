@@ -0,0 +1,107 @@
+//go:build go1.23
+
+package tuple
+
+import (
+	{{- if eq .Len 2}}
+	"maps"
+	{{- end}}
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+{{/* These variables can be used when the context of dot changes. */}}
+{{$len := .Len}}
+{{$intOverload := buildSingleTypedOverload .Indexes "int"}}
+
+func TestT{{.Len}}_All(t *testing.T) {
+	tup := New{{.Len}}({{range .Indexes}}{{. | quote}},{{end}})
+
+	var indexes []int
+	var values []any
+	for index, value := range tup.All() {
+		indexes = append(indexes, index)
+		values = append(values, value)
+	}
+
+	require.Equal(t, []int{ {{- range $index, $num := .Indexes}}{{$index}},{{end -}} }, indexes)
+	require.Equal(t, tup.Slice(), values)
+}
+
+func TestT{{.Len}}_All_Break(t *testing.T) {
+	tup := New{{.Len}}({{range .Indexes}}{{. | quote}},{{end}})
+
+	var values []any
+	for _, value := range tup.All() {
+		values = append(values, value)
+		break
+	}
+
+	require.Equal(t, []any{"1"}, values)
+}
+
+func TestT{{.Len}}_ZipSeq(t *testing.T) {
+	want := []{{$intOverload}}{
+		New{{.Len}}({{range .Indexes}}{{.}},{{end}}),
+		New{{.Len}}({{range .Indexes}}{{inc .}},{{end}}),
+	}
+
+	require.Equal(t, want, slices.Collect(ZipSeq{{.Len}}({{range .Indexes}}slices.Values([]int{ {{- .}}, {{inc .}}{{- "}"}}),{{end}})))
+	{{- range $shortIndex := .Indexes}}
+	require.Equal(t, want[:1], slices.Collect(ZipSeq{{$len}}({{range $.Indexes}}{{if eq . $shortIndex}}slices.Values([]int{ {{- .}}{{- "}"}}){{else}}slices.Values([]int{ {{- .}}, {{inc .}}{{- "}"}}){{end}},{{end}})))
+	{{- end}}
+}
+
+func TestT{{.Len}}_ZipSeq_Break(t *testing.T) {
+	var got []{{$intOverload}}
+	for tup := range ZipSeq{{.Len}}({{range .Indexes}}slices.Values([]int{ {{- .}}, {{inc .}}{{- "}"}}),{{end}}) {
+		got = append(got, tup)
+		break
+	}
+
+	require.Equal(t, []{{$intOverload}}{New{{.Len}}({{range .Indexes}}{{.}},{{end}})}, got)
+}
+
+func TestT{{.Len}}_ReadCSVSeq(t *testing.T) {
+	data := "{{range .Indexes}}{{if ne . 1}},{{end}}{{.}}{{end}}\n{{range .Indexes}}{{if ne . 1}},{{end}}x{{end}}\n{{range .Indexes}}{{if ne . 1}},{{end}}{{inc .}}{{end}}\n"
+
+	var got []{{$intOverload}}
+	var errs []error
+	for tup, err := range ReadCSVSeq{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}int{{end}}](strings.NewReader(data), CSVOptions{}) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		got = append(got, tup)
+	}
+
+	require.Equal(t, []{{$intOverload}}{
+		New{{.Len}}({{range .Indexes}}{{.}},{{end}}),
+		New{{.Len}}({{range .Indexes}}{{inc .}},{{end}}),
+	}, got)
+	require.Len(t, errs, 1)
+
+	var csvErr *CSVError
+	require.ErrorAs(t, errs[0], &csvErr)
+	require.Equal(t, 2, csvErr.Line)
+	require.Equal(t, 1, csvErr.Column)
+}
+{{- if eq .Len 2}}
+
+func TestToSeq2(t *testing.T) {
+	tups := []T2[string, int]{
+		New2("a", 1),
+		New2("b", 2),
+	}
+
+	require.Equal(t, map[string]int{"a": 1, "b": 2}, maps.Collect(ToSeq2(slices.Values(tups))))
+}
+
+func TestFromSeq2(t *testing.T) {
+	got := slices.Collect(FromSeq2(maps.All(map[string]int{"a": 1, "b": 2})))
+	require.ElementsMatch(t, []T2[string, int]{New2("a", 1), New2("b", 2)}, got)
+}
+{{- end}}
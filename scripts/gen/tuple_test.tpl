@@ -1,7 +1,17 @@
 package tuple
 
 import (
+	"database/sql/driver"
+	"encoding/binary"
 	"encoding/json"
+	"encoding/xml"
+	"hash/maphash"
+	"io"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -11,6 +21,7 @@ import (
 {{$indexes := .Indexes}}
 {{$len := .Len}}
 {{$stringOverload := buildSingleTypedOverload $indexes "string"}}
+{{$intOverload := buildSingleTypedOverload $indexes "int"}}
 
 func TestT{{.Len}}_New(t *testing.T) {
 	tup := New{{.Len}}({{range .Indexes}}{{. | quote}},{{end}})
@@ -34,6 +45,154 @@ func TestT{{.Len}}_Values(t *testing.T) {
 	{{end -}}
 }
 
+func TestT{{.Len}}_MapAt(t *testing.T) {
+	tup := New{{.Len}}({{range .Indexes}}{{.}},{{end}})
+	{{range $mapIndex := .Indexes}}
+	require.Equal(t, New{{$len}}(
+		{{- range $.Indexes -}}
+		{{- if eq . $mapIndex}}{{. | quote}},{{else}}{{.}},{{end -}}
+		{{- end -}}
+	), Map{{$len}}At{{$mapIndex}}(tup, strconv.Itoa))
+	{{- end}}
+}
+
+func TestT{{.Len}}_Map(t *testing.T) {
+	tup := New{{.Len}}({{range .Indexes}}{{.}},{{end}})
+	got := Map{{.Len}}(tup, {{range .Indexes}}strconv.Itoa,{{end}})
+	require.Equal(t, New{{.Len}}({{range .Indexes}}{{. | quote}},{{end}}), got)
+}
+
+{{if lt .Len .MaxLen -}}
+func TestT{{.Len}}_Append(t *testing.T) {
+	tup := New{{.Len}}({{range .Indexes}}{{. | quote}},{{end}})
+	require.Equal(t, New{{inc .Len}}({{range seq 1 (inc .Len)}}{{. | quote}},{{end}}), Append{{.Len}}(tup, {{inc .Len | quote}}))
+}
+
+func TestT{{.Len}}_Prepend(t *testing.T) {
+	tup := New{{.Len}}({{range seq 2 (inc .Len)}}{{. | quote}},{{end}})
+	require.Equal(t, New{{inc .Len}}({{range seq 1 (inc .Len)}}{{. | quote}},{{end}}), Prepend{{.Len}}(tup, "1"))
+}
+
+func TestT{{.Len}}_Concat(t *testing.T) {
+	tup := New{{.Len}}({{range .Indexes}}{{. | quote}},{{end}})
+	{{range $otherLen := seq 1 (sub .MaxLen .Len)}}
+	require.Equal(t,
+		New{{add $len $otherLen}}({{range seq 1 (add $len $otherLen)}}{{. | quote}},{{end}}),
+		Concat{{$len}}_{{$otherLen}}(tup, New{{$otherLen}}({{range seq (inc $len) (add $len $otherLen)}}{{. | quote}},{{end}})),
+	)
+	{{- end}}
+}
+
+{{end -}}
+{{if gt .Len 1 -}}
+func TestT{{.Len}}_Take(t *testing.T) {
+	tup := New{{.Len}}({{range .Indexes}}{{. | quote}},{{end}})
+	{{range $count := seq 1 (sub .Len 1)}}
+	require.Equal(t, New{{$count}}({{range seq 1 $count}}{{. | quote}},{{end}}), Take{{$len}}_{{$count}}(tup))
+	{{- end}}
+}
+
+func TestT{{.Len}}_Drop(t *testing.T) {
+	tup := New{{.Len}}({{range .Indexes}}{{. | quote}},{{end}})
+	{{range $count := seq 1 (sub .Len 1)}}
+	require.Equal(t, New{{sub $len $count}}({{range seq (inc $count) $len}}{{. | quote}},{{end}}), Drop{{$len}}_{{$count}}(tup))
+	{{- end}}
+}
+
+func TestT{{.Len}}_Split(t *testing.T) {
+	tup := New{{.Len}}({{range .Indexes}}{{. | quote}},{{end}})
+	{{range $count := seq 1 (sub .Len 1)}}
+	require.Equal(t,
+		New2(New{{$count}}({{range seq 1 $count}}{{. | quote}},{{end}}), New{{sub $len $count}}({{range seq (inc $count) $len}}{{. | quote}},{{end}})),
+		Split{{$len}}_{{$count}}(tup),
+	)
+	{{- end}}
+}
+
+{{end -}}
+{{if gt .Len 1 -}}
+func TestT{{.Len}}_Reverse(t *testing.T) {
+	tup := New{{.Len}}({{range .Indexes}}{{.}},{{end}})
+	require.Equal(t, New{{.Len}}({{range reverse .Indexes}}{{.}},{{end}}), Reverse{{.Len}}(tup))
+}
+
+{{end -}}
+{{if eq .Len 2 -}}
+func TestT{{.Len}}_Swap(t *testing.T) {
+	tup := New2(1, "2")
+	require.Equal(t, New2("2", 1), tup.Swap())
+}
+
+{{end -}}
+func TestT{{.Len}}_Apply(t *testing.T) {
+	tup := New{{.Len}}({{range .Indexes}}{{. | quote}},{{end}})
+	got := Apply{{.Len}}(tup, func({{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}v{{$index}}{{end}} string) string {
+		return {{range $i, $index := .Indexes}}{{if gt $i 0}} + {{end}}v{{$index}}{{end}}
+	})
+	require.Equal(t, "{{range .Indexes}}{{.}}{{end}}", got)
+}
+
+func TestT{{.Len}}_Tupled(t *testing.T) {
+	tup := New{{.Len}}({{range .Indexes}}{{. | quote}},{{end}})
+	f := Tupled{{.Len}}(func({{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}v{{$index}}{{end}} string) string {
+		return {{range $i, $index := .Indexes}}{{if gt $i 0}} + {{end}}v{{$index}}{{end}}
+	})
+	require.Equal(t, "{{range .Indexes}}{{.}}{{end}}", f(tup))
+}
+
+func TestT{{.Len}}_Untupled(t *testing.T) {
+	f := Untupled{{.Len}}(func(tup {{$stringOverload}}) {{$stringOverload}} {
+		return tup
+	})
+	require.Equal(t, New{{.Len}}({{range .Indexes}}{{. | quote}},{{end}}), f({{range .Indexes}}{{. | quote}},{{end}}))
+}
+
+func TestT{{.Len}}_Zip(t *testing.T) {
+	want := []T{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}int{{end}}]{
+		New{{.Len}}({{range .Indexes}}{{.}},{{end}}),
+		New{{.Len}}({{range .Indexes}}{{inc .}},{{end}}),
+	}
+
+	require.Equal(t, want, Zip{{.Len}}({{range .Indexes}}[]int{ {{- .}}, {{inc .}}{{- "}"}},{{end}}))
+	{{- range $shortIndex := .Indexes}}
+	require.Equal(t, want[:1], Zip{{$len}}({{range $.Indexes}}{{if eq . $shortIndex}}[]int{ {{- .}}{{- "}"}}{{else}}[]int{ {{- .}}, {{inc .}}{{- "}"}}{{end}},{{end}}))
+	{{- end}}
+}
+
+func TestT{{.Len}}_ZipStrict(t *testing.T) {
+	want := []T{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}int{{end}}]{
+		New{{.Len}}({{range .Indexes}}{{.}},{{end}}),
+		New{{.Len}}({{range .Indexes}}{{inc .}},{{end}}),
+	}
+
+	got, err := Zip{{.Len}}Strict({{range .Indexes}}[]int{ {{- .}}, {{inc .}}{{- "}"}},{{end}})
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+	{{- if gt .Len 1}}
+	{{range $shortIndex := .Indexes}}
+	_, err = Zip{{$len}}Strict({{range $.Indexes}}{{if eq . $shortIndex}}[]int{ {{- .}}{{- "}"}}{{else}}[]int{ {{- .}}, {{inc .}}{{- "}"}}{{end}},{{end}})
+	{{- if eq $shortIndex 1}}
+	require.EqualError(t, err, "slice 2 length 2 must match slice 1 length 1")
+	{{- else}}
+	require.EqualError(t, err, "slice {{$shortIndex}} length 1 must match slice 1 length 2")
+	{{- end}}
+	var lengthErr{{$shortIndex}} *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr{{$shortIndex}})
+	{{- end}}
+	{{- end}}
+}
+
+func TestT{{.Len}}_Unzip(t *testing.T) {
+	{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}s{{$index}}{{end}} := Unzip{{.Len}}([]T{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}int{{end}}]{
+		New{{.Len}}({{range .Indexes}}{{.}},{{end}}),
+		New{{.Len}}({{range .Indexes}}{{inc .}},{{end}}),
+	})
+
+	{{range .Indexes -}}
+	require.Equal(t, []int{ {{- .}}, {{inc .}}{{- "}"}}, s{{.}})
+	{{end -}}
+}
+
 func TestT{{.Len}}_Compare(t *testing.T) {
 	lesser := New{{.Len}}({{range .Indexes}}{{.}},{{end}})
 	greater := New{{.Len}}({{range .Indexes}}{{. | inc}},{{end}})
@@ -214,6 +373,119 @@ func TestT{{.Len}}_CompareC(t *testing.T) {
 	}
 }
 
+func TestT{{.Len}}_CmpFunc(t *testing.T) {
+	tups := []T{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}int{{end}}]{
+		New{{.Len}}({{range .Indexes}}{{inc .}},{{end}}),
+		New{{.Len}}({{range .Indexes}}{{.}},{{end}}),
+		New{{.Len}}({{range .Indexes}}{{inc (inc .)}},{{end}}),
+	}
+
+	cmp := CmpFunc{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}int{{end}}]()
+	slices.SortFunc(tups, cmp)
+	require.Equal(t, []T{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}int{{end}}]{
+		New{{.Len}}({{range .Indexes}}{{.}},{{end}}),
+		New{{.Len}}({{range .Indexes}}{{inc .}},{{end}}),
+		New{{.Len}}({{range .Indexes}}{{inc (inc .)}},{{end}}),
+	}, tups)
+
+	index, found := slices.BinarySearchFunc(tups, New{{.Len}}({{range .Indexes}}{{inc .}},{{end}}), cmp)
+	require.True(t, found)
+	require.Equal(t, 1, index)
+}
+
+func TestT{{.Len}}_CmpFuncC(t *testing.T) {
+	tups := []T{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}stringComparable{{end}}]{
+		New{{.Len}}({{range .Indexes}}stringComparable({{inc . | quote}}),{{end}}),
+		New{{.Len}}({{range .Indexes}}stringComparable({{. | quote}}),{{end}}),
+	}
+
+	slices.SortFunc(tups, CmpFunc{{.Len}}C[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}stringComparable{{end}}]())
+	require.Equal(t, []T{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}stringComparable{{end}}]{
+		New{{.Len}}({{range .Indexes}}stringComparable({{. | quote}}),{{end}}),
+		New{{.Len}}({{range .Indexes}}stringComparable({{inc . | quote}}),{{end}}),
+	}, tups)
+}
+
+func TestT{{.Len}}_Compare_NaN(t *testing.T) {
+	nan := New{{.Len}}({{range .Indexes}}math.NaN(),{{end}})
+	num := New{{.Len}}({{range .Indexes}}{{.}}.0,{{end}})
+
+	require.True(t, Compare{{.Len}}(nan, nan).EQ())
+	require.True(t, Compare{{.Len}}(nan, num).LT())
+	require.True(t, Compare{{.Len}}(num, nan).GT())
+}
+
+func TestT{{.Len}}_Comparator(t *testing.T) {
+	compare := Comparator{{.Len}}({{range .Indexes}}CompareOrdered[int],{{end}})
+	lesser := New{{.Len}}({{range .Indexes}}{{.}},{{end}})
+
+	require.Equal(t, 0, compare(lesser, lesser))
+	{{- range $greaterIndex := .Indexes}}
+
+	greater{{$greaterIndex}} := New{{$len}}({{range $.Indexes}}{{if eq . $greaterIndex}}{{inc .}}{{else}}{{.}}{{end}},{{end}})
+	require.Equal(t, -1, compare(lesser, greater{{$greaterIndex}}))
+	require.Equal(t, 1, compare(greater{{$greaterIndex}}, lesser))
+	{{- end}}
+}
+
+func TestT{{.Len}}_Comparator_Comparable(t *testing.T) {
+	compare := Comparator{{.Len}}({{range .Indexes}}CompareComparable[stringComparable],{{end}})
+	lesser := New{{.Len}}({{range .Indexes}}stringComparable({{. | quote}}),{{end}})
+	greater := New{{.Len}}({{range .Indexes}}stringComparable({{inc . | quote}}),{{end}})
+
+	require.Equal(t, -1, compare(lesser, greater))
+	require.Equal(t, 1, compare(greater, lesser))
+	require.Equal(t, 0, compare(lesser, lesser))
+}
+{{- if gt .Len 1}}
+
+func TestT{{.Len}}_Comparator_ShortCircuit(t *testing.T) {
+	unreachable := func(a, b int) int {
+		t.Fatal("comparison function should not be called")
+		return 0
+	}
+
+	compare := Comparator{{.Len}}(CompareOrdered[int], {{range $i, $index := .Indexes}}{{if gt $i 0}}unreachable,{{end}}{{end}})
+	require.Equal(t, -1, compare(New{{.Len}}({{range .Indexes}}{{.}},{{end}}), New{{.Len}}({{range .Indexes}}{{inc .}},{{end}})))
+}
+{{- end}}
+
+func TestT{{.Len}}_OrderBy(t *testing.T) {
+	lesser := New{{.Len}}({{range .Indexes}}{{.}},{{end}})
+	asc := OrderBy{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}int{{end}}]({{range .Indexes}}Asc,{{end}})
+	{{- range $descIndex := .Indexes}}
+
+	greater{{$descIndex}} := New{{$len}}({{range $.Indexes}}{{if eq . $descIndex}}{{inc .}}{{else}}{{.}}{{end}},{{end}})
+	desc{{$descIndex}} := OrderBy{{$len}}[{{range $i, $index := $.Indexes}}{{if gt $i 0}}, {{end}}int{{end}}]({{range $.Indexes}}{{if eq . $descIndex}}Desc{{else}}Asc{{end}},{{end}})
+	require.Equal(t, -1, asc(lesser, greater{{$descIndex}}))
+	require.Equal(t, 1, desc{{$descIndex}}(lesser, greater{{$descIndex}}))
+	require.Equal(t, 0, desc{{$descIndex}}(lesser, lesser))
+	{{- end}}
+}
+
+func TestT{{.Len}}_Comparator_OrderedPtrBy(t *testing.T) {
+	compare := Comparator{{.Len}}(
+		{{- range .Indexes}}
+		{{- if eq . $len}}
+		OrderedPtrBy[int](Desc.NilsFirst()),
+		{{- else}}
+		OrderedBy[int](Asc),
+		{{- end}}
+		{{- end}}
+	)
+
+	tups := []T{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}{{if eq $index $len}}*int{{else}}int{{end}}{{end}}]{
+		New{{.Len}}({{range .Indexes}}{{if eq . $len}}ptr(1){{else}}{{.}}{{end}},{{end}}),
+		New{{.Len}}({{range .Indexes}}{{if eq . $len}}ptr(2){{else}}{{.}}{{end}},{{end}}),
+		New{{.Len}}({{range .Indexes}}{{if eq . $len}}(*int)(nil){{else}}{{.}}{{end}},{{end}}),
+	}
+	slices.SortFunc(tups, compare)
+
+	require.Nil(t, tups[0].V{{.Len}})
+	require.Equal(t, 2, *tups[1].V{{.Len}})
+	require.Equal(t, 1, *tups[2].V{{.Len}})
+}
+
 func TestT{{.Len}}_EqualE(t *testing.T) {
 	a := New{{.Len}}({{range .Indexes}}intEqualable({{.}}),{{end}})
 	b := New{{.Len}}({{range .Indexes}}intEqualable({{. | inc}}),{{end}})
@@ -222,6 +494,34 @@ func TestT{{.Len}}_EqualE(t *testing.T) {
 	require.True(t, Equal{{.Len}}E(a, a))
 }
 
+func TestT{{.Len}}_EqualFunc(t *testing.T) {
+	a := New{{.Len}}({{range .Indexes}}[]int{ {{- .}}{{- "}"}},{{end}})
+	b := New{{.Len}}({{range .Indexes}}[]int{ {{- .}}{{- "}"}},{{end}})
+
+	require.True(t, EqualFunc{{.Len}}(a, b, {{range .Indexes}}slices.Equal[[]int],{{end}}))
+	{{- range $diffIndex := .Indexes}}
+	require.False(t, EqualFunc{{$len}}(a, New{{$len}}({{range $.Indexes}}[]int{ {{- if eq . $diffIndex}}{{inc .}}{{else}}{{.}}{{end}}{{- "}"}},{{end}}), {{range $.Indexes}}slices.Equal[[]int],{{end}}))
+	{{- end}}
+}
+
+func TestT{{.Len}}_EqualMethod(t *testing.T) {
+	a := New{{.Len}}({{range .Indexes}}[]int{ {{- .}}{{- "}"}},{{end}})
+
+	require.True(t, a.Equal(New{{.Len}}({{range .Indexes}}[]int{ {{- .}}{{- "}"}},{{end}})))
+	{{- range $diffIndex := .Indexes}}
+	require.False(t, a.Equal(New{{$len}}({{range $.Indexes}}[]int{ {{- if eq . $diffIndex}}{{inc .}}{{else}}{{.}}{{end}}{{- "}"}},{{end}})))
+	{{- end}}
+}
+
+func TestT{{.Len}}_EqualMethod_Nested(t *testing.T) {
+	a := New{{.Len}}({{range .Indexes}}New1(intEqualable({{.}})),{{end}})
+	b := New{{.Len}}({{range .Indexes}}New1(intEqualable({{inc .}})),{{end}})
+
+	require.True(t, a.Equal(a))
+	require.False(t, a.Equal(b))
+	require.True(t, Equal{{.Len}}E(a, a))
+}
+
 func TestT{{.Len}}_String(t *testing.T) {
 	tup := New{{.Len}}({{range .Indexes}}{{. | quote}},{{end}})
 	require.Equal(t, `[{{range $i, $index := .Indexes}}{{if gt $i 0}} {{end}}{{. | quote}}{{end}}]`, tup.String())
@@ -237,6 +537,31 @@ func TestT{{.Len}}_GoString(t *testing.T) {
 	}`, tup.GoString())
 }
 
+func TestT{{.Len}}_Parse(t *testing.T) {
+	tup := New{{.Len}}({{range .Indexes}}{{. | quote}},{{end}})
+
+	got, err := Parse{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}string{{end}}](tup.String())
+	require.NoError(t, err)
+	require.Equal(t, tup, got)
+}
+
+func TestT{{.Len}}_Parse_Mixed(t *testing.T) {
+	tup := New{{.Len}}({{range .Indexes}}New2({{. | quote}}, {{.}}.5),{{end}})
+
+	got, err := Parse{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}T2[string, float64]{{end}}](tup.String())
+	require.NoError(t, err)
+	require.Equal(t, tup, got)
+}
+
+func TestT{{.Len}}_Parse_Invalid(t *testing.T) {
+	_, err := Parse{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}int{{end}}](`[{{range .Indexes}}{{if ne . 1}} {{end}}{{. | quote}}{{end}}]`)
+	require.Error(t, err)
+
+	var parseErr *ParseError
+	require.ErrorAs(t, err, &parseErr)
+	require.Equal(t, 1, parseErr.Offset)
+}
+
 func TestT{{.Len}}_ToArray(t *testing.T) {
 	tup := New{{.Len}}({{range .Indexes}}{{. | quote}},{{end}})
 	require.Equal(t, [{{.Len}}]any{
@@ -475,6 +800,339 @@ func TestT{{.Len}}_FromSlice(t *testing.T) {
 	}
 }
 
+func TestT{{.Len}}_FromSlice_Errors(t *testing.T) {
+	_, err := FromSlice{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}string{{end}}]([]any{})
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, &LengthMismatchError{Source: "slice", Expected: {{.Len}}, Actual: 0}, lengthErr)
+	require.EqualError(t, err, "slice length 0 must match number of tuple values {{.Len}}")
+
+	{{range $testIndex, $index := .Indexes -}}
+	_, err = FromSlice{{$len}}[{{range $i, $_ := $indexes}}{{if gt $i 0}}, {{end}}string{{end}}]([]any{ {{- range $arrayIndex, $elemIndex := $indexes}}{{if eq $testIndex $arrayIndex}}{{$elemIndex}}{{else}}{{$elemIndex | quote}}{{end}},{{end -}} })
+	var typeErr{{$index}} *ElementTypeError
+	require.ErrorAs(t, err, &typeErr{{$index}})
+	require.Equal(t, {{$testIndex}}, typeErr{{$index}}.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr{{$index}}.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr{{$index}}.Actual)
+	require.EqualError(t, err, "value at slice index {{$testIndex}} expected to have type string but has type int")
+
+	{{end -}}
+	_, err = FromArray{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}string{{end}}]([{{.Len}}]any{})
+	var arrayTypeErr *ElementTypeError
+	require.ErrorAs(t, err, &arrayTypeErr)
+	require.Equal(t, &ElementTypeError{Source: "array", Index: 0, Expected: reflect.TypeOf("")}, arrayTypeErr)
+	require.EqualError(t, err, "value at array index 0 expected to have type string but has type <nil>")
+}
+
+func TestT{{.Len}}_UnmarshalJSON_Errors(t *testing.T) {
+	var tup {{$stringOverload}}
+
+	err := json.Unmarshal([]byte(`[]`), &tup)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, {{.Len}}, lengthErr.Expected)
+	require.Equal(t, 0, lengthErr.Actual)
+	require.EqualError(t, err, "unmarshalled json array length 0 must match number of tuple values {{.Len}}")
+
+	err = tup.UnmarshalJSON([]byte(`[{{range .Indexes}}{{if ne . 1}},{{end}}{{if eq . $len}}{{.}}{{else}}{{. | quote}}{{end}}{{end}}]`))
+	var elementErr *ElementUnmarshalError
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, {{sub .Len 1}}, elementErr.Index)
+	require.Equal(t, "{{.Len}}", elementErr.Value)
+	var typeErr *json.UnmarshalTypeError
+	require.ErrorAs(t, err, &typeErr)
+	require.ErrorContains(t, err, `value "{{.Len}}" at slice index {{sub .Len 1}} failed to unmarshal: `)
+}
+
+func TestT{{.Len}}_ConvertSlice(t *testing.T) {
+	tests := []struct{
+		name string
+		slice []any
+		want {{$intOverload}}
+		wantErr bool
+	}{
+		{
+			name: "all types match",
+			slice: []any{ {{- range .Indexes}}{{.}},{{end -}} },
+			want: New{{.Len}}({{range .Indexes}}{{.}},{{end}}),
+		},
+		{
+			name: "decoded json numbers",
+			slice: []any{ {{- range .Indexes}}float64({{.}}),{{end -}} },
+			want: New{{.Len}}({{range .Indexes}}{{.}},{{end}}),
+		},
+		{
+			name: "json numbers",
+			slice: []any{ {{- range .Indexes}}json.Number({{. | quote}}),{{end -}} },
+			want: New{{.Len}}({{range .Indexes}}{{.}},{{end}}),
+		},
+		{
+			name: "slice empty",
+			slice: []any{},
+			wantErr: true,
+		},
+		{{- range $testIndex, $index := .Indexes}}
+		{
+			name: "index {{$index}} bad type",
+			slice: []any{ {{- range $arrayIndex, $elemIndex := $indexes}}{{if eq $testIndex $arrayIndex}}{{$elemIndex | quote}}{{else}}{{$elemIndex}}{{end}},{{end -}} },
+			wantErr: true,
+		},
+		{
+			name: "index {{$index}} fractional number",
+			slice: []any{ {{- range $arrayIndex, $elemIndex := $indexes}}{{if eq $testIndex $arrayIndex}}{{$elemIndex}}.5{{else}}{{$elemIndex}}{{end}},{{end -}} },
+			wantErr: true,
+		},
+		{{- end}}
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tup, err := ConvertSlice{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}int{{end}}](tt.slice)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, tup)
+		})
+	}
+}
+
+func TestT{{.Len}}_ConvertSlice_Errors(t *testing.T) {
+	_, err := ConvertSlice{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}int8{{end}}]([]any{})
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+
+	_, err = ConvertSlice{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}int8{{end}}]([]any{ {{- range .Indexes}}{{if eq . $len}}true{{else}}{{.}}{{end}},{{end -}} })
+	var typeErr *ElementTypeError
+	require.ErrorAs(t, err, &typeErr)
+	require.Equal(t, {{sub .Len 1}}, typeErr.Index)
+
+	_, err = ConvertSlice{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}int8{{end}}]([]any{ {{- range .Indexes}}{{if eq . $len}}float64(128){{else}}{{.}}{{end}},{{end -}} })
+	var conversionErr *ElementConversionError
+	require.ErrorAs(t, err, &conversionErr)
+	require.Equal(t, {{sub .Len 1}}, conversionErr.Index)
+	require.Equal(t, reflect.TypeOf(int8(0)), conversionErr.Expected)
+}
+
+func TestT{{.Len}}_ScanRow(t *testing.T) {
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{ {{- range .Indexes}}"c{{.}}",{{end -}} },
+		rows: [][]driver.Value{
+			{ {{- range .Indexes}}int64({{.}}),{{end -}} },
+		},
+	})
+
+	require.True(t, rows.Next())
+	got, err := ScanRow{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}{{if eq $index 1}}string{{else}}int{{end}}{{end}}](rows)
+	require.NoError(t, err)
+	require.Equal(t, New{{.Len}}({{range .Indexes}}{{if eq . 1}}"1"{{else}}{{.}}{{end}},{{end}}), got)
+}
+
+func TestT{{.Len}}_ScanRow_ColumnsMismatch(t *testing.T) {
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{ {{- range .Indexes}}"c{{.}}",{{end}}"extra"{{- "}"}},
+		rows: [][]driver.Value{
+			{ {{- range .Indexes}}int64({{.}}),{{end}}int64(0){{- "}"}},
+		},
+	})
+
+	require.True(t, rows.Next())
+	_, err := ScanRow{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}int{{end}}](rows)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.EqualError(t, err, "row columns count {{inc .Len}} must match number of tuple values {{.Len}}")
+}
+
+func TestT{{.Len}}_CollectRows(t *testing.T) {
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{ {{- range .Indexes}}"c{{.}}",{{end -}} },
+		rows: [][]driver.Value{
+			{ {{- range .Indexes}}int64({{.}}),{{end -}} },
+			{ {{- range .Indexes}}int64({{inc .}}),{{end -}} },
+		},
+	})
+
+	got, err := CollectRows{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}int{{end}}](rows)
+	require.NoError(t, err)
+	require.Equal(t, []{{$intOverload}}{
+		New{{.Len}}({{range .Indexes}}{{.}},{{end}}),
+		New{{.Len}}({{range .Indexes}}{{inc .}},{{end}}),
+	}, got)
+
+	rows = fakeQuery(t, fakeResult{columns: []string{ {{- range .Indexes}}"c{{.}}",{{end -}} }})
+	got, err = CollectRows{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}int{{end}}](rows)
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
+
+func TestT{{.Len}}_ReadCSV(t *testing.T) {
+	tests := []struct{
+		name string
+		data string
+		opts CSVOptions
+		want []{{$intOverload}}
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			data: "",
+			want: nil,
+		},
+		{
+			name: "records",
+			data: "{{range .Indexes}}{{if ne . 1}},{{end}}{{.}}{{end}}\n{{range .Indexes}}{{if ne . 1}},{{end}}{{inc .}}{{end}}\n",
+			want: []{{$intOverload}}{
+				New{{.Len}}({{range .Indexes}}{{.}},{{end}}),
+				New{{.Len}}({{range .Indexes}}{{inc .}},{{end}}),
+			},
+		},
+		{
+			name: "header",
+			data: "{{range .Indexes}}{{if ne . 1}},{{end}}v{{.}}{{end}}\n{{range .Indexes}}{{if ne . 1}},{{end}}{{.}}{{end}}\n",
+			opts: CSVOptions{Header: []string{ {{- range .Indexes}}"v{{.}}",{{end -}} }},
+			want: []{{$intOverload}}{New{{.Len}}({{range .Indexes}}{{.}},{{end}})},
+		},
+		{
+			name: "skip header",
+			data: "{{range .Indexes}}{{if ne . 1}},{{end}}c{{.}}{{end}}\n{{range .Indexes}}{{if ne . 1}},{{end}}{{.}}{{end}}\n",
+			opts: CSVOptions{SkipHeader: true},
+			want: []{{$intOverload}}{New{{.Len}}({{range .Indexes}}{{.}},{{end}})},
+		},
+		{
+			name: "mismatching header",
+			data: "{{range .Indexes}}{{if ne . 1}},{{end}}c{{.}}{{end}}\n{{range .Indexes}}{{if ne . 1}},{{end}}{{.}}{{end}}\n",
+			opts: CSVOptions{Header: []string{ {{- range .Indexes}}"v{{.}}",{{end -}} }},
+			wantErr: true,
+		},
+		{
+			name: "semicolon delimiter",
+			data: "{{range .Indexes}}{{if ne . 1}};{{end}}{{.}}{{end}}\n",
+			opts: CSVOptions{Comma: ';'},
+			want: []{{$intOverload}}{New{{.Len}}({{range .Indexes}}{{.}},{{end}})},
+		},
+		{
+			name: "too many fields",
+			data: "{{range .Indexes}}{{.}},{{end}}0\n",
+			wantErr: true,
+		},
+		{
+			name: "invalid field",
+			data: "{{range .Indexes}}{{if ne . 1}},{{end}}{{if eq . $len}}x{{else}}{{.}}{{end}}{{end}}\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadCSV{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}int{{end}}](strings.NewReader(tt.data), tt.opts)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT{{.Len}}_ReadCSV_Error(t *testing.T) {
+	data := "{{range .Indexes}}{{if ne . 1}},{{end}}{{.}}{{end}}\n{{range .Indexes}}{{if ne . 1}},{{end}}{{if eq . $len}}x{{else}}{{.}}{{end}}{{end}}\n"
+
+	_, err := ReadCSV{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}int{{end}}](strings.NewReader(data), CSVOptions{})
+	var csvErr *CSVError
+	require.ErrorAs(t, err, &csvErr)
+	require.Equal(t, 2, csvErr.Line)
+	require.Equal(t, {{.Len}}, csvErr.Column)
+
+	var elementErr *ElementUnmarshalError
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, "x", elementErr.Value)
+}
+
+func TestT{{.Len}}_WriteCSV(t *testing.T) {
+	tups := []{{$stringOverload}}{
+		New{{.Len}}({{range .Indexes}}{{. | quote}},{{end}}),
+		New{{.Len}}({{range .Indexes}}{{printf "%d,\"%d\"" . . | quote}},{{end}}),
+	}
+
+	var buf strings.Builder
+	err := WriteCSV{{.Len}}(&buf, tups, CSVOptions{Header: []string{ {{- range .Indexes}}"v{{.}}",{{end -}} }})
+	require.NoError(t, err)
+	require.Equal(t, "{{range .Indexes}}{{if ne . 1}},{{end}}v{{.}}{{end}}\n{{range .Indexes}}{{if ne . 1}},{{end}}{{.}}{{end}}\n{{range .Indexes}}{{if ne . 1}},{{end}}\"{{.}},\"\"{{.}}\"\"\"{{end}}\n", buf.String())
+
+	got, err := ReadCSV{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}string{{end}}](strings.NewReader(buf.String()), CSVOptions{SkipHeader: true})
+	require.NoError(t, err)
+	require.Equal(t, tups, got)
+}
+
+func TestT{{.Len}}_WriteCSV_Error(t *testing.T) {
+	tups := []T{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}{{if eq $index $len}}[]int{{else}}string{{end}}{{end}}]{
+		{{"{"}}{{range .Indexes}}V{{.}}: {{if eq . $len}}[]int{ {{- .}}{{"}"}}{{else}}"a\nb"{{end}},{{end}}{{"}"}},
+	}
+
+	var buf strings.Builder
+	err := WriteCSV{{.Len}}(&buf, tups, CSVOptions{Header: []string{ {{- range .Indexes}}"v{{.}}",{{end -}} }})
+	var csvErr *CSVError
+	require.ErrorAs(t, err, &csvErr)
+	require.Equal(t, 2, csvErr.Line)
+	require.Equal(t, {{.Len}}, csvErr.Column)
+
+	err = WriteCSV{{.Len}}(&buf, tups, CSVOptions{Header: []string{ {{- range .Indexes}}"v{{.}}",{{end}}"extra"{{"}"}}})
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+}
+
+func TestT{{.Len}}_Hash(t *testing.T) {
+	seed := maphash.MakeSeed()
+	tup := New{{.Len}}({{range .Indexes}}{{. | quote}},{{end}})
+
+	require.Equal(t, tup.Hash(seed), New{{.Len}}({{range .Indexes}}{{. | quote}},{{end}}).Hash(seed))
+	{{- range $diffIndex := .Indexes}}
+	require.NotEqual(t, tup.Hash(seed), New{{$len}}({{range $.Indexes}}{{if eq . $diffIndex}}{{inc . | quote}}{{else}}{{. | quote}}{{end}},{{end}}).Hash(seed))
+	{{- end}}
+}
+
+func TestT{{.Len}}_Hash_Nested(t *testing.T) {
+	seed := maphash.MakeSeed()
+	tup := New{{.Len}}({{range .Indexes}}New2({{. | quote}}, {{.}}),{{end}})
+
+	require.Equal(t, tup.Hash(seed), New{{.Len}}({{range .Indexes}}New2({{. | quote}}, {{.}}),{{end}}).Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New{{.Len}}({{range .Indexes}}New2({{. | quote}}, {{inc .}}),{{end}}).Hash(seed))
+}
+
+func TestT{{.Len}}_Hash_Unsupported(t *testing.T) {
+	seed := maphash.MakeSeed()
+	tup := New{{.Len}}({{range .Indexes}}[]int{ {{- .}}{{- "}"}},{{end}})
+	require.NotPanics(t, func() {
+		tup.Hash(seed)
+	})
+	require.Equal(t, tup.Hash(seed), New{{.Len}}({{range .Indexes}}[]int{ {{- .}}{{- "}"}},{{end}}).Hash(seed))
+
+	var nilTup T{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}any{{end}}]
+	require.NotPanics(t, func() {
+		nilTup.Hash(seed)
+	})
+	require.Panics(t, func() {
+		HashValue(seed, tup.V1)
+	})
+}
+
+func TestT{{.Len}}_HashFunc(t *testing.T) {
+	seed := maphash.MakeSeed()
+	hashSlice := func(seed maphash.Seed, v []int) uint64 {
+		return HashValue(seed, len(v))
+	}
+
+	hash := HashFunc{{.Len}}({{range .Indexes}}hashSlice,{{end}})
+	tup := New{{.Len}}({{range .Indexes}}[]int{ {{- .}}{{- "}"}},{{end}})
+
+	require.Equal(t, hash(seed, tup), hash(seed, New{{.Len}}({{range .Indexes}}[]int{ {{- inc .}}{{- "}"}},{{end}})))
+	require.NotEqual(t, hash(seed, tup), hash(seed, New{{.Len}}({{range .Indexes}}[]int{ {{- .}}, {{inc .}}{{- "}"}},{{end}})))
+}
+
 func TestT{{.Len}}_MarshalJSON(t *testing.T) {
 	tup := New{{.Len}}({{range .Indexes}}{{. | quote}},{{end}})
 
@@ -581,3 +1239,639 @@ func TestT{{.Len}}_Marshal_Unmarshal(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, tup, unmarshalled)
 }
+
+func TestT{{.Len}}_UnmarshalJSONWith(t *testing.T) {
+	tests := []struct{
+		name string
+		data string
+		opts JSONDecodeOptions
+		want {{$stringOverload}}
+		wantErr bool
+	}{
+		{
+			name: "empty json array",
+			data: `[]`,
+			wantErr: true,
+		},
+		{
+			name: "allow shorter empty json array",
+			data: `[]`,
+			opts: JSONDecodeOptions{AllowShorter: true},
+			want: {{$stringOverload}}{},
+		},
+		{{- if gt .Len 1}}
+		{
+			name: "allow shorter partial json array",
+			data: `["1"]`,
+			opts: JSONDecodeOptions{AllowShorter: true},
+			want: {{$stringOverload}}{V1: "1"},
+		},
+		{{- end}}
+		{
+			name: "allow shorter longer json array",
+			data: `[{{range .Indexes}}{{. | quote}},{{end}}"10"]`,
+			opts: JSONDecodeOptions{AllowShorter: true},
+			wantErr: true,
+		},
+		{
+			name: "json array with null values",
+			data: `[{{range .Indexes}}{{if ne . 1}},{{end}}null{{end}}]`,
+			want: {{$stringOverload}}{},
+		},
+		{
+			name: "disallow null json array with null values",
+			data: `[{{range .Indexes}}{{if ne . 1}},{{end}}null{{end}}]`,
+			opts: JSONDecodeOptions{DisallowNull: true},
+			wantErr: true,
+		},
+		{
+			name: "disallow null json array of valid types",
+			data: `[{{range .Indexes}}{{if ne . 1}},{{end}}{{. | quote}}{{end}}]`,
+			opts: JSONDecodeOptions{DisallowNull: true},
+			want: New{{.Len}}({{range .Indexes}}{{. | quote}},{{end}}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got {{$stringOverload}}
+			err := got.UnmarshalJSONWith([]byte(tt.data), tt.opts)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT{{.Len}}_UnmarshalJSONWith_Nested(t *testing.T) {
+	type Custom struct {
+		Name string `json:"name"`
+	}
+
+	data := []byte(`[{{range .Indexes}}{{if ne . 1}},{{end}}[{{.}}, {"name": {{. | quote}}, "age": {{.}}}]{{end}}]`)
+
+	var got T{{.Len}}[{{range .Indexes}}T2[any, Custom],{{end}}]
+	err := got.UnmarshalJSONWith(data, JSONDecodeOptions{UseNumber: true})
+	require.NoError(t, err)
+	require.Equal(t, New{{.Len}}({{range .Indexes}}New2[any](json.Number({{. | quote}}), Custom{Name: {{. | quote}}{{"}"}}),{{end}}), got)
+
+	err = got.UnmarshalJSONWith(data, JSONDecodeOptions{DisallowUnknownFields: true})
+	require.Error(t, err)
+}
+
+
+func TestT{{.Len}}_MarshalJSONLabeled(t *testing.T) {
+	tup := New{{.Len}}({{range .Indexes}}{{.}},{{end}})
+	labels := WithLabels({{range .Indexes}}"v{{.}}",{{end}})
+
+	got, err := tup.MarshalJSONLabeled(labels)
+	require.NoError(t, err)
+	require.Equal(t, `{{"{"}}{{range .Indexes}}{{if ne . 1}},{{end}}"v{{.}}":{{.}}{{end}}{{"}"}}`, string(got))
+
+	_, err = tup.MarshalJSONLabeled(WithLabels({{range .Indexes}}"v{{.}}",{{end}}"extra"))
+	require.Error(t, err)
+}
+
+func TestT{{.Len}}_UnmarshalJSONLabeled(t *testing.T) {
+	tests := []struct{
+		name string
+		data string
+		labels Labels
+		want {{$intOverload}}
+		wantErr bool
+	}{
+		{
+			name: "all keys",
+			data: `{{"{"}}{{range .Indexes}}{{if ne . 1}},{{end}}"v{{.}}":{{.}}{{end}}{{"}"}}`,
+			labels: WithLabels({{range .Indexes}}"v{{.}}",{{end}}),
+			want: New{{.Len}}({{range .Indexes}}{{.}},{{end}}),
+		},
+		{
+			name: "missing keys",
+			data: `{}`,
+			labels: WithLabels({{range .Indexes}}"v{{.}}",{{end}}),
+			want: {{$intOverload}}{},
+		},
+		{
+			name: "strict missing keys",
+			data: `{}`,
+			labels: WithLabels({{range .Indexes}}"v{{.}}",{{end}}).Strict(),
+			wantErr: true,
+		},
+		{
+			name: "unknown keys",
+			data: `{{"{"}}{{range .Indexes}}"v{{.}}":{{.}},{{end}}"extra":0{{"}"}}`,
+			labels: WithLabels({{range .Indexes}}"v{{.}}",{{end}}),
+			want: New{{.Len}}({{range .Indexes}}{{.}},{{end}}),
+		},
+		{
+			name: "strict unknown keys",
+			data: `{{"{"}}{{range .Indexes}}"v{{.}}":{{.}},{{end}}"extra":0{{"}"}}`,
+			labels: WithLabels({{range .Indexes}}"v{{.}}",{{end}}).Strict(),
+			wantErr: true,
+		},
+		{
+			name: "json array",
+			data: `[{{range .Indexes}}{{if ne . 1}},{{end}}{{.}}{{end}}]`,
+			labels: WithLabels({{range .Indexes}}"v{{.}}",{{end}}),
+			wantErr: true,
+		},
+		{
+			name: "labels count mismatch",
+			data: `{}`,
+			labels: WithLabels({{range .Indexes}}"v{{.}}",{{end}}"extra"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got {{$intOverload}}
+			err := got.UnmarshalJSONLabeled([]byte(tt.data), tt.labels)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT{{.Len}}_MarshalText(t *testing.T) {
+	tup := New{{.Len}}({{range .Indexes}}{{. | quote}},{{end}})
+
+	got, err := tup.MarshalText()
+	require.NoError(t, err)
+	require.Equal(t, `{{range .Indexes}}{{if ne . 1}},{{end}}{{.}}{{end}}`, string(got))
+}
+
+func TestT{{.Len}}_MarshalText_Unsupported(t *testing.T) {
+	tup := New{{.Len}}({{range .Indexes}}[]int{ {{- .}}{{- "}"}},{{end}})
+
+	_, err := tup.MarshalText()
+	require.Error(t, err)
+}
+
+func TestT{{.Len}}_UnmarshalText(t *testing.T) {
+	tests := []struct{
+		name string
+		data string
+		want {{$stringOverload}}
+		wantErr bool
+	}{
+		{
+			name: "valid values",
+			data: `{{range .Indexes}}{{if ne . 1}},{{end}}{{.}}{{end}}`,
+			want: New{{.Len}}({{range .Indexes}}{{. | quote}},{{end}}),
+		},
+		{
+			name: "escaped values",
+			data: `{{range .Indexes}}{{if ne . 1}},{{end}}a\,{{.}}\\{{end}}`,
+			want: New{{.Len}}({{range .Indexes}}`a,{{.}}\`,{{end}}),
+		},
+		{{- if gt .Len 1}}
+		{
+			name: "too few values",
+			data: `{{range .Indexes}}{{if gt . 1}}{{if gt . 2}},{{end}}{{.}}{{end}}{{end}}`,
+			wantErr: true,
+		},
+		{{- end}}
+		{
+			name: "too many values",
+			data: `{{range .Indexes}}{{.}},{{end}}extra`,
+			wantErr: true,
+		},
+		{
+			name: "dangling escape",
+			data: `{{range .Indexes}}{{if ne . 1}},{{end}}{{.}}{{end}}\`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got {{$stringOverload}}
+			err := got.UnmarshalText([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT{{.Len}}_MarshalText_UnmarshalText(t *testing.T) {
+	tup := New{{.Len}}({{range .Indexes}}New2({{. | printf "a,%d\\\\" | quote}}, {{.}}),{{end}})
+
+	marshalled, err := tup.MarshalText()
+	require.NoError(t, err)
+
+	var unmarshalled T{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}T2[string, int]{{end}}]
+	err = unmarshalled.UnmarshalText(marshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT{{.Len}}_GobEncode_GobDecode(t *testing.T) {
+	t.Run("strings", func(t *testing.T) {
+		requireGobRoundTrip(t, New{{.Len}}({{range .Indexes}}{{. | quote}},{{end}}))
+	})
+
+	t.Run("zero values", func(t *testing.T) {
+		requireGobRoundTrip(t, {{$intOverload}}{})
+	})
+
+	t.Run("nested tuples", func(t *testing.T) {
+		requireGobRoundTrip(t, New{{.Len}}({{range .Indexes}}New2({{. | quote}}, {{.}}),{{end}}))
+	})
+
+	t.Run("pointers", func(t *testing.T) {
+		requireGobRoundTrip(t, New{{.Len}}({{range .Indexes}}ptr({{.}}),{{end}}))
+	})
+
+	t.Run("pointers to zero values", func(t *testing.T) {
+		requireGobRoundTrip(t, New{{.Len}}({{range .Indexes}}ptr(0),{{end}}))
+	})
+
+	t.Run("nil pointers", func(t *testing.T) {
+		requireGobRoundTrip(t, T{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}*int{{end}}]{})
+	})
+
+	t.Run("nested tuple pointers", func(t *testing.T) {
+		requireGobRoundTrip(t, New{{.Len}}({{range .Indexes}}{{if eq . 1}}(*T2[string, *int])(nil){{else}}&T2[string, *int]{V1: {{. | quote}}, V2: ptr({{.}})}{{end}},{{end}}))
+	})
+
+	t.Run("interface values", func(t *testing.T) {
+		requireGobRoundTrip(t, T{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}any{{end}}]{ {{- range .Indexes}}V{{.}}: {{if eq . 1}}nil{{else if eq (sub . 1) 1}}{{. | quote}}{{else}}{{.}}{{end}},{{end -}} })
+	})
+
+	t.Run("struct field", func(t *testing.T) {
+		type Custom struct {
+			Tup {{$intOverload}}
+		}
+
+		requireGobRoundTrip(t, Custom{Tup: New{{.Len}}({{range .Indexes}}{{.}},{{end}})})
+	})
+}
+
+func TestT{{.Len}}_GobDecode_Invalid(t *testing.T) {
+	encoded, err := {{if eq .Len 1}}New2("1", "2"){{else}}New1("1"){{end}}.GobEncode()
+	require.NoError(t, err)
+
+	var tup {{$stringOverload}}
+	err = tup.GobDecode(encoded)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, {{.Len}}, lengthErr.Expected)
+
+	encoded, err = New{{.Len}}({{range .Indexes}}{{. | quote}},{{end}}).GobEncode()
+	require.NoError(t, err)
+
+	var mismatched {{$intOverload}}
+	require.Error(t, mismatched.GobDecode(encoded))
+	require.Error(t, tup.GobDecode(nil))
+}
+
+func TestT{{.Len}}_MarshalComposite(t *testing.T) {
+	got, err := New{{.Len}}({{range .Indexes}}{{if eq . 1}}"foo bar"{{else}}{{.}}{{end}},{{end}}).MarshalComposite()
+	require.NoError(t, err)
+	require.Equal(t, `("foo bar"{{range .Indexes}}{{if ne . 1}},{{.}}{{end}}{{end}})`, string(got))
+
+	got, err = T{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}*int{{end}}]{}.MarshalComposite()
+	require.NoError(t, err)
+	require.Equal(t, `({{range .Indexes}}{{if ne . 1}},{{end}}{{end}})`, string(got))
+
+	got, err = New{{.Len}}({{range .Indexes}}New2({{. | quote}}, {{.}}),{{end}}).MarshalComposite()
+	require.NoError(t, err)
+	require.Equal(t, `({{range .Indexes}}{{if ne . 1}},{{end}}"({{.}},{{.}})"{{end}})`, string(got))
+}
+
+func TestT{{.Len}}_UnmarshalComposite(t *testing.T) {
+	tests := []struct{
+		name string
+		data string
+		want {{$stringOverload}}
+		wantErr bool
+	}{
+		{
+			name: "unquoted values",
+			data: `({{range .Indexes}}{{if ne . 1}},{{end}}{{.}}{{end}})`,
+			want: New{{.Len}}({{range .Indexes}}{{. | quote}},{{end}}),
+		},
+		{
+			name: "quoted values",
+			data: `({{range .Indexes}}{{if ne . 1}},{{end}}"{{.}}, \\"""{{end}})`,
+			want: New{{.Len}}({{range .Indexes}}{{printf "%d, \\\"" . | quote}},{{end}}),
+		},
+		{
+			name: "empty values",
+			data: `({{range .Indexes}}{{if ne . 1}},{{end}}""{{end}})`,
+			want: {{$stringOverload}}{},
+		},
+		{
+			name: "null values",
+			data: `({{range .Indexes}}{{if ne . 1}},{{end}}{{end}})`,
+			wantErr: true,
+		},
+		{
+			name: "too many fields",
+			data: `({{range .Indexes}}{{.}},{{end}}0)`,
+			wantErr: true,
+		},
+		{
+			name: "missing parentheses",
+			data: `{{range .Indexes}}{{if ne . 1}},{{end}}{{.}}{{end}}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got {{$stringOverload}}
+			err := got.UnmarshalComposite([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT{{.Len}}_MarshalComposite_UnmarshalComposite(t *testing.T) {
+	tup := New{{.Len}}({{range .Indexes}}{{if eq . 1}}(*T2[string, *int])(nil){{else}}&T2[string, *int]{V1: {{printf "(%d, \"%d\\\")" . . | quote}}, V2: ptr({{.}})}{{end}},{{end}})
+
+	marshalled, err := tup.MarshalComposite()
+	require.NoError(t, err)
+
+	var unmarshalled T{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}*T2[string, *int]{{end}}]
+	err = unmarshalled.UnmarshalComposite(marshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT{{.Len}}_MarshalXML(t *testing.T) {
+	got, err := xml.Marshal(New{{.Len}}({{range .Indexes}}{{.}},{{end}}))
+	require.NoError(t, err)
+	require.Equal(t, `<T{{.Len}}>{{range .Indexes}}<v{{.}}>{{.}}</v{{.}}>{{end}}</T{{.Len}}>`, string(got))
+
+	type Custom struct {
+		XMLName xml.Name `xml:"custom"`
+		Tup {{$stringOverload}} `xml:"tup"`
+	}
+
+	got, err = xml.Marshal(Custom{Tup: New{{.Len}}({{range .Indexes}}{{printf "<%d>" . | quote}},{{end}})})
+	require.NoError(t, err)
+	require.Equal(t, `<custom><tup>{{range .Indexes}}<v{{.}}>&lt;{{.}}&gt;</v{{.}}>{{end}}</tup></custom>`, string(got))
+}
+
+func TestT{{.Len}}_UnmarshalXML(t *testing.T) {
+	tests := []struct{
+		name string
+		data string
+		want {{$intOverload}}
+		wantErr bool
+	}{
+		{
+			name: "all elements",
+			data: `<T{{.Len}}>{{range .Indexes}}<v{{.}}>{{.}}</v{{.}}>{{end}}</T{{.Len}}>`,
+			want: New{{.Len}}({{range .Indexes}}{{.}},{{end}}),
+		},
+		{
+			name: "whitespace and comments",
+			data: `<T{{.Len}}>{{range .Indexes}}
+				<!-- v{{.}} --> <v{{.}}> {{.}} </v{{.}}>{{end}}
+			</T{{.Len}}>`,
+			want: New{{.Len}}({{range .Indexes}}{{.}},{{end}}),
+		},
+		{
+			name: "no elements",
+			data: `<T{{.Len}}></T{{.Len}}>`,
+			wantErr: true,
+		},
+		{
+			name: "too many elements",
+			data: `<T{{.Len}}>{{range .Indexes}}<v{{.}}>{{.}}</v{{.}}>{{end}}<v0>0</v0></T{{.Len}}>`,
+			wantErr: true,
+		},
+		{
+			name: "wrong element names",
+			data: `<T{{.Len}}>{{range .Indexes}}<V{{.}}>{{.}}</V{{.}}>{{end}}</T{{.Len}}>`,
+			wantErr: true,
+		},
+		{
+			name: "invalid value",
+			data: `<T{{.Len}}>{{range .Indexes}}<v{{.}}>{{if eq . $len}}x{{else}}{{.}}{{end}}</v{{.}}>{{end}}</T{{.Len}}>`,
+			wantErr: true,
+		},
+		{
+			name: "unterminated",
+			data: `<T{{.Len}}>{{range .Indexes}}<v{{.}}>{{.}}</v{{.}}>{{end}}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got {{$intOverload}}
+			err := xml.Unmarshal([]byte(tt.data), &got)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT{{.Len}}_UnmarshalXML_Errors(t *testing.T) {
+	var tup {{$intOverload}}
+
+	err := xml.Unmarshal([]byte(`<T{{.Len}}>{{range .Indexes}}{{if ne . $len}}<v{{.}}>{{.}}</v{{.}}>{{end}}{{end}}</T{{.Len}}>`), &tup)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.EqualError(t, err, "unmarshalled xml elements count {{sub .Len 1}} must match number of tuple values {{.Len}}")
+
+	err = xml.Unmarshal([]byte(`<T{{.Len}}>{{range .Indexes}}<v{{.}}>{{.}}</v{{.}}>{{end}}<v0>0</v0><v0>0</v0></T{{.Len}}>`), &tup)
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, {{add .Len 2}}, lengthErr.Actual)
+
+	err = xml.Unmarshal([]byte(`<T{{.Len}}>{{range .Indexes}}<v{{.}}>{{if eq . $len}}x{{else}}{{.}}{{end}}</v{{.}}>{{end}}</T{{.Len}}>`), &tup)
+	var elementErr *ElementUnmarshalError
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, {{sub .Len 1}}, elementErr.Index)
+	require.Equal(t, "v{{.Len}}", elementErr.Label)
+}
+
+func TestT{{.Len}}_MarshalXML_UnmarshalXML_NilPointers(t *testing.T) {
+	tup := New{{.Len}}({{range .Indexes}}{{if eq . 1}}(*int)(nil){{else}}ptr({{.}}){{end}},{{end}})
+
+	got, err := xml.Marshal(tup)
+	require.NoError(t, err)
+	require.Equal(t, `<T{{.Len}}>{{range .Indexes}}{{if eq . 1}}<v1 nil="true"></v1>{{else}}<v{{.}}>{{.}}</v{{.}}>{{end}}{{end}}</T{{.Len}}>`, string(got))
+
+	unmarshalled := New{{.Len}}({{range .Indexes}}ptr(0),{{end}})
+	err = xml.Unmarshal(got, &unmarshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT{{.Len}}_MarshalXML_UnmarshalXML_Slices(t *testing.T) {
+	tup := New{{.Len}}({{range .Indexes}}[]int{ {{- .}}, {{inc .}}{{- "}"}},{{end}})
+
+	got, err := xml.Marshal(tup)
+	require.NoError(t, err)
+	require.Equal(t, `<T{{.Len}}>{{range .Indexes}}<v{{.}}><item>{{.}}</item><item>{{inc .}}</item></v{{.}}>{{end}}</T{{.Len}}>`, string(got))
+
+	var unmarshalled T{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}[]int{{end}}]
+	err = xml.Unmarshal(got, &unmarshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT{{.Len}}_MarshalXMLLabeled_UnmarshalXMLLabeled(t *testing.T) {
+	tup := New{{.Len}}({{range .Indexes}}New2({{. | quote}}, ptr({{.}})),{{end}})
+	labels := WithLabels({{range .Indexes}}"item{{.}}",{{end}})
+
+	var buf strings.Builder
+	encoder := xml.NewEncoder(&buf)
+	err := tup.MarshalXMLLabeled(encoder, xml.StartElement{Name: xml.Name{Local: "items"}}, labels)
+	require.NoError(t, err)
+	require.NoError(t, encoder.Flush())
+	require.Equal(t, `<items>{{range .Indexes}}<item{{.}}><v1>{{.}}</v1><v2>{{.}}</v2></item{{.}}>{{end}}</items>`, buf.String())
+
+	decoder := xml.NewDecoder(strings.NewReader(buf.String()))
+	start, err := decoder.Token()
+	require.NoError(t, err)
+
+	var unmarshalled T{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}T2[string, *int]{{end}}]
+	err = unmarshalled.UnmarshalXMLLabeled(decoder, start.(xml.StartElement), labels)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+
+	err = tup.MarshalXMLLabeled(encoder, xml.StartElement{Name: xml.Name{Local: "items"}}, WithLabels({{range .Indexes}}"item{{.}}",{{end}}"extra"))
+	require.Error(t, err)
+}
+
+func TestT{{.Len}}_MarshalBinary(t *testing.T) {
+	tup := New{{.Len}}({{range .Indexes}}uint16({{.}}),{{end}})
+
+	got, err := tup.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, []byte{ {{- range .Indexes}}{{.}}, 0,{{end -}} }, got)
+
+	got, err = tup.AppendBinaryOrder([]byte{0xff}, binary.BigEndian)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0xff, {{- range .Indexes}}0, {{.}},{{end -}} }, got)
+
+	_, err = New{{.Len}}({{range .Indexes}}{{if eq . $len}}[]int{ {{- .}}{{"}"}}{{else}}{{.}}{{end}},{{end}}).MarshalBinary()
+	require.Error(t, err)
+}
+
+func TestT{{.Len}}_MarshalBinary_UnmarshalBinary(t *testing.T) {
+	tup := New{{.Len}}(
+		{{- range .Indexes}}
+		{{- if eq (sub . 1) 0}}
+		int64(-{{.}}),
+		{{- else if eq (sub . 2) 0}}
+		"foo{{.}}",
+		{{- else if eq (sub . 3) 0}}
+		true,
+		{{- else if eq (sub . 4) 0}}
+		[]byte("bar{{.}}"),
+		{{- else if eq (sub . 5) 0}}
+		New2(float32({{.}}.5), "baz"),
+		{{- else}}
+		float64({{.}}.5),
+		{{- end}}
+		{{- end}}
+	)
+
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		marshalled, err := tup.AppendBinaryOrder(nil, order.(binary.AppendByteOrder))
+		require.NoError(t, err)
+
+		var unmarshalled T{{.Len}}[
+			{{- range $i, $index := .Indexes}}
+			{{- if gt $i 0}}, {{end}}
+			{{- if eq (sub $index 1) 0}}int64
+			{{- else if eq (sub $index 2) 0}}string
+			{{- else if eq (sub $index 3) 0}}bool
+			{{- else if eq (sub $index 4) 0}}[]byte
+			{{- else if eq (sub $index 5) 0}}T2[float32, string]
+			{{- else}}float64
+			{{- end}}
+			{{- end -}}
+		]
+		err = unmarshalled.UnmarshalBinaryOrder(marshalled, order)
+		require.NoError(t, err)
+		require.Equal(t, tup, unmarshalled)
+	}
+}
+
+func TestT{{.Len}}_UnmarshalBinary_Invalid(t *testing.T) {
+	marshalled, err := New{{.Len}}({{range .Indexes}}uint16({{.}}),{{end}}).MarshalBinary()
+	require.NoError(t, err)
+
+	var tup T{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}uint16{{end}}]
+	err = tup.UnmarshalBinary(marshalled[:len(marshalled)-1])
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	require.EqualError(t, err, "value at index {{sub .Len 1}} failed to unmarshal: unexpected EOF")
+
+	err = tup.UnmarshalBinary(append(marshalled, 0))
+	require.EqualError(t, err, "binary data has 1 trailing bytes")
+}
+
+func TestT{{.Len}}_BinarySlice(t *testing.T) {
+	tups := []{{$intOverload}}{
+		New{{.Len}}({{range .Indexes}}{{.}},{{end}}),
+		New{{.Len}}({{range .Indexes}}-{{.}},{{end}}),
+	}
+
+	marshalled, err := AppendBinarySlice{{.Len}}(nil, binary.LittleEndian, tups)
+	require.NoError(t, err)
+	require.Len(t, marshalled, 1+2*{{.Len}}*8)
+
+	got, err := UnmarshalBinarySlice{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}int{{end}}](marshalled, binary.LittleEndian, nil)
+	require.NoError(t, err)
+	require.Equal(t, tups, got)
+
+	got, err = UnmarshalBinarySlice{{.Len}}(marshalled, binary.LittleEndian, got)
+	require.NoError(t, err)
+	require.Equal(t, append(tups, tups...), got)
+
+	empty, err := AppendBinarySlice{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}int{{end}}](nil, binary.LittleEndian, nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0}, empty)
+
+	_, err = AppendBinarySlice{{.Len}}(nil, binary.LittleEndian, []T{{.Len}}[{{range $i, $index := .Indexes}}{{if gt $i 0}}, {{end}}[]int{{end}}]{ {{- "{}"}}{{"}"}})
+	require.Error(t, err)
+}
+
+func TestT{{.Len}}_MarshalJSON_MapKey(t *testing.T) {
+	m := map[{{$stringOverload}}]int{
+		New{{.Len}}({{range .Indexes}}{{. | quote}},{{end}}): 1,
+	}
+
+	marshalled, err := json.Marshal(m)
+	require.NoError(t, err)
+	require.Equal(t, `{"{{range .Indexes}}{{if ne . 1}},{{end}}{{.}}{{end}}":1}`, string(marshalled))
+
+	var unmarshalled map[{{$stringOverload}}]int
+	err = json.Unmarshal(marshalled, &unmarshalled)
+	require.NoError(t, err)
+	require.Equal(t, m, unmarshalled)
+}
@@ -0,0 +1,87 @@
+package tuple
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// scanRow scans the current row of rows into the values pointed by ptrs in the same order.
+// The number of columns of rows must match the number of values.
+func scanRow(rows *sql.Rows, ptrs []any) error {
+	columns, err := rows.Columns()
+	if err != nil {
+		return fmt.Errorf("unable to get row columns for tuple: %w", err)
+	}
+
+	if len(columns) != len(ptrs) {
+		return &LengthMismatchError{Source: "row columns", Expected: len(ptrs), Actual: len(columns), count: true}
+	}
+
+	return rows.Scan(ptrs...)
+}
+
+// collectRows scans all the rows using scan and closes them.
+func collectRows[T any](rows *sql.Rows, scan func(rows *sql.Rows) (T, error)) ([]T, error) {
+	defer rows.Close()
+
+	var result []T
+	for rows.Next() {
+		value, err := scan(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, value)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// JSONColumn holds a value stored in a database column as JSON, such as a tuple stored as a JSON array.
+// JSONColumn implements the driver.Valuer and sql.Scanner interfaces.
+type JSONColumn[T any] struct {
+	V T
+}
+
+// NewJSONColumn returns a JSONColumn holding the given value.
+func NewJSONColumn[T any](v T) JSONColumn[T] {
+	return JSONColumn[T]{V: v}
+}
+
+// Value marshals the value into a JSON string, implementing the driver.Valuer interface.
+func (c JSONColumn[T]) Value() (driver.Value, error) {
+	marshalled, err := json.Marshal(c.V)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal json column value: %w", err)
+	}
+
+	return string(marshalled), nil
+}
+
+// Scan unmarshals the value from a JSON string or byte slice, implementing the sql.Scanner interface.
+// Scanning a NULL column returns an error.
+func (c *JSONColumn[T]) Scan(src any) error {
+	var data []byte
+	switch src := src.(type) {
+	case []byte:
+		data = src
+	case string:
+		data = []byte(src)
+	case nil:
+		return fmt.Errorf("unable to scan NULL into json column of type %s", typeName[T]())
+	default:
+		return fmt.Errorf("unable to scan value of type %T into json column of type %s", src, typeName[T]())
+	}
+
+	if err := json.Unmarshal(data, &c.V); err != nil {
+		return fmt.Errorf("unable to unmarshal json column value: %w", err)
+	}
+
+	return nil
+}
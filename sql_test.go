@@ -0,0 +1,245 @@
+package tuple
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDriverName is the name the fake in-memory database driver is registered with.
+const fakeDriverName = "tuple-fake"
+
+func init() {
+	sql.Register(fakeDriverName, fakeDriver{})
+}
+
+// fakeResult holds the columns and rows returned by a fake query.
+type fakeResult struct {
+	columns []string
+	rows    [][]driver.Value
+	err     error
+}
+
+// fakeQueries holds the results of fake queries by their query strings, and the arguments of the last executed fake queries.
+var fakeQueries sync.Map
+
+// fakeDriver is an in-memory database driver returning results registered using fakeQuery,
+// and recording the arguments of executed statements.
+type fakeDriver struct{}
+
+type fakeConn struct{}
+
+type fakeStmt struct {
+	query string
+}
+
+type fakeRows struct {
+	result fakeResult
+	index  int
+}
+
+func (fakeDriver) Open(string) (driver.Conn, error) {
+	return fakeConn{}, nil
+}
+
+func (fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return fakeStmt{query: query}, nil
+}
+
+func (fakeConn) Close() error {
+	return nil
+}
+
+func (fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+func (fakeStmt) Close() error {
+	return nil
+}
+
+func (fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	fakeQueries.Store(s.query, args)
+	return driver.RowsAffected(1), nil
+}
+
+func (s fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	result, ok := fakeQueries.Load(s.query)
+	if !ok {
+		return nil, errors.New("unknown query")
+	}
+
+	return &fakeRows{result: result.(fakeResult)}, nil
+}
+
+func (r *fakeRows) Columns() []string {
+	return r.result.columns
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.index >= len(r.result.rows) {
+		if r.result.err != nil {
+			return r.result.err
+		}
+		return io.EOF
+	}
+
+	copy(dest, r.result.rows[r.index])
+	r.index++
+	return nil
+}
+
+// fakeQuery returns the rows of a fake query returning the given result.
+func fakeQuery(t *testing.T, result fakeResult) *sql.Rows {
+	t.Helper()
+
+	db, err := sql.Open(fakeDriverName, "")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	fakeQueries.Store(t.Name(), result)
+	rows, err := db.Query(t.Name())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, rows.Close())
+	})
+
+	return rows
+}
+
+// fakeExec executes a fake statement with the given arguments, and returns the arguments as received by the driver.
+func fakeExec(t *testing.T, args ...any) []driver.Value {
+	t.Helper()
+
+	db, err := sql.Open(fakeDriverName, "")
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec(t.Name(), args...)
+	require.NoError(t, err)
+
+	received, ok := fakeQueries.Load(t.Name())
+	require.True(t, ok)
+	return received.([]driver.Value)
+}
+
+func Test_scanRow(t *testing.T) {
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{"id", "name", "created_at"},
+		rows:    [][]driver.Value{{int64(1), "foo", createdAt}},
+	})
+
+	require.True(t, rows.Next())
+
+	var id int
+	var name string
+	var created time.Time
+	require.NoError(t, scanRow(rows, []any{&id, &name, &created}))
+	require.Equal(t, 1, id)
+	require.Equal(t, "foo", name)
+	require.Equal(t, createdAt, created)
+
+	err := scanRow(rows, []any{&id, &name})
+	require.EqualError(t, err, "row columns count 3 must match number of tuple values 2")
+}
+
+func Test_collectRows(t *testing.T) {
+	scan := func(rows *sql.Rows) (int, error) {
+		var v int
+		err := rows.Scan(&v)
+		return v, err
+	}
+
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{"v"},
+		rows:    [][]driver.Value{{int64(1)}, {int64(2)}},
+	})
+	got, err := collectRows(rows, scan)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2}, got)
+
+	t.Run("rows error", func(t *testing.T) {
+		rows := fakeQuery(t, fakeResult{
+			columns: []string{"v"},
+			rows:    [][]driver.Value{{int64(1)}},
+			err:     errors.New("connection lost"),
+		})
+		_, err := collectRows(rows, scan)
+		require.EqualError(t, err, "connection lost")
+	})
+
+	t.Run("scan error", func(t *testing.T) {
+		rows := fakeQuery(t, fakeResult{
+			columns: []string{"v"},
+			rows:    [][]driver.Value{{"foo"}},
+		})
+		_, err := collectRows(rows, scan)
+		require.Error(t, err)
+	})
+}
+
+func TestJSONColumn_Value(t *testing.T) {
+	args := fakeExec(t, NewJSONColumn(New2(1, "foo")))
+	require.Equal(t, []driver.Value{`[1,"foo"]`}, args)
+
+	_, err := NewJSONColumn(make(chan int)).Value()
+	require.Error(t, err)
+}
+
+func TestJSONColumn_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     any
+		want    T2[int, string]
+		wantErr bool
+	}{
+		{name: "string", src: `[1,"foo"]`, want: New2(1, "foo")},
+		{name: "bytes", src: []byte(`[1,"foo"]`), want: New2(1, "foo")},
+		{name: "null", src: nil, wantErr: true},
+		{name: "unsupported type", src: int64(1), wantErr: true},
+		{name: "invalid json", src: `[1,`, wantErr: true},
+		{name: "length mismatch", src: `[1]`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var column JSONColumn[T2[int, string]]
+			err := column.Scan(tt.src)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, column.V)
+		})
+	}
+}
+
+func TestJSONColumn_ScanRow(t *testing.T) {
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{"id", "tags"},
+		rows:    [][]driver.Value{{int64(1), []byte(`["foo",2]`)}},
+	})
+
+	got, err := CollectRows2[int, JSONColumn[T2[string, int]]](rows)
+	require.NoError(t, err)
+	require.Equal(t, []T2[int, JSONColumn[T2[string, int]]]{New2(1, NewJSONColumn(New2("foo", 2)))}, got)
+}
@@ -0,0 +1,142 @@
+package tuple
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const (
+	// textSeparator separates the tuple values in the text representation of tuples.
+	textSeparator = ','
+	// textEscape escapes separator and escape characters within the tuple values in the text representation of tuples.
+	textEscape = '\\'
+)
+
+// joinText joins the given values into a single text, separated by textSeparator.
+// Separator and escape characters within the values are escaped by textEscape.
+func joinText(values []string) string {
+	var b strings.Builder
+	for i, value := range values {
+		if i > 0 {
+			b.WriteByte(textSeparator)
+		}
+
+		for j := 0; j < len(value); j++ {
+			if value[j] == textSeparator || value[j] == textEscape {
+				b.WriteByte(textEscape)
+			}
+			b.WriteByte(value[j])
+		}
+	}
+
+	return b.String()
+}
+
+// splitText splits a text created by joinText back into its unescaped values.
+// The returned slice always holds at least one value.
+func splitText(text string) ([]string, error) {
+	var values []string
+	var b strings.Builder
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		case textEscape:
+			if i+1 >= len(text) {
+				return nil, fmt.Errorf("escape character at index %d is not followed by an escaped character", i)
+			}
+			i++
+			b.WriteByte(text[i])
+		case textSeparator:
+			values = append(values, b.String())
+			b.Reset()
+		default:
+			b.WriteByte(text[i])
+		}
+	}
+
+	return append(values, b.String()), nil
+}
+
+// marshalTextValue returns the text representation of a single tuple value.
+// Values implementing encoding.TextMarshaler are marshalled using their MarshalText method.
+// Otherwise, the value must be of a boolean, numeric or string kind.
+func marshalTextValue(v any) (string, error) {
+	if marshaler, ok := v.(encoding.TextMarshaler); ok {
+		text, err := marshaler.MarshalText()
+		if err != nil {
+			return "", err
+		}
+		return string(text), nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'g', -1, rv.Type().Bits()), nil
+	case reflect.Complex64, reflect.Complex128:
+		return strconv.FormatComplex(rv.Complex(), 'g', -1, rv.Type().Bits()), nil
+	case reflect.String:
+		return rv.String(), nil
+	case reflect.Invalid:
+		return "", fmt.Errorf("unable to marshal nil value to text")
+	default:
+		return "", fmt.Errorf("unable to marshal value of type %s to text", rv.Type())
+	}
+}
+
+// unmarshalTextValue unmarshals a single tuple value from its text representation into the value pointed by ptr.
+// Values implementing encoding.TextUnmarshaler are unmarshalled using their UnmarshalText method.
+// Otherwise, the value must be of a boolean, numeric or string kind.
+func unmarshalTextValue(text string, ptr any) error {
+	if unmarshaler, ok := ptr.(encoding.TextUnmarshaler); ok {
+		return unmarshaler.UnmarshalText([]byte(text))
+	}
+
+	rv := reflect.ValueOf(ptr).Elem()
+	switch rv.Kind() {
+	case reflect.Bool:
+		v, err := strconv.ParseBool(text)
+		if err != nil {
+			return err
+		}
+		rv.SetBool(v)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, err := strconv.ParseInt(text, 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetInt(v)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v, err := strconv.ParseUint(text, 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetUint(v)
+	case reflect.Float32, reflect.Float64:
+		v, err := strconv.ParseFloat(text, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetFloat(v)
+	case reflect.Complex64, reflect.Complex128:
+		v, err := strconv.ParseComplex(text, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetComplex(v)
+	case reflect.String:
+		rv.SetString(text)
+	default:
+		return fmt.Errorf("unable to unmarshal text to value of type %s", rv.Type())
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package tuple
+
+import (
+	"net/netip"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_joinText_splitText(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		want   string
+	}{
+		{name: "single value", values: []string{"foo"}, want: `foo`},
+		{name: "single empty value", values: []string{""}, want: ``},
+		{name: "multiple values", values: []string{"foo", "bar"}, want: `foo,bar`},
+		{name: "empty values", values: []string{"", ""}, want: `,`},
+		{name: "escaped separator", values: []string{"a,b", "c"}, want: `a\,b,c`},
+		{name: "escaped escape", values: []string{`a\`, `\b`}, want: `a\\,\\b`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text := joinText(tt.values)
+			require.Equal(t, tt.want, text)
+
+			values, err := splitText(text)
+			require.NoError(t, err)
+			require.Equal(t, tt.values, values)
+		})
+	}
+}
+
+func Test_splitText_DanglingEscape(t *testing.T) {
+	_, err := splitText(`a\`)
+	require.Error(t, err)
+}
+
+func Test_marshalTextValue_unmarshalTextValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		ptr   any
+		want  string
+	}{
+		{name: "bool", value: true, ptr: new(bool), want: "true"},
+		{name: "int", value: -5, ptr: new(int), want: "-5"},
+		{name: "int8", value: int8(-5), ptr: new(int8), want: "-5"},
+		{name: "uint", value: uint(5), ptr: new(uint), want: "5"},
+		{name: "float32", value: float32(1.5), ptr: new(float32), want: "1.5"},
+		{name: "float64", value: 1.25, ptr: new(float64), want: "1.25"},
+		{name: "complex", value: 1 + 2i, ptr: new(complex128), want: "(1+2i)"},
+		{name: "string", value: "foo", ptr: new(string), want: "foo"},
+		{name: "named string", value: approximationHelper("foo"), ptr: new(approximationHelper), want: "foo"},
+		{name: "text marshaler", value: netip.MustParseAddr("127.0.0.1"), ptr: new(netip.Addr), want: "127.0.0.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, err := marshalTextValue(tt.value)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, text)
+
+			err = unmarshalTextValue(text, tt.ptr)
+			require.NoError(t, err)
+			require.Equal(t, tt.value, reflect.ValueOf(tt.ptr).Elem().Interface())
+		})
+	}
+}
+
+func Test_marshalTextValue_Unsupported(t *testing.T) {
+	_, err := marshalTextValue([]int{1})
+	require.Error(t, err)
+
+	_, err = marshalTextValue(nil)
+	require.Error(t, err)
+}
+
+func Test_unmarshalTextValue_Invalid(t *testing.T) {
+	require.Error(t, unmarshalTextValue("foo", new(int)))
+	require.Error(t, unmarshalTextValue("300", new(int8)))
+	require.Error(t, unmarshalTextValue("-1", new(uint)))
+	require.Error(t, unmarshalTextValue("foo", new(bool)))
+	require.Error(t, unmarshalTextValue("foo", new(float64)))
+	require.Error(t, unmarshalTextValue("foo", new([]int)))
+}
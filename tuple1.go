@@ -1,10 +1,14 @@
 package tuple
 
 import (
+	"cmp"
+	"database/sql"
+	"encoding/binary"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
-
-	"golang.org/x/exp/constraints"
+	"hash/maphash"
+	"io"
 )
 
 // T1 is a tuple type holding 1 generic values.
@@ -57,7 +61,7 @@ func New1[Ty1 any](v1 Ty1) T1[Ty1] {
 func FromArray1[Ty1 any](arr [1]any) (T1[Ty1], error) {
 	v1, ok := arr[0].(Ty1)
 	if !ok {
-		return T1[Ty1]{}, fmt.Errorf("value at array index 0 expected to have type %s but has type %T", typeName[Ty1](), arr[0])
+		return T1[Ty1]{}, newElementTypeError[Ty1]("array", 0, arr[0])
 	}
 
 	return New1(v1), nil
@@ -73,12 +77,12 @@ func FromArray1X[Ty1 any](arr [1]any) T1[Ty1] {
 // If the length of the slice doesn't match, or any of the values can not be converted to the generic type, an error is returned.
 func FromSlice1[Ty1 any](values []any) (T1[Ty1], error) {
 	if len(values) != 1 {
-		return T1[Ty1]{}, fmt.Errorf("slice length %d must match number of tuple values 1", len(values))
+		return T1[Ty1]{}, &LengthMismatchError{Source: "slice", Expected: 1, Actual: len(values)}
 	}
 
 	v1, ok := values[0].(Ty1)
 	if !ok {
-		return T1[Ty1]{}, fmt.Errorf("value at slice index 0 expected to have type %s but has type %T", typeName[Ty1](), values[0])
+		return T1[Ty1]{}, newElementTypeError[Ty1]("slice", 0, values[0])
 	}
 
 	return New1(v1), nil
@@ -88,7 +92,7 @@ func FromSlice1[Ty1 any](values []any) (T1[Ty1], error) {
 // If the length of the slice doesn't match, or any of the values can not be converted to the generic type, the function panics.
 func FromSlice1X[Ty1 any](values []any) T1[Ty1] {
 	if len(values) != 1 {
-		panic(fmt.Errorf("slice length %d must match number of tuple values 1", len(values)))
+		panic(&LengthMismatchError{Source: "slice", Expected: 1, Actual: len(values)})
 	}
 
 	v1 := values[0].(Ty1)
@@ -96,11 +100,257 @@ func FromSlice1X[Ty1 any](values []any) T1[Ty1] {
 	return New1(v1)
 }
 
+// ConvertSlice1 returns a tuple from a slice of length 1, converting the values to the generic types when needed.
+// Unlike FromSlice1, numeric values are converted between numeric types as long as they fit the generic type,
+// json.Number values are parsed into numeric types, strings and byte slices are converted to each other,
+// and strings and byte slices are unmarshalled into types implementing encoding.TextUnmarshaler.
+// This enables creating tuples from slices decoded by encoding/json, which hold float64 values for numbers.
+// If the length of the slice doesn't match, a *LengthMismatchError is returned.
+// If any of the values can not be converted to the generic type, a *ElementTypeError is returned.
+// If the conversion of any of the values fails, for example when it overflows the generic type, a *ElementConversionError is returned.
+func ConvertSlice1[Ty1 any](values []any) (T1[Ty1], error) {
+	if len(values) != 1 {
+		return T1[Ty1]{}, &LengthMismatchError{Source: "slice", Expected: 1, Actual: len(values)}
+	}
+
+	var t T1[Ty1]
+	if err := convertSliceValue(0, values[0], &t.V1); err != nil {
+		return T1[Ty1]{}, err
+	}
+
+	return t, nil
+}
+
+// ScanRow1 scans the current row of rows into a tuple, with each of the columns scanned into the matching tuple value.
+// If the number of columns doesn't match the number of tuple values, a *LengthMismatchError is returned.
+func ScanRow1[Ty1 any](rows *sql.Rows) (T1[Ty1], error) {
+	var t T1[Ty1]
+	if err := scanRow(rows, []any{
+		&t.V1,
+	}); err != nil {
+		return T1[Ty1]{}, err
+	}
+
+	return t, nil
+}
+
+// CollectRows1 scans all the rows into a slice of tuples using ScanRow1, and closes the rows.
+func CollectRows1[Ty1 any](rows *sql.Rows) ([]T1[Ty1], error) {
+	return collectRows(rows, ScanRow1[Ty1])
+}
+
+// ReadCSV1 reads all the CSV records of r into tuples, with each of the fields converted to the matching tuple value.
+// Fields are converted using the UnmarshalText method of values implementing encoding.TextUnmarshaler,
+// and otherwise must be of boolean, numeric or string kinds.
+// If a record fails to convert, a *CSVError holding its line and column numbers is returned.
+// If a record fails to parse, including records with a number of fields that doesn't match, a *csv.ParseError is returned.
+func ReadCSV1[Ty1 any](r io.Reader, opts CSVOptions) ([]T1[Ty1], error) {
+	return readCSV(r, opts, func(t *T1[Ty1]) []any {
+		return []any{
+			&t.V1,
+		}
+	})
+}
+
+// WriteCSV1 writes the tuples into w as CSV records, with each of the tuple values converted to the matching field.
+// Values are converted the same way as MarshalText does.
+// If a tuple fails to convert, a *CSVError holding the line and column numbers of its record is returned.
+func WriteCSV1[Ty1 any](w io.Writer, tuples []T1[Ty1], opts CSVOptions) error {
+	return writeCSV(w, tuples, opts, T1[Ty1].Slice)
+}
+
+// Parse1 returns a tuple from its string representation, as returned by the String method.
+// Supported values are booleans, numbers, quoted strings and nested tuples.
+// If the string fails to parse, a *ParseError holding the offset of the failure is returned.
+func Parse1[Ty1 any](s string) (T1[Ty1], error) {
+	var t T1[Ty1]
+	if err := parseTuple(s, &t); err != nil {
+		return T1[Ty1]{}, err
+	}
+
+	return t, nil
+}
+
+// Map1At1 returns a new tuple with the function f applied to the value at position 1 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map1At1[Ty1, R any](t T1[Ty1], f func(Ty1) R) T1[R] {
+	return T1[R]{
+		V1: f(t.V1),
+	}
+}
+
+// Map1 returns a new tuple with each of the functions applied to the tuple value at the matching position.
+func Map1[Ty1, R1 any](t T1[Ty1], f1 func(Ty1) R1) T1[R1] {
+	return T1[R1]{
+		V1: f1(t.V1),
+	}
+}
+
+// Append1 returns a new tuple holding the tuple values followed by the value v.
+func Append1[Ty1, Ty2 any](t T1[Ty1], v Ty2) T2[Ty1, Ty2] {
+	return T2[Ty1, Ty2]{
+		V1: t.V1,
+		V2: v,
+	}
+}
+
+// Prepend1 returns a new tuple holding the value v followed by the tuple values.
+func Prepend1[Ty1, Ty2 any](t T1[Ty2], v Ty1) T2[Ty1, Ty2] {
+	return T2[Ty1, Ty2]{
+		V1: v,
+		V2: t.V1,
+	}
+}
+
+// Concat1_1 returns a new tuple holding the values of t1 followed by the values of t2.
+func Concat1_1[Ty1, Ty2 any](t1 T1[Ty1], t2 T1[Ty2]) T2[Ty1, Ty2] {
+	return T2[Ty1, Ty2]{
+		V1: t1.V1,
+		V2: t2.V1,
+	}
+}
+
+// Concat1_2 returns a new tuple holding the values of t1 followed by the values of t2.
+func Concat1_2[Ty1, Ty2, Ty3 any](t1 T1[Ty1], t2 T2[Ty2, Ty3]) T3[Ty1, Ty2, Ty3] {
+	return T3[Ty1, Ty2, Ty3]{
+		V1: t1.V1,
+		V2: t2.V1,
+		V3: t2.V2,
+	}
+}
+
+// Concat1_3 returns a new tuple holding the values of t1 followed by the values of t2.
+func Concat1_3[Ty1, Ty2, Ty3, Ty4 any](t1 T1[Ty1], t2 T3[Ty2, Ty3, Ty4]) T4[Ty1, Ty2, Ty3, Ty4] {
+	return T4[Ty1, Ty2, Ty3, Ty4]{
+		V1: t1.V1,
+		V2: t2.V1,
+		V3: t2.V2,
+		V4: t2.V3,
+	}
+}
+
+// Concat1_4 returns a new tuple holding the values of t1 followed by the values of t2.
+func Concat1_4[Ty1, Ty2, Ty3, Ty4, Ty5 any](t1 T1[Ty1], t2 T4[Ty2, Ty3, Ty4, Ty5]) T5[Ty1, Ty2, Ty3, Ty4, Ty5] {
+	return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{
+		V1: t1.V1,
+		V2: t2.V1,
+		V3: t2.V2,
+		V4: t2.V3,
+		V5: t2.V4,
+	}
+}
+
+// Concat1_5 returns a new tuple holding the values of t1 followed by the values of t2.
+func Concat1_5[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](t1 T1[Ty1], t2 T5[Ty2, Ty3, Ty4, Ty5, Ty6]) T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6] {
+	return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{
+		V1: t1.V1,
+		V2: t2.V1,
+		V3: t2.V2,
+		V4: t2.V3,
+		V5: t2.V4,
+		V6: t2.V5,
+	}
+}
+
+// Concat1_6 returns a new tuple holding the values of t1 followed by the values of t2.
+func Concat1_6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](t1 T1[Ty1], t2 T6[Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7] {
+	return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{
+		V1: t1.V1,
+		V2: t2.V1,
+		V3: t2.V2,
+		V4: t2.V3,
+		V5: t2.V4,
+		V6: t2.V5,
+		V7: t2.V6,
+	}
+}
+
+// Concat1_7 returns a new tuple holding the values of t1 followed by the values of t2.
+func Concat1_7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8 any](t1 T1[Ty1], t2 T7[Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8]) T8[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8] {
+	return T8[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8]{
+		V1: t1.V1,
+		V2: t2.V1,
+		V3: t2.V2,
+		V4: t2.V3,
+		V5: t2.V4,
+		V6: t2.V5,
+		V7: t2.V6,
+		V8: t2.V7,
+	}
+}
+
+// Concat1_8 returns a new tuple holding the values of t1 followed by the values of t2.
+func Concat1_8[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](t1 T1[Ty1], t2 T8[Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9] {
+	return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{
+		V1: t1.V1,
+		V2: t2.V1,
+		V3: t2.V2,
+		V4: t2.V3,
+		V5: t2.V4,
+		V6: t2.V5,
+		V7: t2.V6,
+		V8: t2.V7,
+		V9: t2.V8,
+	}
+}
+
+// Apply1 calls the function f with the tuple values as arguments and returns its result.
+func Apply1[Ty1, R any](t T1[Ty1], f func(Ty1) R) R {
+	return f(t.Values())
+}
+
+// Tupled1 returns a function that accepts a tuple and calls f with the tuple values as arguments.
+func Tupled1[Ty1, R any](f func(Ty1) R) func(T1[Ty1]) R {
+	return func(t T1[Ty1]) R {
+		return f(t.Values())
+	}
+}
+
+// Untupled1 returns a function that accepts 1 arguments and calls g with a tuple holding them.
+func Untupled1[Ty1, R any](g func(T1[Ty1]) R) func(Ty1) R {
+	return func(v1 Ty1) R {
+		return g(New1(v1))
+	}
+}
+
+// Zip1 returns a slice of tuples, where each tuple holds the values at the same index of each of the given slices.
+// If the slices have different lengths, the returned slice is truncated to the length of the shortest slice.
+// To require all slices to have the same length, use the Zip1Strict function.
+func Zip1[Ty1 any](s1 []Ty1) []T1[Ty1] {
+	length := minLen(len(s1))
+
+	zipped := make([]T1[Ty1], length)
+	for i := range zipped {
+		zipped[i] = T1[Ty1]{
+			V1: s1[i],
+		}
+	}
+
+	return zipped
+}
+
+// Zip1Strict returns a slice of tuples, where each tuple holds the values at the same index of each of the given slices.
+// If the slices have different lengths, an error is returned.
+// To truncate the result to the length of the shortest slice instead, use the Zip1 function.
+func Zip1Strict[Ty1 any](s1 []Ty1) ([]T1[Ty1], error) {
+	return Zip1(s1), nil
+}
+
+// Unzip1 returns 1 slices, where each slice holds the tuple values at the matching position of each of the given tuples.
+func Unzip1[Ty1 any](ts []T1[Ty1]) []Ty1 {
+	s1 := make([]Ty1, len(ts))
+	for i, t := range ts {
+		s1[i] = t.V1
+	}
+
+	return s1
+}
+
 // Equal1 returns whether the host tuple is equal to the other tuple.
 // All tuple elements of the host and guest parameters must match the "comparable" built-in constraint.
 // To test equality of tuples that hold custom Equalable values, use the Equal1E function.
 // To test equality of tuples that hold custom Comparable values, use the Equal1C function.
-// Otherwise, use Equal or reflect.DeepEqual to test tuples of any types.
+// Otherwise, use the Equal method or the EqualFunc1 function to test tuples of any types.
 func Equal1[Ty1 comparable](host, guest T1[Ty1]) bool {
 	return host.V1 == guest.V1
 }
@@ -109,7 +359,7 @@ func Equal1[Ty1 comparable](host, guest T1[Ty1]) bool {
 // All tuple elements of the host and guest parameters must match the Equalable constraint.
 // To test equality of tuples that hold built-in "comparable" values, use the Equal1 function.
 // To test equality of tuples that hold custom Comparable values, use the Equal1C function.
-// Otherwise, use Equal or reflect.DeepEqual to test tuples of any types.
+// Otherwise, use the Equal method or the EqualFunc1 function to test tuples of any types.
 func Equal1E[Ty1 Equalable[Ty1]](host, guest T1[Ty1]) bool {
 	return host.V1.Equal(guest.V1)
 }
@@ -118,15 +368,28 @@ func Equal1E[Ty1 Equalable[Ty1]](host, guest T1[Ty1]) bool {
 // All tuple elements of the host and guest parameters must match the Comparable constraint.
 // To test equality of tuples that hold built-in "comparable" values, use the Equal1 function.
 // To test equality of tuples that hold custom Equalable values, use the Equal1E function.
-// Otherwise, use Equal or reflect.DeepEqual to test tuples of any types.
+// Otherwise, use the Equal method or the EqualFunc1 function to test tuples of any types.
 func Equal1C[Ty1 Comparable[Ty1]](host, guest T1[Ty1]) bool {
 	return host.V1.CompareTo(guest.V1).EQ()
 }
 
+// EqualFunc1 returns whether the host tuple is equal to the guest tuple, using the given equality function for each element.
+// EqualFunc1 short-circuits once one of the elements is not equal, and the rest of the equality functions are not called.
+func EqualFunc1[Ty1 any](host, guest T1[Ty1], eq1 func(a, b Ty1) bool) bool {
+	return eq1(host.V1, guest.V1)
+}
+
+// Equal returns whether the tuple is equal to the guest tuple.
+// Each element is tested using the Equalable constraint if it is implemented, then the Comparable constraint,
+// then the "==" operator if the element is comparable, and otherwise using reflect.DeepEqual.
+func (t T1[Ty1]) Equal(guest T1[Ty1]) bool {
+	return equalValues(t.V1, guest.V1)
+}
+
 // Compare1 returns whether the host tuple is semantically less than, equal to, or greater than the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the Compare1C function.
-func Compare1[Ty1 constraints.Ordered](host, guest T1[Ty1]) OrderedComparisonResult {
+func Compare1[Ty1 cmp.Ordered](host, guest T1[Ty1]) OrderedComparisonResult {
 	return multiCompare(
 		func() OrderedComparisonResult { return compareOrdered(host.V1, guest.V1) },
 	)
@@ -141,10 +404,52 @@ func Compare1C[Ty1 Comparable[Ty1]](host, guest T1[Ty1]) OrderedComparisonResult
 	)
 }
 
+// CmpFunc1 returns a comparison function of tuples, which returns a negative number when a < b, a positive number when a > b,
+// and zero when a == b. The returned function can be used with slices.SortFunc, slices.BinarySearchFunc and similar functions.
+// All tuple elements must match the "Ordered" constraint.
+// To compare tuples that hold custom comparable values, use the CmpFunc1C function.
+func CmpFunc1[Ty1 cmp.Ordered]() func(a, b T1[Ty1]) int {
+	return func(a, b T1[Ty1]) int {
+		return int(Compare1(a, b))
+	}
+}
+
+// CmpFunc1C returns a comparison function of tuples, which returns a negative number when a < b, a positive number when a > b,
+// and zero when a == b. The returned function can be used with slices.SortFunc, slices.BinarySearchFunc and similar functions.
+// All tuple elements must match the Comparable constraint.
+// To compare tuples that hold built-in "Ordered" values, use the CmpFunc1 function.
+func CmpFunc1C[Ty1 Comparable[Ty1]]() func(a, b T1[Ty1]) int {
+	return func(a, b T1[Ty1]) int {
+		return int(Compare1C(a, b))
+	}
+}
+
+// Comparator1 returns a comparison function of tuples, which compares the tuple elements by order using the given
+// element comparison functions. Each element comparison function must return a negative number when a < b, a positive number
+// when a > b, and zero when a == b, such as cmp.Compare, CompareOrdered and CompareComparable.
+// The returned function short-circuits once one of the elements is not equal, and the rest of the element comparison functions
+// are not called.
+func Comparator1[Ty1 any](c1 func(a, b Ty1) int) func(a, b T1[Ty1]) int {
+	return func(a, b T1[Ty1]) int {
+		return int(multiCompare(
+			func() OrderedComparisonResult { return OrderedComparisonResult(c1(a.V1, b.V1)) },
+		))
+	}
+}
+
+// OrderBy1 returns a comparison function of tuples, which compares the tuple elements by order according to the
+// sort order given for each element, such as OrderBy1(Asc, Desc, ...).
+// All tuple elements must match the "Ordered" constraint.
+// To order tuples that hold other element types, such as pointers, use the Comparator1 function with
+// the OrderFunc and OrderPtrFunc element comparison functions.
+func OrderBy1[Ty1 cmp.Ordered](o1 SortOrder) func(a, b T1[Ty1]) int {
+	return Comparator1(OrderedBy[Ty1](o1))
+}
+
 // LessThan1 returns whether the host tuple is semantically less than the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the LessThan1C function.
-func LessThan1[Ty1 constraints.Ordered](host, guest T1[Ty1]) bool {
+func LessThan1[Ty1 cmp.Ordered](host, guest T1[Ty1]) bool {
 	return Compare1(host, guest).LT()
 }
 
@@ -158,7 +463,7 @@ func LessThan1C[Ty1 Comparable[Ty1]](host, guest T1[Ty1]) bool {
 // LessOrEqual1 returns whether the host tuple is semantically less than or equal to the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the LessOrEqual1C function.
-func LessOrEqual1[Ty1 constraints.Ordered](host, guest T1[Ty1]) bool {
+func LessOrEqual1[Ty1 cmp.Ordered](host, guest T1[Ty1]) bool {
 	return Compare1(host, guest).LE()
 }
 
@@ -172,7 +477,7 @@ func LessOrEqual1C[Ty1 Comparable[Ty1]](host, guest T1[Ty1]) bool {
 // GreaterThan1 returns whether the host tuple is semantically greater than the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the GreaterThan1C function.
-func GreaterThan1[Ty1 constraints.Ordered](host, guest T1[Ty1]) bool {
+func GreaterThan1[Ty1 cmp.Ordered](host, guest T1[Ty1]) bool {
 	return Compare1(host, guest).GT()
 }
 
@@ -186,7 +491,7 @@ func GreaterThan1C[Ty1 Comparable[Ty1]](host, guest T1[Ty1]) bool {
 // GreaterOrEqual1 returns whether the host tuple is semantically greater than or equal to the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the GreaterOrEqual1C function.
-func GreaterOrEqual1[Ty1 constraints.Ordered](host, guest T1[Ty1]) bool {
+func GreaterOrEqual1[Ty1 cmp.Ordered](host, guest T1[Ty1]) bool {
 	return Compare1(host, guest).GE()
 }
 
@@ -197,6 +502,29 @@ func GreaterOrEqual1C[Ty1 Comparable[Ty1]](host, guest T1[Ty1]) bool {
 	return Compare1C(host, guest).GE()
 }
 
+// Hash returns a hash of the tuple values using the given seed.
+// Equal tuples return equal hashes for the same seed.
+// Elements supported by the HashValue function are hashed by their values. Other elements, such as slices, maps,
+// functions, nil pointers and nil interfaces, are hashed by their type only, so tuples differing only in these elements collide.
+// To hash tuples holding other element types by their values, use the HashFunc1 function.
+func (t T1[Ty1]) Hash(seed maphash.Seed) uint64 {
+	var h maphash.Hash
+	h.SetSeed(seed)
+	writeHashElement(&h, seed, t.V1)
+	return h.Sum64()
+}
+
+// HashFunc1 returns a hash function of tuples, which hashes the tuple values using the given element hash functions.
+// Each element hash function must return equal hashes for equal values and the same seed, such as HashValue.
+func HashFunc1[Ty1 any](h1 func(seed maphash.Seed, v Ty1) uint64) func(seed maphash.Seed, t T1[Ty1]) uint64 {
+	return func(seed maphash.Seed, t T1[Ty1]) uint64 {
+		var h maphash.Hash
+		h.SetSeed(seed)
+		writeHashUint64(&h, h1(seed, t.V1))
+		return h.Sum64()
+	}
+}
+
 // MarshalJSON marshals the tuple into a JSON array.
 func (t T1[Ty1]) MarshalJSON() ([]byte, error) {
 	return json.Marshal(t.Slice())
@@ -204,17 +532,181 @@ func (t T1[Ty1]) MarshalJSON() ([]byte, error) {
 
 // MarshalJSON unmarshals the tuple from a JSON array.
 func (t *T1[Ty1]) UnmarshalJSON(data []byte) error {
-	// Working with json.RawMessage instead of any enables custom struct support.
-	var slice []json.RawMessage
-	if err := json.Unmarshal(data, &slice); err != nil {
-		return fmt.Errorf("unable to unmarshal json array for tuple: %w", err)
+	return t.UnmarshalJSONWith(data, JSONDecodeOptions{})
+}
+
+// UnmarshalJSONWith unmarshals the tuple from a JSON array using the given decode options.
+// The options are applied to nested tuples as well, including tuples within slices, arrays, maps, pointers and struct fields.
+func (t *T1[Ty1]) UnmarshalJSONWith(data []byte, opts JSONDecodeOptions) error {
+	return unmarshalJSONArray(data, opts, []any{
+		&t.V1,
+	})
+}
+
+// MarshalJSONLabeled marshals the tuple into a JSON object, where each of the tuple values is keyed by its matching label.
+// The number of labels must match the number of tuple values.
+func (t T1[Ty1]) MarshalJSONLabeled(labels Labels) ([]byte, error) {
+	return marshalJSONLabeled(labels, t.Slice())
+}
+
+// UnmarshalJSONLabeled unmarshals the tuple from a JSON object, where each of the tuple values is keyed by its matching label.
+// The number of labels must match the number of tuple values.
+// Unless the labels are strict, missing keys leave the matching tuple values unchanged, and unknown keys are ignored.
+func (t *T1[Ty1]) UnmarshalJSONLabeled(data []byte, labels Labels) error {
+	return unmarshalJSONLabeled(data, labels, []any{
+		&t.V1,
+	})
+}
+
+// MarshalText marshals the tuple into a text of comma separated values.
+// Commas and backslashes within the values are escaped by a backslash.
+// Values implementing encoding.TextMarshaler are marshalled using their MarshalText method.
+// Otherwise, the values must be of boolean, numeric or string kinds.
+func (t T1[Ty1]) MarshalText() ([]byte, error) {
+	var values [1]string
+	var err error
+	if values[0], err = marshalTextValue(t.V1); err != nil {
+		return nil, fmt.Errorf("value at index 0 failed to marshal: %w", err)
 	}
 
-	if len(slice) != 1 {
-		return fmt.Errorf("unmarshalled json array length %d must match number of tuple values 1", len(slice))
+	return []byte(joinText(values[:])), nil
+}
+
+// UnmarshalText unmarshals the tuple from a text of comma separated values, as created by MarshalText.
+func (t *T1[Ty1]) UnmarshalText(data []byte) error {
+	values, err := splitText(string(data))
+	if err != nil {
+		return fmt.Errorf("unable to unmarshal text for tuple: %w", err)
+	}
+
+	if len(values) != 1 {
+		return &LengthMismatchError{Source: "unmarshalled text values", Expected: 1, Actual: len(values), count: true}
 	}
-	if err := json.Unmarshal(slice[0], &t.V1); err != nil {
-		return fmt.Errorf("value %q at slice index 0 failed to unmarshal: %w", string(slice[0]), err)
+	if err := unmarshalTextValue(values[0], &t.V1); err != nil {
+		return &ElementUnmarshalError{Index: 0, Value: values[0], Err: err, location: locationIndex}
 	}
 	return nil
 }
+
+// GobEncode encodes the tuple for encoding/gob, implementing the gob.GobEncoder interface.
+// Nil pointer and interface values are preserved as nil, and pointers to zero values are preserved as non-nil.
+// See the package documentation for the wire format.
+func (t T1[Ty1]) GobEncode() ([]byte, error) {
+	return gobEncodeValues([]any{
+		&t.V1,
+	})
+}
+
+// GobDecode decodes the tuple from encoding/gob, as encoded by GobEncode, implementing the gob.GobDecoder interface.
+// If the number of encoded values doesn't match, a *LengthMismatchError is returned.
+func (t *T1[Ty1]) GobDecode(data []byte) error {
+	return gobDecodeValues(data, []any{
+		&t.V1,
+	})
+}
+
+// MarshalComposite marshals the tuple into a PostgreSQL composite literal, such as (1,"foo bar",).
+// Values are quoted and escaped the same way PostgreSQL does, and nil values and pointers are marshalled as NULL.
+// Nested tuples are marshalled as nested composite values, and other values are marshalled the same way as MarshalText does.
+func (t T1[Ty1]) MarshalComposite() ([]byte, error) {
+	return marshalComposite(t.Slice())
+}
+
+// UnmarshalComposite unmarshals the tuple from a PostgreSQL composite literal, as created by MarshalComposite or by PostgreSQL.
+// NULL values can only be unmarshalled into pointers, interfaces, slices and maps, which are set to nil.
+// If the number of composite fields doesn't match, a *LengthMismatchError is returned.
+func (t *T1[Ty1]) UnmarshalComposite(data []byte) error {
+	return unmarshalComposite(data, []any{
+		&t.V1,
+	})
+}
+
+// MarshalXML marshals the tuple into a sequence of xml child elements named v1 to v1, implementing the xml.Marshaler interface.
+// Nil values are marshalled as empty child elements with a nil="true" attribute,
+// and slice values are marshalled as child elements holding an item element for each of the slice items.
+// Use MarshalXMLLabeled to name the child elements differently.
+func (t T1[Ty1]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return t.MarshalXMLLabeled(e, start, indexLabels(1))
+}
+
+// UnmarshalXML unmarshals the tuple from a sequence of xml child elements named v1 to v1, implementing the xml.Unmarshaler interface.
+// The number of child elements must match the number of tuple values, the same way as UnmarshalJSON requires.
+func (t *T1[Ty1]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return t.UnmarshalXMLLabeled(d, start, indexLabels(1))
+}
+
+// MarshalXMLLabeled marshals the tuple into a sequence of xml child elements, named by the labels matching each of the tuple values.
+// The number of labels must match the number of tuple values.
+func (t T1[Ty1]) MarshalXMLLabeled(e *xml.Encoder, start xml.StartElement, labels Labels) error {
+	return marshalXML(e, start, labels, t.Slice())
+}
+
+// UnmarshalXMLLabeled unmarshals the tuple from a sequence of xml child elements, named by the labels matching each of the tuple values.
+// The child elements must be named by the labels in the same order, and their number must match the number of tuple values.
+// If the number of child elements doesn't match, a *LengthMismatchError is returned.
+func (t *T1[Ty1]) UnmarshalXMLLabeled(d *xml.Decoder, start xml.StartElement, labels Labels) error {
+	return unmarshalXML(d, start, labels, []any{
+		&t.V1,
+	})
+}
+
+// MarshalBinary marshals the tuple into its binary encoding using little-endian byte order,
+// implementing the encoding.BinaryMarshaler interface.
+// The tuple values must be of boolean, fixed-size numeric, string or byte slice kinds, or nested tuples.
+// See the package documentation for the binary encoding.
+func (t T1[Ty1]) MarshalBinary() ([]byte, error) {
+	return t.AppendBinary(nil)
+}
+
+// AppendBinary appends the binary encoding of the tuple to b using little-endian byte order,
+// the same way as MarshalBinary does.
+func (t T1[Ty1]) AppendBinary(b []byte) ([]byte, error) {
+	return t.AppendBinaryOrder(b, binary.LittleEndian)
+}
+
+// AppendBinaryOrder appends the binary encoding of the tuple to b using the given byte order.
+// Values of common types are encoded without allocations, other than the ones needed to grow b.
+func (t T1[Ty1]) AppendBinaryOrder(b []byte, order binary.AppendByteOrder) ([]byte, error) {
+	var err error
+	if b, err = appendBinaryValue(b, order, t.V1); err != nil {
+		return nil, fmt.Errorf("value at index 0 failed to marshal: %w", err)
+	}
+
+	return b, nil
+}
+
+// UnmarshalBinary unmarshals the tuple from its binary encoding using little-endian byte order,
+// implementing the encoding.BinaryUnmarshaler interface.
+func (t *T1[Ty1]) UnmarshalBinary(data []byte) error {
+	return t.UnmarshalBinaryOrder(data, binary.LittleEndian)
+}
+
+// UnmarshalBinaryOrder unmarshals the tuple from its binary encoding using the given byte order.
+// The data must hold exactly the binary encoding of the tuple.
+func (t *T1[Ty1]) UnmarshalBinaryOrder(data []byte, order binary.ByteOrder) error {
+	return unmarshalBinary(data, order, t)
+}
+
+// decodeBinary decodes the tuple from the beginning of data using the given byte order, and returns the rest of the data.
+func (t *T1[Ty1]) decodeBinary(data []byte, order binary.ByteOrder) ([]byte, error) {
+	var err error
+	if data, err = decodeBinaryValue(data, order, &t.V1); err != nil {
+		return nil, fmt.Errorf("value at index 0 failed to unmarshal: %w", err)
+	}
+
+	return data, nil
+}
+
+// AppendBinarySlice1 appends the binary encoding of a slice of tuples to b using the given byte order.
+// The slice is encoded as its length followed by the binary encoding of each of the tuples.
+func AppendBinarySlice1[Ty1 any](b []byte, order binary.AppendByteOrder, tuples []T1[Ty1]) ([]byte, error) {
+	return appendBinarySlice(b, order, tuples)
+}
+
+// UnmarshalBinarySlice1 unmarshals a slice of tuples from its binary encoding using the given byte order,
+// as encoded by AppendBinarySlice1, and appends the tuples to dst.
+// Tuples are decoded in place, so decoding into a dst with enough capacity, such as a previously decoded slice truncated to zero length,
+// doesn't allocate unless the tuples hold strings, or byte slices longer than the ones previously held in that capacity.
+func UnmarshalBinarySlice1[Ty1 any](data []byte, order binary.ByteOrder, dst []T1[Ty1]) ([]T1[Ty1], error) {
+	return unmarshalBinarySlice(data, order, dst)
+}
@@ -0,0 +1,40 @@
+//go:build go1.23
+
+package tuple
+
+import (
+	"io"
+	"iter"
+)
+
+// All returns an iterator over the index and value of each of the tuple values.
+func (t T1[Ty1]) All() iter.Seq2[int, any] {
+	return func(yield func(int, any) bool) {
+		if !yield(0, t.V1) {
+			return
+		}
+	}
+}
+
+// ZipSeq1 returns an iterator over tuples, where each tuple holds the next value of each of the given iterators.
+// The returned iterator stops once any of the given iterators is exhausted.
+func ZipSeq1[Ty1 any](s1 iter.Seq[Ty1]) iter.Seq[T1[Ty1]] {
+	return func(yield func(T1[Ty1]) bool) {
+		for v1 := range s1 {
+			if !yield(New1(v1)) {
+				return
+			}
+		}
+	}
+}
+
+// ReadCSVSeq1 returns an iterator over the CSV records of r converted into tuples, the same way as ReadCSV1 does.
+// Records that fail to parse or convert are yielded along with their errors, and the iteration continues with the next record.
+// Other errors, such as read errors, are yielded once and stop the iteration.
+func ReadCSVSeq1[Ty1 any](r io.Reader, opts CSVOptions) iter.Seq2[T1[Ty1], error] {
+	return readCSVSeq(r, opts, func(t *T1[Ty1]) []any {
+		return []any{
+			&t.V1,
+		}
+	})
+}
@@ -0,0 +1,82 @@
+//go:build go1.23
+
+package tuple
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestT1_All(t *testing.T) {
+	tup := New1("1")
+
+	var indexes []int
+	var values []any
+	for index, value := range tup.All() {
+		indexes = append(indexes, index)
+		values = append(values, value)
+	}
+
+	require.Equal(t, []int{0}, indexes)
+	require.Equal(t, tup.Slice(), values)
+}
+
+func TestT1_All_Break(t *testing.T) {
+	tup := New1("1")
+
+	var values []any
+	for _, value := range tup.All() {
+		values = append(values, value)
+		break
+	}
+
+	require.Equal(t, []any{"1"}, values)
+}
+
+func TestT1_ZipSeq(t *testing.T) {
+	want := []T1[int]{
+		New1(1),
+		New1(2),
+	}
+
+	require.Equal(t, want, slices.Collect(ZipSeq1(slices.Values([]int{1, 2}))))
+	require.Equal(t, want[:1], slices.Collect(ZipSeq1(slices.Values([]int{1}))))
+}
+
+func TestT1_ZipSeq_Break(t *testing.T) {
+	var got []T1[int]
+	for tup := range ZipSeq1(slices.Values([]int{1, 2})) {
+		got = append(got, tup)
+		break
+	}
+
+	require.Equal(t, []T1[int]{New1(1)}, got)
+}
+
+func TestT1_ReadCSVSeq(t *testing.T) {
+	data := "1\nx\n2\n"
+
+	var got []T1[int]
+	var errs []error
+	for tup, err := range ReadCSVSeq1[int](strings.NewReader(data), CSVOptions{}) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		got = append(got, tup)
+	}
+
+	require.Equal(t, []T1[int]{
+		New1(1),
+		New1(2),
+	}, got)
+	require.Len(t, errs, 1)
+
+	var csvErr *CSVError
+	require.ErrorAs(t, errs[0], &csvErr)
+	require.Equal(t, 2, csvErr.Line)
+	require.Equal(t, 1, csvErr.Column)
+}
@@ -1,7 +1,17 @@
 package tuple
 
 import (
+	"database/sql/driver"
+	"encoding/binary"
 	"encoding/json"
+	"encoding/xml"
+	"hash/maphash"
+	"io"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -25,6 +35,118 @@ func TestT1_Values(t *testing.T) {
 	require.Equal(t, "1", v1)
 }
 
+func TestT1_MapAt(t *testing.T) {
+	tup := New1(1)
+
+	require.Equal(t, New1("1"), Map1At1(tup, strconv.Itoa))
+}
+
+func TestT1_Map(t *testing.T) {
+	tup := New1(1)
+	got := Map1(tup, strconv.Itoa)
+	require.Equal(t, New1("1"), got)
+}
+
+func TestT1_Append(t *testing.T) {
+	tup := New1("1")
+	require.Equal(t, New2("1", "2"), Append1(tup, "2"))
+}
+
+func TestT1_Prepend(t *testing.T) {
+	tup := New1("2")
+	require.Equal(t, New2("1", "2"), Prepend1(tup, "1"))
+}
+
+func TestT1_Concat(t *testing.T) {
+	tup := New1("1")
+
+	require.Equal(t,
+		New2("1", "2"),
+		Concat1_1(tup, New1("2")),
+	)
+	require.Equal(t,
+		New3("1", "2", "3"),
+		Concat1_2(tup, New2("2", "3")),
+	)
+	require.Equal(t,
+		New4("1", "2", "3", "4"),
+		Concat1_3(tup, New3("2", "3", "4")),
+	)
+	require.Equal(t,
+		New5("1", "2", "3", "4", "5"),
+		Concat1_4(tup, New4("2", "3", "4", "5")),
+	)
+	require.Equal(t,
+		New6("1", "2", "3", "4", "5", "6"),
+		Concat1_5(tup, New5("2", "3", "4", "5", "6")),
+	)
+	require.Equal(t,
+		New7("1", "2", "3", "4", "5", "6", "7"),
+		Concat1_6(tup, New6("2", "3", "4", "5", "6", "7")),
+	)
+	require.Equal(t,
+		New8("1", "2", "3", "4", "5", "6", "7", "8"),
+		Concat1_7(tup, New7("2", "3", "4", "5", "6", "7", "8")),
+	)
+	require.Equal(t,
+		New9("1", "2", "3", "4", "5", "6", "7", "8", "9"),
+		Concat1_8(tup, New8("2", "3", "4", "5", "6", "7", "8", "9")),
+	)
+}
+
+func TestT1_Apply(t *testing.T) {
+	tup := New1("1")
+	got := Apply1(tup, func(v1 string) string {
+		return v1
+	})
+	require.Equal(t, "1", got)
+}
+
+func TestT1_Tupled(t *testing.T) {
+	tup := New1("1")
+	f := Tupled1(func(v1 string) string {
+		return v1
+	})
+	require.Equal(t, "1", f(tup))
+}
+
+func TestT1_Untupled(t *testing.T) {
+	f := Untupled1(func(tup T1[string]) T1[string] {
+		return tup
+	})
+	require.Equal(t, New1("1"), f("1"))
+}
+
+func TestT1_Zip(t *testing.T) {
+	want := []T1[int]{
+		New1(1),
+		New1(2),
+	}
+
+	require.Equal(t, want, Zip1([]int{1, 2}))
+	require.Equal(t, want[:1], Zip1([]int{1}))
+}
+
+func TestT1_ZipStrict(t *testing.T) {
+	want := []T1[int]{
+		New1(1),
+		New1(2),
+	}
+
+	got, err := Zip1Strict([]int{1, 2})
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+func TestT1_Unzip(t *testing.T) {
+	s1 := Unzip1([]T1[int]{
+		New1(1),
+		New1(2),
+	})
+
+	require.Equal(t, []int{1, 2}, s1)
+}
+
 func TestT1_Compare(t *testing.T) {
 	lesser := New1(1)
 	greater := New1(2)
@@ -205,6 +327,97 @@ func TestT1_CompareC(t *testing.T) {
 	}
 }
 
+func TestT1_CmpFunc(t *testing.T) {
+	tups := []T1[int]{
+		New1(2),
+		New1(1),
+		New1(3),
+	}
+
+	cmp := CmpFunc1[int]()
+	slices.SortFunc(tups, cmp)
+	require.Equal(t, []T1[int]{
+		New1(1),
+		New1(2),
+		New1(3),
+	}, tups)
+
+	index, found := slices.BinarySearchFunc(tups, New1(2), cmp)
+	require.True(t, found)
+	require.Equal(t, 1, index)
+}
+
+func TestT1_CmpFuncC(t *testing.T) {
+	tups := []T1[stringComparable]{
+		New1(stringComparable("2")),
+		New1(stringComparable("1")),
+	}
+
+	slices.SortFunc(tups, CmpFunc1C[stringComparable]())
+	require.Equal(t, []T1[stringComparable]{
+		New1(stringComparable("1")),
+		New1(stringComparable("2")),
+	}, tups)
+}
+
+func TestT1_Compare_NaN(t *testing.T) {
+	nan := New1(math.NaN())
+	num := New1(1.0)
+
+	require.True(t, Compare1(nan, nan).EQ())
+	require.True(t, Compare1(nan, num).LT())
+	require.True(t, Compare1(num, nan).GT())
+}
+
+func TestT1_Comparator(t *testing.T) {
+	compare := Comparator1(CompareOrdered[int])
+	lesser := New1(1)
+
+	require.Equal(t, 0, compare(lesser, lesser))
+
+	greater1 := New1(2)
+	require.Equal(t, -1, compare(lesser, greater1))
+	require.Equal(t, 1, compare(greater1, lesser))
+}
+
+func TestT1_Comparator_Comparable(t *testing.T) {
+	compare := Comparator1(CompareComparable[stringComparable])
+	lesser := New1(stringComparable("1"))
+	greater := New1(stringComparable("2"))
+
+	require.Equal(t, -1, compare(lesser, greater))
+	require.Equal(t, 1, compare(greater, lesser))
+	require.Equal(t, 0, compare(lesser, lesser))
+}
+
+func TestT1_OrderBy(t *testing.T) {
+	lesser := New1(1)
+	asc := OrderBy1[int](Asc)
+
+	greater1 := New1(2)
+	desc1 := OrderBy1[int](Desc)
+	require.Equal(t, -1, asc(lesser, greater1))
+	require.Equal(t, 1, desc1(lesser, greater1))
+	require.Equal(t, 0, desc1(lesser, lesser))
+}
+
+func TestT1_Comparator_OrderedPtrBy(t *testing.T) {
+	compare := Comparator1(
+		OrderedPtrBy[int](Desc.NilsFirst()),
+	)
+
+	tups := []T1[*int]{
+		New1(ptr(1)),
+		New1(ptr(2)),
+		New1((*int)(nil)),
+	}
+	slices.SortFunc(tups, compare)
+
+	require.Nil(t, tups[0].V1)
+	require.Equal(t, 2, *tups[1].V1)
+	require.Equal(t, 1, *tups[2].V1)
+}
+
 func TestT1_EqualE(t *testing.T) {
 	a := New1(intEqualable(1))
 	b := New1(intEqualable(2))
@@ -213,6 +426,30 @@ func TestT1_EqualE(t *testing.T) {
 	require.True(t, Equal1E(a, a))
 }
 
+func TestT1_EqualFunc(t *testing.T) {
+	a := New1([]int{1})
+	b := New1([]int{1})
+
+	require.True(t, EqualFunc1(a, b, slices.Equal[[]int]))
+	require.False(t, EqualFunc1(a, New1([]int{2}), slices.Equal[[]int]))
+}
+
+func TestT1_EqualMethod(t *testing.T) {
+	a := New1([]int{1})
+
+	require.True(t, a.Equal(New1([]int{1})))
+	require.False(t, a.Equal(New1([]int{2})))
+}
+
+func TestT1_EqualMethod_Nested(t *testing.T) {
+	a := New1(New1(intEqualable(1)))
+	b := New1(New1(intEqualable(2)))
+
+	require.True(t, a.Equal(a))
+	require.False(t, a.Equal(b))
+	require.True(t, Equal1E(a, a))
+}
+
 func TestT1_String(t *testing.T) {
 	tup := New1("1")
 	require.Equal(t, `["1"]`, tup.String())
@@ -223,6 +460,31 @@ func TestT1_GoString(t *testing.T) {
 	require.Equal(t, `tuple.T1[string]{V1: "1"}`, tup.GoString())
 }
 
+func TestT1_Parse(t *testing.T) {
+	tup := New1("1")
+
+	got, err := Parse1[string](tup.String())
+	require.NoError(t, err)
+	require.Equal(t, tup, got)
+}
+
+func TestT1_Parse_Mixed(t *testing.T) {
+	tup := New1(New2("1", 1.5))
+
+	got, err := Parse1[T2[string, float64]](tup.String())
+	require.NoError(t, err)
+	require.Equal(t, tup, got)
+}
+
+func TestT1_Parse_Invalid(t *testing.T) {
+	_, err := Parse1[int](`["1"]`)
+	require.Error(t, err)
+
+	var parseErr *ParseError
+	require.ErrorAs(t, err, &parseErr)
+	require.Equal(t, 1, parseErr.Offset)
+}
+
 func TestT1_ToArray(t *testing.T) {
 	tup := New1("1")
 	require.Equal(t, [1]any{
@@ -421,6 +683,333 @@ func TestT1_FromSlice(t *testing.T) {
 	}
 }
 
+func TestT1_FromSlice_Errors(t *testing.T) {
+	_, err := FromSlice1[string]([]any{})
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, &LengthMismatchError{Source: "slice", Expected: 1, Actual: 0}, lengthErr)
+	require.EqualError(t, err, "slice length 0 must match number of tuple values 1")
+
+	_, err = FromSlice1[string]([]any{1})
+	var typeErr1 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr1)
+	require.Equal(t, 0, typeErr1.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr1.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr1.Actual)
+	require.EqualError(t, err, "value at slice index 0 expected to have type string but has type int")
+
+	_, err = FromArray1[string]([1]any{})
+	var arrayTypeErr *ElementTypeError
+	require.ErrorAs(t, err, &arrayTypeErr)
+	require.Equal(t, &ElementTypeError{Source: "array", Index: 0, Expected: reflect.TypeOf("")}, arrayTypeErr)
+	require.EqualError(t, err, "value at array index 0 expected to have type string but has type <nil>")
+}
+
+func TestT1_UnmarshalJSON_Errors(t *testing.T) {
+	var tup T1[string]
+
+	err := json.Unmarshal([]byte(`[]`), &tup)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, 1, lengthErr.Expected)
+	require.Equal(t, 0, lengthErr.Actual)
+	require.EqualError(t, err, "unmarshalled json array length 0 must match number of tuple values 1")
+
+	err = tup.UnmarshalJSON([]byte(`[1]`))
+	var elementErr *ElementUnmarshalError
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, 0, elementErr.Index)
+	require.Equal(t, "1", elementErr.Value)
+	var typeErr *json.UnmarshalTypeError
+	require.ErrorAs(t, err, &typeErr)
+	require.ErrorContains(t, err, `value "1" at slice index 0 failed to unmarshal: `)
+}
+
+func TestT1_ConvertSlice(t *testing.T) {
+	tests := []struct {
+		name    string
+		slice   []any
+		want    T1[int]
+		wantErr bool
+	}{
+		{
+			name:  "all types match",
+			slice: []any{1},
+			want:  New1(1),
+		},
+		{
+			name:  "decoded json numbers",
+			slice: []any{float64(1)},
+			want:  New1(1),
+		},
+		{
+			name:  "json numbers",
+			slice: []any{json.Number("1")},
+			want:  New1(1),
+		},
+		{
+			name:    "slice empty",
+			slice:   []any{},
+			wantErr: true,
+		},
+		{
+			name:    "index 1 bad type",
+			slice:   []any{"1"},
+			wantErr: true,
+		},
+		{
+			name:    "index 1 fractional number",
+			slice:   []any{1.5},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tup, err := ConvertSlice1[int](tt.slice)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, tup)
+		})
+	}
+}
+
+func TestT1_ConvertSlice_Errors(t *testing.T) {
+	_, err := ConvertSlice1[int8]([]any{})
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+
+	_, err = ConvertSlice1[int8]([]any{true})
+	var typeErr *ElementTypeError
+	require.ErrorAs(t, err, &typeErr)
+	require.Equal(t, 0, typeErr.Index)
+
+	_, err = ConvertSlice1[int8]([]any{float64(128)})
+	var conversionErr *ElementConversionError
+	require.ErrorAs(t, err, &conversionErr)
+	require.Equal(t, 0, conversionErr.Index)
+	require.Equal(t, reflect.TypeOf(int8(0)), conversionErr.Expected)
+}
+
+func TestT1_ScanRow(t *testing.T) {
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{"c1"},
+		rows: [][]driver.Value{
+			{int64(1)},
+		},
+	})
+
+	require.True(t, rows.Next())
+	got, err := ScanRow1[string](rows)
+	require.NoError(t, err)
+	require.Equal(t, New1("1"), got)
+}
+
+func TestT1_ScanRow_ColumnsMismatch(t *testing.T) {
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{"c1", "extra"},
+		rows: [][]driver.Value{
+			{int64(1), int64(0)},
+		},
+	})
+
+	require.True(t, rows.Next())
+	_, err := ScanRow1[int](rows)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.EqualError(t, err, "row columns count 2 must match number of tuple values 1")
+}
+
+func TestT1_CollectRows(t *testing.T) {
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{"c1"},
+		rows: [][]driver.Value{
+			{int64(1)},
+			{int64(2)},
+		},
+	})
+
+	got, err := CollectRows1[int](rows)
+	require.NoError(t, err)
+	require.Equal(t, []T1[int]{
+		New1(1),
+		New1(2),
+	}, got)
+
+	rows = fakeQuery(t, fakeResult{columns: []string{"c1"}})
+	got, err = CollectRows1[int](rows)
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
+
+func TestT1_ReadCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		opts    CSVOptions
+		want    []T1[int]
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			data: "",
+			want: nil,
+		},
+		{
+			name: "records",
+			data: "1\n2\n",
+			want: []T1[int]{
+				New1(1),
+				New1(2),
+			},
+		},
+		{
+			name: "header",
+			data: "v1\n1\n",
+			opts: CSVOptions{Header: []string{"v1"}},
+			want: []T1[int]{New1(1)},
+		},
+		{
+			name: "skip header",
+			data: "c1\n1\n",
+			opts: CSVOptions{SkipHeader: true},
+			want: []T1[int]{New1(1)},
+		},
+		{
+			name:    "mismatching header",
+			data:    "c1\n1\n",
+			opts:    CSVOptions{Header: []string{"v1"}},
+			wantErr: true,
+		},
+		{
+			name: "semicolon delimiter",
+			data: "1\n",
+			opts: CSVOptions{Comma: ';'},
+			want: []T1[int]{New1(1)},
+		},
+		{
+			name:    "too many fields",
+			data:    "1,0\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid field",
+			data:    "x\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadCSV1[int](strings.NewReader(tt.data), tt.opts)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT1_ReadCSV_Error(t *testing.T) {
+	data := "1\nx\n"
+
+	_, err := ReadCSV1[int](strings.NewReader(data), CSVOptions{})
+	var csvErr *CSVError
+	require.ErrorAs(t, err, &csvErr)
+	require.Equal(t, 2, csvErr.Line)
+	require.Equal(t, 1, csvErr.Column)
+
+	var elementErr *ElementUnmarshalError
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, "x", elementErr.Value)
+}
+
+func TestT1_WriteCSV(t *testing.T) {
+	tups := []T1[string]{
+		New1("1"),
+		New1("1,\"1\""),
+	}
+
+	var buf strings.Builder
+	err := WriteCSV1(&buf, tups, CSVOptions{Header: []string{"v1"}})
+	require.NoError(t, err)
+	require.Equal(t, "v1\n1\n\"1,\"\"1\"\"\"\n", buf.String())
+
+	got, err := ReadCSV1[string](strings.NewReader(buf.String()), CSVOptions{SkipHeader: true})
+	require.NoError(t, err)
+	require.Equal(t, tups, got)
+}
+
+func TestT1_WriteCSV_Error(t *testing.T) {
+	tups := []T1[[]int]{
+		{V1: []int{1}},
+	}
+
+	var buf strings.Builder
+	err := WriteCSV1(&buf, tups, CSVOptions{Header: []string{"v1"}})
+	var csvErr *CSVError
+	require.ErrorAs(t, err, &csvErr)
+	require.Equal(t, 2, csvErr.Line)
+	require.Equal(t, 1, csvErr.Column)
+
+	err = WriteCSV1(&buf, tups, CSVOptions{Header: []string{"v1", "extra"}})
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+}
+
+func TestT1_Hash(t *testing.T) {
+	seed := maphash.MakeSeed()
+	tup := New1("1")
+
+	require.Equal(t, tup.Hash(seed), New1("1").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New1("2").Hash(seed))
+}
+
+func TestT1_Hash_Nested(t *testing.T) {
+	seed := maphash.MakeSeed()
+	tup := New1(New2("1", 1))
+
+	require.Equal(t, tup.Hash(seed), New1(New2("1", 1)).Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New1(New2("1", 2)).Hash(seed))
+}
+
+func TestT1_Hash_Unsupported(t *testing.T) {
+	seed := maphash.MakeSeed()
+	tup := New1([]int{1})
+	require.NotPanics(t, func() {
+		tup.Hash(seed)
+	})
+	require.Equal(t, tup.Hash(seed), New1([]int{1}).Hash(seed))
+
+	var nilTup T1[any]
+	require.NotPanics(t, func() {
+		nilTup.Hash(seed)
+	})
+	require.Panics(t, func() {
+		HashValue(seed, tup.V1)
+	})
+}
+
+func TestT1_HashFunc(t *testing.T) {
+	seed := maphash.MakeSeed()
+	hashSlice := func(seed maphash.Seed, v []int) uint64 {
+		return HashValue(seed, len(v))
+	}
+
+	hash := HashFunc1(hashSlice)
+	tup := New1([]int{1})
+
+	require.Equal(t, hash(seed, tup), hash(seed, New1([]int{2})))
+	require.NotEqual(t, hash(seed, tup), hash(seed, New1([]int{1, 2})))
+}
+
 func TestT1_MarshalJSON(t *testing.T) {
 	tup := New1("1")
 
@@ -523,3 +1112,598 @@ func TestT1_Marshal_Unmarshal(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, tup, unmarshalled)
 }
+
+func TestT1_UnmarshalJSONWith(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		opts    JSONDecodeOptions
+		want    T1[string]
+		wantErr bool
+	}{
+		{
+			name:    "empty json array",
+			data:    `[]`,
+			wantErr: true,
+		},
+		{
+			name: "allow shorter empty json array",
+			data: `[]`,
+			opts: JSONDecodeOptions{AllowShorter: true},
+			want: T1[string]{},
+		},
+		{
+			name:    "allow shorter longer json array",
+			data:    `["1","10"]`,
+			opts:    JSONDecodeOptions{AllowShorter: true},
+			wantErr: true,
+		},
+		{
+			name: "json array with null values",
+			data: `[null]`,
+			want: T1[string]{},
+		},
+		{
+			name:    "disallow null json array with null values",
+			data:    `[null]`,
+			opts:    JSONDecodeOptions{DisallowNull: true},
+			wantErr: true,
+		},
+		{
+			name: "disallow null json array of valid types",
+			data: `["1"]`,
+			opts: JSONDecodeOptions{DisallowNull: true},
+			want: New1("1"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T1[string]
+			err := got.UnmarshalJSONWith([]byte(tt.data), tt.opts)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT1_UnmarshalJSONWith_Nested(t *testing.T) {
+	type Custom struct {
+		Name string `json:"name"`
+	}
+
+	data := []byte(`[[1, {"name": "1", "age": 1}]]`)
+
+	var got T1[T2[any, Custom]]
+	err := got.UnmarshalJSONWith(data, JSONDecodeOptions{UseNumber: true})
+	require.NoError(t, err)
+	require.Equal(t, New1(New2[any](json.Number("1"), Custom{Name: "1"})), got)
+
+	err = got.UnmarshalJSONWith(data, JSONDecodeOptions{DisallowUnknownFields: true})
+	require.Error(t, err)
+}
+
+func TestT1_MarshalJSONLabeled(t *testing.T) {
+	tup := New1(1)
+	labels := WithLabels("v1")
+
+	got, err := tup.MarshalJSONLabeled(labels)
+	require.NoError(t, err)
+	require.Equal(t, `{"v1":1}`, string(got))
+
+	_, err = tup.MarshalJSONLabeled(WithLabels("v1", "extra"))
+	require.Error(t, err)
+}
+
+func TestT1_UnmarshalJSONLabeled(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		labels  Labels
+		want    T1[int]
+		wantErr bool
+	}{
+		{
+			name:   "all keys",
+			data:   `{"v1":1}`,
+			labels: WithLabels("v1"),
+			want:   New1(1),
+		},
+		{
+			name:   "missing keys",
+			data:   `{}`,
+			labels: WithLabels("v1"),
+			want:   T1[int]{},
+		},
+		{
+			name:    "strict missing keys",
+			data:    `{}`,
+			labels:  WithLabels("v1").Strict(),
+			wantErr: true,
+		},
+		{
+			name:   "unknown keys",
+			data:   `{"v1":1,"extra":0}`,
+			labels: WithLabels("v1"),
+			want:   New1(1),
+		},
+		{
+			name:    "strict unknown keys",
+			data:    `{"v1":1,"extra":0}`,
+			labels:  WithLabels("v1").Strict(),
+			wantErr: true,
+		},
+		{
+			name:    "json array",
+			data:    `[1]`,
+			labels:  WithLabels("v1"),
+			wantErr: true,
+		},
+		{
+			name:    "labels count mismatch",
+			data:    `{}`,
+			labels:  WithLabels("v1", "extra"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T1[int]
+			err := got.UnmarshalJSONLabeled([]byte(tt.data), tt.labels)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT1_MarshalText(t *testing.T) {
+	tup := New1("1")
+
+	got, err := tup.MarshalText()
+	require.NoError(t, err)
+	require.Equal(t, `1`, string(got))
+}
+
+func TestT1_MarshalText_Unsupported(t *testing.T) {
+	tup := New1([]int{1})
+
+	_, err := tup.MarshalText()
+	require.Error(t, err)
+}
+
+func TestT1_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    T1[string]
+		wantErr bool
+	}{
+		{
+			name: "valid values",
+			data: `1`,
+			want: New1("1"),
+		},
+		{
+			name: "escaped values",
+			data: `a\,1\\`,
+			want: New1(`a,1\`),
+		},
+		{
+			name:    "too many values",
+			data:    `1,extra`,
+			wantErr: true,
+		},
+		{
+			name:    "dangling escape",
+			data:    `1\`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T1[string]
+			err := got.UnmarshalText([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT1_MarshalText_UnmarshalText(t *testing.T) {
+	tup := New1(New2("a,1\\\\", 1))
+
+	marshalled, err := tup.MarshalText()
+	require.NoError(t, err)
+
+	var unmarshalled T1[T2[string, int]]
+	err = unmarshalled.UnmarshalText(marshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT1_GobEncode_GobDecode(t *testing.T) {
+	t.Run("strings", func(t *testing.T) {
+		requireGobRoundTrip(t, New1("1"))
+	})
+
+	t.Run("zero values", func(t *testing.T) {
+		requireGobRoundTrip(t, T1[int]{})
+	})
+
+	t.Run("nested tuples", func(t *testing.T) {
+		requireGobRoundTrip(t, New1(New2("1", 1)))
+	})
+
+	t.Run("pointers", func(t *testing.T) {
+		requireGobRoundTrip(t, New1(ptr(1)))
+	})
+
+	t.Run("pointers to zero values", func(t *testing.T) {
+		requireGobRoundTrip(t, New1(ptr(0)))
+	})
+
+	t.Run("nil pointers", func(t *testing.T) {
+		requireGobRoundTrip(t, T1[*int]{})
+	})
+
+	t.Run("nested tuple pointers", func(t *testing.T) {
+		requireGobRoundTrip(t, New1((*T2[string, *int])(nil)))
+	})
+
+	t.Run("interface values", func(t *testing.T) {
+		requireGobRoundTrip(t, T1[any]{V1: nil})
+	})
+
+	t.Run("struct field", func(t *testing.T) {
+		type Custom struct {
+			Tup T1[int]
+		}
+
+		requireGobRoundTrip(t, Custom{Tup: New1(1)})
+	})
+}
+
+func TestT1_GobDecode_Invalid(t *testing.T) {
+	encoded, err := New2("1", "2").GobEncode()
+	require.NoError(t, err)
+
+	var tup T1[string]
+	err = tup.GobDecode(encoded)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, 1, lengthErr.Expected)
+
+	encoded, err = New1("1").GobEncode()
+	require.NoError(t, err)
+
+	var mismatched T1[int]
+	require.Error(t, mismatched.GobDecode(encoded))
+	require.Error(t, tup.GobDecode(nil))
+}
+
+func TestT1_MarshalComposite(t *testing.T) {
+	got, err := New1("foo bar").MarshalComposite()
+	require.NoError(t, err)
+	require.Equal(t, `("foo bar")`, string(got))
+
+	got, err = T1[*int]{}.MarshalComposite()
+	require.NoError(t, err)
+	require.Equal(t, `()`, string(got))
+
+	got, err = New1(New2("1", 1)).MarshalComposite()
+	require.NoError(t, err)
+	require.Equal(t, `("(1,1)")`, string(got))
+}
+
+func TestT1_UnmarshalComposite(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    T1[string]
+		wantErr bool
+	}{
+		{
+			name: "unquoted values",
+			data: `(1)`,
+			want: New1("1"),
+		},
+		{
+			name: "quoted values",
+			data: `("1, \\""")`,
+			want: New1("1, \\\""),
+		},
+		{
+			name: "empty values",
+			data: `("")`,
+			want: T1[string]{},
+		},
+		{
+			name:    "null values",
+			data:    `()`,
+			wantErr: true,
+		},
+		{
+			name:    "too many fields",
+			data:    `(1,0)`,
+			wantErr: true,
+		},
+		{
+			name:    "missing parentheses",
+			data:    `1`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T1[string]
+			err := got.UnmarshalComposite([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT1_MarshalComposite_UnmarshalComposite(t *testing.T) {
+	tup := New1((*T2[string, *int])(nil))
+
+	marshalled, err := tup.MarshalComposite()
+	require.NoError(t, err)
+
+	var unmarshalled T1[*T2[string, *int]]
+	err = unmarshalled.UnmarshalComposite(marshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT1_MarshalXML(t *testing.T) {
+	got, err := xml.Marshal(New1(1))
+	require.NoError(t, err)
+	require.Equal(t, `<T1><v1>1</v1></T1>`, string(got))
+
+	type Custom struct {
+		XMLName xml.Name   `xml:"custom"`
+		Tup     T1[string] `xml:"tup"`
+	}
+
+	got, err = xml.Marshal(Custom{Tup: New1("<1>")})
+	require.NoError(t, err)
+	require.Equal(t, `<custom><tup><v1>&lt;1&gt;</v1></tup></custom>`, string(got))
+}
+
+func TestT1_UnmarshalXML(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    T1[int]
+		wantErr bool
+	}{
+		{
+			name: "all elements",
+			data: `<T1><v1>1</v1></T1>`,
+			want: New1(1),
+		},
+		{
+			name: "whitespace and comments",
+			data: `<T1>
+				<!-- v1 --> <v1> 1 </v1>
+			</T1>`,
+			want: New1(1),
+		},
+		{
+			name:    "no elements",
+			data:    `<T1></T1>`,
+			wantErr: true,
+		},
+		{
+			name:    "too many elements",
+			data:    `<T1><v1>1</v1><v0>0</v0></T1>`,
+			wantErr: true,
+		},
+		{
+			name:    "wrong element names",
+			data:    `<T1><V1>1</V1></T1>`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid value",
+			data:    `<T1><v1>x</v1></T1>`,
+			wantErr: true,
+		},
+		{
+			name:    "unterminated",
+			data:    `<T1><v1>1</v1>`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T1[int]
+			err := xml.Unmarshal([]byte(tt.data), &got)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT1_UnmarshalXML_Errors(t *testing.T) {
+	var tup T1[int]
+
+	err := xml.Unmarshal([]byte(`<T1></T1>`), &tup)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.EqualError(t, err, "unmarshalled xml elements count 0 must match number of tuple values 1")
+
+	err = xml.Unmarshal([]byte(`<T1><v1>1</v1><v0>0</v0><v0>0</v0></T1>`), &tup)
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, 3, lengthErr.Actual)
+
+	err = xml.Unmarshal([]byte(`<T1><v1>x</v1></T1>`), &tup)
+	var elementErr *ElementUnmarshalError
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, 0, elementErr.Index)
+	require.Equal(t, "v1", elementErr.Label)
+}
+
+func TestT1_MarshalXML_UnmarshalXML_NilPointers(t *testing.T) {
+	tup := New1((*int)(nil))
+
+	got, err := xml.Marshal(tup)
+	require.NoError(t, err)
+	require.Equal(t, `<T1><v1 nil="true"></v1></T1>`, string(got))
+
+	unmarshalled := New1(ptr(0))
+	err = xml.Unmarshal(got, &unmarshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT1_MarshalXML_UnmarshalXML_Slices(t *testing.T) {
+	tup := New1([]int{1, 2})
+
+	got, err := xml.Marshal(tup)
+	require.NoError(t, err)
+	require.Equal(t, `<T1><v1><item>1</item><item>2</item></v1></T1>`, string(got))
+
+	var unmarshalled T1[[]int]
+	err = xml.Unmarshal(got, &unmarshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT1_MarshalXMLLabeled_UnmarshalXMLLabeled(t *testing.T) {
+	tup := New1(New2("1", ptr(1)))
+	labels := WithLabels("item1")
+
+	var buf strings.Builder
+	encoder := xml.NewEncoder(&buf)
+	err := tup.MarshalXMLLabeled(encoder, xml.StartElement{Name: xml.Name{Local: "items"}}, labels)
+	require.NoError(t, err)
+	require.NoError(t, encoder.Flush())
+	require.Equal(t, `<items><item1><v1>1</v1><v2>1</v2></item1></items>`, buf.String())
+
+	decoder := xml.NewDecoder(strings.NewReader(buf.String()))
+	start, err := decoder.Token()
+	require.NoError(t, err)
+
+	var unmarshalled T1[T2[string, *int]]
+	err = unmarshalled.UnmarshalXMLLabeled(decoder, start.(xml.StartElement), labels)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+
+	err = tup.MarshalXMLLabeled(encoder, xml.StartElement{Name: xml.Name{Local: "items"}}, WithLabels("item1", "extra"))
+	require.Error(t, err)
+}
+
+func TestT1_MarshalBinary(t *testing.T) {
+	tup := New1(uint16(1))
+
+	got, err := tup.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 0}, got)
+
+	got, err = tup.AppendBinaryOrder([]byte{0xff}, binary.BigEndian)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0xff, 0, 1}, got)
+
+	_, err = New1([]int{1}).MarshalBinary()
+	require.Error(t, err)
+}
+
+func TestT1_MarshalBinary_UnmarshalBinary(t *testing.T) {
+	tup := New1(
+		int64(-1),
+	)
+
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		marshalled, err := tup.AppendBinaryOrder(nil, order.(binary.AppendByteOrder))
+		require.NoError(t, err)
+
+		var unmarshalled T1[int64]
+		err = unmarshalled.UnmarshalBinaryOrder(marshalled, order)
+		require.NoError(t, err)
+		require.Equal(t, tup, unmarshalled)
+	}
+}
+
+func TestT1_UnmarshalBinary_Invalid(t *testing.T) {
+	marshalled, err := New1(uint16(1)).MarshalBinary()
+	require.NoError(t, err)
+
+	var tup T1[uint16]
+	err = tup.UnmarshalBinary(marshalled[:len(marshalled)-1])
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	require.EqualError(t, err, "value at index 0 failed to unmarshal: unexpected EOF")
+
+	err = tup.UnmarshalBinary(append(marshalled, 0))
+	require.EqualError(t, err, "binary data has 1 trailing bytes")
+}
+
+func TestT1_BinarySlice(t *testing.T) {
+	tups := []T1[int]{
+		New1(1),
+		New1(-1),
+	}
+
+	marshalled, err := AppendBinarySlice1(nil, binary.LittleEndian, tups)
+	require.NoError(t, err)
+	require.Len(t, marshalled, 1+2*1*8)
+
+	got, err := UnmarshalBinarySlice1[int](marshalled, binary.LittleEndian, nil)
+	require.NoError(t, err)
+	require.Equal(t, tups, got)
+
+	got, err = UnmarshalBinarySlice1(marshalled, binary.LittleEndian, got)
+	require.NoError(t, err)
+	require.Equal(t, append(tups, tups...), got)
+
+	empty, err := AppendBinarySlice1[int](nil, binary.LittleEndian, nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0}, empty)
+
+	_, err = AppendBinarySlice1(nil, binary.LittleEndian, []T1[[]int]{{}})
+	require.Error(t, err)
+}
+
+func TestT1_MarshalJSON_MapKey(t *testing.T) {
+	m := map[T1[string]]int{
+		New1("1"): 1,
+	}
+
+	marshalled, err := json.Marshal(m)
+	require.NoError(t, err)
+	require.Equal(t, `{"1":1}`, string(marshalled))
+
+	var unmarshalled map[T1[string]]int
+	err = json.Unmarshal(marshalled, &unmarshalled)
+	require.NoError(t, err)
+	require.Equal(t, m, unmarshalled)
+}
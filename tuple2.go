@@ -1,10 +1,14 @@
 package tuple
 
 import (
+	"cmp"
+	"database/sql"
+	"encoding/binary"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
-
-	"golang.org/x/exp/constraints"
+	"hash/maphash"
+	"io"
 )
 
 // T2 is a tuple type holding 2 generic values.
@@ -60,11 +64,11 @@ func New2[Ty1, Ty2 any](v1 Ty1, v2 Ty2) T2[Ty1, Ty2] {
 func FromArray2[Ty1, Ty2 any](arr [2]any) (T2[Ty1, Ty2], error) {
 	v1, ok := arr[0].(Ty1)
 	if !ok {
-		return T2[Ty1, Ty2]{}, fmt.Errorf("value at array index 0 expected to have type %s but has type %T", typeName[Ty1](), arr[0])
+		return T2[Ty1, Ty2]{}, newElementTypeError[Ty1]("array", 0, arr[0])
 	}
 	v2, ok := arr[1].(Ty2)
 	if !ok {
-		return T2[Ty1, Ty2]{}, fmt.Errorf("value at array index 1 expected to have type %s but has type %T", typeName[Ty2](), arr[1])
+		return T2[Ty1, Ty2]{}, newElementTypeError[Ty2]("array", 1, arr[1])
 	}
 
 	return New2(v1, v2), nil
@@ -80,16 +84,16 @@ func FromArray2X[Ty1, Ty2 any](arr [2]any) T2[Ty1, Ty2] {
 // If the length of the slice doesn't match, or any of the values can not be converted to the generic type, an error is returned.
 func FromSlice2[Ty1, Ty2 any](values []any) (T2[Ty1, Ty2], error) {
 	if len(values) != 2 {
-		return T2[Ty1, Ty2]{}, fmt.Errorf("slice length %d must match number of tuple values 2", len(values))
+		return T2[Ty1, Ty2]{}, &LengthMismatchError{Source: "slice", Expected: 2, Actual: len(values)}
 	}
 
 	v1, ok := values[0].(Ty1)
 	if !ok {
-		return T2[Ty1, Ty2]{}, fmt.Errorf("value at slice index 0 expected to have type %s but has type %T", typeName[Ty1](), values[0])
+		return T2[Ty1, Ty2]{}, newElementTypeError[Ty1]("slice", 0, values[0])
 	}
 	v2, ok := values[1].(Ty2)
 	if !ok {
-		return T2[Ty1, Ty2]{}, fmt.Errorf("value at slice index 1 expected to have type %s but has type %T", typeName[Ty2](), values[1])
+		return T2[Ty1, Ty2]{}, newElementTypeError[Ty2]("slice", 1, values[1])
 	}
 
 	return New2(v1, v2), nil
@@ -99,7 +103,7 @@ func FromSlice2[Ty1, Ty2 any](values []any) (T2[Ty1, Ty2], error) {
 // If the length of the slice doesn't match, or any of the values can not be converted to the generic type, the function panics.
 func FromSlice2X[Ty1, Ty2 any](values []any) T2[Ty1, Ty2] {
 	if len(values) != 2 {
-		panic(fmt.Errorf("slice length %d must match number of tuple values 2", len(values)))
+		panic(&LengthMismatchError{Source: "slice", Expected: 2, Actual: len(values)})
 	}
 
 	v1 := values[0].(Ty1)
@@ -108,11 +112,309 @@ func FromSlice2X[Ty1, Ty2 any](values []any) T2[Ty1, Ty2] {
 	return New2(v1, v2)
 }
 
+// ConvertSlice2 returns a tuple from a slice of length 2, converting the values to the generic types when needed.
+// Unlike FromSlice2, numeric values are converted between numeric types as long as they fit the generic type,
+// json.Number values are parsed into numeric types, strings and byte slices are converted to each other,
+// and strings and byte slices are unmarshalled into types implementing encoding.TextUnmarshaler.
+// This enables creating tuples from slices decoded by encoding/json, which hold float64 values for numbers.
+// If the length of the slice doesn't match, a *LengthMismatchError is returned.
+// If any of the values can not be converted to the generic type, a *ElementTypeError is returned.
+// If the conversion of any of the values fails, for example when it overflows the generic type, a *ElementConversionError is returned.
+func ConvertSlice2[Ty1, Ty2 any](values []any) (T2[Ty1, Ty2], error) {
+	if len(values) != 2 {
+		return T2[Ty1, Ty2]{}, &LengthMismatchError{Source: "slice", Expected: 2, Actual: len(values)}
+	}
+
+	var t T2[Ty1, Ty2]
+	if err := convertSliceValue(0, values[0], &t.V1); err != nil {
+		return T2[Ty1, Ty2]{}, err
+	}
+	if err := convertSliceValue(1, values[1], &t.V2); err != nil {
+		return T2[Ty1, Ty2]{}, err
+	}
+
+	return t, nil
+}
+
+// ScanRow2 scans the current row of rows into a tuple, with each of the columns scanned into the matching tuple value.
+// If the number of columns doesn't match the number of tuple values, a *LengthMismatchError is returned.
+func ScanRow2[Ty1, Ty2 any](rows *sql.Rows) (T2[Ty1, Ty2], error) {
+	var t T2[Ty1, Ty2]
+	if err := scanRow(rows, []any{
+		&t.V1,
+		&t.V2,
+	}); err != nil {
+		return T2[Ty1, Ty2]{}, err
+	}
+
+	return t, nil
+}
+
+// CollectRows2 scans all the rows into a slice of tuples using ScanRow2, and closes the rows.
+func CollectRows2[Ty1, Ty2 any](rows *sql.Rows) ([]T2[Ty1, Ty2], error) {
+	return collectRows(rows, ScanRow2[Ty1, Ty2])
+}
+
+// ReadCSV2 reads all the CSV records of r into tuples, with each of the fields converted to the matching tuple value.
+// Fields are converted using the UnmarshalText method of values implementing encoding.TextUnmarshaler,
+// and otherwise must be of boolean, numeric or string kinds.
+// If a record fails to convert, a *CSVError holding its line and column numbers is returned.
+// If a record fails to parse, including records with a number of fields that doesn't match, a *csv.ParseError is returned.
+func ReadCSV2[Ty1, Ty2 any](r io.Reader, opts CSVOptions) ([]T2[Ty1, Ty2], error) {
+	return readCSV(r, opts, func(t *T2[Ty1, Ty2]) []any {
+		return []any{
+			&t.V1,
+			&t.V2,
+		}
+	})
+}
+
+// WriteCSV2 writes the tuples into w as CSV records, with each of the tuple values converted to the matching field.
+// Values are converted the same way as MarshalText does.
+// If a tuple fails to convert, a *CSVError holding the line and column numbers of its record is returned.
+func WriteCSV2[Ty1, Ty2 any](w io.Writer, tuples []T2[Ty1, Ty2], opts CSVOptions) error {
+	return writeCSV(w, tuples, opts, T2[Ty1, Ty2].Slice)
+}
+
+// Parse2 returns a tuple from its string representation, as returned by the String method.
+// Supported values are booleans, numbers, quoted strings and nested tuples.
+// If the string fails to parse, a *ParseError holding the offset of the failure is returned.
+func Parse2[Ty1, Ty2 any](s string) (T2[Ty1, Ty2], error) {
+	var t T2[Ty1, Ty2]
+	if err := parseTuple(s, &t); err != nil {
+		return T2[Ty1, Ty2]{}, err
+	}
+
+	return t, nil
+}
+
+// Map2At1 returns a new tuple with the function f applied to the value at position 1 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map2At1[Ty1, Ty2, R any](t T2[Ty1, Ty2], f func(Ty1) R) T2[R, Ty2] {
+	return T2[R, Ty2]{
+		V1: f(t.V1),
+		V2: t.V2,
+	}
+}
+
+// Map2At2 returns a new tuple with the function f applied to the value at position 2 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map2At2[Ty1, Ty2, R any](t T2[Ty1, Ty2], f func(Ty2) R) T2[Ty1, R] {
+	return T2[Ty1, R]{
+		V1: t.V1,
+		V2: f(t.V2),
+	}
+}
+
+// Map2 returns a new tuple with each of the functions applied to the tuple value at the matching position.
+func Map2[Ty1, Ty2, R1, R2 any](t T2[Ty1, Ty2], f1 func(Ty1) R1, f2 func(Ty2) R2) T2[R1, R2] {
+	return T2[R1, R2]{
+		V1: f1(t.V1),
+		V2: f2(t.V2),
+	}
+}
+
+// Append2 returns a new tuple holding the tuple values followed by the value v.
+func Append2[Ty1, Ty2, Ty3 any](t T2[Ty1, Ty2], v Ty3) T3[Ty1, Ty2, Ty3] {
+	return T3[Ty1, Ty2, Ty3]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: v,
+	}
+}
+
+// Prepend2 returns a new tuple holding the value v followed by the tuple values.
+func Prepend2[Ty1, Ty2, Ty3 any](t T2[Ty2, Ty3], v Ty1) T3[Ty1, Ty2, Ty3] {
+	return T3[Ty1, Ty2, Ty3]{
+		V1: v,
+		V2: t.V1,
+		V3: t.V2,
+	}
+}
+
+// Concat2_1 returns a new tuple holding the values of t1 followed by the values of t2.
+func Concat2_1[Ty1, Ty2, Ty3 any](t1 T2[Ty1, Ty2], t2 T1[Ty3]) T3[Ty1, Ty2, Ty3] {
+	return T3[Ty1, Ty2, Ty3]{
+		V1: t1.V1,
+		V2: t1.V2,
+		V3: t2.V1,
+	}
+}
+
+// Concat2_2 returns a new tuple holding the values of t1 followed by the values of t2.
+func Concat2_2[Ty1, Ty2, Ty3, Ty4 any](t1 T2[Ty1, Ty2], t2 T2[Ty3, Ty4]) T4[Ty1, Ty2, Ty3, Ty4] {
+	return T4[Ty1, Ty2, Ty3, Ty4]{
+		V1: t1.V1,
+		V2: t1.V2,
+		V3: t2.V1,
+		V4: t2.V2,
+	}
+}
+
+// Concat2_3 returns a new tuple holding the values of t1 followed by the values of t2.
+func Concat2_3[Ty1, Ty2, Ty3, Ty4, Ty5 any](t1 T2[Ty1, Ty2], t2 T3[Ty3, Ty4, Ty5]) T5[Ty1, Ty2, Ty3, Ty4, Ty5] {
+	return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{
+		V1: t1.V1,
+		V2: t1.V2,
+		V3: t2.V1,
+		V4: t2.V2,
+		V5: t2.V3,
+	}
+}
+
+// Concat2_4 returns a new tuple holding the values of t1 followed by the values of t2.
+func Concat2_4[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](t1 T2[Ty1, Ty2], t2 T4[Ty3, Ty4, Ty5, Ty6]) T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6] {
+	return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{
+		V1: t1.V1,
+		V2: t1.V2,
+		V3: t2.V1,
+		V4: t2.V2,
+		V5: t2.V3,
+		V6: t2.V4,
+	}
+}
+
+// Concat2_5 returns a new tuple holding the values of t1 followed by the values of t2.
+func Concat2_5[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](t1 T2[Ty1, Ty2], t2 T5[Ty3, Ty4, Ty5, Ty6, Ty7]) T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7] {
+	return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{
+		V1: t1.V1,
+		V2: t1.V2,
+		V3: t2.V1,
+		V4: t2.V2,
+		V5: t2.V3,
+		V6: t2.V4,
+		V7: t2.V5,
+	}
+}
+
+// Concat2_6 returns a new tuple holding the values of t1 followed by the values of t2.
+func Concat2_6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8 any](t1 T2[Ty1, Ty2], t2 T6[Ty3, Ty4, Ty5, Ty6, Ty7, Ty8]) T8[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8] {
+	return T8[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8]{
+		V1: t1.V1,
+		V2: t1.V2,
+		V3: t2.V1,
+		V4: t2.V2,
+		V5: t2.V3,
+		V6: t2.V4,
+		V7: t2.V5,
+		V8: t2.V6,
+	}
+}
+
+// Concat2_7 returns a new tuple holding the values of t1 followed by the values of t2.
+func Concat2_7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](t1 T2[Ty1, Ty2], t2 T7[Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9] {
+	return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{
+		V1: t1.V1,
+		V2: t1.V2,
+		V3: t2.V1,
+		V4: t2.V2,
+		V5: t2.V3,
+		V6: t2.V4,
+		V7: t2.V5,
+		V8: t2.V6,
+		V9: t2.V7,
+	}
+}
+
+// Take2_1 returns a new tuple holding the first 1 values of the tuple.
+func Take2_1[Ty1, Ty2 any](t T2[Ty1, Ty2]) T1[Ty1] {
+	return T1[Ty1]{
+		V1: t.V1,
+	}
+}
+
+// Drop2_1 returns a new tuple holding the values of the tuple without the first 1 values.
+func Drop2_1[Ty1, Ty2 any](t T2[Ty1, Ty2]) T1[Ty2] {
+	return T1[Ty2]{
+		V1: t.V2,
+	}
+}
+
+// Split2_1 splits the tuple into a tuple of its first 1 values and a tuple of the rest of its values.
+func Split2_1[Ty1, Ty2 any](t T2[Ty1, Ty2]) T2[T1[Ty1], T1[Ty2]] {
+	return T2[T1[Ty1], T1[Ty2]]{
+		V1: Take2_1(t),
+		V2: Drop2_1(t),
+	}
+}
+
+// Reverse2 returns a new tuple holding the tuple values in reverse order.
+func Reverse2[Ty1, Ty2 any](t T2[Ty1, Ty2]) T2[Ty2, Ty1] {
+	return T2[Ty2, Ty1]{
+		V1: t.V2,
+		V2: t.V1,
+	}
+}
+
+// Swap returns a new tuple holding the tuple values in swapped order.
+func (t T2[Ty1, Ty2]) Swap() T2[Ty2, Ty1] {
+	return Reverse2(t)
+}
+
+// Apply2 calls the function f with the tuple values as arguments and returns its result.
+func Apply2[Ty1, Ty2, R any](t T2[Ty1, Ty2], f func(Ty1, Ty2) R) R {
+	return f(t.Values())
+}
+
+// Tupled2 returns a function that accepts a tuple and calls f with the tuple values as arguments.
+func Tupled2[Ty1, Ty2, R any](f func(Ty1, Ty2) R) func(T2[Ty1, Ty2]) R {
+	return func(t T2[Ty1, Ty2]) R {
+		return f(t.Values())
+	}
+}
+
+// Untupled2 returns a function that accepts 2 arguments and calls g with a tuple holding them.
+func Untupled2[Ty1, Ty2, R any](g func(T2[Ty1, Ty2]) R) func(Ty1, Ty2) R {
+	return func(v1 Ty1, v2 Ty2) R {
+		return g(New2(v1, v2))
+	}
+}
+
+// Zip2 returns a slice of tuples, where each tuple holds the values at the same index of each of the given slices.
+// If the slices have different lengths, the returned slice is truncated to the length of the shortest slice.
+// To require all slices to have the same length, use the Zip2Strict function.
+func Zip2[Ty1, Ty2 any](s1 []Ty1, s2 []Ty2) []T2[Ty1, Ty2] {
+	length := minLen(len(s1), len(s2))
+
+	zipped := make([]T2[Ty1, Ty2], length)
+	for i := range zipped {
+		zipped[i] = T2[Ty1, Ty2]{
+			V1: s1[i],
+			V2: s2[i],
+		}
+	}
+
+	return zipped
+}
+
+// Zip2Strict returns a slice of tuples, where each tuple holds the values at the same index of each of the given slices.
+// If the slices have different lengths, an error is returned.
+// To truncate the result to the length of the shortest slice instead, use the Zip2 function.
+func Zip2Strict[Ty1, Ty2 any](s1 []Ty1, s2 []Ty2) ([]T2[Ty1, Ty2], error) {
+	if len(s2) != len(s1) {
+		return nil, &LengthMismatchError{Source: "slice 2", Expected: len(s1), Actual: len(s2), expectedSource: "slice 1"}
+	}
+
+	return Zip2(s1, s2), nil
+}
+
+// Unzip2 returns 2 slices, where each slice holds the tuple values at the matching position of each of the given tuples.
+func Unzip2[Ty1, Ty2 any](ts []T2[Ty1, Ty2]) ([]Ty1, []Ty2) {
+	s1 := make([]Ty1, len(ts))
+	s2 := make([]Ty2, len(ts))
+	for i, t := range ts {
+		s1[i] = t.V1
+		s2[i] = t.V2
+	}
+
+	return s1, s2
+}
+
 // Equal2 returns whether the host tuple is equal to the other tuple.
 // All tuple elements of the host and guest parameters must match the "comparable" built-in constraint.
 // To test equality of tuples that hold custom Equalable values, use the Equal2E function.
 // To test equality of tuples that hold custom Comparable values, use the Equal2C function.
-// Otherwise, use Equal or reflect.DeepEqual to test tuples of any types.
+// Otherwise, use the Equal method or the EqualFunc2 function to test tuples of any types.
 func Equal2[Ty1, Ty2 comparable](host, guest T2[Ty1, Ty2]) bool {
 	return host.V1 == guest.V1 && host.V2 == guest.V2
 }
@@ -121,7 +423,7 @@ func Equal2[Ty1, Ty2 comparable](host, guest T2[Ty1, Ty2]) bool {
 // All tuple elements of the host and guest parameters must match the Equalable constraint.
 // To test equality of tuples that hold built-in "comparable" values, use the Equal2 function.
 // To test equality of tuples that hold custom Comparable values, use the Equal2C function.
-// Otherwise, use Equal or reflect.DeepEqual to test tuples of any types.
+// Otherwise, use the Equal method or the EqualFunc2 function to test tuples of any types.
 func Equal2E[Ty1 Equalable[Ty1], Ty2 Equalable[Ty2]](host, guest T2[Ty1, Ty2]) bool {
 	return host.V1.Equal(guest.V1) && host.V2.Equal(guest.V2)
 }
@@ -130,15 +432,28 @@ func Equal2E[Ty1 Equalable[Ty1], Ty2 Equalable[Ty2]](host, guest T2[Ty1, Ty2]) b
 // All tuple elements of the host and guest parameters must match the Comparable constraint.
 // To test equality of tuples that hold built-in "comparable" values, use the Equal2 function.
 // To test equality of tuples that hold custom Equalable values, use the Equal2E function.
-// Otherwise, use Equal or reflect.DeepEqual to test tuples of any types.
+// Otherwise, use the Equal method or the EqualFunc2 function to test tuples of any types.
 func Equal2C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2]](host, guest T2[Ty1, Ty2]) bool {
 	return host.V1.CompareTo(guest.V1).EQ() && host.V2.CompareTo(guest.V2).EQ()
 }
 
+// EqualFunc2 returns whether the host tuple is equal to the guest tuple, using the given equality function for each element.
+// EqualFunc2 short-circuits once one of the elements is not equal, and the rest of the equality functions are not called.
+func EqualFunc2[Ty1, Ty2 any](host, guest T2[Ty1, Ty2], eq1 func(a, b Ty1) bool, eq2 func(a, b Ty2) bool) bool {
+	return eq1(host.V1, guest.V1) && eq2(host.V2, guest.V2)
+}
+
+// Equal returns whether the tuple is equal to the guest tuple.
+// Each element is tested using the Equalable constraint if it is implemented, then the Comparable constraint,
+// then the "==" operator if the element is comparable, and otherwise using reflect.DeepEqual.
+func (t T2[Ty1, Ty2]) Equal(guest T2[Ty1, Ty2]) bool {
+	return equalValues(t.V1, guest.V1) && equalValues(t.V2, guest.V2)
+}
+
 // Compare2 returns whether the host tuple is semantically less than, equal to, or greater than the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the Compare2C function.
-func Compare2[Ty1, Ty2 constraints.Ordered](host, guest T2[Ty1, Ty2]) OrderedComparisonResult {
+func Compare2[Ty1, Ty2 cmp.Ordered](host, guest T2[Ty1, Ty2]) OrderedComparisonResult {
 	return multiCompare(
 		func() OrderedComparisonResult { return compareOrdered(host.V1, guest.V1) },
 
@@ -157,10 +472,54 @@ func Compare2C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2]](host, guest T2[Ty1, Ty2
 	)
 }
 
+// CmpFunc2 returns a comparison function of tuples, which returns a negative number when a < b, a positive number when a > b,
+// and zero when a == b. The returned function can be used with slices.SortFunc, slices.BinarySearchFunc and similar functions.
+// All tuple elements must match the "Ordered" constraint.
+// To compare tuples that hold custom comparable values, use the CmpFunc2C function.
+func CmpFunc2[Ty1, Ty2 cmp.Ordered]() func(a, b T2[Ty1, Ty2]) int {
+	return func(a, b T2[Ty1, Ty2]) int {
+		return int(Compare2(a, b))
+	}
+}
+
+// CmpFunc2C returns a comparison function of tuples, which returns a negative number when a < b, a positive number when a > b,
+// and zero when a == b. The returned function can be used with slices.SortFunc, slices.BinarySearchFunc and similar functions.
+// All tuple elements must match the Comparable constraint.
+// To compare tuples that hold built-in "Ordered" values, use the CmpFunc2 function.
+func CmpFunc2C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2]]() func(a, b T2[Ty1, Ty2]) int {
+	return func(a, b T2[Ty1, Ty2]) int {
+		return int(Compare2C(a, b))
+	}
+}
+
+// Comparator2 returns a comparison function of tuples, which compares the tuple elements by order using the given
+// element comparison functions. Each element comparison function must return a negative number when a < b, a positive number
+// when a > b, and zero when a == b, such as cmp.Compare, CompareOrdered and CompareComparable.
+// The returned function short-circuits once one of the elements is not equal, and the rest of the element comparison functions
+// are not called.
+func Comparator2[Ty1, Ty2 any](c1 func(a, b Ty1) int, c2 func(a, b Ty2) int) func(a, b T2[Ty1, Ty2]) int {
+	return func(a, b T2[Ty1, Ty2]) int {
+		return int(multiCompare(
+			func() OrderedComparisonResult { return OrderedComparisonResult(c1(a.V1, b.V1)) },
+
+			func() OrderedComparisonResult { return OrderedComparisonResult(c2(a.V2, b.V2)) },
+		))
+	}
+}
+
+// OrderBy2 returns a comparison function of tuples, which compares the tuple elements by order according to the
+// sort order given for each element, such as OrderBy2(Asc, Desc, ...).
+// All tuple elements must match the "Ordered" constraint.
+// To order tuples that hold other element types, such as pointers, use the Comparator2 function with
+// the OrderFunc and OrderPtrFunc element comparison functions.
+func OrderBy2[Ty1, Ty2 cmp.Ordered](o1, o2 SortOrder) func(a, b T2[Ty1, Ty2]) int {
+	return Comparator2(OrderedBy[Ty1](o1), OrderedBy[Ty2](o2))
+}
+
 // LessThan2 returns whether the host tuple is semantically less than the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the LessThan2C function.
-func LessThan2[Ty1, Ty2 constraints.Ordered](host, guest T2[Ty1, Ty2]) bool {
+func LessThan2[Ty1, Ty2 cmp.Ordered](host, guest T2[Ty1, Ty2]) bool {
 	return Compare2(host, guest).LT()
 }
 
@@ -174,7 +533,7 @@ func LessThan2C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2]](host, guest T2[Ty1, Ty
 // LessOrEqual2 returns whether the host tuple is semantically less than or equal to the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the LessOrEqual2C function.
-func LessOrEqual2[Ty1, Ty2 constraints.Ordered](host, guest T2[Ty1, Ty2]) bool {
+func LessOrEqual2[Ty1, Ty2 cmp.Ordered](host, guest T2[Ty1, Ty2]) bool {
 	return Compare2(host, guest).LE()
 }
 
@@ -188,7 +547,7 @@ func LessOrEqual2C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2]](host, guest T2[Ty1,
 // GreaterThan2 returns whether the host tuple is semantically greater than the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the GreaterThan2C function.
-func GreaterThan2[Ty1, Ty2 constraints.Ordered](host, guest T2[Ty1, Ty2]) bool {
+func GreaterThan2[Ty1, Ty2 cmp.Ordered](host, guest T2[Ty1, Ty2]) bool {
 	return Compare2(host, guest).GT()
 }
 
@@ -202,7 +561,7 @@ func GreaterThan2C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2]](host, guest T2[Ty1,
 // GreaterOrEqual2 returns whether the host tuple is semantically greater than or equal to the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the GreaterOrEqual2C function.
-func GreaterOrEqual2[Ty1, Ty2 constraints.Ordered](host, guest T2[Ty1, Ty2]) bool {
+func GreaterOrEqual2[Ty1, Ty2 cmp.Ordered](host, guest T2[Ty1, Ty2]) bool {
 	return Compare2(host, guest).GE()
 }
 
@@ -213,6 +572,31 @@ func GreaterOrEqual2C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2]](host, guest T2[T
 	return Compare2C(host, guest).GE()
 }
 
+// Hash returns a hash of the tuple values using the given seed.
+// Equal tuples return equal hashes for the same seed.
+// Elements supported by the HashValue function are hashed by their values. Other elements, such as slices, maps,
+// functions, nil pointers and nil interfaces, are hashed by their type only, so tuples differing only in these elements collide.
+// To hash tuples holding other element types by their values, use the HashFunc2 function.
+func (t T2[Ty1, Ty2]) Hash(seed maphash.Seed) uint64 {
+	var h maphash.Hash
+	h.SetSeed(seed)
+	writeHashElement(&h, seed, t.V1)
+	writeHashElement(&h, seed, t.V2)
+	return h.Sum64()
+}
+
+// HashFunc2 returns a hash function of tuples, which hashes the tuple values using the given element hash functions.
+// Each element hash function must return equal hashes for equal values and the same seed, such as HashValue.
+func HashFunc2[Ty1, Ty2 any](h1 func(seed maphash.Seed, v Ty1) uint64, h2 func(seed maphash.Seed, v Ty2) uint64) func(seed maphash.Seed, t T2[Ty1, Ty2]) uint64 {
+	return func(seed maphash.Seed, t T2[Ty1, Ty2]) uint64 {
+		var h maphash.Hash
+		h.SetSeed(seed)
+		writeHashUint64(&h, h1(seed, t.V1))
+		writeHashUint64(&h, h2(seed, t.V2))
+		return h.Sum64()
+	}
+}
+
 // MarshalJSON marshals the tuple into a JSON array.
 func (t T2[Ty1, Ty2]) MarshalJSON() ([]byte, error) {
 	return json.Marshal(t.Slice())
@@ -220,21 +604,200 @@ func (t T2[Ty1, Ty2]) MarshalJSON() ([]byte, error) {
 
 // MarshalJSON unmarshals the tuple from a JSON array.
 func (t *T2[Ty1, Ty2]) UnmarshalJSON(data []byte) error {
-	// Working with json.RawMessage instead of any enables custom struct support.
-	var slice []json.RawMessage
-	if err := json.Unmarshal(data, &slice); err != nil {
-		return fmt.Errorf("unable to unmarshal json array for tuple: %w", err)
+	return t.UnmarshalJSONWith(data, JSONDecodeOptions{})
+}
+
+// UnmarshalJSONWith unmarshals the tuple from a JSON array using the given decode options.
+// The options are applied to nested tuples as well, including tuples within slices, arrays, maps, pointers and struct fields.
+func (t *T2[Ty1, Ty2]) UnmarshalJSONWith(data []byte, opts JSONDecodeOptions) error {
+	return unmarshalJSONArray(data, opts, []any{
+		&t.V1,
+		&t.V2,
+	})
+}
+
+// MarshalJSONLabeled marshals the tuple into a JSON object, where each of the tuple values is keyed by its matching label.
+// The number of labels must match the number of tuple values.
+func (t T2[Ty1, Ty2]) MarshalJSONLabeled(labels Labels) ([]byte, error) {
+	return marshalJSONLabeled(labels, t.Slice())
+}
+
+// UnmarshalJSONLabeled unmarshals the tuple from a JSON object, where each of the tuple values is keyed by its matching label.
+// The number of labels must match the number of tuple values.
+// Unless the labels are strict, missing keys leave the matching tuple values unchanged, and unknown keys are ignored.
+func (t *T2[Ty1, Ty2]) UnmarshalJSONLabeled(data []byte, labels Labels) error {
+	return unmarshalJSONLabeled(data, labels, []any{
+		&t.V1,
+		&t.V2,
+	})
+}
+
+// MarshalText marshals the tuple into a text of comma separated values.
+// Commas and backslashes within the values are escaped by a backslash.
+// Values implementing encoding.TextMarshaler are marshalled using their MarshalText method.
+// Otherwise, the values must be of boolean, numeric or string kinds.
+func (t T2[Ty1, Ty2]) MarshalText() ([]byte, error) {
+	var values [2]string
+	var err error
+	if values[0], err = marshalTextValue(t.V1); err != nil {
+		return nil, fmt.Errorf("value at index 0 failed to marshal: %w", err)
+	}
+	if values[1], err = marshalTextValue(t.V2); err != nil {
+		return nil, fmt.Errorf("value at index 1 failed to marshal: %w", err)
+	}
+
+	return []byte(joinText(values[:])), nil
+}
+
+// UnmarshalText unmarshals the tuple from a text of comma separated values, as created by MarshalText.
+func (t *T2[Ty1, Ty2]) UnmarshalText(data []byte) error {
+	values, err := splitText(string(data))
+	if err != nil {
+		return fmt.Errorf("unable to unmarshal text for tuple: %w", err)
 	}
 
-	if len(slice) != 2 {
-		return fmt.Errorf("unmarshalled json array length %d must match number of tuple values 2", len(slice))
+	if len(values) != 2 {
+		return &LengthMismatchError{Source: "unmarshalled text values", Expected: 2, Actual: len(values), count: true}
 	}
-	if err := json.Unmarshal(slice[0], &t.V1); err != nil {
-		return fmt.Errorf("value %q at slice index 0 failed to unmarshal: %w", string(slice[0]), err)
+	if err := unmarshalTextValue(values[0], &t.V1); err != nil {
+		return &ElementUnmarshalError{Index: 0, Value: values[0], Err: err, location: locationIndex}
 	}
 
-	if err := json.Unmarshal(slice[1], &t.V2); err != nil {
-		return fmt.Errorf("value %q at slice index 1 failed to unmarshal: %w", string(slice[1]), err)
+	if err := unmarshalTextValue(values[1], &t.V2); err != nil {
+		return &ElementUnmarshalError{Index: 1, Value: values[1], Err: err, location: locationIndex}
 	}
 	return nil
 }
+
+// GobEncode encodes the tuple for encoding/gob, implementing the gob.GobEncoder interface.
+// Nil pointer and interface values are preserved as nil, and pointers to zero values are preserved as non-nil.
+// See the package documentation for the wire format.
+func (t T2[Ty1, Ty2]) GobEncode() ([]byte, error) {
+	return gobEncodeValues([]any{
+		&t.V1,
+		&t.V2,
+	})
+}
+
+// GobDecode decodes the tuple from encoding/gob, as encoded by GobEncode, implementing the gob.GobDecoder interface.
+// If the number of encoded values doesn't match, a *LengthMismatchError is returned.
+func (t *T2[Ty1, Ty2]) GobDecode(data []byte) error {
+	return gobDecodeValues(data, []any{
+		&t.V1,
+		&t.V2,
+	})
+}
+
+// MarshalComposite marshals the tuple into a PostgreSQL composite literal, such as (1,"foo bar",).
+// Values are quoted and escaped the same way PostgreSQL does, and nil values and pointers are marshalled as NULL.
+// Nested tuples are marshalled as nested composite values, and other values are marshalled the same way as MarshalText does.
+func (t T2[Ty1, Ty2]) MarshalComposite() ([]byte, error) {
+	return marshalComposite(t.Slice())
+}
+
+// UnmarshalComposite unmarshals the tuple from a PostgreSQL composite literal, as created by MarshalComposite or by PostgreSQL.
+// NULL values can only be unmarshalled into pointers, interfaces, slices and maps, which are set to nil.
+// If the number of composite fields doesn't match, a *LengthMismatchError is returned.
+func (t *T2[Ty1, Ty2]) UnmarshalComposite(data []byte) error {
+	return unmarshalComposite(data, []any{
+		&t.V1,
+		&t.V2,
+	})
+}
+
+// MarshalXML marshals the tuple into a sequence of xml child elements named v1 to v2, implementing the xml.Marshaler interface.
+// Nil values are marshalled as empty child elements with a nil="true" attribute,
+// and slice values are marshalled as child elements holding an item element for each of the slice items.
+// Use MarshalXMLLabeled to name the child elements differently.
+func (t T2[Ty1, Ty2]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return t.MarshalXMLLabeled(e, start, indexLabels(2))
+}
+
+// UnmarshalXML unmarshals the tuple from a sequence of xml child elements named v1 to v2, implementing the xml.Unmarshaler interface.
+// The number of child elements must match the number of tuple values, the same way as UnmarshalJSON requires.
+func (t *T2[Ty1, Ty2]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return t.UnmarshalXMLLabeled(d, start, indexLabels(2))
+}
+
+// MarshalXMLLabeled marshals the tuple into a sequence of xml child elements, named by the labels matching each of the tuple values.
+// The number of labels must match the number of tuple values.
+func (t T2[Ty1, Ty2]) MarshalXMLLabeled(e *xml.Encoder, start xml.StartElement, labels Labels) error {
+	return marshalXML(e, start, labels, t.Slice())
+}
+
+// UnmarshalXMLLabeled unmarshals the tuple from a sequence of xml child elements, named by the labels matching each of the tuple values.
+// The child elements must be named by the labels in the same order, and their number must match the number of tuple values.
+// If the number of child elements doesn't match, a *LengthMismatchError is returned.
+func (t *T2[Ty1, Ty2]) UnmarshalXMLLabeled(d *xml.Decoder, start xml.StartElement, labels Labels) error {
+	return unmarshalXML(d, start, labels, []any{
+		&t.V1,
+		&t.V2,
+	})
+}
+
+// MarshalBinary marshals the tuple into its binary encoding using little-endian byte order,
+// implementing the encoding.BinaryMarshaler interface.
+// The tuple values must be of boolean, fixed-size numeric, string or byte slice kinds, or nested tuples.
+// See the package documentation for the binary encoding.
+func (t T2[Ty1, Ty2]) MarshalBinary() ([]byte, error) {
+	return t.AppendBinary(nil)
+}
+
+// AppendBinary appends the binary encoding of the tuple to b using little-endian byte order,
+// the same way as MarshalBinary does.
+func (t T2[Ty1, Ty2]) AppendBinary(b []byte) ([]byte, error) {
+	return t.AppendBinaryOrder(b, binary.LittleEndian)
+}
+
+// AppendBinaryOrder appends the binary encoding of the tuple to b using the given byte order.
+// Values of common types are encoded without allocations, other than the ones needed to grow b.
+func (t T2[Ty1, Ty2]) AppendBinaryOrder(b []byte, order binary.AppendByteOrder) ([]byte, error) {
+	var err error
+	if b, err = appendBinaryValue(b, order, t.V1); err != nil {
+		return nil, fmt.Errorf("value at index 0 failed to marshal: %w", err)
+	}
+	if b, err = appendBinaryValue(b, order, t.V2); err != nil {
+		return nil, fmt.Errorf("value at index 1 failed to marshal: %w", err)
+	}
+
+	return b, nil
+}
+
+// UnmarshalBinary unmarshals the tuple from its binary encoding using little-endian byte order,
+// implementing the encoding.BinaryUnmarshaler interface.
+func (t *T2[Ty1, Ty2]) UnmarshalBinary(data []byte) error {
+	return t.UnmarshalBinaryOrder(data, binary.LittleEndian)
+}
+
+// UnmarshalBinaryOrder unmarshals the tuple from its binary encoding using the given byte order.
+// The data must hold exactly the binary encoding of the tuple.
+func (t *T2[Ty1, Ty2]) UnmarshalBinaryOrder(data []byte, order binary.ByteOrder) error {
+	return unmarshalBinary(data, order, t)
+}
+
+// decodeBinary decodes the tuple from the beginning of data using the given byte order, and returns the rest of the data.
+func (t *T2[Ty1, Ty2]) decodeBinary(data []byte, order binary.ByteOrder) ([]byte, error) {
+	var err error
+	if data, err = decodeBinaryValue(data, order, &t.V1); err != nil {
+		return nil, fmt.Errorf("value at index 0 failed to unmarshal: %w", err)
+	}
+	if data, err = decodeBinaryValue(data, order, &t.V2); err != nil {
+		return nil, fmt.Errorf("value at index 1 failed to unmarshal: %w", err)
+	}
+
+	return data, nil
+}
+
+// AppendBinarySlice2 appends the binary encoding of a slice of tuples to b using the given byte order.
+// The slice is encoded as its length followed by the binary encoding of each of the tuples.
+func AppendBinarySlice2[Ty1, Ty2 any](b []byte, order binary.AppendByteOrder, tuples []T2[Ty1, Ty2]) ([]byte, error) {
+	return appendBinarySlice(b, order, tuples)
+}
+
+// UnmarshalBinarySlice2 unmarshals a slice of tuples from its binary encoding using the given byte order,
+// as encoded by AppendBinarySlice2, and appends the tuples to dst.
+// Tuples are decoded in place, so decoding into a dst with enough capacity, such as a previously decoded slice truncated to zero length,
+// doesn't allocate unless the tuples hold strings, or byte slices longer than the ones previously held in that capacity.
+func UnmarshalBinarySlice2[Ty1, Ty2 any](data []byte, order binary.ByteOrder, dst []T2[Ty1, Ty2]) ([]T2[Ty1, Ty2], error) {
+	return unmarshalBinarySlice(data, order, dst)
+}
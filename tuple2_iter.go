@@ -0,0 +1,74 @@
+//go:build go1.23
+
+package tuple
+
+import (
+	"io"
+	"iter"
+)
+
+// All returns an iterator over the index and value of each of the tuple values.
+func (t T2[Ty1, Ty2]) All() iter.Seq2[int, any] {
+	return func(yield func(int, any) bool) {
+		if !yield(0, t.V1) {
+			return
+		}
+		if !yield(1, t.V2) {
+			return
+		}
+	}
+}
+
+// ZipSeq2 returns an iterator over tuples, where each tuple holds the next value of each of the given iterators.
+// The returned iterator stops once any of the given iterators is exhausted.
+func ZipSeq2[Ty1, Ty2 any](s1 iter.Seq[Ty1], s2 iter.Seq[Ty2]) iter.Seq[T2[Ty1, Ty2]] {
+	return func(yield func(T2[Ty1, Ty2]) bool) {
+		next2, stop2 := iter.Pull(s2)
+		defer stop2()
+
+		for v1 := range s1 {
+			v2, ok := next2()
+			if !ok {
+				return
+			}
+
+			if !yield(New2(v1, v2)) {
+				return
+			}
+		}
+	}
+}
+
+// ToSeq2 converts an iterator over tuples of 2 values into an iterator over pairs of values.
+func ToSeq2[Ty1, Ty2 any](seq iter.Seq[T2[Ty1, Ty2]]) iter.Seq2[Ty1, Ty2] {
+	return func(yield func(Ty1, Ty2) bool) {
+		for t := range seq {
+			if !yield(t.V1, t.V2) {
+				return
+			}
+		}
+	}
+}
+
+// FromSeq2 converts an iterator over pairs of values into an iterator over tuples of 2 values.
+func FromSeq2[Ty1, Ty2 any](seq iter.Seq2[Ty1, Ty2]) iter.Seq[T2[Ty1, Ty2]] {
+	return func(yield func(T2[Ty1, Ty2]) bool) {
+		for v1, v2 := range seq {
+			if !yield(New2(v1, v2)) {
+				return
+			}
+		}
+	}
+}
+
+// ReadCSVSeq2 returns an iterator over the CSV records of r converted into tuples, the same way as ReadCSV2 does.
+// Records that fail to parse or convert are yielded along with their errors, and the iteration continues with the next record.
+// Other errors, such as read errors, are yielded once and stop the iteration.
+func ReadCSVSeq2[Ty1, Ty2 any](r io.Reader, opts CSVOptions) iter.Seq2[T2[Ty1, Ty2], error] {
+	return readCSVSeq(r, opts, func(t *T2[Ty1, Ty2]) []any {
+		return []any{
+			&t.V1,
+			&t.V2,
+		}
+	})
+}
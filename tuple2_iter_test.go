@@ -0,0 +1,98 @@
+//go:build go1.23
+
+package tuple
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestT2_All(t *testing.T) {
+	tup := New2("1", "2")
+
+	var indexes []int
+	var values []any
+	for index, value := range tup.All() {
+		indexes = append(indexes, index)
+		values = append(values, value)
+	}
+
+	require.Equal(t, []int{0, 1}, indexes)
+	require.Equal(t, tup.Slice(), values)
+}
+
+func TestT2_All_Break(t *testing.T) {
+	tup := New2("1", "2")
+
+	var values []any
+	for _, value := range tup.All() {
+		values = append(values, value)
+		break
+	}
+
+	require.Equal(t, []any{"1"}, values)
+}
+
+func TestT2_ZipSeq(t *testing.T) {
+	want := []T2[int, int]{
+		New2(1, 2),
+		New2(2, 3),
+	}
+
+	require.Equal(t, want, slices.Collect(ZipSeq2(slices.Values([]int{1, 2}), slices.Values([]int{2, 3}))))
+	require.Equal(t, want[:1], slices.Collect(ZipSeq2(slices.Values([]int{1}), slices.Values([]int{2, 3}))))
+	require.Equal(t, want[:1], slices.Collect(ZipSeq2(slices.Values([]int{1, 2}), slices.Values([]int{2}))))
+}
+
+func TestT2_ZipSeq_Break(t *testing.T) {
+	var got []T2[int, int]
+	for tup := range ZipSeq2(slices.Values([]int{1, 2}), slices.Values([]int{2, 3})) {
+		got = append(got, tup)
+		break
+	}
+
+	require.Equal(t, []T2[int, int]{New2(1, 2)}, got)
+}
+
+func TestT2_ReadCSVSeq(t *testing.T) {
+	data := "1,2\nx,x\n2,3\n"
+
+	var got []T2[int, int]
+	var errs []error
+	for tup, err := range ReadCSVSeq2[int, int](strings.NewReader(data), CSVOptions{}) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		got = append(got, tup)
+	}
+
+	require.Equal(t, []T2[int, int]{
+		New2(1, 2),
+		New2(2, 3),
+	}, got)
+	require.Len(t, errs, 1)
+
+	var csvErr *CSVError
+	require.ErrorAs(t, errs[0], &csvErr)
+	require.Equal(t, 2, csvErr.Line)
+	require.Equal(t, 1, csvErr.Column)
+}
+
+func TestToSeq2(t *testing.T) {
+	tups := []T2[string, int]{
+		New2("a", 1),
+		New2("b", 2),
+	}
+
+	require.Equal(t, map[string]int{"a": 1, "b": 2}, maps.Collect(ToSeq2(slices.Values(tups))))
+}
+
+func TestFromSeq2(t *testing.T) {
+	got := slices.Collect(FromSeq2(maps.All(map[string]int{"a": 1, "b": 2})))
+	require.ElementsMatch(t, []T2[string, int]{New2("a", 1), New2("b", 2)}, got)
+}
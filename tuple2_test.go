@@ -1,7 +1,17 @@
 package tuple
 
 import (
+	"database/sql/driver"
+	"encoding/binary"
 	"encoding/json"
+	"encoding/xml"
+	"hash/maphash"
+	"io"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -27,6 +37,157 @@ func TestT2_Values(t *testing.T) {
 	require.Equal(t, "2", v2)
 }
 
+func TestT2_MapAt(t *testing.T) {
+	tup := New2(1, 2)
+
+	require.Equal(t, New2("1", 2), Map2At1(tup, strconv.Itoa))
+	require.Equal(t, New2(1, "2"), Map2At2(tup, strconv.Itoa))
+}
+
+func TestT2_Map(t *testing.T) {
+	tup := New2(1, 2)
+	got := Map2(tup, strconv.Itoa, strconv.Itoa)
+	require.Equal(t, New2("1", "2"), got)
+}
+
+func TestT2_Append(t *testing.T) {
+	tup := New2("1", "2")
+	require.Equal(t, New3("1", "2", "3"), Append2(tup, "3"))
+}
+
+func TestT2_Prepend(t *testing.T) {
+	tup := New2("2", "3")
+	require.Equal(t, New3("1", "2", "3"), Prepend2(tup, "1"))
+}
+
+func TestT2_Concat(t *testing.T) {
+	tup := New2("1", "2")
+
+	require.Equal(t,
+		New3("1", "2", "3"),
+		Concat2_1(tup, New1("3")),
+	)
+	require.Equal(t,
+		New4("1", "2", "3", "4"),
+		Concat2_2(tup, New2("3", "4")),
+	)
+	require.Equal(t,
+		New5("1", "2", "3", "4", "5"),
+		Concat2_3(tup, New3("3", "4", "5")),
+	)
+	require.Equal(t,
+		New6("1", "2", "3", "4", "5", "6"),
+		Concat2_4(tup, New4("3", "4", "5", "6")),
+	)
+	require.Equal(t,
+		New7("1", "2", "3", "4", "5", "6", "7"),
+		Concat2_5(tup, New5("3", "4", "5", "6", "7")),
+	)
+	require.Equal(t,
+		New8("1", "2", "3", "4", "5", "6", "7", "8"),
+		Concat2_6(tup, New6("3", "4", "5", "6", "7", "8")),
+	)
+	require.Equal(t,
+		New9("1", "2", "3", "4", "5", "6", "7", "8", "9"),
+		Concat2_7(tup, New7("3", "4", "5", "6", "7", "8", "9")),
+	)
+}
+
+func TestT2_Take(t *testing.T) {
+	tup := New2("1", "2")
+
+	require.Equal(t, New1("1"), Take2_1(tup))
+}
+
+func TestT2_Drop(t *testing.T) {
+	tup := New2("1", "2")
+
+	require.Equal(t, New1("2"), Drop2_1(tup))
+}
+
+func TestT2_Split(t *testing.T) {
+	tup := New2("1", "2")
+
+	require.Equal(t,
+		New2(New1("1"), New1("2")),
+		Split2_1(tup),
+	)
+}
+
+func TestT2_Reverse(t *testing.T) {
+	tup := New2(1, 2)
+	require.Equal(t, New2(2, 1), Reverse2(tup))
+}
+
+func TestT2_Swap(t *testing.T) {
+	tup := New2(1, "2")
+	require.Equal(t, New2("2", 1), tup.Swap())
+}
+
+func TestT2_Apply(t *testing.T) {
+	tup := New2("1", "2")
+	got := Apply2(tup, func(v1, v2 string) string {
+		return v1 + v2
+	})
+	require.Equal(t, "12", got)
+}
+
+func TestT2_Tupled(t *testing.T) {
+	tup := New2("1", "2")
+	f := Tupled2(func(v1, v2 string) string {
+		return v1 + v2
+	})
+	require.Equal(t, "12", f(tup))
+}
+
+func TestT2_Untupled(t *testing.T) {
+	f := Untupled2(func(tup T2[string, string]) T2[string, string] {
+		return tup
+	})
+	require.Equal(t, New2("1", "2"), f("1", "2"))
+}
+
+func TestT2_Zip(t *testing.T) {
+	want := []T2[int, int]{
+		New2(1, 2),
+		New2(2, 3),
+	}
+
+	require.Equal(t, want, Zip2([]int{1, 2}, []int{2, 3}))
+	require.Equal(t, want[:1], Zip2([]int{1}, []int{2, 3}))
+	require.Equal(t, want[:1], Zip2([]int{1, 2}, []int{2}))
+}
+
+func TestT2_ZipStrict(t *testing.T) {
+	want := []T2[int, int]{
+		New2(1, 2),
+		New2(2, 3),
+	}
+
+	got, err := Zip2Strict([]int{1, 2}, []int{2, 3})
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	_, err = Zip2Strict([]int{1}, []int{2, 3})
+	require.EqualError(t, err, "slice 2 length 2 must match slice 1 length 1")
+	var lengthErr1 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr1)
+	_, err = Zip2Strict([]int{1, 2}, []int{2})
+	require.EqualError(t, err, "slice 2 length 1 must match slice 1 length 2")
+	var lengthErr2 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr2)
+}
+
+func TestT2_Unzip(t *testing.T) {
+	s1, s2 := Unzip2([]T2[int, int]{
+		New2(1, 2),
+		New2(2, 3),
+	})
+
+	require.Equal(t, []int{1, 2}, s1)
+	require.Equal(t, []int{2, 3}, s2)
+}
+
 func TestT2_Compare(t *testing.T) {
 	lesser := New2(1, 2)
 	greater := New2(2, 3)
@@ -207,6 +368,118 @@ func TestT2_CompareC(t *testing.T) {
 	}
 }
 
+func TestT2_CmpFunc(t *testing.T) {
+	tups := []T2[int, int]{
+		New2(2, 3),
+		New2(1, 2),
+		New2(3, 4),
+	}
+
+	cmp := CmpFunc2[int, int]()
+	slices.SortFunc(tups, cmp)
+	require.Equal(t, []T2[int, int]{
+		New2(1, 2),
+		New2(2, 3),
+		New2(3, 4),
+	}, tups)
+
+	index, found := slices.BinarySearchFunc(tups, New2(2, 3), cmp)
+	require.True(t, found)
+	require.Equal(t, 1, index)
+}
+
+func TestT2_CmpFuncC(t *testing.T) {
+	tups := []T2[stringComparable, stringComparable]{
+		New2(stringComparable("2"), stringComparable("3")),
+		New2(stringComparable("1"), stringComparable("2")),
+	}
+
+	slices.SortFunc(tups, CmpFunc2C[stringComparable, stringComparable]())
+	require.Equal(t, []T2[stringComparable, stringComparable]{
+		New2(stringComparable("1"), stringComparable("2")),
+		New2(stringComparable("2"), stringComparable("3")),
+	}, tups)
+}
+
+func TestT2_Compare_NaN(t *testing.T) {
+	nan := New2(math.NaN(), math.NaN())
+	num := New2(1.0, 2.0)
+
+	require.True(t, Compare2(nan, nan).EQ())
+	require.True(t, Compare2(nan, num).LT())
+	require.True(t, Compare2(num, nan).GT())
+}
+
+func TestT2_Comparator(t *testing.T) {
+	compare := Comparator2(CompareOrdered[int], CompareOrdered[int])
+	lesser := New2(1, 2)
+
+	require.Equal(t, 0, compare(lesser, lesser))
+
+	greater1 := New2(2, 2)
+	require.Equal(t, -1, compare(lesser, greater1))
+	require.Equal(t, 1, compare(greater1, lesser))
+
+	greater2 := New2(1, 3)
+	require.Equal(t, -1, compare(lesser, greater2))
+	require.Equal(t, 1, compare(greater2, lesser))
+}
+
+func TestT2_Comparator_Comparable(t *testing.T) {
+	compare := Comparator2(CompareComparable[stringComparable], CompareComparable[stringComparable])
+	lesser := New2(stringComparable("1"), stringComparable("2"))
+	greater := New2(stringComparable("2"), stringComparable("3"))
+
+	require.Equal(t, -1, compare(lesser, greater))
+	require.Equal(t, 1, compare(greater, lesser))
+	require.Equal(t, 0, compare(lesser, lesser))
+}
+
+func TestT2_Comparator_ShortCircuit(t *testing.T) {
+	unreachable := func(a, b int) int {
+		t.Fatal("comparison function should not be called")
+		return 0
+	}
+
+	compare := Comparator2(CompareOrdered[int], unreachable)
+	require.Equal(t, -1, compare(New2(1, 2), New2(2, 3)))
+}
+
+func TestT2_OrderBy(t *testing.T) {
+	lesser := New2(1, 2)
+	asc := OrderBy2[int, int](Asc, Asc)
+
+	greater1 := New2(2, 2)
+	desc1 := OrderBy2[int, int](Desc, Asc)
+	require.Equal(t, -1, asc(lesser, greater1))
+	require.Equal(t, 1, desc1(lesser, greater1))
+	require.Equal(t, 0, desc1(lesser, lesser))
+
+	greater2 := New2(1, 3)
+	desc2 := OrderBy2[int, int](Asc, Desc)
+	require.Equal(t, -1, asc(lesser, greater2))
+	require.Equal(t, 1, desc2(lesser, greater2))
+	require.Equal(t, 0, desc2(lesser, lesser))
+}
+
+func TestT2_Comparator_OrderedPtrBy(t *testing.T) {
+	compare := Comparator2(
+		OrderedBy[int](Asc),
+		OrderedPtrBy[int](Desc.NilsFirst()),
+	)
+
+	tups := []T2[int, *int]{
+		New2(1, ptr(1)),
+		New2(1, ptr(2)),
+		New2(1, (*int)(nil)),
+	}
+	slices.SortFunc(tups, compare)
+
+	require.Nil(t, tups[0].V2)
+	require.Equal(t, 2, *tups[1].V2)
+	require.Equal(t, 1, *tups[2].V2)
+}
+
 func TestT2_EqualE(t *testing.T) {
 	a := New2(intEqualable(1), intEqualable(2))
 	b := New2(intEqualable(2), intEqualable(3))
@@ -215,6 +488,32 @@ func TestT2_EqualE(t *testing.T) {
 	require.True(t, Equal2E(a, a))
 }
 
+func TestT2_EqualFunc(t *testing.T) {
+	a := New2([]int{1}, []int{2})
+	b := New2([]int{1}, []int{2})
+
+	require.True(t, EqualFunc2(a, b, slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc2(a, New2([]int{2}, []int{2}), slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc2(a, New2([]int{1}, []int{3}), slices.Equal[[]int], slices.Equal[[]int]))
+}
+
+func TestT2_EqualMethod(t *testing.T) {
+	a := New2([]int{1}, []int{2})
+
+	require.True(t, a.Equal(New2([]int{1}, []int{2})))
+	require.False(t, a.Equal(New2([]int{2}, []int{2})))
+	require.False(t, a.Equal(New2([]int{1}, []int{3})))
+}
+
+func TestT2_EqualMethod_Nested(t *testing.T) {
+	a := New2(New1(intEqualable(1)), New1(intEqualable(2)))
+	b := New2(New1(intEqualable(2)), New1(intEqualable(3)))
+
+	require.True(t, a.Equal(a))
+	require.False(t, a.Equal(b))
+	require.True(t, Equal2E(a, a))
+}
+
 func TestT2_String(t *testing.T) {
 	tup := New2("1", "2")
 	require.Equal(t, `["1" "2"]`, tup.String())
@@ -225,6 +524,31 @@ func TestT2_GoString(t *testing.T) {
 	require.Equal(t, `tuple.T2[string, string]{V1: "1", V2: "2"}`, tup.GoString())
 }
 
+func TestT2_Parse(t *testing.T) {
+	tup := New2("1", "2")
+
+	got, err := Parse2[string, string](tup.String())
+	require.NoError(t, err)
+	require.Equal(t, tup, got)
+}
+
+func TestT2_Parse_Mixed(t *testing.T) {
+	tup := New2(New2("1", 1.5), New2("2", 2.5))
+
+	got, err := Parse2[T2[string, float64], T2[string, float64]](tup.String())
+	require.NoError(t, err)
+	require.Equal(t, tup, got)
+}
+
+func TestT2_Parse_Invalid(t *testing.T) {
+	_, err := Parse2[int, int](`["1" "2"]`)
+	require.Error(t, err)
+
+	var parseErr *ParseError
+	require.ErrorAs(t, err, &parseErr)
+	require.Equal(t, 1, parseErr.Offset)
+}
+
 func TestT2_ToArray(t *testing.T) {
 	tup := New2("1", "2")
 	require.Equal(t, [2]any{
@@ -447,6 +771,352 @@ func TestT2_FromSlice(t *testing.T) {
 	}
 }
 
+func TestT2_FromSlice_Errors(t *testing.T) {
+	_, err := FromSlice2[string, string]([]any{})
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, &LengthMismatchError{Source: "slice", Expected: 2, Actual: 0}, lengthErr)
+	require.EqualError(t, err, "slice length 0 must match number of tuple values 2")
+
+	_, err = FromSlice2[string, string]([]any{1, "2"})
+	var typeErr1 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr1)
+	require.Equal(t, 0, typeErr1.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr1.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr1.Actual)
+	require.EqualError(t, err, "value at slice index 0 expected to have type string but has type int")
+
+	_, err = FromSlice2[string, string]([]any{"1", 2})
+	var typeErr2 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr2)
+	require.Equal(t, 1, typeErr2.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr2.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr2.Actual)
+	require.EqualError(t, err, "value at slice index 1 expected to have type string but has type int")
+
+	_, err = FromArray2[string, string]([2]any{})
+	var arrayTypeErr *ElementTypeError
+	require.ErrorAs(t, err, &arrayTypeErr)
+	require.Equal(t, &ElementTypeError{Source: "array", Index: 0, Expected: reflect.TypeOf("")}, arrayTypeErr)
+	require.EqualError(t, err, "value at array index 0 expected to have type string but has type <nil>")
+}
+
+func TestT2_UnmarshalJSON_Errors(t *testing.T) {
+	var tup T2[string, string]
+
+	err := json.Unmarshal([]byte(`[]`), &tup)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, 2, lengthErr.Expected)
+	require.Equal(t, 0, lengthErr.Actual)
+	require.EqualError(t, err, "unmarshalled json array length 0 must match number of tuple values 2")
+
+	err = tup.UnmarshalJSON([]byte(`["1",2]`))
+	var elementErr *ElementUnmarshalError
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, 1, elementErr.Index)
+	require.Equal(t, "2", elementErr.Value)
+	var typeErr *json.UnmarshalTypeError
+	require.ErrorAs(t, err, &typeErr)
+	require.ErrorContains(t, err, `value "2" at slice index 1 failed to unmarshal: `)
+}
+
+func TestT2_ConvertSlice(t *testing.T) {
+	tests := []struct {
+		name    string
+		slice   []any
+		want    T2[int, int]
+		wantErr bool
+	}{
+		{
+			name:  "all types match",
+			slice: []any{1, 2},
+			want:  New2(1, 2),
+		},
+		{
+			name:  "decoded json numbers",
+			slice: []any{float64(1), float64(2)},
+			want:  New2(1, 2),
+		},
+		{
+			name:  "json numbers",
+			slice: []any{json.Number("1"), json.Number("2")},
+			want:  New2(1, 2),
+		},
+		{
+			name:    "slice empty",
+			slice:   []any{},
+			wantErr: true,
+		},
+		{
+			name:    "index 1 bad type",
+			slice:   []any{"1", 2},
+			wantErr: true,
+		},
+		{
+			name:    "index 1 fractional number",
+			slice:   []any{1.5, 2},
+			wantErr: true,
+		},
+		{
+			name:    "index 2 bad type",
+			slice:   []any{1, "2"},
+			wantErr: true,
+		},
+		{
+			name:    "index 2 fractional number",
+			slice:   []any{1, 2.5},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tup, err := ConvertSlice2[int, int](tt.slice)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, tup)
+		})
+	}
+}
+
+func TestT2_ConvertSlice_Errors(t *testing.T) {
+	_, err := ConvertSlice2[int8, int8]([]any{})
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+
+	_, err = ConvertSlice2[int8, int8]([]any{1, true})
+	var typeErr *ElementTypeError
+	require.ErrorAs(t, err, &typeErr)
+	require.Equal(t, 1, typeErr.Index)
+
+	_, err = ConvertSlice2[int8, int8]([]any{1, float64(128)})
+	var conversionErr *ElementConversionError
+	require.ErrorAs(t, err, &conversionErr)
+	require.Equal(t, 1, conversionErr.Index)
+	require.Equal(t, reflect.TypeOf(int8(0)), conversionErr.Expected)
+}
+
+func TestT2_ScanRow(t *testing.T) {
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{"c1", "c2"},
+		rows: [][]driver.Value{
+			{int64(1), int64(2)},
+		},
+	})
+
+	require.True(t, rows.Next())
+	got, err := ScanRow2[string, int](rows)
+	require.NoError(t, err)
+	require.Equal(t, New2("1", 2), got)
+}
+
+func TestT2_ScanRow_ColumnsMismatch(t *testing.T) {
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{"c1", "c2", "extra"},
+		rows: [][]driver.Value{
+			{int64(1), int64(2), int64(0)},
+		},
+	})
+
+	require.True(t, rows.Next())
+	_, err := ScanRow2[int, int](rows)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.EqualError(t, err, "row columns count 3 must match number of tuple values 2")
+}
+
+func TestT2_CollectRows(t *testing.T) {
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{"c1", "c2"},
+		rows: [][]driver.Value{
+			{int64(1), int64(2)},
+			{int64(2), int64(3)},
+		},
+	})
+
+	got, err := CollectRows2[int, int](rows)
+	require.NoError(t, err)
+	require.Equal(t, []T2[int, int]{
+		New2(1, 2),
+		New2(2, 3),
+	}, got)
+
+	rows = fakeQuery(t, fakeResult{columns: []string{"c1", "c2"}})
+	got, err = CollectRows2[int, int](rows)
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
+
+func TestT2_ReadCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		opts    CSVOptions
+		want    []T2[int, int]
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			data: "",
+			want: nil,
+		},
+		{
+			name: "records",
+			data: "1,2\n2,3\n",
+			want: []T2[int, int]{
+				New2(1, 2),
+				New2(2, 3),
+			},
+		},
+		{
+			name: "header",
+			data: "v1,v2\n1,2\n",
+			opts: CSVOptions{Header: []string{"v1", "v2"}},
+			want: []T2[int, int]{New2(1, 2)},
+		},
+		{
+			name: "skip header",
+			data: "c1,c2\n1,2\n",
+			opts: CSVOptions{SkipHeader: true},
+			want: []T2[int, int]{New2(1, 2)},
+		},
+		{
+			name:    "mismatching header",
+			data:    "c1,c2\n1,2\n",
+			opts:    CSVOptions{Header: []string{"v1", "v2"}},
+			wantErr: true,
+		},
+		{
+			name: "semicolon delimiter",
+			data: "1;2\n",
+			opts: CSVOptions{Comma: ';'},
+			want: []T2[int, int]{New2(1, 2)},
+		},
+		{
+			name:    "too many fields",
+			data:    "1,2,0\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid field",
+			data:    "1,x\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadCSV2[int, int](strings.NewReader(tt.data), tt.opts)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT2_ReadCSV_Error(t *testing.T) {
+	data := "1,2\n1,x\n"
+
+	_, err := ReadCSV2[int, int](strings.NewReader(data), CSVOptions{})
+	var csvErr *CSVError
+	require.ErrorAs(t, err, &csvErr)
+	require.Equal(t, 2, csvErr.Line)
+	require.Equal(t, 2, csvErr.Column)
+
+	var elementErr *ElementUnmarshalError
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, "x", elementErr.Value)
+}
+
+func TestT2_WriteCSV(t *testing.T) {
+	tups := []T2[string, string]{
+		New2("1", "2"),
+		New2("1,\"1\"", "2,\"2\""),
+	}
+
+	var buf strings.Builder
+	err := WriteCSV2(&buf, tups, CSVOptions{Header: []string{"v1", "v2"}})
+	require.NoError(t, err)
+	require.Equal(t, "v1,v2\n1,2\n\"1,\"\"1\"\"\",\"2,\"\"2\"\"\"\n", buf.String())
+
+	got, err := ReadCSV2[string, string](strings.NewReader(buf.String()), CSVOptions{SkipHeader: true})
+	require.NoError(t, err)
+	require.Equal(t, tups, got)
+}
+
+func TestT2_WriteCSV_Error(t *testing.T) {
+	tups := []T2[string, []int]{
+		{V1: "a\nb", V2: []int{2}},
+	}
+
+	var buf strings.Builder
+	err := WriteCSV2(&buf, tups, CSVOptions{Header: []string{"v1", "v2"}})
+	var csvErr *CSVError
+	require.ErrorAs(t, err, &csvErr)
+	require.Equal(t, 2, csvErr.Line)
+	require.Equal(t, 2, csvErr.Column)
+
+	err = WriteCSV2(&buf, tups, CSVOptions{Header: []string{"v1", "v2", "extra"}})
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+}
+
+func TestT2_Hash(t *testing.T) {
+	seed := maphash.MakeSeed()
+	tup := New2("1", "2")
+
+	require.Equal(t, tup.Hash(seed), New2("1", "2").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New2("2", "2").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New2("1", "3").Hash(seed))
+}
+
+func TestT2_Hash_Nested(t *testing.T) {
+	seed := maphash.MakeSeed()
+	tup := New2(New2("1", 1), New2("2", 2))
+
+	require.Equal(t, tup.Hash(seed), New2(New2("1", 1), New2("2", 2)).Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New2(New2("1", 2), New2("2", 3)).Hash(seed))
+}
+
+func TestT2_Hash_Unsupported(t *testing.T) {
+	seed := maphash.MakeSeed()
+	tup := New2([]int{1}, []int{2})
+	require.NotPanics(t, func() {
+		tup.Hash(seed)
+	})
+	require.Equal(t, tup.Hash(seed), New2([]int{1}, []int{2}).Hash(seed))
+
+	var nilTup T2[any, any]
+	require.NotPanics(t, func() {
+		nilTup.Hash(seed)
+	})
+	require.Panics(t, func() {
+		HashValue(seed, tup.V1)
+	})
+}
+
+func TestT2_HashFunc(t *testing.T) {
+	seed := maphash.MakeSeed()
+	hashSlice := func(seed maphash.Seed, v []int) uint64 {
+		return HashValue(seed, len(v))
+	}
+
+	hash := HashFunc2(hashSlice, hashSlice)
+	tup := New2([]int{1}, []int{2})
+
+	require.Equal(t, hash(seed, tup), hash(seed, New2([]int{2}, []int{3})))
+	require.NotEqual(t, hash(seed, tup), hash(seed, New2([]int{1, 2}, []int{2, 3})))
+}
+
 func TestT2_MarshalJSON(t *testing.T) {
 	tup := New2("1", "2")
 
@@ -555,3 +1225,611 @@ func TestT2_Marshal_Unmarshal(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, tup, unmarshalled)
 }
+
+func TestT2_UnmarshalJSONWith(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		opts    JSONDecodeOptions
+		want    T2[string, string]
+		wantErr bool
+	}{
+		{
+			name:    "empty json array",
+			data:    `[]`,
+			wantErr: true,
+		},
+		{
+			name: "allow shorter empty json array",
+			data: `[]`,
+			opts: JSONDecodeOptions{AllowShorter: true},
+			want: T2[string, string]{},
+		},
+		{
+			name: "allow shorter partial json array",
+			data: `["1"]`,
+			opts: JSONDecodeOptions{AllowShorter: true},
+			want: T2[string, string]{V1: "1"},
+		},
+		{
+			name:    "allow shorter longer json array",
+			data:    `["1","2","10"]`,
+			opts:    JSONDecodeOptions{AllowShorter: true},
+			wantErr: true,
+		},
+		{
+			name: "json array with null values",
+			data: `[null,null]`,
+			want: T2[string, string]{},
+		},
+		{
+			name:    "disallow null json array with null values",
+			data:    `[null,null]`,
+			opts:    JSONDecodeOptions{DisallowNull: true},
+			wantErr: true,
+		},
+		{
+			name: "disallow null json array of valid types",
+			data: `["1","2"]`,
+			opts: JSONDecodeOptions{DisallowNull: true},
+			want: New2("1", "2"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T2[string, string]
+			err := got.UnmarshalJSONWith([]byte(tt.data), tt.opts)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT2_UnmarshalJSONWith_Nested(t *testing.T) {
+	type Custom struct {
+		Name string `json:"name"`
+	}
+
+	data := []byte(`[[1, {"name": "1", "age": 1}],[2, {"name": "2", "age": 2}]]`)
+
+	var got T2[T2[any, Custom], T2[any, Custom]]
+	err := got.UnmarshalJSONWith(data, JSONDecodeOptions{UseNumber: true})
+	require.NoError(t, err)
+	require.Equal(t, New2(New2[any](json.Number("1"), Custom{Name: "1"}), New2[any](json.Number("2"), Custom{Name: "2"})), got)
+
+	err = got.UnmarshalJSONWith(data, JSONDecodeOptions{DisallowUnknownFields: true})
+	require.Error(t, err)
+}
+
+func TestT2_MarshalJSONLabeled(t *testing.T) {
+	tup := New2(1, 2)
+	labels := WithLabels("v1", "v2")
+
+	got, err := tup.MarshalJSONLabeled(labels)
+	require.NoError(t, err)
+	require.Equal(t, `{"v1":1,"v2":2}`, string(got))
+
+	_, err = tup.MarshalJSONLabeled(WithLabels("v1", "v2", "extra"))
+	require.Error(t, err)
+}
+
+func TestT2_UnmarshalJSONLabeled(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		labels  Labels
+		want    T2[int, int]
+		wantErr bool
+	}{
+		{
+			name:   "all keys",
+			data:   `{"v1":1,"v2":2}`,
+			labels: WithLabels("v1", "v2"),
+			want:   New2(1, 2),
+		},
+		{
+			name:   "missing keys",
+			data:   `{}`,
+			labels: WithLabels("v1", "v2"),
+			want:   T2[int, int]{},
+		},
+		{
+			name:    "strict missing keys",
+			data:    `{}`,
+			labels:  WithLabels("v1", "v2").Strict(),
+			wantErr: true,
+		},
+		{
+			name:   "unknown keys",
+			data:   `{"v1":1,"v2":2,"extra":0}`,
+			labels: WithLabels("v1", "v2"),
+			want:   New2(1, 2),
+		},
+		{
+			name:    "strict unknown keys",
+			data:    `{"v1":1,"v2":2,"extra":0}`,
+			labels:  WithLabels("v1", "v2").Strict(),
+			wantErr: true,
+		},
+		{
+			name:    "json array",
+			data:    `[1,2]`,
+			labels:  WithLabels("v1", "v2"),
+			wantErr: true,
+		},
+		{
+			name:    "labels count mismatch",
+			data:    `{}`,
+			labels:  WithLabels("v1", "v2", "extra"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T2[int, int]
+			err := got.UnmarshalJSONLabeled([]byte(tt.data), tt.labels)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT2_MarshalText(t *testing.T) {
+	tup := New2("1", "2")
+
+	got, err := tup.MarshalText()
+	require.NoError(t, err)
+	require.Equal(t, `1,2`, string(got))
+}
+
+func TestT2_MarshalText_Unsupported(t *testing.T) {
+	tup := New2([]int{1}, []int{2})
+
+	_, err := tup.MarshalText()
+	require.Error(t, err)
+}
+
+func TestT2_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    T2[string, string]
+		wantErr bool
+	}{
+		{
+			name: "valid values",
+			data: `1,2`,
+			want: New2("1", "2"),
+		},
+		{
+			name: "escaped values",
+			data: `a\,1\\,a\,2\\`,
+			want: New2(`a,1\`, `a,2\`),
+		},
+		{
+			name:    "too few values",
+			data:    `2`,
+			wantErr: true,
+		},
+		{
+			name:    "too many values",
+			data:    `1,2,extra`,
+			wantErr: true,
+		},
+		{
+			name:    "dangling escape",
+			data:    `1,2\`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T2[string, string]
+			err := got.UnmarshalText([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT2_MarshalText_UnmarshalText(t *testing.T) {
+	tup := New2(New2("a,1\\\\", 1), New2("a,2\\\\", 2))
+
+	marshalled, err := tup.MarshalText()
+	require.NoError(t, err)
+
+	var unmarshalled T2[T2[string, int], T2[string, int]]
+	err = unmarshalled.UnmarshalText(marshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT2_GobEncode_GobDecode(t *testing.T) {
+	t.Run("strings", func(t *testing.T) {
+		requireGobRoundTrip(t, New2("1", "2"))
+	})
+
+	t.Run("zero values", func(t *testing.T) {
+		requireGobRoundTrip(t, T2[int, int]{})
+	})
+
+	t.Run("nested tuples", func(t *testing.T) {
+		requireGobRoundTrip(t, New2(New2("1", 1), New2("2", 2)))
+	})
+
+	t.Run("pointers", func(t *testing.T) {
+		requireGobRoundTrip(t, New2(ptr(1), ptr(2)))
+	})
+
+	t.Run("pointers to zero values", func(t *testing.T) {
+		requireGobRoundTrip(t, New2(ptr(0), ptr(0)))
+	})
+
+	t.Run("nil pointers", func(t *testing.T) {
+		requireGobRoundTrip(t, T2[*int, *int]{})
+	})
+
+	t.Run("nested tuple pointers", func(t *testing.T) {
+		requireGobRoundTrip(t, New2((*T2[string, *int])(nil), &T2[string, *int]{V1: "2", V2: ptr(2)}))
+	})
+
+	t.Run("interface values", func(t *testing.T) {
+		requireGobRoundTrip(t, T2[any, any]{V1: nil, V2: "2"})
+	})
+
+	t.Run("struct field", func(t *testing.T) {
+		type Custom struct {
+			Tup T2[int, int]
+		}
+
+		requireGobRoundTrip(t, Custom{Tup: New2(1, 2)})
+	})
+}
+
+func TestT2_GobDecode_Invalid(t *testing.T) {
+	encoded, err := New1("1").GobEncode()
+	require.NoError(t, err)
+
+	var tup T2[string, string]
+	err = tup.GobDecode(encoded)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, 2, lengthErr.Expected)
+
+	encoded, err = New2("1", "2").GobEncode()
+	require.NoError(t, err)
+
+	var mismatched T2[int, int]
+	require.Error(t, mismatched.GobDecode(encoded))
+	require.Error(t, tup.GobDecode(nil))
+}
+
+func TestT2_MarshalComposite(t *testing.T) {
+	got, err := New2("foo bar", 2).MarshalComposite()
+	require.NoError(t, err)
+	require.Equal(t, `("foo bar",2)`, string(got))
+
+	got, err = T2[*int, *int]{}.MarshalComposite()
+	require.NoError(t, err)
+	require.Equal(t, `(,)`, string(got))
+
+	got, err = New2(New2("1", 1), New2("2", 2)).MarshalComposite()
+	require.NoError(t, err)
+	require.Equal(t, `("(1,1)","(2,2)")`, string(got))
+}
+
+func TestT2_UnmarshalComposite(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    T2[string, string]
+		wantErr bool
+	}{
+		{
+			name: "unquoted values",
+			data: `(1,2)`,
+			want: New2("1", "2"),
+		},
+		{
+			name: "quoted values",
+			data: `("1, \\""","2, \\""")`,
+			want: New2("1, \\\"", "2, \\\""),
+		},
+		{
+			name: "empty values",
+			data: `("","")`,
+			want: T2[string, string]{},
+		},
+		{
+			name:    "null values",
+			data:    `(,)`,
+			wantErr: true,
+		},
+		{
+			name:    "too many fields",
+			data:    `(1,2,0)`,
+			wantErr: true,
+		},
+		{
+			name:    "missing parentheses",
+			data:    `1,2`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T2[string, string]
+			err := got.UnmarshalComposite([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT2_MarshalComposite_UnmarshalComposite(t *testing.T) {
+	tup := New2((*T2[string, *int])(nil), &T2[string, *int]{V1: "(2, \"2\\\")", V2: ptr(2)})
+
+	marshalled, err := tup.MarshalComposite()
+	require.NoError(t, err)
+
+	var unmarshalled T2[*T2[string, *int], *T2[string, *int]]
+	err = unmarshalled.UnmarshalComposite(marshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT2_MarshalXML(t *testing.T) {
+	got, err := xml.Marshal(New2(1, 2))
+	require.NoError(t, err)
+	require.Equal(t, `<T2><v1>1</v1><v2>2</v2></T2>`, string(got))
+
+	type Custom struct {
+		XMLName xml.Name           `xml:"custom"`
+		Tup     T2[string, string] `xml:"tup"`
+	}
+
+	got, err = xml.Marshal(Custom{Tup: New2("<1>", "<2>")})
+	require.NoError(t, err)
+	require.Equal(t, `<custom><tup><v1>&lt;1&gt;</v1><v2>&lt;2&gt;</v2></tup></custom>`, string(got))
+}
+
+func TestT2_UnmarshalXML(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    T2[int, int]
+		wantErr bool
+	}{
+		{
+			name: "all elements",
+			data: `<T2><v1>1</v1><v2>2</v2></T2>`,
+			want: New2(1, 2),
+		},
+		{
+			name: "whitespace and comments",
+			data: `<T2>
+				<!-- v1 --> <v1> 1 </v1>
+				<!-- v2 --> <v2> 2 </v2>
+			</T2>`,
+			want: New2(1, 2),
+		},
+		{
+			name:    "no elements",
+			data:    `<T2></T2>`,
+			wantErr: true,
+		},
+		{
+			name:    "too many elements",
+			data:    `<T2><v1>1</v1><v2>2</v2><v0>0</v0></T2>`,
+			wantErr: true,
+		},
+		{
+			name:    "wrong element names",
+			data:    `<T2><V1>1</V1><V2>2</V2></T2>`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid value",
+			data:    `<T2><v1>1</v1><v2>x</v2></T2>`,
+			wantErr: true,
+		},
+		{
+			name:    "unterminated",
+			data:    `<T2><v1>1</v1><v2>2</v2>`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T2[int, int]
+			err := xml.Unmarshal([]byte(tt.data), &got)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT2_UnmarshalXML_Errors(t *testing.T) {
+	var tup T2[int, int]
+
+	err := xml.Unmarshal([]byte(`<T2><v1>1</v1></T2>`), &tup)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.EqualError(t, err, "unmarshalled xml elements count 1 must match number of tuple values 2")
+
+	err = xml.Unmarshal([]byte(`<T2><v1>1</v1><v2>2</v2><v0>0</v0><v0>0</v0></T2>`), &tup)
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, 4, lengthErr.Actual)
+
+	err = xml.Unmarshal([]byte(`<T2><v1>1</v1><v2>x</v2></T2>`), &tup)
+	var elementErr *ElementUnmarshalError
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, 1, elementErr.Index)
+	require.Equal(t, "v2", elementErr.Label)
+}
+
+func TestT2_MarshalXML_UnmarshalXML_NilPointers(t *testing.T) {
+	tup := New2((*int)(nil), ptr(2))
+
+	got, err := xml.Marshal(tup)
+	require.NoError(t, err)
+	require.Equal(t, `<T2><v1 nil="true"></v1><v2>2</v2></T2>`, string(got))
+
+	unmarshalled := New2(ptr(0), ptr(0))
+	err = xml.Unmarshal(got, &unmarshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT2_MarshalXML_UnmarshalXML_Slices(t *testing.T) {
+	tup := New2([]int{1, 2}, []int{2, 3})
+
+	got, err := xml.Marshal(tup)
+	require.NoError(t, err)
+	require.Equal(t, `<T2><v1><item>1</item><item>2</item></v1><v2><item>2</item><item>3</item></v2></T2>`, string(got))
+
+	var unmarshalled T2[[]int, []int]
+	err = xml.Unmarshal(got, &unmarshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT2_MarshalXMLLabeled_UnmarshalXMLLabeled(t *testing.T) {
+	tup := New2(New2("1", ptr(1)), New2("2", ptr(2)))
+	labels := WithLabels("item1", "item2")
+
+	var buf strings.Builder
+	encoder := xml.NewEncoder(&buf)
+	err := tup.MarshalXMLLabeled(encoder, xml.StartElement{Name: xml.Name{Local: "items"}}, labels)
+	require.NoError(t, err)
+	require.NoError(t, encoder.Flush())
+	require.Equal(t, `<items><item1><v1>1</v1><v2>1</v2></item1><item2><v1>2</v1><v2>2</v2></item2></items>`, buf.String())
+
+	decoder := xml.NewDecoder(strings.NewReader(buf.String()))
+	start, err := decoder.Token()
+	require.NoError(t, err)
+
+	var unmarshalled T2[T2[string, *int], T2[string, *int]]
+	err = unmarshalled.UnmarshalXMLLabeled(decoder, start.(xml.StartElement), labels)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+
+	err = tup.MarshalXMLLabeled(encoder, xml.StartElement{Name: xml.Name{Local: "items"}}, WithLabels("item1", "item2", "extra"))
+	require.Error(t, err)
+}
+
+func TestT2_MarshalBinary(t *testing.T) {
+	tup := New2(uint16(1), uint16(2))
+
+	got, err := tup.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 0, 2, 0}, got)
+
+	got, err = tup.AppendBinaryOrder([]byte{0xff}, binary.BigEndian)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0xff, 0, 1, 0, 2}, got)
+
+	_, err = New2(1, []int{2}).MarshalBinary()
+	require.Error(t, err)
+}
+
+func TestT2_MarshalBinary_UnmarshalBinary(t *testing.T) {
+	tup := New2(
+		int64(-1),
+		"foo2",
+	)
+
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		marshalled, err := tup.AppendBinaryOrder(nil, order.(binary.AppendByteOrder))
+		require.NoError(t, err)
+
+		var unmarshalled T2[int64, string]
+		err = unmarshalled.UnmarshalBinaryOrder(marshalled, order)
+		require.NoError(t, err)
+		require.Equal(t, tup, unmarshalled)
+	}
+}
+
+func TestT2_UnmarshalBinary_Invalid(t *testing.T) {
+	marshalled, err := New2(uint16(1), uint16(2)).MarshalBinary()
+	require.NoError(t, err)
+
+	var tup T2[uint16, uint16]
+	err = tup.UnmarshalBinary(marshalled[:len(marshalled)-1])
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	require.EqualError(t, err, "value at index 1 failed to unmarshal: unexpected EOF")
+
+	err = tup.UnmarshalBinary(append(marshalled, 0))
+	require.EqualError(t, err, "binary data has 1 trailing bytes")
+}
+
+func TestT2_BinarySlice(t *testing.T) {
+	tups := []T2[int, int]{
+		New2(1, 2),
+		New2(-1, -2),
+	}
+
+	marshalled, err := AppendBinarySlice2(nil, binary.LittleEndian, tups)
+	require.NoError(t, err)
+	require.Len(t, marshalled, 1+2*2*8)
+
+	got, err := UnmarshalBinarySlice2[int, int](marshalled, binary.LittleEndian, nil)
+	require.NoError(t, err)
+	require.Equal(t, tups, got)
+
+	got, err = UnmarshalBinarySlice2(marshalled, binary.LittleEndian, got)
+	require.NoError(t, err)
+	require.Equal(t, append(tups, tups...), got)
+
+	empty, err := AppendBinarySlice2[int, int](nil, binary.LittleEndian, nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0}, empty)
+
+	_, err = AppendBinarySlice2(nil, binary.LittleEndian, []T2[[]int, []int]{{}})
+	require.Error(t, err)
+}
+
+func TestT2_MarshalJSON_MapKey(t *testing.T) {
+	m := map[T2[string, string]]int{
+		New2("1", "2"): 1,
+	}
+
+	marshalled, err := json.Marshal(m)
+	require.NoError(t, err)
+	require.Equal(t, `{"1,2":1}`, string(marshalled))
+
+	var unmarshalled map[T2[string, string]]int
+	err = json.Unmarshal(marshalled, &unmarshalled)
+	require.NoError(t, err)
+	require.Equal(t, m, unmarshalled)
+}
@@ -0,0 +1,62 @@
+//go:build go1.23
+
+package tuple
+
+import (
+	"io"
+	"iter"
+)
+
+// All returns an iterator over the index and value of each of the tuple values.
+func (t T3[Ty1, Ty2, Ty3]) All() iter.Seq2[int, any] {
+	return func(yield func(int, any) bool) {
+		if !yield(0, t.V1) {
+			return
+		}
+		if !yield(1, t.V2) {
+			return
+		}
+		if !yield(2, t.V3) {
+			return
+		}
+	}
+}
+
+// ZipSeq3 returns an iterator over tuples, where each tuple holds the next value of each of the given iterators.
+// The returned iterator stops once any of the given iterators is exhausted.
+func ZipSeq3[Ty1, Ty2, Ty3 any](s1 iter.Seq[Ty1], s2 iter.Seq[Ty2], s3 iter.Seq[Ty3]) iter.Seq[T3[Ty1, Ty2, Ty3]] {
+	return func(yield func(T3[Ty1, Ty2, Ty3]) bool) {
+		next2, stop2 := iter.Pull(s2)
+		defer stop2()
+		next3, stop3 := iter.Pull(s3)
+		defer stop3()
+
+		for v1 := range s1 {
+			v2, ok := next2()
+			if !ok {
+				return
+			}
+			v3, ok := next3()
+			if !ok {
+				return
+			}
+
+			if !yield(New3(v1, v2, v3)) {
+				return
+			}
+		}
+	}
+}
+
+// ReadCSVSeq3 returns an iterator over the CSV records of r converted into tuples, the same way as ReadCSV3 does.
+// Records that fail to parse or convert are yielded along with their errors, and the iteration continues with the next record.
+// Other errors, such as read errors, are yielded once and stop the iteration.
+func ReadCSVSeq3[Ty1, Ty2, Ty3 any](r io.Reader, opts CSVOptions) iter.Seq2[T3[Ty1, Ty2, Ty3], error] {
+	return readCSVSeq(r, opts, func(t *T3[Ty1, Ty2, Ty3]) []any {
+		return []any{
+			&t.V1,
+			&t.V2,
+			&t.V3,
+		}
+	})
+}
@@ -0,0 +1,84 @@
+//go:build go1.23
+
+package tuple
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestT3_All(t *testing.T) {
+	tup := New3("1", "2", "3")
+
+	var indexes []int
+	var values []any
+	for index, value := range tup.All() {
+		indexes = append(indexes, index)
+		values = append(values, value)
+	}
+
+	require.Equal(t, []int{0, 1, 2}, indexes)
+	require.Equal(t, tup.Slice(), values)
+}
+
+func TestT3_All_Break(t *testing.T) {
+	tup := New3("1", "2", "3")
+
+	var values []any
+	for _, value := range tup.All() {
+		values = append(values, value)
+		break
+	}
+
+	require.Equal(t, []any{"1"}, values)
+}
+
+func TestT3_ZipSeq(t *testing.T) {
+	want := []T3[int, int, int]{
+		New3(1, 2, 3),
+		New3(2, 3, 4),
+	}
+
+	require.Equal(t, want, slices.Collect(ZipSeq3(slices.Values([]int{1, 2}), slices.Values([]int{2, 3}), slices.Values([]int{3, 4}))))
+	require.Equal(t, want[:1], slices.Collect(ZipSeq3(slices.Values([]int{1}), slices.Values([]int{2, 3}), slices.Values([]int{3, 4}))))
+	require.Equal(t, want[:1], slices.Collect(ZipSeq3(slices.Values([]int{1, 2}), slices.Values([]int{2}), slices.Values([]int{3, 4}))))
+	require.Equal(t, want[:1], slices.Collect(ZipSeq3(slices.Values([]int{1, 2}), slices.Values([]int{2, 3}), slices.Values([]int{3}))))
+}
+
+func TestT3_ZipSeq_Break(t *testing.T) {
+	var got []T3[int, int, int]
+	for tup := range ZipSeq3(slices.Values([]int{1, 2}), slices.Values([]int{2, 3}), slices.Values([]int{3, 4})) {
+		got = append(got, tup)
+		break
+	}
+
+	require.Equal(t, []T3[int, int, int]{New3(1, 2, 3)}, got)
+}
+
+func TestT3_ReadCSVSeq(t *testing.T) {
+	data := "1,2,3\nx,x,x\n2,3,4\n"
+
+	var got []T3[int, int, int]
+	var errs []error
+	for tup, err := range ReadCSVSeq3[int, int, int](strings.NewReader(data), CSVOptions{}) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		got = append(got, tup)
+	}
+
+	require.Equal(t, []T3[int, int, int]{
+		New3(1, 2, 3),
+		New3(2, 3, 4),
+	}, got)
+	require.Len(t, errs, 1)
+
+	var csvErr *CSVError
+	require.ErrorAs(t, errs[0], &csvErr)
+	require.Equal(t, 2, csvErr.Line)
+	require.Equal(t, 1, csvErr.Column)
+}
@@ -1,7 +1,17 @@
 package tuple
 
 import (
+	"database/sql/driver"
+	"encoding/binary"
 	"encoding/json"
+	"encoding/xml"
+	"hash/maphash"
+	"io"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -29,6 +39,161 @@ func TestT3_Values(t *testing.T) {
 	require.Equal(t, "3", v3)
 }
 
+func TestT3_MapAt(t *testing.T) {
+	tup := New3(1, 2, 3)
+
+	require.Equal(t, New3("1", 2, 3), Map3At1(tup, strconv.Itoa))
+	require.Equal(t, New3(1, "2", 3), Map3At2(tup, strconv.Itoa))
+	require.Equal(t, New3(1, 2, "3"), Map3At3(tup, strconv.Itoa))
+}
+
+func TestT3_Map(t *testing.T) {
+	tup := New3(1, 2, 3)
+	got := Map3(tup, strconv.Itoa, strconv.Itoa, strconv.Itoa)
+	require.Equal(t, New3("1", "2", "3"), got)
+}
+
+func TestT3_Append(t *testing.T) {
+	tup := New3("1", "2", "3")
+	require.Equal(t, New4("1", "2", "3", "4"), Append3(tup, "4"))
+}
+
+func TestT3_Prepend(t *testing.T) {
+	tup := New3("2", "3", "4")
+	require.Equal(t, New4("1", "2", "3", "4"), Prepend3(tup, "1"))
+}
+
+func TestT3_Concat(t *testing.T) {
+	tup := New3("1", "2", "3")
+
+	require.Equal(t,
+		New4("1", "2", "3", "4"),
+		Concat3_1(tup, New1("4")),
+	)
+	require.Equal(t,
+		New5("1", "2", "3", "4", "5"),
+		Concat3_2(tup, New2("4", "5")),
+	)
+	require.Equal(t,
+		New6("1", "2", "3", "4", "5", "6"),
+		Concat3_3(tup, New3("4", "5", "6")),
+	)
+	require.Equal(t,
+		New7("1", "2", "3", "4", "5", "6", "7"),
+		Concat3_4(tup, New4("4", "5", "6", "7")),
+	)
+	require.Equal(t,
+		New8("1", "2", "3", "4", "5", "6", "7", "8"),
+		Concat3_5(tup, New5("4", "5", "6", "7", "8")),
+	)
+	require.Equal(t,
+		New9("1", "2", "3", "4", "5", "6", "7", "8", "9"),
+		Concat3_6(tup, New6("4", "5", "6", "7", "8", "9")),
+	)
+}
+
+func TestT3_Take(t *testing.T) {
+	tup := New3("1", "2", "3")
+
+	require.Equal(t, New1("1"), Take3_1(tup))
+	require.Equal(t, New2("1", "2"), Take3_2(tup))
+}
+
+func TestT3_Drop(t *testing.T) {
+	tup := New3("1", "2", "3")
+
+	require.Equal(t, New2("2", "3"), Drop3_1(tup))
+	require.Equal(t, New1("3"), Drop3_2(tup))
+}
+
+func TestT3_Split(t *testing.T) {
+	tup := New3("1", "2", "3")
+
+	require.Equal(t,
+		New2(New1("1"), New2("2", "3")),
+		Split3_1(tup),
+	)
+	require.Equal(t,
+		New2(New2("1", "2"), New1("3")),
+		Split3_2(tup),
+	)
+}
+
+func TestT3_Reverse(t *testing.T) {
+	tup := New3(1, 2, 3)
+	require.Equal(t, New3(3, 2, 1), Reverse3(tup))
+}
+
+func TestT3_Apply(t *testing.T) {
+	tup := New3("1", "2", "3")
+	got := Apply3(tup, func(v1, v2, v3 string) string {
+		return v1 + v2 + v3
+	})
+	require.Equal(t, "123", got)
+}
+
+func TestT3_Tupled(t *testing.T) {
+	tup := New3("1", "2", "3")
+	f := Tupled3(func(v1, v2, v3 string) string {
+		return v1 + v2 + v3
+	})
+	require.Equal(t, "123", f(tup))
+}
+
+func TestT3_Untupled(t *testing.T) {
+	f := Untupled3(func(tup T3[string, string, string]) T3[string, string, string] {
+		return tup
+	})
+	require.Equal(t, New3("1", "2", "3"), f("1", "2", "3"))
+}
+
+func TestT3_Zip(t *testing.T) {
+	want := []T3[int, int, int]{
+		New3(1, 2, 3),
+		New3(2, 3, 4),
+	}
+
+	require.Equal(t, want, Zip3([]int{1, 2}, []int{2, 3}, []int{3, 4}))
+	require.Equal(t, want[:1], Zip3([]int{1}, []int{2, 3}, []int{3, 4}))
+	require.Equal(t, want[:1], Zip3([]int{1, 2}, []int{2}, []int{3, 4}))
+	require.Equal(t, want[:1], Zip3([]int{1, 2}, []int{2, 3}, []int{3}))
+}
+
+func TestT3_ZipStrict(t *testing.T) {
+	want := []T3[int, int, int]{
+		New3(1, 2, 3),
+		New3(2, 3, 4),
+	}
+
+	got, err := Zip3Strict([]int{1, 2}, []int{2, 3}, []int{3, 4})
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	_, err = Zip3Strict([]int{1}, []int{2, 3}, []int{3, 4})
+	require.EqualError(t, err, "slice 2 length 2 must match slice 1 length 1")
+	var lengthErr1 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr1)
+	_, err = Zip3Strict([]int{1, 2}, []int{2}, []int{3, 4})
+	require.EqualError(t, err, "slice 2 length 1 must match slice 1 length 2")
+	var lengthErr2 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr2)
+	_, err = Zip3Strict([]int{1, 2}, []int{2, 3}, []int{3})
+	require.EqualError(t, err, "slice 3 length 1 must match slice 1 length 2")
+	var lengthErr3 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr3)
+}
+
+func TestT3_Unzip(t *testing.T) {
+	s1, s2, s3 := Unzip3([]T3[int, int, int]{
+		New3(1, 2, 3),
+		New3(2, 3, 4),
+	})
+
+	require.Equal(t, []int{1, 2}, s1)
+	require.Equal(t, []int{2, 3}, s2)
+	require.Equal(t, []int{3, 4}, s3)
+}
+
 func TestT3_Compare(t *testing.T) {
 	lesser := New3(1, 2, 3)
 	greater := New3(2, 3, 4)
@@ -209,6 +374,129 @@ func TestT3_CompareC(t *testing.T) {
 	}
 }
 
+func TestT3_CmpFunc(t *testing.T) {
+	tups := []T3[int, int, int]{
+		New3(2, 3, 4),
+		New3(1, 2, 3),
+		New3(3, 4, 5),
+	}
+
+	cmp := CmpFunc3[int, int, int]()
+	slices.SortFunc(tups, cmp)
+	require.Equal(t, []T3[int, int, int]{
+		New3(1, 2, 3),
+		New3(2, 3, 4),
+		New3(3, 4, 5),
+	}, tups)
+
+	index, found := slices.BinarySearchFunc(tups, New3(2, 3, 4), cmp)
+	require.True(t, found)
+	require.Equal(t, 1, index)
+}
+
+func TestT3_CmpFuncC(t *testing.T) {
+	tups := []T3[stringComparable, stringComparable, stringComparable]{
+		New3(stringComparable("2"), stringComparable("3"), stringComparable("4")),
+		New3(stringComparable("1"), stringComparable("2"), stringComparable("3")),
+	}
+
+	slices.SortFunc(tups, CmpFunc3C[stringComparable, stringComparable, stringComparable]())
+	require.Equal(t, []T3[stringComparable, stringComparable, stringComparable]{
+		New3(stringComparable("1"), stringComparable("2"), stringComparable("3")),
+		New3(stringComparable("2"), stringComparable("3"), stringComparable("4")),
+	}, tups)
+}
+
+func TestT3_Compare_NaN(t *testing.T) {
+	nan := New3(math.NaN(), math.NaN(), math.NaN())
+	num := New3(1.0, 2.0, 3.0)
+
+	require.True(t, Compare3(nan, nan).EQ())
+	require.True(t, Compare3(nan, num).LT())
+	require.True(t, Compare3(num, nan).GT())
+}
+
+func TestT3_Comparator(t *testing.T) {
+	compare := Comparator3(CompareOrdered[int], CompareOrdered[int], CompareOrdered[int])
+	lesser := New3(1, 2, 3)
+
+	require.Equal(t, 0, compare(lesser, lesser))
+
+	greater1 := New3(2, 2, 3)
+	require.Equal(t, -1, compare(lesser, greater1))
+	require.Equal(t, 1, compare(greater1, lesser))
+
+	greater2 := New3(1, 3, 3)
+	require.Equal(t, -1, compare(lesser, greater2))
+	require.Equal(t, 1, compare(greater2, lesser))
+
+	greater3 := New3(1, 2, 4)
+	require.Equal(t, -1, compare(lesser, greater3))
+	require.Equal(t, 1, compare(greater3, lesser))
+}
+
+func TestT3_Comparator_Comparable(t *testing.T) {
+	compare := Comparator3(CompareComparable[stringComparable], CompareComparable[stringComparable], CompareComparable[stringComparable])
+	lesser := New3(stringComparable("1"), stringComparable("2"), stringComparable("3"))
+	greater := New3(stringComparable("2"), stringComparable("3"), stringComparable("4"))
+
+	require.Equal(t, -1, compare(lesser, greater))
+	require.Equal(t, 1, compare(greater, lesser))
+	require.Equal(t, 0, compare(lesser, lesser))
+}
+
+func TestT3_Comparator_ShortCircuit(t *testing.T) {
+	unreachable := func(a, b int) int {
+		t.Fatal("comparison function should not be called")
+		return 0
+	}
+
+	compare := Comparator3(CompareOrdered[int], unreachable, unreachable)
+	require.Equal(t, -1, compare(New3(1, 2, 3), New3(2, 3, 4)))
+}
+
+func TestT3_OrderBy(t *testing.T) {
+	lesser := New3(1, 2, 3)
+	asc := OrderBy3[int, int, int](Asc, Asc, Asc)
+
+	greater1 := New3(2, 2, 3)
+	desc1 := OrderBy3[int, int, int](Desc, Asc, Asc)
+	require.Equal(t, -1, asc(lesser, greater1))
+	require.Equal(t, 1, desc1(lesser, greater1))
+	require.Equal(t, 0, desc1(lesser, lesser))
+
+	greater2 := New3(1, 3, 3)
+	desc2 := OrderBy3[int, int, int](Asc, Desc, Asc)
+	require.Equal(t, -1, asc(lesser, greater2))
+	require.Equal(t, 1, desc2(lesser, greater2))
+	require.Equal(t, 0, desc2(lesser, lesser))
+
+	greater3 := New3(1, 2, 4)
+	desc3 := OrderBy3[int, int, int](Asc, Asc, Desc)
+	require.Equal(t, -1, asc(lesser, greater3))
+	require.Equal(t, 1, desc3(lesser, greater3))
+	require.Equal(t, 0, desc3(lesser, lesser))
+}
+
+func TestT3_Comparator_OrderedPtrBy(t *testing.T) {
+	compare := Comparator3(
+		OrderedBy[int](Asc),
+		OrderedBy[int](Asc),
+		OrderedPtrBy[int](Desc.NilsFirst()),
+	)
+
+	tups := []T3[int, int, *int]{
+		New3(1, 2, ptr(1)),
+		New3(1, 2, ptr(2)),
+		New3(1, 2, (*int)(nil)),
+	}
+	slices.SortFunc(tups, compare)
+
+	require.Nil(t, tups[0].V3)
+	require.Equal(t, 2, *tups[1].V3)
+	require.Equal(t, 1, *tups[2].V3)
+}
+
 func TestT3_EqualE(t *testing.T) {
 	a := New3(intEqualable(1), intEqualable(2), intEqualable(3))
 	b := New3(intEqualable(2), intEqualable(3), intEqualable(4))
@@ -217,6 +505,34 @@ func TestT3_EqualE(t *testing.T) {
 	require.True(t, Equal3E(a, a))
 }
 
+func TestT3_EqualFunc(t *testing.T) {
+	a := New3([]int{1}, []int{2}, []int{3})
+	b := New3([]int{1}, []int{2}, []int{3})
+
+	require.True(t, EqualFunc3(a, b, slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc3(a, New3([]int{2}, []int{2}, []int{3}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc3(a, New3([]int{1}, []int{3}, []int{3}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc3(a, New3([]int{1}, []int{2}, []int{4}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+}
+
+func TestT3_EqualMethod(t *testing.T) {
+	a := New3([]int{1}, []int{2}, []int{3})
+
+	require.True(t, a.Equal(New3([]int{1}, []int{2}, []int{3})))
+	require.False(t, a.Equal(New3([]int{2}, []int{2}, []int{3})))
+	require.False(t, a.Equal(New3([]int{1}, []int{3}, []int{3})))
+	require.False(t, a.Equal(New3([]int{1}, []int{2}, []int{4})))
+}
+
+func TestT3_EqualMethod_Nested(t *testing.T) {
+	a := New3(New1(intEqualable(1)), New1(intEqualable(2)), New1(intEqualable(3)))
+	b := New3(New1(intEqualable(2)), New1(intEqualable(3)), New1(intEqualable(4)))
+
+	require.True(t, a.Equal(a))
+	require.False(t, a.Equal(b))
+	require.True(t, Equal3E(a, a))
+}
+
 func TestT3_String(t *testing.T) {
 	tup := New3("1", "2", "3")
 	require.Equal(t, `["1" "2" "3"]`, tup.String())
@@ -227,6 +543,31 @@ func TestT3_GoString(t *testing.T) {
 	require.Equal(t, `tuple.T3[string, string, string]{V1: "1", V2: "2", V3: "3"}`, tup.GoString())
 }
 
+func TestT3_Parse(t *testing.T) {
+	tup := New3("1", "2", "3")
+
+	got, err := Parse3[string, string, string](tup.String())
+	require.NoError(t, err)
+	require.Equal(t, tup, got)
+}
+
+func TestT3_Parse_Mixed(t *testing.T) {
+	tup := New3(New2("1", 1.5), New2("2", 2.5), New2("3", 3.5))
+
+	got, err := Parse3[T2[string, float64], T2[string, float64], T2[string, float64]](tup.String())
+	require.NoError(t, err)
+	require.Equal(t, tup, got)
+}
+
+func TestT3_Parse_Invalid(t *testing.T) {
+	_, err := Parse3[int, int, int](`["1" "2" "3"]`)
+	require.Error(t, err)
+
+	var parseErr *ParseError
+	require.ErrorAs(t, err, &parseErr)
+	require.Equal(t, 1, parseErr.Offset)
+}
+
 func TestT3_ToArray(t *testing.T) {
 	tup := New3("1", "2", "3")
 	require.Equal(t, [3]any{
@@ -477,79 +818,265 @@ func TestT3_FromSlice(t *testing.T) {
 	}
 }
 
-func TestT3_MarshalJSON(t *testing.T) {
-	tup := New3("1", "2", "3")
+func TestT3_FromSlice_Errors(t *testing.T) {
+	_, err := FromSlice3[string, string, string]([]any{})
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, &LengthMismatchError{Source: "slice", Expected: 3, Actual: 0}, lengthErr)
+	require.EqualError(t, err, "slice length 0 must match number of tuple values 3")
 
-	got, err := json.Marshal(tup)
-	require.NoError(t, err)
-	require.Equal(t, got, []byte(`["1","2","3"]`))
+	_, err = FromSlice3[string, string, string]([]any{1, "2", "3"})
+	var typeErr1 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr1)
+	require.Equal(t, 0, typeErr1.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr1.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr1.Actual)
+	require.EqualError(t, err, "value at slice index 0 expected to have type string but has type int")
+
+	_, err = FromSlice3[string, string, string]([]any{"1", 2, "3"})
+	var typeErr2 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr2)
+	require.Equal(t, 1, typeErr2.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr2.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr2.Actual)
+	require.EqualError(t, err, "value at slice index 1 expected to have type string but has type int")
+
+	_, err = FromSlice3[string, string, string]([]any{"1", "2", 3})
+	var typeErr3 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr3)
+	require.Equal(t, 2, typeErr3.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr3.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr3.Actual)
+	require.EqualError(t, err, "value at slice index 2 expected to have type string but has type int")
+
+	_, err = FromArray3[string, string, string]([3]any{})
+	var arrayTypeErr *ElementTypeError
+	require.ErrorAs(t, err, &arrayTypeErr)
+	require.Equal(t, &ElementTypeError{Source: "array", Index: 0, Expected: reflect.TypeOf("")}, arrayTypeErr)
+	require.EqualError(t, err, "value at array index 0 expected to have type string but has type <nil>")
 }
 
-func TestT3_UnmarshalJSON(t *testing.T) {
+func TestT3_UnmarshalJSON_Errors(t *testing.T) {
+	var tup T3[string, string, string]
+
+	err := json.Unmarshal([]byte(`[]`), &tup)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, 3, lengthErr.Expected)
+	require.Equal(t, 0, lengthErr.Actual)
+	require.EqualError(t, err, "unmarshalled json array length 0 must match number of tuple values 3")
+
+	err = tup.UnmarshalJSON([]byte(`["1","2",3]`))
+	var elementErr *ElementUnmarshalError
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, 2, elementErr.Index)
+	require.Equal(t, "3", elementErr.Value)
+	var typeErr *json.UnmarshalTypeError
+	require.ErrorAs(t, err, &typeErr)
+	require.ErrorContains(t, err, `value "3" at slice index 2 failed to unmarshal: `)
+}
+
+func TestT3_ConvertSlice(t *testing.T) {
 	tests := []struct {
 		name    string
-		data    []byte
-		want    T3[string, string, string]
+		slice   []any
+		want    T3[int, int, int]
 		wantErr bool
 	}{
 		{
-			name:    "nil data",
-			data:    nil,
+			name:  "all types match",
+			slice: []any{1, 2, 3},
+			want:  New3(1, 2, 3),
+		},
+		{
+			name:  "decoded json numbers",
+			slice: []any{float64(1), float64(2), float64(3)},
+			want:  New3(1, 2, 3),
+		},
+		{
+			name:  "json numbers",
+			slice: []any{json.Number("1"), json.Number("2"), json.Number("3")},
+			want:  New3(1, 2, 3),
+		},
+		{
+			name:    "slice empty",
+			slice:   []any{},
 			wantErr: true,
 		},
 		{
-			name:    "empty data",
-			data:    []byte{},
+			name:    "index 1 bad type",
+			slice:   []any{"1", 2, 3},
 			wantErr: true,
 		},
 		{
-			name:    "string data",
-			data:    []byte(`"hi"`),
+			name:    "index 1 fractional number",
+			slice:   []any{1.5, 2, 3},
 			wantErr: true,
 		},
 		{
-			name:    "empty json array",
-			data:    []byte(`[]`),
+			name:    "index 2 bad type",
+			slice:   []any{1, "2", 3},
 			wantErr: true,
 		},
 		{
-			name:    "longer json array",
-			data:    []byte(`["1", "2", "3", "4", "5", "6", "7", "8", "9", "10"]`),
+			name:    "index 2 fractional number",
+			slice:   []any{1, 2.5, 3},
 			wantErr: true,
 		},
-
 		{
-			name:    "json array with invalid type at index 0",
-			data:    []byte(`[1,"2","3"]`),
+			name:    "index 3 bad type",
+			slice:   []any{1, 2, "3"},
 			wantErr: true,
 		},
 		{
-			name:    "json array with invalid type at index 1",
-			data:    []byte(`["1",2,"3"]`),
+			name:    "index 3 fractional number",
+			slice:   []any{1, 2, 3.5},
 			wantErr: true,
 		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tup, err := ConvertSlice3[int, int, int](tt.slice)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, tup)
+		})
+	}
+}
+
+func TestT3_ConvertSlice_Errors(t *testing.T) {
+	_, err := ConvertSlice3[int8, int8, int8]([]any{})
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+
+	_, err = ConvertSlice3[int8, int8, int8]([]any{1, 2, true})
+	var typeErr *ElementTypeError
+	require.ErrorAs(t, err, &typeErr)
+	require.Equal(t, 2, typeErr.Index)
+
+	_, err = ConvertSlice3[int8, int8, int8]([]any{1, 2, float64(128)})
+	var conversionErr *ElementConversionError
+	require.ErrorAs(t, err, &conversionErr)
+	require.Equal(t, 2, conversionErr.Index)
+	require.Equal(t, reflect.TypeOf(int8(0)), conversionErr.Expected)
+}
+
+func TestT3_ScanRow(t *testing.T) {
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{"c1", "c2", "c3"},
+		rows: [][]driver.Value{
+			{int64(1), int64(2), int64(3)},
+		},
+	})
+
+	require.True(t, rows.Next())
+	got, err := ScanRow3[string, int, int](rows)
+	require.NoError(t, err)
+	require.Equal(t, New3("1", 2, 3), got)
+}
+
+func TestT3_ScanRow_ColumnsMismatch(t *testing.T) {
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{"c1", "c2", "c3", "extra"},
+		rows: [][]driver.Value{
+			{int64(1), int64(2), int64(3), int64(0)},
+		},
+	})
+
+	require.True(t, rows.Next())
+	_, err := ScanRow3[int, int, int](rows)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.EqualError(t, err, "row columns count 4 must match number of tuple values 3")
+}
+
+func TestT3_CollectRows(t *testing.T) {
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{"c1", "c2", "c3"},
+		rows: [][]driver.Value{
+			{int64(1), int64(2), int64(3)},
+			{int64(2), int64(3), int64(4)},
+		},
+	})
+
+	got, err := CollectRows3[int, int, int](rows)
+	require.NoError(t, err)
+	require.Equal(t, []T3[int, int, int]{
+		New3(1, 2, 3),
+		New3(2, 3, 4),
+	}, got)
+
+	rows = fakeQuery(t, fakeResult{columns: []string{"c1", "c2", "c3"}})
+	got, err = CollectRows3[int, int, int](rows)
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
+
+func TestT3_ReadCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		opts    CSVOptions
+		want    []T3[int, int, int]
+		wantErr bool
+	}{
 		{
-			name:    "json array with invalid type at index 2",
-			data:    []byte(`["1","2",3]`),
+			name: "empty",
+			data: "",
+			want: nil,
+		},
+		{
+			name: "records",
+			data: "1,2,3\n2,3,4\n",
+			want: []T3[int, int, int]{
+				New3(1, 2, 3),
+				New3(2, 3, 4),
+			},
+		},
+		{
+			name: "header",
+			data: "v1,v2,v3\n1,2,3\n",
+			opts: CSVOptions{Header: []string{"v1", "v2", "v3"}},
+			want: []T3[int, int, int]{New3(1, 2, 3)},
+		},
+		{
+			name: "skip header",
+			data: "c1,c2,c3\n1,2,3\n",
+			opts: CSVOptions{SkipHeader: true},
+			want: []T3[int, int, int]{New3(1, 2, 3)},
+		},
+		{
+			name:    "mismatching header",
+			data:    "c1,c2,c3\n1,2,3\n",
+			opts:    CSVOptions{Header: []string{"v1", "v2", "v3"}},
 			wantErr: true,
 		},
 		{
-			name:    "json array of valid types",
-			data:    []byte(`["1","2","3"]`),
-			want:    New3("1", "2", "3"),
-			wantErr: false,
+			name: "semicolon delimiter",
+			data: "1;2;3\n",
+			opts: CSVOptions{Comma: ';'},
+			want: []T3[int, int, int]{New3(1, 2, 3)},
 		},
 		{
-			name:    "json object of valid types",
-			data:    []byte(`{"V1": "1","V2": "2","V3": "3"}`),
+			name:    "too many fields",
+			data:    "1,2,3,0\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid field",
+			data:    "1,2,x\n",
 			wantErr: true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			var got T3[string, string, string]
-			err := json.Unmarshal(tt.data, &got)
+			got, err := ReadCSV3[int, int, int](strings.NewReader(tt.data), tt.opts)
 			if tt.wantErr {
 				require.Error(t, err)
 				return
@@ -561,33 +1088,822 @@ func TestT3_UnmarshalJSON(t *testing.T) {
 	}
 }
 
-func TestT3_Unmarshal_CustomStruct(t *testing.T) {
-	type Custom struct {
-		Name string `json:"name"`
-		Age  int    `json:"age"`
+func TestT3_ReadCSV_Error(t *testing.T) {
+	data := "1,2,3\n1,2,x\n"
+
+	_, err := ReadCSV3[int, int, int](strings.NewReader(data), CSVOptions{})
+	var csvErr *CSVError
+	require.ErrorAs(t, err, &csvErr)
+	require.Equal(t, 2, csvErr.Line)
+	require.Equal(t, 3, csvErr.Column)
+
+	var elementErr *ElementUnmarshalError
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, "x", elementErr.Value)
+}
+
+func TestT3_WriteCSV(t *testing.T) {
+	tups := []T3[string, string, string]{
+		New3("1", "2", "3"),
+		New3("1,\"1\"", "2,\"2\"", "3,\"3\""),
 	}
 
-	want := New3(Custom{Name: "1", Age: 1}, Custom{Name: "2", Age: 2}, Custom{Name: "3", Age: 3})
-	var got T3[Custom, Custom, Custom]
-	err := json.Unmarshal([]byte(`[
-		{ "name": "1", "age": 1 },
-		{ "name": "2", "age": 2 },
-		{ "name": "3", "age": 3 }
-	]`), &got)
+	var buf strings.Builder
+	err := WriteCSV3(&buf, tups, CSVOptions{Header: []string{"v1", "v2", "v3"}})
+	require.NoError(t, err)
+	require.Equal(t, "v1,v2,v3\n1,2,3\n\"1,\"\"1\"\"\",\"2,\"\"2\"\"\",\"3,\"\"3\"\"\"\n", buf.String())
 
+	got, err := ReadCSV3[string, string, string](strings.NewReader(buf.String()), CSVOptions{SkipHeader: true})
 	require.NoError(t, err)
-	require.Equal(t, want, got)
+	require.Equal(t, tups, got)
 }
 
-func TestT3_Marshal_Unmarshal(t *testing.T) {
+func TestT3_WriteCSV_Error(t *testing.T) {
+	tups := []T3[string, string, []int]{
+		{V1: "a\nb", V2: "a\nb", V3: []int{3}},
+	}
+
+	var buf strings.Builder
+	err := WriteCSV3(&buf, tups, CSVOptions{Header: []string{"v1", "v2", "v3"}})
+	var csvErr *CSVError
+	require.ErrorAs(t, err, &csvErr)
+	require.Equal(t, 2, csvErr.Line)
+	require.Equal(t, 3, csvErr.Column)
+
+	err = WriteCSV3(&buf, tups, CSVOptions{Header: []string{"v1", "v2", "v3", "extra"}})
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+}
+
+func TestT3_Hash(t *testing.T) {
+	seed := maphash.MakeSeed()
 	tup := New3("1", "2", "3")
 
-	marshalled, err := json.Marshal(tup)
-	require.NoError(t, err)
+	require.Equal(t, tup.Hash(seed), New3("1", "2", "3").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New3("2", "2", "3").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New3("1", "3", "3").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New3("1", "2", "4").Hash(seed))
+}
 
-	var unmarshalled T3[string, string, string]
-	err = json.Unmarshal(marshalled, &unmarshalled)
+func TestT3_Hash_Nested(t *testing.T) {
+	seed := maphash.MakeSeed()
+	tup := New3(New2("1", 1), New2("2", 2), New2("3", 3))
+
+	require.Equal(t, tup.Hash(seed), New3(New2("1", 1), New2("2", 2), New2("3", 3)).Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New3(New2("1", 2), New2("2", 3), New2("3", 4)).Hash(seed))
+}
+
+func TestT3_Hash_Unsupported(t *testing.T) {
+	seed := maphash.MakeSeed()
+	tup := New3([]int{1}, []int{2}, []int{3})
+	require.NotPanics(t, func() {
+		tup.Hash(seed)
+	})
+	require.Equal(t, tup.Hash(seed), New3([]int{1}, []int{2}, []int{3}).Hash(seed))
+
+	var nilTup T3[any, any, any]
+	require.NotPanics(t, func() {
+		nilTup.Hash(seed)
+	})
+	require.Panics(t, func() {
+		HashValue(seed, tup.V1)
+	})
+}
+
+func TestT3_HashFunc(t *testing.T) {
+	seed := maphash.MakeSeed()
+	hashSlice := func(seed maphash.Seed, v []int) uint64 {
+		return HashValue(seed, len(v))
+	}
 
+	hash := HashFunc3(hashSlice, hashSlice, hashSlice)
+	tup := New3([]int{1}, []int{2}, []int{3})
+
+	require.Equal(t, hash(seed, tup), hash(seed, New3([]int{2}, []int{3}, []int{4})))
+	require.NotEqual(t, hash(seed, tup), hash(seed, New3([]int{1, 2}, []int{2, 3}, []int{3, 4})))
+}
+
+func TestT3_MarshalJSON(t *testing.T) {
+	tup := New3("1", "2", "3")
+
+	got, err := json.Marshal(tup)
 	require.NoError(t, err)
-	require.Equal(t, tup, unmarshalled)
+	require.Equal(t, got, []byte(`["1","2","3"]`))
+}
+
+func TestT3_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		want    T3[string, string, string]
+		wantErr bool
+	}{
+		{
+			name:    "nil data",
+			data:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "empty data",
+			data:    []byte{},
+			wantErr: true,
+		},
+		{
+			name:    "string data",
+			data:    []byte(`"hi"`),
+			wantErr: true,
+		},
+		{
+			name:    "empty json array",
+			data:    []byte(`[]`),
+			wantErr: true,
+		},
+		{
+			name:    "longer json array",
+			data:    []byte(`["1", "2", "3", "4", "5", "6", "7", "8", "9", "10"]`),
+			wantErr: true,
+		},
+
+		{
+			name:    "json array with invalid type at index 0",
+			data:    []byte(`[1,"2","3"]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array with invalid type at index 1",
+			data:    []byte(`["1",2,"3"]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array with invalid type at index 2",
+			data:    []byte(`["1","2",3]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array of valid types",
+			data:    []byte(`["1","2","3"]`),
+			want:    New3("1", "2", "3"),
+			wantErr: false,
+		},
+		{
+			name:    "json object of valid types",
+			data:    []byte(`{"V1": "1","V2": "2","V3": "3"}`),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T3[string, string, string]
+			err := json.Unmarshal(tt.data, &got)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT3_Unmarshal_CustomStruct(t *testing.T) {
+	type Custom struct {
+		Name string `json:"name"`
+		Age  int    `json:"age"`
+	}
+
+	want := New3(Custom{Name: "1", Age: 1}, Custom{Name: "2", Age: 2}, Custom{Name: "3", Age: 3})
+	var got T3[Custom, Custom, Custom]
+	err := json.Unmarshal([]byte(`[
+		{ "name": "1", "age": 1 },
+		{ "name": "2", "age": 2 },
+		{ "name": "3", "age": 3 }
+	]`), &got)
+
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+func TestT3_Marshal_Unmarshal(t *testing.T) {
+	tup := New3("1", "2", "3")
+
+	marshalled, err := json.Marshal(tup)
+	require.NoError(t, err)
+
+	var unmarshalled T3[string, string, string]
+	err = json.Unmarshal(marshalled, &unmarshalled)
+
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT3_UnmarshalJSONWith(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		opts    JSONDecodeOptions
+		want    T3[string, string, string]
+		wantErr bool
+	}{
+		{
+			name:    "empty json array",
+			data:    `[]`,
+			wantErr: true,
+		},
+		{
+			name: "allow shorter empty json array",
+			data: `[]`,
+			opts: JSONDecodeOptions{AllowShorter: true},
+			want: T3[string, string, string]{},
+		},
+		{
+			name: "allow shorter partial json array",
+			data: `["1"]`,
+			opts: JSONDecodeOptions{AllowShorter: true},
+			want: T3[string, string, string]{V1: "1"},
+		},
+		{
+			name:    "allow shorter longer json array",
+			data:    `["1","2","3","10"]`,
+			opts:    JSONDecodeOptions{AllowShorter: true},
+			wantErr: true,
+		},
+		{
+			name: "json array with null values",
+			data: `[null,null,null]`,
+			want: T3[string, string, string]{},
+		},
+		{
+			name:    "disallow null json array with null values",
+			data:    `[null,null,null]`,
+			opts:    JSONDecodeOptions{DisallowNull: true},
+			wantErr: true,
+		},
+		{
+			name: "disallow null json array of valid types",
+			data: `["1","2","3"]`,
+			opts: JSONDecodeOptions{DisallowNull: true},
+			want: New3("1", "2", "3"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T3[string, string, string]
+			err := got.UnmarshalJSONWith([]byte(tt.data), tt.opts)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT3_UnmarshalJSONWith_Nested(t *testing.T) {
+	type Custom struct {
+		Name string `json:"name"`
+	}
+
+	data := []byte(`[[1, {"name": "1", "age": 1}],[2, {"name": "2", "age": 2}],[3, {"name": "3", "age": 3}]]`)
+
+	var got T3[T2[any, Custom], T2[any, Custom], T2[any, Custom]]
+	err := got.UnmarshalJSONWith(data, JSONDecodeOptions{UseNumber: true})
+	require.NoError(t, err)
+	require.Equal(t, New3(New2[any](json.Number("1"), Custom{Name: "1"}), New2[any](json.Number("2"), Custom{Name: "2"}), New2[any](json.Number("3"), Custom{Name: "3"})), got)
+
+	err = got.UnmarshalJSONWith(data, JSONDecodeOptions{DisallowUnknownFields: true})
+	require.Error(t, err)
+}
+
+func TestT3_MarshalJSONLabeled(t *testing.T) {
+	tup := New3(1, 2, 3)
+	labels := WithLabels("v1", "v2", "v3")
+
+	got, err := tup.MarshalJSONLabeled(labels)
+	require.NoError(t, err)
+	require.Equal(t, `{"v1":1,"v2":2,"v3":3}`, string(got))
+
+	_, err = tup.MarshalJSONLabeled(WithLabels("v1", "v2", "v3", "extra"))
+	require.Error(t, err)
+}
+
+func TestT3_UnmarshalJSONLabeled(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		labels  Labels
+		want    T3[int, int, int]
+		wantErr bool
+	}{
+		{
+			name:   "all keys",
+			data:   `{"v1":1,"v2":2,"v3":3}`,
+			labels: WithLabels("v1", "v2", "v3"),
+			want:   New3(1, 2, 3),
+		},
+		{
+			name:   "missing keys",
+			data:   `{}`,
+			labels: WithLabels("v1", "v2", "v3"),
+			want:   T3[int, int, int]{},
+		},
+		{
+			name:    "strict missing keys",
+			data:    `{}`,
+			labels:  WithLabels("v1", "v2", "v3").Strict(),
+			wantErr: true,
+		},
+		{
+			name:   "unknown keys",
+			data:   `{"v1":1,"v2":2,"v3":3,"extra":0}`,
+			labels: WithLabels("v1", "v2", "v3"),
+			want:   New3(1, 2, 3),
+		},
+		{
+			name:    "strict unknown keys",
+			data:    `{"v1":1,"v2":2,"v3":3,"extra":0}`,
+			labels:  WithLabels("v1", "v2", "v3").Strict(),
+			wantErr: true,
+		},
+		{
+			name:    "json array",
+			data:    `[1,2,3]`,
+			labels:  WithLabels("v1", "v2", "v3"),
+			wantErr: true,
+		},
+		{
+			name:    "labels count mismatch",
+			data:    `{}`,
+			labels:  WithLabels("v1", "v2", "v3", "extra"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T3[int, int, int]
+			err := got.UnmarshalJSONLabeled([]byte(tt.data), tt.labels)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT3_MarshalText(t *testing.T) {
+	tup := New3("1", "2", "3")
+
+	got, err := tup.MarshalText()
+	require.NoError(t, err)
+	require.Equal(t, `1,2,3`, string(got))
+}
+
+func TestT3_MarshalText_Unsupported(t *testing.T) {
+	tup := New3([]int{1}, []int{2}, []int{3})
+
+	_, err := tup.MarshalText()
+	require.Error(t, err)
+}
+
+func TestT3_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    T3[string, string, string]
+		wantErr bool
+	}{
+		{
+			name: "valid values",
+			data: `1,2,3`,
+			want: New3("1", "2", "3"),
+		},
+		{
+			name: "escaped values",
+			data: `a\,1\\,a\,2\\,a\,3\\`,
+			want: New3(`a,1\`, `a,2\`, `a,3\`),
+		},
+		{
+			name:    "too few values",
+			data:    `2,3`,
+			wantErr: true,
+		},
+		{
+			name:    "too many values",
+			data:    `1,2,3,extra`,
+			wantErr: true,
+		},
+		{
+			name:    "dangling escape",
+			data:    `1,2,3\`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T3[string, string, string]
+			err := got.UnmarshalText([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT3_MarshalText_UnmarshalText(t *testing.T) {
+	tup := New3(New2("a,1\\\\", 1), New2("a,2\\\\", 2), New2("a,3\\\\", 3))
+
+	marshalled, err := tup.MarshalText()
+	require.NoError(t, err)
+
+	var unmarshalled T3[T2[string, int], T2[string, int], T2[string, int]]
+	err = unmarshalled.UnmarshalText(marshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT3_GobEncode_GobDecode(t *testing.T) {
+	t.Run("strings", func(t *testing.T) {
+		requireGobRoundTrip(t, New3("1", "2", "3"))
+	})
+
+	t.Run("zero values", func(t *testing.T) {
+		requireGobRoundTrip(t, T3[int, int, int]{})
+	})
+
+	t.Run("nested tuples", func(t *testing.T) {
+		requireGobRoundTrip(t, New3(New2("1", 1), New2("2", 2), New2("3", 3)))
+	})
+
+	t.Run("pointers", func(t *testing.T) {
+		requireGobRoundTrip(t, New3(ptr(1), ptr(2), ptr(3)))
+	})
+
+	t.Run("pointers to zero values", func(t *testing.T) {
+		requireGobRoundTrip(t, New3(ptr(0), ptr(0), ptr(0)))
+	})
+
+	t.Run("nil pointers", func(t *testing.T) {
+		requireGobRoundTrip(t, T3[*int, *int, *int]{})
+	})
+
+	t.Run("nested tuple pointers", func(t *testing.T) {
+		requireGobRoundTrip(t, New3((*T2[string, *int])(nil), &T2[string, *int]{V1: "2", V2: ptr(2)}, &T2[string, *int]{V1: "3", V2: ptr(3)}))
+	})
+
+	t.Run("interface values", func(t *testing.T) {
+		requireGobRoundTrip(t, T3[any, any, any]{V1: nil, V2: "2", V3: 3})
+	})
+
+	t.Run("struct field", func(t *testing.T) {
+		type Custom struct {
+			Tup T3[int, int, int]
+		}
+
+		requireGobRoundTrip(t, Custom{Tup: New3(1, 2, 3)})
+	})
+}
+
+func TestT3_GobDecode_Invalid(t *testing.T) {
+	encoded, err := New1("1").GobEncode()
+	require.NoError(t, err)
+
+	var tup T3[string, string, string]
+	err = tup.GobDecode(encoded)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, 3, lengthErr.Expected)
+
+	encoded, err = New3("1", "2", "3").GobEncode()
+	require.NoError(t, err)
+
+	var mismatched T3[int, int, int]
+	require.Error(t, mismatched.GobDecode(encoded))
+	require.Error(t, tup.GobDecode(nil))
+}
+
+func TestT3_MarshalComposite(t *testing.T) {
+	got, err := New3("foo bar", 2, 3).MarshalComposite()
+	require.NoError(t, err)
+	require.Equal(t, `("foo bar",2,3)`, string(got))
+
+	got, err = T3[*int, *int, *int]{}.MarshalComposite()
+	require.NoError(t, err)
+	require.Equal(t, `(,,)`, string(got))
+
+	got, err = New3(New2("1", 1), New2("2", 2), New2("3", 3)).MarshalComposite()
+	require.NoError(t, err)
+	require.Equal(t, `("(1,1)","(2,2)","(3,3)")`, string(got))
+}
+
+func TestT3_UnmarshalComposite(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    T3[string, string, string]
+		wantErr bool
+	}{
+		{
+			name: "unquoted values",
+			data: `(1,2,3)`,
+			want: New3("1", "2", "3"),
+		},
+		{
+			name: "quoted values",
+			data: `("1, \\""","2, \\""","3, \\""")`,
+			want: New3("1, \\\"", "2, \\\"", "3, \\\""),
+		},
+		{
+			name: "empty values",
+			data: `("","","")`,
+			want: T3[string, string, string]{},
+		},
+		{
+			name:    "null values",
+			data:    `(,,)`,
+			wantErr: true,
+		},
+		{
+			name:    "too many fields",
+			data:    `(1,2,3,0)`,
+			wantErr: true,
+		},
+		{
+			name:    "missing parentheses",
+			data:    `1,2,3`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T3[string, string, string]
+			err := got.UnmarshalComposite([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT3_MarshalComposite_UnmarshalComposite(t *testing.T) {
+	tup := New3((*T2[string, *int])(nil), &T2[string, *int]{V1: "(2, \"2\\\")", V2: ptr(2)}, &T2[string, *int]{V1: "(3, \"3\\\")", V2: ptr(3)})
+
+	marshalled, err := tup.MarshalComposite()
+	require.NoError(t, err)
+
+	var unmarshalled T3[*T2[string, *int], *T2[string, *int], *T2[string, *int]]
+	err = unmarshalled.UnmarshalComposite(marshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT3_MarshalXML(t *testing.T) {
+	got, err := xml.Marshal(New3(1, 2, 3))
+	require.NoError(t, err)
+	require.Equal(t, `<T3><v1>1</v1><v2>2</v2><v3>3</v3></T3>`, string(got))
+
+	type Custom struct {
+		XMLName xml.Name                   `xml:"custom"`
+		Tup     T3[string, string, string] `xml:"tup"`
+	}
+
+	got, err = xml.Marshal(Custom{Tup: New3("<1>", "<2>", "<3>")})
+	require.NoError(t, err)
+	require.Equal(t, `<custom><tup><v1>&lt;1&gt;</v1><v2>&lt;2&gt;</v2><v3>&lt;3&gt;</v3></tup></custom>`, string(got))
+}
+
+func TestT3_UnmarshalXML(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    T3[int, int, int]
+		wantErr bool
+	}{
+		{
+			name: "all elements",
+			data: `<T3><v1>1</v1><v2>2</v2><v3>3</v3></T3>`,
+			want: New3(1, 2, 3),
+		},
+		{
+			name: "whitespace and comments",
+			data: `<T3>
+				<!-- v1 --> <v1> 1 </v1>
+				<!-- v2 --> <v2> 2 </v2>
+				<!-- v3 --> <v3> 3 </v3>
+			</T3>`,
+			want: New3(1, 2, 3),
+		},
+		{
+			name:    "no elements",
+			data:    `<T3></T3>`,
+			wantErr: true,
+		},
+		{
+			name:    "too many elements",
+			data:    `<T3><v1>1</v1><v2>2</v2><v3>3</v3><v0>0</v0></T3>`,
+			wantErr: true,
+		},
+		{
+			name:    "wrong element names",
+			data:    `<T3><V1>1</V1><V2>2</V2><V3>3</V3></T3>`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid value",
+			data:    `<T3><v1>1</v1><v2>2</v2><v3>x</v3></T3>`,
+			wantErr: true,
+		},
+		{
+			name:    "unterminated",
+			data:    `<T3><v1>1</v1><v2>2</v2><v3>3</v3>`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T3[int, int, int]
+			err := xml.Unmarshal([]byte(tt.data), &got)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT3_UnmarshalXML_Errors(t *testing.T) {
+	var tup T3[int, int, int]
+
+	err := xml.Unmarshal([]byte(`<T3><v1>1</v1><v2>2</v2></T3>`), &tup)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.EqualError(t, err, "unmarshalled xml elements count 2 must match number of tuple values 3")
+
+	err = xml.Unmarshal([]byte(`<T3><v1>1</v1><v2>2</v2><v3>3</v3><v0>0</v0><v0>0</v0></T3>`), &tup)
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, 5, lengthErr.Actual)
+
+	err = xml.Unmarshal([]byte(`<T3><v1>1</v1><v2>2</v2><v3>x</v3></T3>`), &tup)
+	var elementErr *ElementUnmarshalError
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, 2, elementErr.Index)
+	require.Equal(t, "v3", elementErr.Label)
+}
+
+func TestT3_MarshalXML_UnmarshalXML_NilPointers(t *testing.T) {
+	tup := New3((*int)(nil), ptr(2), ptr(3))
+
+	got, err := xml.Marshal(tup)
+	require.NoError(t, err)
+	require.Equal(t, `<T3><v1 nil="true"></v1><v2>2</v2><v3>3</v3></T3>`, string(got))
+
+	unmarshalled := New3(ptr(0), ptr(0), ptr(0))
+	err = xml.Unmarshal(got, &unmarshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT3_MarshalXML_UnmarshalXML_Slices(t *testing.T) {
+	tup := New3([]int{1, 2}, []int{2, 3}, []int{3, 4})
+
+	got, err := xml.Marshal(tup)
+	require.NoError(t, err)
+	require.Equal(t, `<T3><v1><item>1</item><item>2</item></v1><v2><item>2</item><item>3</item></v2><v3><item>3</item><item>4</item></v3></T3>`, string(got))
+
+	var unmarshalled T3[[]int, []int, []int]
+	err = xml.Unmarshal(got, &unmarshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT3_MarshalXMLLabeled_UnmarshalXMLLabeled(t *testing.T) {
+	tup := New3(New2("1", ptr(1)), New2("2", ptr(2)), New2("3", ptr(3)))
+	labels := WithLabels("item1", "item2", "item3")
+
+	var buf strings.Builder
+	encoder := xml.NewEncoder(&buf)
+	err := tup.MarshalXMLLabeled(encoder, xml.StartElement{Name: xml.Name{Local: "items"}}, labels)
+	require.NoError(t, err)
+	require.NoError(t, encoder.Flush())
+	require.Equal(t, `<items><item1><v1>1</v1><v2>1</v2></item1><item2><v1>2</v1><v2>2</v2></item2><item3><v1>3</v1><v2>3</v2></item3></items>`, buf.String())
+
+	decoder := xml.NewDecoder(strings.NewReader(buf.String()))
+	start, err := decoder.Token()
+	require.NoError(t, err)
+
+	var unmarshalled T3[T2[string, *int], T2[string, *int], T2[string, *int]]
+	err = unmarshalled.UnmarshalXMLLabeled(decoder, start.(xml.StartElement), labels)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+
+	err = tup.MarshalXMLLabeled(encoder, xml.StartElement{Name: xml.Name{Local: "items"}}, WithLabels("item1", "item2", "item3", "extra"))
+	require.Error(t, err)
+}
+
+func TestT3_MarshalBinary(t *testing.T) {
+	tup := New3(uint16(1), uint16(2), uint16(3))
+
+	got, err := tup.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 0, 2, 0, 3, 0}, got)
+
+	got, err = tup.AppendBinaryOrder([]byte{0xff}, binary.BigEndian)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0xff, 0, 1, 0, 2, 0, 3}, got)
+
+	_, err = New3(1, 2, []int{3}).MarshalBinary()
+	require.Error(t, err)
+}
+
+func TestT3_MarshalBinary_UnmarshalBinary(t *testing.T) {
+	tup := New3(
+		int64(-1),
+		"foo2",
+		true,
+	)
+
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		marshalled, err := tup.AppendBinaryOrder(nil, order.(binary.AppendByteOrder))
+		require.NoError(t, err)
+
+		var unmarshalled T3[int64, string, bool]
+		err = unmarshalled.UnmarshalBinaryOrder(marshalled, order)
+		require.NoError(t, err)
+		require.Equal(t, tup, unmarshalled)
+	}
+}
+
+func TestT3_UnmarshalBinary_Invalid(t *testing.T) {
+	marshalled, err := New3(uint16(1), uint16(2), uint16(3)).MarshalBinary()
+	require.NoError(t, err)
+
+	var tup T3[uint16, uint16, uint16]
+	err = tup.UnmarshalBinary(marshalled[:len(marshalled)-1])
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	require.EqualError(t, err, "value at index 2 failed to unmarshal: unexpected EOF")
+
+	err = tup.UnmarshalBinary(append(marshalled, 0))
+	require.EqualError(t, err, "binary data has 1 trailing bytes")
+}
+
+func TestT3_BinarySlice(t *testing.T) {
+	tups := []T3[int, int, int]{
+		New3(1, 2, 3),
+		New3(-1, -2, -3),
+	}
+
+	marshalled, err := AppendBinarySlice3(nil, binary.LittleEndian, tups)
+	require.NoError(t, err)
+	require.Len(t, marshalled, 1+2*3*8)
+
+	got, err := UnmarshalBinarySlice3[int, int, int](marshalled, binary.LittleEndian, nil)
+	require.NoError(t, err)
+	require.Equal(t, tups, got)
+
+	got, err = UnmarshalBinarySlice3(marshalled, binary.LittleEndian, got)
+	require.NoError(t, err)
+	require.Equal(t, append(tups, tups...), got)
+
+	empty, err := AppendBinarySlice3[int, int, int](nil, binary.LittleEndian, nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0}, empty)
+
+	_, err = AppendBinarySlice3(nil, binary.LittleEndian, []T3[[]int, []int, []int]{{}})
+	require.Error(t, err)
+}
+
+func TestT3_MarshalJSON_MapKey(t *testing.T) {
+	m := map[T3[string, string, string]]int{
+		New3("1", "2", "3"): 1,
+	}
+
+	marshalled, err := json.Marshal(m)
+	require.NoError(t, err)
+	require.Equal(t, `{"1,2,3":1}`, string(marshalled))
+
+	var unmarshalled map[T3[string, string, string]]int
+	err = json.Unmarshal(marshalled, &unmarshalled)
+	require.NoError(t, err)
+	require.Equal(t, m, unmarshalled)
 }
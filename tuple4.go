@@ -1,10 +1,14 @@
 package tuple
 
 import (
+	"cmp"
+	"database/sql"
+	"encoding/binary"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
-
-	"golang.org/x/exp/constraints"
+	"hash/maphash"
+	"io"
 )
 
 // T4 is a tuple type holding 4 generic values.
@@ -66,19 +70,19 @@ func New4[Ty1, Ty2, Ty3, Ty4 any](v1 Ty1, v2 Ty2, v3 Ty3, v4 Ty4) T4[Ty1, Ty2, T
 func FromArray4[Ty1, Ty2, Ty3, Ty4 any](arr [4]any) (T4[Ty1, Ty2, Ty3, Ty4], error) {
 	v1, ok := arr[0].(Ty1)
 	if !ok {
-		return T4[Ty1, Ty2, Ty3, Ty4]{}, fmt.Errorf("value at array index 0 expected to have type %s but has type %T", typeName[Ty1](), arr[0])
+		return T4[Ty1, Ty2, Ty3, Ty4]{}, newElementTypeError[Ty1]("array", 0, arr[0])
 	}
 	v2, ok := arr[1].(Ty2)
 	if !ok {
-		return T4[Ty1, Ty2, Ty3, Ty4]{}, fmt.Errorf("value at array index 1 expected to have type %s but has type %T", typeName[Ty2](), arr[1])
+		return T4[Ty1, Ty2, Ty3, Ty4]{}, newElementTypeError[Ty2]("array", 1, arr[1])
 	}
 	v3, ok := arr[2].(Ty3)
 	if !ok {
-		return T4[Ty1, Ty2, Ty3, Ty4]{}, fmt.Errorf("value at array index 2 expected to have type %s but has type %T", typeName[Ty3](), arr[2])
+		return T4[Ty1, Ty2, Ty3, Ty4]{}, newElementTypeError[Ty3]("array", 2, arr[2])
 	}
 	v4, ok := arr[3].(Ty4)
 	if !ok {
-		return T4[Ty1, Ty2, Ty3, Ty4]{}, fmt.Errorf("value at array index 3 expected to have type %s but has type %T", typeName[Ty4](), arr[3])
+		return T4[Ty1, Ty2, Ty3, Ty4]{}, newElementTypeError[Ty4]("array", 3, arr[3])
 	}
 
 	return New4(v1, v2, v3, v4), nil
@@ -94,24 +98,24 @@ func FromArray4X[Ty1, Ty2, Ty3, Ty4 any](arr [4]any) T4[Ty1, Ty2, Ty3, Ty4] {
 // If the length of the slice doesn't match, or any of the values can not be converted to the generic type, an error is returned.
 func FromSlice4[Ty1, Ty2, Ty3, Ty4 any](values []any) (T4[Ty1, Ty2, Ty3, Ty4], error) {
 	if len(values) != 4 {
-		return T4[Ty1, Ty2, Ty3, Ty4]{}, fmt.Errorf("slice length %d must match number of tuple values 4", len(values))
+		return T4[Ty1, Ty2, Ty3, Ty4]{}, &LengthMismatchError{Source: "slice", Expected: 4, Actual: len(values)}
 	}
 
 	v1, ok := values[0].(Ty1)
 	if !ok {
-		return T4[Ty1, Ty2, Ty3, Ty4]{}, fmt.Errorf("value at slice index 0 expected to have type %s but has type %T", typeName[Ty1](), values[0])
+		return T4[Ty1, Ty2, Ty3, Ty4]{}, newElementTypeError[Ty1]("slice", 0, values[0])
 	}
 	v2, ok := values[1].(Ty2)
 	if !ok {
-		return T4[Ty1, Ty2, Ty3, Ty4]{}, fmt.Errorf("value at slice index 1 expected to have type %s but has type %T", typeName[Ty2](), values[1])
+		return T4[Ty1, Ty2, Ty3, Ty4]{}, newElementTypeError[Ty2]("slice", 1, values[1])
 	}
 	v3, ok := values[2].(Ty3)
 	if !ok {
-		return T4[Ty1, Ty2, Ty3, Ty4]{}, fmt.Errorf("value at slice index 2 expected to have type %s but has type %T", typeName[Ty3](), values[2])
+		return T4[Ty1, Ty2, Ty3, Ty4]{}, newElementTypeError[Ty3]("slice", 2, values[2])
 	}
 	v4, ok := values[3].(Ty4)
 	if !ok {
-		return T4[Ty1, Ty2, Ty3, Ty4]{}, fmt.Errorf("value at slice index 3 expected to have type %s but has type %T", typeName[Ty4](), values[3])
+		return T4[Ty1, Ty2, Ty3, Ty4]{}, newElementTypeError[Ty4]("slice", 3, values[3])
 	}
 
 	return New4(v1, v2, v3, v4), nil
@@ -121,7 +125,7 @@ func FromSlice4[Ty1, Ty2, Ty3, Ty4 any](values []any) (T4[Ty1, Ty2, Ty3, Ty4], e
 // If the length of the slice doesn't match, or any of the values can not be converted to the generic type, the function panics.
 func FromSlice4X[Ty1, Ty2, Ty3, Ty4 any](values []any) T4[Ty1, Ty2, Ty3, Ty4] {
 	if len(values) != 4 {
-		panic(fmt.Errorf("slice length %d must match number of tuple values 4", len(values)))
+		panic(&LengthMismatchError{Source: "slice", Expected: 4, Actual: len(values)})
 	}
 
 	v1 := values[0].(Ty1)
@@ -132,11 +136,391 @@ func FromSlice4X[Ty1, Ty2, Ty3, Ty4 any](values []any) T4[Ty1, Ty2, Ty3, Ty4] {
 	return New4(v1, v2, v3, v4)
 }
 
+// ConvertSlice4 returns a tuple from a slice of length 4, converting the values to the generic types when needed.
+// Unlike FromSlice4, numeric values are converted between numeric types as long as they fit the generic type,
+// json.Number values are parsed into numeric types, strings and byte slices are converted to each other,
+// and strings and byte slices are unmarshalled into types implementing encoding.TextUnmarshaler.
+// This enables creating tuples from slices decoded by encoding/json, which hold float64 values for numbers.
+// If the length of the slice doesn't match, a *LengthMismatchError is returned.
+// If any of the values can not be converted to the generic type, a *ElementTypeError is returned.
+// If the conversion of any of the values fails, for example when it overflows the generic type, a *ElementConversionError is returned.
+func ConvertSlice4[Ty1, Ty2, Ty3, Ty4 any](values []any) (T4[Ty1, Ty2, Ty3, Ty4], error) {
+	if len(values) != 4 {
+		return T4[Ty1, Ty2, Ty3, Ty4]{}, &LengthMismatchError{Source: "slice", Expected: 4, Actual: len(values)}
+	}
+
+	var t T4[Ty1, Ty2, Ty3, Ty4]
+	if err := convertSliceValue(0, values[0], &t.V1); err != nil {
+		return T4[Ty1, Ty2, Ty3, Ty4]{}, err
+	}
+	if err := convertSliceValue(1, values[1], &t.V2); err != nil {
+		return T4[Ty1, Ty2, Ty3, Ty4]{}, err
+	}
+	if err := convertSliceValue(2, values[2], &t.V3); err != nil {
+		return T4[Ty1, Ty2, Ty3, Ty4]{}, err
+	}
+	if err := convertSliceValue(3, values[3], &t.V4); err != nil {
+		return T4[Ty1, Ty2, Ty3, Ty4]{}, err
+	}
+
+	return t, nil
+}
+
+// ScanRow4 scans the current row of rows into a tuple, with each of the columns scanned into the matching tuple value.
+// If the number of columns doesn't match the number of tuple values, a *LengthMismatchError is returned.
+func ScanRow4[Ty1, Ty2, Ty3, Ty4 any](rows *sql.Rows) (T4[Ty1, Ty2, Ty3, Ty4], error) {
+	var t T4[Ty1, Ty2, Ty3, Ty4]
+	if err := scanRow(rows, []any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+	}); err != nil {
+		return T4[Ty1, Ty2, Ty3, Ty4]{}, err
+	}
+
+	return t, nil
+}
+
+// CollectRows4 scans all the rows into a slice of tuples using ScanRow4, and closes the rows.
+func CollectRows4[Ty1, Ty2, Ty3, Ty4 any](rows *sql.Rows) ([]T4[Ty1, Ty2, Ty3, Ty4], error) {
+	return collectRows(rows, ScanRow4[Ty1, Ty2, Ty3, Ty4])
+}
+
+// ReadCSV4 reads all the CSV records of r into tuples, with each of the fields converted to the matching tuple value.
+// Fields are converted using the UnmarshalText method of values implementing encoding.TextUnmarshaler,
+// and otherwise must be of boolean, numeric or string kinds.
+// If a record fails to convert, a *CSVError holding its line and column numbers is returned.
+// If a record fails to parse, including records with a number of fields that doesn't match, a *csv.ParseError is returned.
+func ReadCSV4[Ty1, Ty2, Ty3, Ty4 any](r io.Reader, opts CSVOptions) ([]T4[Ty1, Ty2, Ty3, Ty4], error) {
+	return readCSV(r, opts, func(t *T4[Ty1, Ty2, Ty3, Ty4]) []any {
+		return []any{
+			&t.V1,
+			&t.V2,
+			&t.V3,
+			&t.V4,
+		}
+	})
+}
+
+// WriteCSV4 writes the tuples into w as CSV records, with each of the tuple values converted to the matching field.
+// Values are converted the same way as MarshalText does.
+// If a tuple fails to convert, a *CSVError holding the line and column numbers of its record is returned.
+func WriteCSV4[Ty1, Ty2, Ty3, Ty4 any](w io.Writer, tuples []T4[Ty1, Ty2, Ty3, Ty4], opts CSVOptions) error {
+	return writeCSV(w, tuples, opts, T4[Ty1, Ty2, Ty3, Ty4].Slice)
+}
+
+// Parse4 returns a tuple from its string representation, as returned by the String method.
+// Supported values are booleans, numbers, quoted strings and nested tuples.
+// If the string fails to parse, a *ParseError holding the offset of the failure is returned.
+func Parse4[Ty1, Ty2, Ty3, Ty4 any](s string) (T4[Ty1, Ty2, Ty3, Ty4], error) {
+	var t T4[Ty1, Ty2, Ty3, Ty4]
+	if err := parseTuple(s, &t); err != nil {
+		return T4[Ty1, Ty2, Ty3, Ty4]{}, err
+	}
+
+	return t, nil
+}
+
+// Map4At1 returns a new tuple with the function f applied to the value at position 1 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map4At1[Ty1, Ty2, Ty3, Ty4, R any](t T4[Ty1, Ty2, Ty3, Ty4], f func(Ty1) R) T4[R, Ty2, Ty3, Ty4] {
+	return T4[R, Ty2, Ty3, Ty4]{
+		V1: f(t.V1),
+		V2: t.V2,
+		V3: t.V3,
+		V4: t.V4,
+	}
+}
+
+// Map4At2 returns a new tuple with the function f applied to the value at position 2 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map4At2[Ty1, Ty2, Ty3, Ty4, R any](t T4[Ty1, Ty2, Ty3, Ty4], f func(Ty2) R) T4[Ty1, R, Ty3, Ty4] {
+	return T4[Ty1, R, Ty3, Ty4]{
+		V1: t.V1,
+		V2: f(t.V2),
+		V3: t.V3,
+		V4: t.V4,
+	}
+}
+
+// Map4At3 returns a new tuple with the function f applied to the value at position 3 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map4At3[Ty1, Ty2, Ty3, Ty4, R any](t T4[Ty1, Ty2, Ty3, Ty4], f func(Ty3) R) T4[Ty1, Ty2, R, Ty4] {
+	return T4[Ty1, Ty2, R, Ty4]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: f(t.V3),
+		V4: t.V4,
+	}
+}
+
+// Map4At4 returns a new tuple with the function f applied to the value at position 4 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map4At4[Ty1, Ty2, Ty3, Ty4, R any](t T4[Ty1, Ty2, Ty3, Ty4], f func(Ty4) R) T4[Ty1, Ty2, Ty3, R] {
+	return T4[Ty1, Ty2, Ty3, R]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+		V4: f(t.V4),
+	}
+}
+
+// Map4 returns a new tuple with each of the functions applied to the tuple value at the matching position.
+func Map4[Ty1, Ty2, Ty3, Ty4, R1, R2, R3, R4 any](t T4[Ty1, Ty2, Ty3, Ty4], f1 func(Ty1) R1, f2 func(Ty2) R2, f3 func(Ty3) R3, f4 func(Ty4) R4) T4[R1, R2, R3, R4] {
+	return T4[R1, R2, R3, R4]{
+		V1: f1(t.V1),
+		V2: f2(t.V2),
+		V3: f3(t.V3),
+		V4: f4(t.V4),
+	}
+}
+
+// Append4 returns a new tuple holding the tuple values followed by the value v.
+func Append4[Ty1, Ty2, Ty3, Ty4, Ty5 any](t T4[Ty1, Ty2, Ty3, Ty4], v Ty5) T5[Ty1, Ty2, Ty3, Ty4, Ty5] {
+	return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+		V4: t.V4,
+		V5: v,
+	}
+}
+
+// Prepend4 returns a new tuple holding the value v followed by the tuple values.
+func Prepend4[Ty1, Ty2, Ty3, Ty4, Ty5 any](t T4[Ty2, Ty3, Ty4, Ty5], v Ty1) T5[Ty1, Ty2, Ty3, Ty4, Ty5] {
+	return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{
+		V1: v,
+		V2: t.V1,
+		V3: t.V2,
+		V4: t.V3,
+		V5: t.V4,
+	}
+}
+
+// Concat4_1 returns a new tuple holding the values of t1 followed by the values of t2.
+func Concat4_1[Ty1, Ty2, Ty3, Ty4, Ty5 any](t1 T4[Ty1, Ty2, Ty3, Ty4], t2 T1[Ty5]) T5[Ty1, Ty2, Ty3, Ty4, Ty5] {
+	return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{
+		V1: t1.V1,
+		V2: t1.V2,
+		V3: t1.V3,
+		V4: t1.V4,
+		V5: t2.V1,
+	}
+}
+
+// Concat4_2 returns a new tuple holding the values of t1 followed by the values of t2.
+func Concat4_2[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](t1 T4[Ty1, Ty2, Ty3, Ty4], t2 T2[Ty5, Ty6]) T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6] {
+	return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{
+		V1: t1.V1,
+		V2: t1.V2,
+		V3: t1.V3,
+		V4: t1.V4,
+		V5: t2.V1,
+		V6: t2.V2,
+	}
+}
+
+// Concat4_3 returns a new tuple holding the values of t1 followed by the values of t2.
+func Concat4_3[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](t1 T4[Ty1, Ty2, Ty3, Ty4], t2 T3[Ty5, Ty6, Ty7]) T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7] {
+	return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{
+		V1: t1.V1,
+		V2: t1.V2,
+		V3: t1.V3,
+		V4: t1.V4,
+		V5: t2.V1,
+		V6: t2.V2,
+		V7: t2.V3,
+	}
+}
+
+// Concat4_4 returns a new tuple holding the values of t1 followed by the values of t2.
+func Concat4_4[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8 any](t1 T4[Ty1, Ty2, Ty3, Ty4], t2 T4[Ty5, Ty6, Ty7, Ty8]) T8[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8] {
+	return T8[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8]{
+		V1: t1.V1,
+		V2: t1.V2,
+		V3: t1.V3,
+		V4: t1.V4,
+		V5: t2.V1,
+		V6: t2.V2,
+		V7: t2.V3,
+		V8: t2.V4,
+	}
+}
+
+// Concat4_5 returns a new tuple holding the values of t1 followed by the values of t2.
+func Concat4_5[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](t1 T4[Ty1, Ty2, Ty3, Ty4], t2 T5[Ty5, Ty6, Ty7, Ty8, Ty9]) T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9] {
+	return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{
+		V1: t1.V1,
+		V2: t1.V2,
+		V3: t1.V3,
+		V4: t1.V4,
+		V5: t2.V1,
+		V6: t2.V2,
+		V7: t2.V3,
+		V8: t2.V4,
+		V9: t2.V5,
+	}
+}
+
+// Take4_1 returns a new tuple holding the first 1 values of the tuple.
+func Take4_1[Ty1, Ty2, Ty3, Ty4 any](t T4[Ty1, Ty2, Ty3, Ty4]) T1[Ty1] {
+	return T1[Ty1]{
+		V1: t.V1,
+	}
+}
+
+// Drop4_1 returns a new tuple holding the values of the tuple without the first 1 values.
+func Drop4_1[Ty1, Ty2, Ty3, Ty4 any](t T4[Ty1, Ty2, Ty3, Ty4]) T3[Ty2, Ty3, Ty4] {
+	return T3[Ty2, Ty3, Ty4]{
+		V1: t.V2,
+		V2: t.V3,
+		V3: t.V4,
+	}
+}
+
+// Split4_1 splits the tuple into a tuple of its first 1 values and a tuple of the rest of its values.
+func Split4_1[Ty1, Ty2, Ty3, Ty4 any](t T4[Ty1, Ty2, Ty3, Ty4]) T2[T1[Ty1], T3[Ty2, Ty3, Ty4]] {
+	return T2[T1[Ty1], T3[Ty2, Ty3, Ty4]]{
+		V1: Take4_1(t),
+		V2: Drop4_1(t),
+	}
+}
+
+// Take4_2 returns a new tuple holding the first 2 values of the tuple.
+func Take4_2[Ty1, Ty2, Ty3, Ty4 any](t T4[Ty1, Ty2, Ty3, Ty4]) T2[Ty1, Ty2] {
+	return T2[Ty1, Ty2]{
+		V1: t.V1,
+		V2: t.V2,
+	}
+}
+
+// Drop4_2 returns a new tuple holding the values of the tuple without the first 2 values.
+func Drop4_2[Ty1, Ty2, Ty3, Ty4 any](t T4[Ty1, Ty2, Ty3, Ty4]) T2[Ty3, Ty4] {
+	return T2[Ty3, Ty4]{
+		V1: t.V3,
+		V2: t.V4,
+	}
+}
+
+// Split4_2 splits the tuple into a tuple of its first 2 values and a tuple of the rest of its values.
+func Split4_2[Ty1, Ty2, Ty3, Ty4 any](t T4[Ty1, Ty2, Ty3, Ty4]) T2[T2[Ty1, Ty2], T2[Ty3, Ty4]] {
+	return T2[T2[Ty1, Ty2], T2[Ty3, Ty4]]{
+		V1: Take4_2(t),
+		V2: Drop4_2(t),
+	}
+}
+
+// Take4_3 returns a new tuple holding the first 3 values of the tuple.
+func Take4_3[Ty1, Ty2, Ty3, Ty4 any](t T4[Ty1, Ty2, Ty3, Ty4]) T3[Ty1, Ty2, Ty3] {
+	return T3[Ty1, Ty2, Ty3]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+	}
+}
+
+// Drop4_3 returns a new tuple holding the values of the tuple without the first 3 values.
+func Drop4_3[Ty1, Ty2, Ty3, Ty4 any](t T4[Ty1, Ty2, Ty3, Ty4]) T1[Ty4] {
+	return T1[Ty4]{
+		V1: t.V4,
+	}
+}
+
+// Split4_3 splits the tuple into a tuple of its first 3 values and a tuple of the rest of its values.
+func Split4_3[Ty1, Ty2, Ty3, Ty4 any](t T4[Ty1, Ty2, Ty3, Ty4]) T2[T3[Ty1, Ty2, Ty3], T1[Ty4]] {
+	return T2[T3[Ty1, Ty2, Ty3], T1[Ty4]]{
+		V1: Take4_3(t),
+		V2: Drop4_3(t),
+	}
+}
+
+// Reverse4 returns a new tuple holding the tuple values in reverse order.
+func Reverse4[Ty1, Ty2, Ty3, Ty4 any](t T4[Ty1, Ty2, Ty3, Ty4]) T4[Ty4, Ty3, Ty2, Ty1] {
+	return T4[Ty4, Ty3, Ty2, Ty1]{
+		V1: t.V4,
+		V2: t.V3,
+		V3: t.V2,
+		V4: t.V1,
+	}
+}
+
+// Apply4 calls the function f with the tuple values as arguments and returns its result.
+func Apply4[Ty1, Ty2, Ty3, Ty4, R any](t T4[Ty1, Ty2, Ty3, Ty4], f func(Ty1, Ty2, Ty3, Ty4) R) R {
+	return f(t.Values())
+}
+
+// Tupled4 returns a function that accepts a tuple and calls f with the tuple values as arguments.
+func Tupled4[Ty1, Ty2, Ty3, Ty4, R any](f func(Ty1, Ty2, Ty3, Ty4) R) func(T4[Ty1, Ty2, Ty3, Ty4]) R {
+	return func(t T4[Ty1, Ty2, Ty3, Ty4]) R {
+		return f(t.Values())
+	}
+}
+
+// Untupled4 returns a function that accepts 4 arguments and calls g with a tuple holding them.
+func Untupled4[Ty1, Ty2, Ty3, Ty4, R any](g func(T4[Ty1, Ty2, Ty3, Ty4]) R) func(Ty1, Ty2, Ty3, Ty4) R {
+	return func(v1 Ty1, v2 Ty2, v3 Ty3, v4 Ty4) R {
+		return g(New4(v1, v2, v3, v4))
+	}
+}
+
+// Zip4 returns a slice of tuples, where each tuple holds the values at the same index of each of the given slices.
+// If the slices have different lengths, the returned slice is truncated to the length of the shortest slice.
+// To require all slices to have the same length, use the Zip4Strict function.
+func Zip4[Ty1, Ty2, Ty3, Ty4 any](s1 []Ty1, s2 []Ty2, s3 []Ty3, s4 []Ty4) []T4[Ty1, Ty2, Ty3, Ty4] {
+	length := minLen(len(s1), len(s2), len(s3), len(s4))
+
+	zipped := make([]T4[Ty1, Ty2, Ty3, Ty4], length)
+	for i := range zipped {
+		zipped[i] = T4[Ty1, Ty2, Ty3, Ty4]{
+			V1: s1[i],
+			V2: s2[i],
+			V3: s3[i],
+			V4: s4[i],
+		}
+	}
+
+	return zipped
+}
+
+// Zip4Strict returns a slice of tuples, where each tuple holds the values at the same index of each of the given slices.
+// If the slices have different lengths, an error is returned.
+// To truncate the result to the length of the shortest slice instead, use the Zip4 function.
+func Zip4Strict[Ty1, Ty2, Ty3, Ty4 any](s1 []Ty1, s2 []Ty2, s3 []Ty3, s4 []Ty4) ([]T4[Ty1, Ty2, Ty3, Ty4], error) {
+	if len(s2) != len(s1) {
+		return nil, &LengthMismatchError{Source: "slice 2", Expected: len(s1), Actual: len(s2), expectedSource: "slice 1"}
+	}
+	if len(s3) != len(s1) {
+		return nil, &LengthMismatchError{Source: "slice 3", Expected: len(s1), Actual: len(s3), expectedSource: "slice 1"}
+	}
+	if len(s4) != len(s1) {
+		return nil, &LengthMismatchError{Source: "slice 4", Expected: len(s1), Actual: len(s4), expectedSource: "slice 1"}
+	}
+
+	return Zip4(s1, s2, s3, s4), nil
+}
+
+// Unzip4 returns 4 slices, where each slice holds the tuple values at the matching position of each of the given tuples.
+func Unzip4[Ty1, Ty2, Ty3, Ty4 any](ts []T4[Ty1, Ty2, Ty3, Ty4]) ([]Ty1, []Ty2, []Ty3, []Ty4) {
+	s1 := make([]Ty1, len(ts))
+	s2 := make([]Ty2, len(ts))
+	s3 := make([]Ty3, len(ts))
+	s4 := make([]Ty4, len(ts))
+	for i, t := range ts {
+		s1[i] = t.V1
+		s2[i] = t.V2
+		s3[i] = t.V3
+		s4[i] = t.V4
+	}
+
+	return s1, s2, s3, s4
+}
+
 // Equal4 returns whether the host tuple is equal to the other tuple.
 // All tuple elements of the host and guest parameters must match the "comparable" built-in constraint.
 // To test equality of tuples that hold custom Equalable values, use the Equal4E function.
 // To test equality of tuples that hold custom Comparable values, use the Equal4C function.
-// Otherwise, use Equal or reflect.DeepEqual to test tuples of any types.
+// Otherwise, use the Equal method or the EqualFunc4 function to test tuples of any types.
 func Equal4[Ty1, Ty2, Ty3, Ty4 comparable](host, guest T4[Ty1, Ty2, Ty3, Ty4]) bool {
 	return host.V1 == guest.V1 && host.V2 == guest.V2 && host.V3 == guest.V3 && host.V4 == guest.V4
 }
@@ -145,7 +529,7 @@ func Equal4[Ty1, Ty2, Ty3, Ty4 comparable](host, guest T4[Ty1, Ty2, Ty3, Ty4]) b
 // All tuple elements of the host and guest parameters must match the Equalable constraint.
 // To test equality of tuples that hold built-in "comparable" values, use the Equal4 function.
 // To test equality of tuples that hold custom Comparable values, use the Equal4C function.
-// Otherwise, use Equal or reflect.DeepEqual to test tuples of any types.
+// Otherwise, use the Equal method or the EqualFunc4 function to test tuples of any types.
 func Equal4E[Ty1 Equalable[Ty1], Ty2 Equalable[Ty2], Ty3 Equalable[Ty3], Ty4 Equalable[Ty4]](host, guest T4[Ty1, Ty2, Ty3, Ty4]) bool {
 	return host.V1.Equal(guest.V1) && host.V2.Equal(guest.V2) && host.V3.Equal(guest.V3) && host.V4.Equal(guest.V4)
 }
@@ -154,15 +538,28 @@ func Equal4E[Ty1 Equalable[Ty1], Ty2 Equalable[Ty2], Ty3 Equalable[Ty3], Ty4 Equ
 // All tuple elements of the host and guest parameters must match the Comparable constraint.
 // To test equality of tuples that hold built-in "comparable" values, use the Equal4 function.
 // To test equality of tuples that hold custom Equalable values, use the Equal4E function.
-// Otherwise, use Equal or reflect.DeepEqual to test tuples of any types.
+// Otherwise, use the Equal method or the EqualFunc4 function to test tuples of any types.
 func Equal4C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[Ty3], Ty4 Comparable[Ty4]](host, guest T4[Ty1, Ty2, Ty3, Ty4]) bool {
 	return host.V1.CompareTo(guest.V1).EQ() && host.V2.CompareTo(guest.V2).EQ() && host.V3.CompareTo(guest.V3).EQ() && host.V4.CompareTo(guest.V4).EQ()
 }
 
+// EqualFunc4 returns whether the host tuple is equal to the guest tuple, using the given equality function for each element.
+// EqualFunc4 short-circuits once one of the elements is not equal, and the rest of the equality functions are not called.
+func EqualFunc4[Ty1, Ty2, Ty3, Ty4 any](host, guest T4[Ty1, Ty2, Ty3, Ty4], eq1 func(a, b Ty1) bool, eq2 func(a, b Ty2) bool, eq3 func(a, b Ty3) bool, eq4 func(a, b Ty4) bool) bool {
+	return eq1(host.V1, guest.V1) && eq2(host.V2, guest.V2) && eq3(host.V3, guest.V3) && eq4(host.V4, guest.V4)
+}
+
+// Equal returns whether the tuple is equal to the guest tuple.
+// Each element is tested using the Equalable constraint if it is implemented, then the Comparable constraint,
+// then the "==" operator if the element is comparable, and otherwise using reflect.DeepEqual.
+func (t T4[Ty1, Ty2, Ty3, Ty4]) Equal(guest T4[Ty1, Ty2, Ty3, Ty4]) bool {
+	return equalValues(t.V1, guest.V1) && equalValues(t.V2, guest.V2) && equalValues(t.V3, guest.V3) && equalValues(t.V4, guest.V4)
+}
+
 // Compare4 returns whether the host tuple is semantically less than, equal to, or greater than the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the Compare4C function.
-func Compare4[Ty1, Ty2, Ty3, Ty4 constraints.Ordered](host, guest T4[Ty1, Ty2, Ty3, Ty4]) OrderedComparisonResult {
+func Compare4[Ty1, Ty2, Ty3, Ty4 cmp.Ordered](host, guest T4[Ty1, Ty2, Ty3, Ty4]) OrderedComparisonResult {
 	return multiCompare(
 		func() OrderedComparisonResult { return compareOrdered(host.V1, guest.V1) },
 
@@ -189,10 +586,58 @@ func Compare4C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[Ty3], Ty
 	)
 }
 
+// CmpFunc4 returns a comparison function of tuples, which returns a negative number when a < b, a positive number when a > b,
+// and zero when a == b. The returned function can be used with slices.SortFunc, slices.BinarySearchFunc and similar functions.
+// All tuple elements must match the "Ordered" constraint.
+// To compare tuples that hold custom comparable values, use the CmpFunc4C function.
+func CmpFunc4[Ty1, Ty2, Ty3, Ty4 cmp.Ordered]() func(a, b T4[Ty1, Ty2, Ty3, Ty4]) int {
+	return func(a, b T4[Ty1, Ty2, Ty3, Ty4]) int {
+		return int(Compare4(a, b))
+	}
+}
+
+// CmpFunc4C returns a comparison function of tuples, which returns a negative number when a < b, a positive number when a > b,
+// and zero when a == b. The returned function can be used with slices.SortFunc, slices.BinarySearchFunc and similar functions.
+// All tuple elements must match the Comparable constraint.
+// To compare tuples that hold built-in "Ordered" values, use the CmpFunc4 function.
+func CmpFunc4C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[Ty3], Ty4 Comparable[Ty4]]() func(a, b T4[Ty1, Ty2, Ty3, Ty4]) int {
+	return func(a, b T4[Ty1, Ty2, Ty3, Ty4]) int {
+		return int(Compare4C(a, b))
+	}
+}
+
+// Comparator4 returns a comparison function of tuples, which compares the tuple elements by order using the given
+// element comparison functions. Each element comparison function must return a negative number when a < b, a positive number
+// when a > b, and zero when a == b, such as cmp.Compare, CompareOrdered and CompareComparable.
+// The returned function short-circuits once one of the elements is not equal, and the rest of the element comparison functions
+// are not called.
+func Comparator4[Ty1, Ty2, Ty3, Ty4 any](c1 func(a, b Ty1) int, c2 func(a, b Ty2) int, c3 func(a, b Ty3) int, c4 func(a, b Ty4) int) func(a, b T4[Ty1, Ty2, Ty3, Ty4]) int {
+	return func(a, b T4[Ty1, Ty2, Ty3, Ty4]) int {
+		return int(multiCompare(
+			func() OrderedComparisonResult { return OrderedComparisonResult(c1(a.V1, b.V1)) },
+
+			func() OrderedComparisonResult { return OrderedComparisonResult(c2(a.V2, b.V2)) },
+
+			func() OrderedComparisonResult { return OrderedComparisonResult(c3(a.V3, b.V3)) },
+
+			func() OrderedComparisonResult { return OrderedComparisonResult(c4(a.V4, b.V4)) },
+		))
+	}
+}
+
+// OrderBy4 returns a comparison function of tuples, which compares the tuple elements by order according to the
+// sort order given for each element, such as OrderBy4(Asc, Desc, ...).
+// All tuple elements must match the "Ordered" constraint.
+// To order tuples that hold other element types, such as pointers, use the Comparator4 function with
+// the OrderFunc and OrderPtrFunc element comparison functions.
+func OrderBy4[Ty1, Ty2, Ty3, Ty4 cmp.Ordered](o1, o2, o3, o4 SortOrder) func(a, b T4[Ty1, Ty2, Ty3, Ty4]) int {
+	return Comparator4(OrderedBy[Ty1](o1), OrderedBy[Ty2](o2), OrderedBy[Ty3](o3), OrderedBy[Ty4](o4))
+}
+
 // LessThan4 returns whether the host tuple is semantically less than the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the LessThan4C function.
-func LessThan4[Ty1, Ty2, Ty3, Ty4 constraints.Ordered](host, guest T4[Ty1, Ty2, Ty3, Ty4]) bool {
+func LessThan4[Ty1, Ty2, Ty3, Ty4 cmp.Ordered](host, guest T4[Ty1, Ty2, Ty3, Ty4]) bool {
 	return Compare4(host, guest).LT()
 }
 
@@ -206,7 +651,7 @@ func LessThan4C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[Ty3], T
 // LessOrEqual4 returns whether the host tuple is semantically less than or equal to the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the LessOrEqual4C function.
-func LessOrEqual4[Ty1, Ty2, Ty3, Ty4 constraints.Ordered](host, guest T4[Ty1, Ty2, Ty3, Ty4]) bool {
+func LessOrEqual4[Ty1, Ty2, Ty3, Ty4 cmp.Ordered](host, guest T4[Ty1, Ty2, Ty3, Ty4]) bool {
 	return Compare4(host, guest).LE()
 }
 
@@ -220,7 +665,7 @@ func LessOrEqual4C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[Ty3]
 // GreaterThan4 returns whether the host tuple is semantically greater than the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the GreaterThan4C function.
-func GreaterThan4[Ty1, Ty2, Ty3, Ty4 constraints.Ordered](host, guest T4[Ty1, Ty2, Ty3, Ty4]) bool {
+func GreaterThan4[Ty1, Ty2, Ty3, Ty4 cmp.Ordered](host, guest T4[Ty1, Ty2, Ty3, Ty4]) bool {
 	return Compare4(host, guest).GT()
 }
 
@@ -234,7 +679,7 @@ func GreaterThan4C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[Ty3]
 // GreaterOrEqual4 returns whether the host tuple is semantically greater than or equal to the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the GreaterOrEqual4C function.
-func GreaterOrEqual4[Ty1, Ty2, Ty3, Ty4 constraints.Ordered](host, guest T4[Ty1, Ty2, Ty3, Ty4]) bool {
+func GreaterOrEqual4[Ty1, Ty2, Ty3, Ty4 cmp.Ordered](host, guest T4[Ty1, Ty2, Ty3, Ty4]) bool {
 	return Compare4(host, guest).GE()
 }
 
@@ -245,6 +690,35 @@ func GreaterOrEqual4C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[T
 	return Compare4C(host, guest).GE()
 }
 
+// Hash returns a hash of the tuple values using the given seed.
+// Equal tuples return equal hashes for the same seed.
+// Elements supported by the HashValue function are hashed by their values. Other elements, such as slices, maps,
+// functions, nil pointers and nil interfaces, are hashed by their type only, so tuples differing only in these elements collide.
+// To hash tuples holding other element types by their values, use the HashFunc4 function.
+func (t T4[Ty1, Ty2, Ty3, Ty4]) Hash(seed maphash.Seed) uint64 {
+	var h maphash.Hash
+	h.SetSeed(seed)
+	writeHashElement(&h, seed, t.V1)
+	writeHashElement(&h, seed, t.V2)
+	writeHashElement(&h, seed, t.V3)
+	writeHashElement(&h, seed, t.V4)
+	return h.Sum64()
+}
+
+// HashFunc4 returns a hash function of tuples, which hashes the tuple values using the given element hash functions.
+// Each element hash function must return equal hashes for equal values and the same seed, such as HashValue.
+func HashFunc4[Ty1, Ty2, Ty3, Ty4 any](h1 func(seed maphash.Seed, v Ty1) uint64, h2 func(seed maphash.Seed, v Ty2) uint64, h3 func(seed maphash.Seed, v Ty3) uint64, h4 func(seed maphash.Seed, v Ty4) uint64) func(seed maphash.Seed, t T4[Ty1, Ty2, Ty3, Ty4]) uint64 {
+	return func(seed maphash.Seed, t T4[Ty1, Ty2, Ty3, Ty4]) uint64 {
+		var h maphash.Hash
+		h.SetSeed(seed)
+		writeHashUint64(&h, h1(seed, t.V1))
+		writeHashUint64(&h, h2(seed, t.V2))
+		writeHashUint64(&h, h3(seed, t.V3))
+		writeHashUint64(&h, h4(seed, t.V4))
+		return h.Sum64()
+	}
+}
+
 // MarshalJSON marshals the tuple into a JSON array.
 func (t T4[Ty1, Ty2, Ty3, Ty4]) MarshalJSON() ([]byte, error) {
 	return json.Marshal(t.Slice())
@@ -252,29 +726,238 @@ func (t T4[Ty1, Ty2, Ty3, Ty4]) MarshalJSON() ([]byte, error) {
 
 // MarshalJSON unmarshals the tuple from a JSON array.
 func (t *T4[Ty1, Ty2, Ty3, Ty4]) UnmarshalJSON(data []byte) error {
-	// Working with json.RawMessage instead of any enables custom struct support.
-	var slice []json.RawMessage
-	if err := json.Unmarshal(data, &slice); err != nil {
-		return fmt.Errorf("unable to unmarshal json array for tuple: %w", err)
+	return t.UnmarshalJSONWith(data, JSONDecodeOptions{})
+}
+
+// UnmarshalJSONWith unmarshals the tuple from a JSON array using the given decode options.
+// The options are applied to nested tuples as well, including tuples within slices, arrays, maps, pointers and struct fields.
+func (t *T4[Ty1, Ty2, Ty3, Ty4]) UnmarshalJSONWith(data []byte, opts JSONDecodeOptions) error {
+	return unmarshalJSONArray(data, opts, []any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+	})
+}
+
+// MarshalJSONLabeled marshals the tuple into a JSON object, where each of the tuple values is keyed by its matching label.
+// The number of labels must match the number of tuple values.
+func (t T4[Ty1, Ty2, Ty3, Ty4]) MarshalJSONLabeled(labels Labels) ([]byte, error) {
+	return marshalJSONLabeled(labels, t.Slice())
+}
+
+// UnmarshalJSONLabeled unmarshals the tuple from a JSON object, where each of the tuple values is keyed by its matching label.
+// The number of labels must match the number of tuple values.
+// Unless the labels are strict, missing keys leave the matching tuple values unchanged, and unknown keys are ignored.
+func (t *T4[Ty1, Ty2, Ty3, Ty4]) UnmarshalJSONLabeled(data []byte, labels Labels) error {
+	return unmarshalJSONLabeled(data, labels, []any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+	})
+}
+
+// MarshalText marshals the tuple into a text of comma separated values.
+// Commas and backslashes within the values are escaped by a backslash.
+// Values implementing encoding.TextMarshaler are marshalled using their MarshalText method.
+// Otherwise, the values must be of boolean, numeric or string kinds.
+func (t T4[Ty1, Ty2, Ty3, Ty4]) MarshalText() ([]byte, error) {
+	var values [4]string
+	var err error
+	if values[0], err = marshalTextValue(t.V1); err != nil {
+		return nil, fmt.Errorf("value at index 0 failed to marshal: %w", err)
+	}
+	if values[1], err = marshalTextValue(t.V2); err != nil {
+		return nil, fmt.Errorf("value at index 1 failed to marshal: %w", err)
+	}
+	if values[2], err = marshalTextValue(t.V3); err != nil {
+		return nil, fmt.Errorf("value at index 2 failed to marshal: %w", err)
 	}
+	if values[3], err = marshalTextValue(t.V4); err != nil {
+		return nil, fmt.Errorf("value at index 3 failed to marshal: %w", err)
+	}
+
+	return []byte(joinText(values[:])), nil
+}
 
-	if len(slice) != 4 {
-		return fmt.Errorf("unmarshalled json array length %d must match number of tuple values 4", len(slice))
+// UnmarshalText unmarshals the tuple from a text of comma separated values, as created by MarshalText.
+func (t *T4[Ty1, Ty2, Ty3, Ty4]) UnmarshalText(data []byte) error {
+	values, err := splitText(string(data))
+	if err != nil {
+		return fmt.Errorf("unable to unmarshal text for tuple: %w", err)
+	}
+
+	if len(values) != 4 {
+		return &LengthMismatchError{Source: "unmarshalled text values", Expected: 4, Actual: len(values), count: true}
 	}
-	if err := json.Unmarshal(slice[0], &t.V1); err != nil {
-		return fmt.Errorf("value %q at slice index 0 failed to unmarshal: %w", string(slice[0]), err)
+	if err := unmarshalTextValue(values[0], &t.V1); err != nil {
+		return &ElementUnmarshalError{Index: 0, Value: values[0], Err: err, location: locationIndex}
 	}
 
-	if err := json.Unmarshal(slice[1], &t.V2); err != nil {
-		return fmt.Errorf("value %q at slice index 1 failed to unmarshal: %w", string(slice[1]), err)
+	if err := unmarshalTextValue(values[1], &t.V2); err != nil {
+		return &ElementUnmarshalError{Index: 1, Value: values[1], Err: err, location: locationIndex}
 	}
 
-	if err := json.Unmarshal(slice[2], &t.V3); err != nil {
-		return fmt.Errorf("value %q at slice index 2 failed to unmarshal: %w", string(slice[2]), err)
+	if err := unmarshalTextValue(values[2], &t.V3); err != nil {
+		return &ElementUnmarshalError{Index: 2, Value: values[2], Err: err, location: locationIndex}
 	}
 
-	if err := json.Unmarshal(slice[3], &t.V4); err != nil {
-		return fmt.Errorf("value %q at slice index 3 failed to unmarshal: %w", string(slice[3]), err)
+	if err := unmarshalTextValue(values[3], &t.V4); err != nil {
+		return &ElementUnmarshalError{Index: 3, Value: values[3], Err: err, location: locationIndex}
 	}
 	return nil
 }
+
+// GobEncode encodes the tuple for encoding/gob, implementing the gob.GobEncoder interface.
+// Nil pointer and interface values are preserved as nil, and pointers to zero values are preserved as non-nil.
+// See the package documentation for the wire format.
+func (t T4[Ty1, Ty2, Ty3, Ty4]) GobEncode() ([]byte, error) {
+	return gobEncodeValues([]any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+	})
+}
+
+// GobDecode decodes the tuple from encoding/gob, as encoded by GobEncode, implementing the gob.GobDecoder interface.
+// If the number of encoded values doesn't match, a *LengthMismatchError is returned.
+func (t *T4[Ty1, Ty2, Ty3, Ty4]) GobDecode(data []byte) error {
+	return gobDecodeValues(data, []any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+	})
+}
+
+// MarshalComposite marshals the tuple into a PostgreSQL composite literal, such as (1,"foo bar",).
+// Values are quoted and escaped the same way PostgreSQL does, and nil values and pointers are marshalled as NULL.
+// Nested tuples are marshalled as nested composite values, and other values are marshalled the same way as MarshalText does.
+func (t T4[Ty1, Ty2, Ty3, Ty4]) MarshalComposite() ([]byte, error) {
+	return marshalComposite(t.Slice())
+}
+
+// UnmarshalComposite unmarshals the tuple from a PostgreSQL composite literal, as created by MarshalComposite or by PostgreSQL.
+// NULL values can only be unmarshalled into pointers, interfaces, slices and maps, which are set to nil.
+// If the number of composite fields doesn't match, a *LengthMismatchError is returned.
+func (t *T4[Ty1, Ty2, Ty3, Ty4]) UnmarshalComposite(data []byte) error {
+	return unmarshalComposite(data, []any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+	})
+}
+
+// MarshalXML marshals the tuple into a sequence of xml child elements named v1 to v4, implementing the xml.Marshaler interface.
+// Nil values are marshalled as empty child elements with a nil="true" attribute,
+// and slice values are marshalled as child elements holding an item element for each of the slice items.
+// Use MarshalXMLLabeled to name the child elements differently.
+func (t T4[Ty1, Ty2, Ty3, Ty4]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return t.MarshalXMLLabeled(e, start, indexLabels(4))
+}
+
+// UnmarshalXML unmarshals the tuple from a sequence of xml child elements named v1 to v4, implementing the xml.Unmarshaler interface.
+// The number of child elements must match the number of tuple values, the same way as UnmarshalJSON requires.
+func (t *T4[Ty1, Ty2, Ty3, Ty4]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return t.UnmarshalXMLLabeled(d, start, indexLabels(4))
+}
+
+// MarshalXMLLabeled marshals the tuple into a sequence of xml child elements, named by the labels matching each of the tuple values.
+// The number of labels must match the number of tuple values.
+func (t T4[Ty1, Ty2, Ty3, Ty4]) MarshalXMLLabeled(e *xml.Encoder, start xml.StartElement, labels Labels) error {
+	return marshalXML(e, start, labels, t.Slice())
+}
+
+// UnmarshalXMLLabeled unmarshals the tuple from a sequence of xml child elements, named by the labels matching each of the tuple values.
+// The child elements must be named by the labels in the same order, and their number must match the number of tuple values.
+// If the number of child elements doesn't match, a *LengthMismatchError is returned.
+func (t *T4[Ty1, Ty2, Ty3, Ty4]) UnmarshalXMLLabeled(d *xml.Decoder, start xml.StartElement, labels Labels) error {
+	return unmarshalXML(d, start, labels, []any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+	})
+}
+
+// MarshalBinary marshals the tuple into its binary encoding using little-endian byte order,
+// implementing the encoding.BinaryMarshaler interface.
+// The tuple values must be of boolean, fixed-size numeric, string or byte slice kinds, or nested tuples.
+// See the package documentation for the binary encoding.
+func (t T4[Ty1, Ty2, Ty3, Ty4]) MarshalBinary() ([]byte, error) {
+	return t.AppendBinary(nil)
+}
+
+// AppendBinary appends the binary encoding of the tuple to b using little-endian byte order,
+// the same way as MarshalBinary does.
+func (t T4[Ty1, Ty2, Ty3, Ty4]) AppendBinary(b []byte) ([]byte, error) {
+	return t.AppendBinaryOrder(b, binary.LittleEndian)
+}
+
+// AppendBinaryOrder appends the binary encoding of the tuple to b using the given byte order.
+// Values of common types are encoded without allocations, other than the ones needed to grow b.
+func (t T4[Ty1, Ty2, Ty3, Ty4]) AppendBinaryOrder(b []byte, order binary.AppendByteOrder) ([]byte, error) {
+	var err error
+	if b, err = appendBinaryValue(b, order, t.V1); err != nil {
+		return nil, fmt.Errorf("value at index 0 failed to marshal: %w", err)
+	}
+	if b, err = appendBinaryValue(b, order, t.V2); err != nil {
+		return nil, fmt.Errorf("value at index 1 failed to marshal: %w", err)
+	}
+	if b, err = appendBinaryValue(b, order, t.V3); err != nil {
+		return nil, fmt.Errorf("value at index 2 failed to marshal: %w", err)
+	}
+	if b, err = appendBinaryValue(b, order, t.V4); err != nil {
+		return nil, fmt.Errorf("value at index 3 failed to marshal: %w", err)
+	}
+
+	return b, nil
+}
+
+// UnmarshalBinary unmarshals the tuple from its binary encoding using little-endian byte order,
+// implementing the encoding.BinaryUnmarshaler interface.
+func (t *T4[Ty1, Ty2, Ty3, Ty4]) UnmarshalBinary(data []byte) error {
+	return t.UnmarshalBinaryOrder(data, binary.LittleEndian)
+}
+
+// UnmarshalBinaryOrder unmarshals the tuple from its binary encoding using the given byte order.
+// The data must hold exactly the binary encoding of the tuple.
+func (t *T4[Ty1, Ty2, Ty3, Ty4]) UnmarshalBinaryOrder(data []byte, order binary.ByteOrder) error {
+	return unmarshalBinary(data, order, t)
+}
+
+// decodeBinary decodes the tuple from the beginning of data using the given byte order, and returns the rest of the data.
+func (t *T4[Ty1, Ty2, Ty3, Ty4]) decodeBinary(data []byte, order binary.ByteOrder) ([]byte, error) {
+	var err error
+	if data, err = decodeBinaryValue(data, order, &t.V1); err != nil {
+		return nil, fmt.Errorf("value at index 0 failed to unmarshal: %w", err)
+	}
+	if data, err = decodeBinaryValue(data, order, &t.V2); err != nil {
+		return nil, fmt.Errorf("value at index 1 failed to unmarshal: %w", err)
+	}
+	if data, err = decodeBinaryValue(data, order, &t.V3); err != nil {
+		return nil, fmt.Errorf("value at index 2 failed to unmarshal: %w", err)
+	}
+	if data, err = decodeBinaryValue(data, order, &t.V4); err != nil {
+		return nil, fmt.Errorf("value at index 3 failed to unmarshal: %w", err)
+	}
+
+	return data, nil
+}
+
+// AppendBinarySlice4 appends the binary encoding of a slice of tuples to b using the given byte order.
+// The slice is encoded as its length followed by the binary encoding of each of the tuples.
+func AppendBinarySlice4[Ty1, Ty2, Ty3, Ty4 any](b []byte, order binary.AppendByteOrder, tuples []T4[Ty1, Ty2, Ty3, Ty4]) ([]byte, error) {
+	return appendBinarySlice(b, order, tuples)
+}
+
+// UnmarshalBinarySlice4 unmarshals a slice of tuples from its binary encoding using the given byte order,
+// as encoded by AppendBinarySlice4, and appends the tuples to dst.
+// Tuples are decoded in place, so decoding into a dst with enough capacity, such as a previously decoded slice truncated to zero length,
+// doesn't allocate unless the tuples hold strings, or byte slices longer than the ones previously held in that capacity.
+func UnmarshalBinarySlice4[Ty1, Ty2, Ty3, Ty4 any](data []byte, order binary.ByteOrder, dst []T4[Ty1, Ty2, Ty3, Ty4]) ([]T4[Ty1, Ty2, Ty3, Ty4], error) {
+	return unmarshalBinarySlice(data, order, dst)
+}
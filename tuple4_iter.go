@@ -0,0 +1,72 @@
+//go:build go1.23
+
+package tuple
+
+import (
+	"io"
+	"iter"
+)
+
+// All returns an iterator over the index and value of each of the tuple values.
+func (t T4[Ty1, Ty2, Ty3, Ty4]) All() iter.Seq2[int, any] {
+	return func(yield func(int, any) bool) {
+		if !yield(0, t.V1) {
+			return
+		}
+		if !yield(1, t.V2) {
+			return
+		}
+		if !yield(2, t.V3) {
+			return
+		}
+		if !yield(3, t.V4) {
+			return
+		}
+	}
+}
+
+// ZipSeq4 returns an iterator over tuples, where each tuple holds the next value of each of the given iterators.
+// The returned iterator stops once any of the given iterators is exhausted.
+func ZipSeq4[Ty1, Ty2, Ty3, Ty4 any](s1 iter.Seq[Ty1], s2 iter.Seq[Ty2], s3 iter.Seq[Ty3], s4 iter.Seq[Ty4]) iter.Seq[T4[Ty1, Ty2, Ty3, Ty4]] {
+	return func(yield func(T4[Ty1, Ty2, Ty3, Ty4]) bool) {
+		next2, stop2 := iter.Pull(s2)
+		defer stop2()
+		next3, stop3 := iter.Pull(s3)
+		defer stop3()
+		next4, stop4 := iter.Pull(s4)
+		defer stop4()
+
+		for v1 := range s1 {
+			v2, ok := next2()
+			if !ok {
+				return
+			}
+			v3, ok := next3()
+			if !ok {
+				return
+			}
+			v4, ok := next4()
+			if !ok {
+				return
+			}
+
+			if !yield(New4(v1, v2, v3, v4)) {
+				return
+			}
+		}
+	}
+}
+
+// ReadCSVSeq4 returns an iterator over the CSV records of r converted into tuples, the same way as ReadCSV4 does.
+// Records that fail to parse or convert are yielded along with their errors, and the iteration continues with the next record.
+// Other errors, such as read errors, are yielded once and stop the iteration.
+func ReadCSVSeq4[Ty1, Ty2, Ty3, Ty4 any](r io.Reader, opts CSVOptions) iter.Seq2[T4[Ty1, Ty2, Ty3, Ty4], error] {
+	return readCSVSeq(r, opts, func(t *T4[Ty1, Ty2, Ty3, Ty4]) []any {
+		return []any{
+			&t.V1,
+			&t.V2,
+			&t.V3,
+			&t.V4,
+		}
+	})
+}
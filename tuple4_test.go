@@ -1,7 +1,17 @@
 package tuple
 
 import (
+	"database/sql/driver"
+	"encoding/binary"
 	"encoding/json"
+	"encoding/xml"
+	"hash/maphash"
+	"io"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -31,6 +41,170 @@ func TestT4_Values(t *testing.T) {
 	require.Equal(t, "4", v4)
 }
 
+func TestT4_MapAt(t *testing.T) {
+	tup := New4(1, 2, 3, 4)
+
+	require.Equal(t, New4("1", 2, 3, 4), Map4At1(tup, strconv.Itoa))
+	require.Equal(t, New4(1, "2", 3, 4), Map4At2(tup, strconv.Itoa))
+	require.Equal(t, New4(1, 2, "3", 4), Map4At3(tup, strconv.Itoa))
+	require.Equal(t, New4(1, 2, 3, "4"), Map4At4(tup, strconv.Itoa))
+}
+
+func TestT4_Map(t *testing.T) {
+	tup := New4(1, 2, 3, 4)
+	got := Map4(tup, strconv.Itoa, strconv.Itoa, strconv.Itoa, strconv.Itoa)
+	require.Equal(t, New4("1", "2", "3", "4"), got)
+}
+
+func TestT4_Append(t *testing.T) {
+	tup := New4("1", "2", "3", "4")
+	require.Equal(t, New5("1", "2", "3", "4", "5"), Append4(tup, "5"))
+}
+
+func TestT4_Prepend(t *testing.T) {
+	tup := New4("2", "3", "4", "5")
+	require.Equal(t, New5("1", "2", "3", "4", "5"), Prepend4(tup, "1"))
+}
+
+func TestT4_Concat(t *testing.T) {
+	tup := New4("1", "2", "3", "4")
+
+	require.Equal(t,
+		New5("1", "2", "3", "4", "5"),
+		Concat4_1(tup, New1("5")),
+	)
+	require.Equal(t,
+		New6("1", "2", "3", "4", "5", "6"),
+		Concat4_2(tup, New2("5", "6")),
+	)
+	require.Equal(t,
+		New7("1", "2", "3", "4", "5", "6", "7"),
+		Concat4_3(tup, New3("5", "6", "7")),
+	)
+	require.Equal(t,
+		New8("1", "2", "3", "4", "5", "6", "7", "8"),
+		Concat4_4(tup, New4("5", "6", "7", "8")),
+	)
+	require.Equal(t,
+		New9("1", "2", "3", "4", "5", "6", "7", "8", "9"),
+		Concat4_5(tup, New5("5", "6", "7", "8", "9")),
+	)
+}
+
+func TestT4_Take(t *testing.T) {
+	tup := New4("1", "2", "3", "4")
+
+	require.Equal(t, New1("1"), Take4_1(tup))
+	require.Equal(t, New2("1", "2"), Take4_2(tup))
+	require.Equal(t, New3("1", "2", "3"), Take4_3(tup))
+}
+
+func TestT4_Drop(t *testing.T) {
+	tup := New4("1", "2", "3", "4")
+
+	require.Equal(t, New3("2", "3", "4"), Drop4_1(tup))
+	require.Equal(t, New2("3", "4"), Drop4_2(tup))
+	require.Equal(t, New1("4"), Drop4_3(tup))
+}
+
+func TestT4_Split(t *testing.T) {
+	tup := New4("1", "2", "3", "4")
+
+	require.Equal(t,
+		New2(New1("1"), New3("2", "3", "4")),
+		Split4_1(tup),
+	)
+	require.Equal(t,
+		New2(New2("1", "2"), New2("3", "4")),
+		Split4_2(tup),
+	)
+	require.Equal(t,
+		New2(New3("1", "2", "3"), New1("4")),
+		Split4_3(tup),
+	)
+}
+
+func TestT4_Reverse(t *testing.T) {
+	tup := New4(1, 2, 3, 4)
+	require.Equal(t, New4(4, 3, 2, 1), Reverse4(tup))
+}
+
+func TestT4_Apply(t *testing.T) {
+	tup := New4("1", "2", "3", "4")
+	got := Apply4(tup, func(v1, v2, v3, v4 string) string {
+		return v1 + v2 + v3 + v4
+	})
+	require.Equal(t, "1234", got)
+}
+
+func TestT4_Tupled(t *testing.T) {
+	tup := New4("1", "2", "3", "4")
+	f := Tupled4(func(v1, v2, v3, v4 string) string {
+		return v1 + v2 + v3 + v4
+	})
+	require.Equal(t, "1234", f(tup))
+}
+
+func TestT4_Untupled(t *testing.T) {
+	f := Untupled4(func(tup T4[string, string, string, string]) T4[string, string, string, string] {
+		return tup
+	})
+	require.Equal(t, New4("1", "2", "3", "4"), f("1", "2", "3", "4"))
+}
+
+func TestT4_Zip(t *testing.T) {
+	want := []T4[int, int, int, int]{
+		New4(1, 2, 3, 4),
+		New4(2, 3, 4, 5),
+	}
+
+	require.Equal(t, want, Zip4([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}))
+	require.Equal(t, want[:1], Zip4([]int{1}, []int{2, 3}, []int{3, 4}, []int{4, 5}))
+	require.Equal(t, want[:1], Zip4([]int{1, 2}, []int{2}, []int{3, 4}, []int{4, 5}))
+	require.Equal(t, want[:1], Zip4([]int{1, 2}, []int{2, 3}, []int{3}, []int{4, 5}))
+	require.Equal(t, want[:1], Zip4([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4}))
+}
+
+func TestT4_ZipStrict(t *testing.T) {
+	want := []T4[int, int, int, int]{
+		New4(1, 2, 3, 4),
+		New4(2, 3, 4, 5),
+	}
+
+	got, err := Zip4Strict([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5})
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	_, err = Zip4Strict([]int{1}, []int{2, 3}, []int{3, 4}, []int{4, 5})
+	require.EqualError(t, err, "slice 2 length 2 must match slice 1 length 1")
+	var lengthErr1 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr1)
+	_, err = Zip4Strict([]int{1, 2}, []int{2}, []int{3, 4}, []int{4, 5})
+	require.EqualError(t, err, "slice 2 length 1 must match slice 1 length 2")
+	var lengthErr2 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr2)
+	_, err = Zip4Strict([]int{1, 2}, []int{2, 3}, []int{3}, []int{4, 5})
+	require.EqualError(t, err, "slice 3 length 1 must match slice 1 length 2")
+	var lengthErr3 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr3)
+	_, err = Zip4Strict([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4})
+	require.EqualError(t, err, "slice 4 length 1 must match slice 1 length 2")
+	var lengthErr4 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr4)
+}
+
+func TestT4_Unzip(t *testing.T) {
+	s1, s2, s3, s4 := Unzip4([]T4[int, int, int, int]{
+		New4(1, 2, 3, 4),
+		New4(2, 3, 4, 5),
+	})
+
+	require.Equal(t, []int{1, 2}, s1)
+	require.Equal(t, []int{2, 3}, s2)
+	require.Equal(t, []int{3, 4}, s3)
+	require.Equal(t, []int{4, 5}, s4)
+}
+
 func TestT4_Compare(t *testing.T) {
 	lesser := New4(1, 2, 3, 4)
 	greater := New4(2, 3, 4, 5)
@@ -211,6 +385,140 @@ func TestT4_CompareC(t *testing.T) {
 	}
 }
 
+func TestT4_CmpFunc(t *testing.T) {
+	tups := []T4[int, int, int, int]{
+		New4(2, 3, 4, 5),
+		New4(1, 2, 3, 4),
+		New4(3, 4, 5, 6),
+	}
+
+	cmp := CmpFunc4[int, int, int, int]()
+	slices.SortFunc(tups, cmp)
+	require.Equal(t, []T4[int, int, int, int]{
+		New4(1, 2, 3, 4),
+		New4(2, 3, 4, 5),
+		New4(3, 4, 5, 6),
+	}, tups)
+
+	index, found := slices.BinarySearchFunc(tups, New4(2, 3, 4, 5), cmp)
+	require.True(t, found)
+	require.Equal(t, 1, index)
+}
+
+func TestT4_CmpFuncC(t *testing.T) {
+	tups := []T4[stringComparable, stringComparable, stringComparable, stringComparable]{
+		New4(stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5")),
+		New4(stringComparable("1"), stringComparable("2"), stringComparable("3"), stringComparable("4")),
+	}
+
+	slices.SortFunc(tups, CmpFunc4C[stringComparable, stringComparable, stringComparable, stringComparable]())
+	require.Equal(t, []T4[stringComparable, stringComparable, stringComparable, stringComparable]{
+		New4(stringComparable("1"), stringComparable("2"), stringComparable("3"), stringComparable("4")),
+		New4(stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5")),
+	}, tups)
+}
+
+func TestT4_Compare_NaN(t *testing.T) {
+	nan := New4(math.NaN(), math.NaN(), math.NaN(), math.NaN())
+	num := New4(1.0, 2.0, 3.0, 4.0)
+
+	require.True(t, Compare4(nan, nan).EQ())
+	require.True(t, Compare4(nan, num).LT())
+	require.True(t, Compare4(num, nan).GT())
+}
+
+func TestT4_Comparator(t *testing.T) {
+	compare := Comparator4(CompareOrdered[int], CompareOrdered[int], CompareOrdered[int], CompareOrdered[int])
+	lesser := New4(1, 2, 3, 4)
+
+	require.Equal(t, 0, compare(lesser, lesser))
+
+	greater1 := New4(2, 2, 3, 4)
+	require.Equal(t, -1, compare(lesser, greater1))
+	require.Equal(t, 1, compare(greater1, lesser))
+
+	greater2 := New4(1, 3, 3, 4)
+	require.Equal(t, -1, compare(lesser, greater2))
+	require.Equal(t, 1, compare(greater2, lesser))
+
+	greater3 := New4(1, 2, 4, 4)
+	require.Equal(t, -1, compare(lesser, greater3))
+	require.Equal(t, 1, compare(greater3, lesser))
+
+	greater4 := New4(1, 2, 3, 5)
+	require.Equal(t, -1, compare(lesser, greater4))
+	require.Equal(t, 1, compare(greater4, lesser))
+}
+
+func TestT4_Comparator_Comparable(t *testing.T) {
+	compare := Comparator4(CompareComparable[stringComparable], CompareComparable[stringComparable], CompareComparable[stringComparable], CompareComparable[stringComparable])
+	lesser := New4(stringComparable("1"), stringComparable("2"), stringComparable("3"), stringComparable("4"))
+	greater := New4(stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5"))
+
+	require.Equal(t, -1, compare(lesser, greater))
+	require.Equal(t, 1, compare(greater, lesser))
+	require.Equal(t, 0, compare(lesser, lesser))
+}
+
+func TestT4_Comparator_ShortCircuit(t *testing.T) {
+	unreachable := func(a, b int) int {
+		t.Fatal("comparison function should not be called")
+		return 0
+	}
+
+	compare := Comparator4(CompareOrdered[int], unreachable, unreachable, unreachable)
+	require.Equal(t, -1, compare(New4(1, 2, 3, 4), New4(2, 3, 4, 5)))
+}
+
+func TestT4_OrderBy(t *testing.T) {
+	lesser := New4(1, 2, 3, 4)
+	asc := OrderBy4[int, int, int, int](Asc, Asc, Asc, Asc)
+
+	greater1 := New4(2, 2, 3, 4)
+	desc1 := OrderBy4[int, int, int, int](Desc, Asc, Asc, Asc)
+	require.Equal(t, -1, asc(lesser, greater1))
+	require.Equal(t, 1, desc1(lesser, greater1))
+	require.Equal(t, 0, desc1(lesser, lesser))
+
+	greater2 := New4(1, 3, 3, 4)
+	desc2 := OrderBy4[int, int, int, int](Asc, Desc, Asc, Asc)
+	require.Equal(t, -1, asc(lesser, greater2))
+	require.Equal(t, 1, desc2(lesser, greater2))
+	require.Equal(t, 0, desc2(lesser, lesser))
+
+	greater3 := New4(1, 2, 4, 4)
+	desc3 := OrderBy4[int, int, int, int](Asc, Asc, Desc, Asc)
+	require.Equal(t, -1, asc(lesser, greater3))
+	require.Equal(t, 1, desc3(lesser, greater3))
+	require.Equal(t, 0, desc3(lesser, lesser))
+
+	greater4 := New4(1, 2, 3, 5)
+	desc4 := OrderBy4[int, int, int, int](Asc, Asc, Asc, Desc)
+	require.Equal(t, -1, asc(lesser, greater4))
+	require.Equal(t, 1, desc4(lesser, greater4))
+	require.Equal(t, 0, desc4(lesser, lesser))
+}
+
+func TestT4_Comparator_OrderedPtrBy(t *testing.T) {
+	compare := Comparator4(
+		OrderedBy[int](Asc),
+		OrderedBy[int](Asc),
+		OrderedBy[int](Asc),
+		OrderedPtrBy[int](Desc.NilsFirst()),
+	)
+
+	tups := []T4[int, int, int, *int]{
+		New4(1, 2, 3, ptr(1)),
+		New4(1, 2, 3, ptr(2)),
+		New4(1, 2, 3, (*int)(nil)),
+	}
+	slices.SortFunc(tups, compare)
+
+	require.Nil(t, tups[0].V4)
+	require.Equal(t, 2, *tups[1].V4)
+	require.Equal(t, 1, *tups[2].V4)
+}
+
 func TestT4_EqualE(t *testing.T) {
 	a := New4(intEqualable(1), intEqualable(2), intEqualable(3), intEqualable(4))
 	b := New4(intEqualable(2), intEqualable(3), intEqualable(4), intEqualable(5))
@@ -219,6 +527,36 @@ func TestT4_EqualE(t *testing.T) {
 	require.True(t, Equal4E(a, a))
 }
 
+func TestT4_EqualFunc(t *testing.T) {
+	a := New4([]int{1}, []int{2}, []int{3}, []int{4})
+	b := New4([]int{1}, []int{2}, []int{3}, []int{4})
+
+	require.True(t, EqualFunc4(a, b, slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc4(a, New4([]int{2}, []int{2}, []int{3}, []int{4}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc4(a, New4([]int{1}, []int{3}, []int{3}, []int{4}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc4(a, New4([]int{1}, []int{2}, []int{4}, []int{4}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc4(a, New4([]int{1}, []int{2}, []int{3}, []int{5}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+}
+
+func TestT4_EqualMethod(t *testing.T) {
+	a := New4([]int{1}, []int{2}, []int{3}, []int{4})
+
+	require.True(t, a.Equal(New4([]int{1}, []int{2}, []int{3}, []int{4})))
+	require.False(t, a.Equal(New4([]int{2}, []int{2}, []int{3}, []int{4})))
+	require.False(t, a.Equal(New4([]int{1}, []int{3}, []int{3}, []int{4})))
+	require.False(t, a.Equal(New4([]int{1}, []int{2}, []int{4}, []int{4})))
+	require.False(t, a.Equal(New4([]int{1}, []int{2}, []int{3}, []int{5})))
+}
+
+func TestT4_EqualMethod_Nested(t *testing.T) {
+	a := New4(New1(intEqualable(1)), New1(intEqualable(2)), New1(intEqualable(3)), New1(intEqualable(4)))
+	b := New4(New1(intEqualable(2)), New1(intEqualable(3)), New1(intEqualable(4)), New1(intEqualable(5)))
+
+	require.True(t, a.Equal(a))
+	require.False(t, a.Equal(b))
+	require.True(t, Equal4E(a, a))
+}
+
 func TestT4_String(t *testing.T) {
 	tup := New4("1", "2", "3", "4")
 	require.Equal(t, `["1" "2" "3" "4"]`, tup.String())
@@ -229,6 +567,31 @@ func TestT4_GoString(t *testing.T) {
 	require.Equal(t, `tuple.T4[string, string, string, string]{V1: "1", V2: "2", V3: "3", V4: "4"}`, tup.GoString())
 }
 
+func TestT4_Parse(t *testing.T) {
+	tup := New4("1", "2", "3", "4")
+
+	got, err := Parse4[string, string, string, string](tup.String())
+	require.NoError(t, err)
+	require.Equal(t, tup, got)
+}
+
+func TestT4_Parse_Mixed(t *testing.T) {
+	tup := New4(New2("1", 1.5), New2("2", 2.5), New2("3", 3.5), New2("4", 4.5))
+
+	got, err := Parse4[T2[string, float64], T2[string, float64], T2[string, float64], T2[string, float64]](tup.String())
+	require.NoError(t, err)
+	require.Equal(t, tup, got)
+}
+
+func TestT4_Parse_Invalid(t *testing.T) {
+	_, err := Parse4[int, int, int, int](`["1" "2" "3" "4"]`)
+	require.Error(t, err)
+
+	var parseErr *ParseError
+	require.ErrorAs(t, err, &parseErr)
+	require.Equal(t, 1, parseErr.Offset)
+}
+
 func TestT4_ToArray(t *testing.T) {
 	tup := New4("1", "2", "3", "4")
 	require.Equal(t, [4]any{
@@ -503,6 +866,390 @@ func TestT4_FromSlice(t *testing.T) {
 	}
 }
 
+func TestT4_FromSlice_Errors(t *testing.T) {
+	_, err := FromSlice4[string, string, string, string]([]any{})
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, &LengthMismatchError{Source: "slice", Expected: 4, Actual: 0}, lengthErr)
+	require.EqualError(t, err, "slice length 0 must match number of tuple values 4")
+
+	_, err = FromSlice4[string, string, string, string]([]any{1, "2", "3", "4"})
+	var typeErr1 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr1)
+	require.Equal(t, 0, typeErr1.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr1.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr1.Actual)
+	require.EqualError(t, err, "value at slice index 0 expected to have type string but has type int")
+
+	_, err = FromSlice4[string, string, string, string]([]any{"1", 2, "3", "4"})
+	var typeErr2 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr2)
+	require.Equal(t, 1, typeErr2.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr2.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr2.Actual)
+	require.EqualError(t, err, "value at slice index 1 expected to have type string but has type int")
+
+	_, err = FromSlice4[string, string, string, string]([]any{"1", "2", 3, "4"})
+	var typeErr3 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr3)
+	require.Equal(t, 2, typeErr3.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr3.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr3.Actual)
+	require.EqualError(t, err, "value at slice index 2 expected to have type string but has type int")
+
+	_, err = FromSlice4[string, string, string, string]([]any{"1", "2", "3", 4})
+	var typeErr4 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr4)
+	require.Equal(t, 3, typeErr4.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr4.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr4.Actual)
+	require.EqualError(t, err, "value at slice index 3 expected to have type string but has type int")
+
+	_, err = FromArray4[string, string, string, string]([4]any{})
+	var arrayTypeErr *ElementTypeError
+	require.ErrorAs(t, err, &arrayTypeErr)
+	require.Equal(t, &ElementTypeError{Source: "array", Index: 0, Expected: reflect.TypeOf("")}, arrayTypeErr)
+	require.EqualError(t, err, "value at array index 0 expected to have type string but has type <nil>")
+}
+
+func TestT4_UnmarshalJSON_Errors(t *testing.T) {
+	var tup T4[string, string, string, string]
+
+	err := json.Unmarshal([]byte(`[]`), &tup)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, 4, lengthErr.Expected)
+	require.Equal(t, 0, lengthErr.Actual)
+	require.EqualError(t, err, "unmarshalled json array length 0 must match number of tuple values 4")
+
+	err = tup.UnmarshalJSON([]byte(`["1","2","3",4]`))
+	var elementErr *ElementUnmarshalError
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, 3, elementErr.Index)
+	require.Equal(t, "4", elementErr.Value)
+	var typeErr *json.UnmarshalTypeError
+	require.ErrorAs(t, err, &typeErr)
+	require.ErrorContains(t, err, `value "4" at slice index 3 failed to unmarshal: `)
+}
+
+func TestT4_ConvertSlice(t *testing.T) {
+	tests := []struct {
+		name    string
+		slice   []any
+		want    T4[int, int, int, int]
+		wantErr bool
+	}{
+		{
+			name:  "all types match",
+			slice: []any{1, 2, 3, 4},
+			want:  New4(1, 2, 3, 4),
+		},
+		{
+			name:  "decoded json numbers",
+			slice: []any{float64(1), float64(2), float64(3), float64(4)},
+			want:  New4(1, 2, 3, 4),
+		},
+		{
+			name:  "json numbers",
+			slice: []any{json.Number("1"), json.Number("2"), json.Number("3"), json.Number("4")},
+			want:  New4(1, 2, 3, 4),
+		},
+		{
+			name:    "slice empty",
+			slice:   []any{},
+			wantErr: true,
+		},
+		{
+			name:    "index 1 bad type",
+			slice:   []any{"1", 2, 3, 4},
+			wantErr: true,
+		},
+		{
+			name:    "index 1 fractional number",
+			slice:   []any{1.5, 2, 3, 4},
+			wantErr: true,
+		},
+		{
+			name:    "index 2 bad type",
+			slice:   []any{1, "2", 3, 4},
+			wantErr: true,
+		},
+		{
+			name:    "index 2 fractional number",
+			slice:   []any{1, 2.5, 3, 4},
+			wantErr: true,
+		},
+		{
+			name:    "index 3 bad type",
+			slice:   []any{1, 2, "3", 4},
+			wantErr: true,
+		},
+		{
+			name:    "index 3 fractional number",
+			slice:   []any{1, 2, 3.5, 4},
+			wantErr: true,
+		},
+		{
+			name:    "index 4 bad type",
+			slice:   []any{1, 2, 3, "4"},
+			wantErr: true,
+		},
+		{
+			name:    "index 4 fractional number",
+			slice:   []any{1, 2, 3, 4.5},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tup, err := ConvertSlice4[int, int, int, int](tt.slice)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, tup)
+		})
+	}
+}
+
+func TestT4_ConvertSlice_Errors(t *testing.T) {
+	_, err := ConvertSlice4[int8, int8, int8, int8]([]any{})
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+
+	_, err = ConvertSlice4[int8, int8, int8, int8]([]any{1, 2, 3, true})
+	var typeErr *ElementTypeError
+	require.ErrorAs(t, err, &typeErr)
+	require.Equal(t, 3, typeErr.Index)
+
+	_, err = ConvertSlice4[int8, int8, int8, int8]([]any{1, 2, 3, float64(128)})
+	var conversionErr *ElementConversionError
+	require.ErrorAs(t, err, &conversionErr)
+	require.Equal(t, 3, conversionErr.Index)
+	require.Equal(t, reflect.TypeOf(int8(0)), conversionErr.Expected)
+}
+
+func TestT4_ScanRow(t *testing.T) {
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{"c1", "c2", "c3", "c4"},
+		rows: [][]driver.Value{
+			{int64(1), int64(2), int64(3), int64(4)},
+		},
+	})
+
+	require.True(t, rows.Next())
+	got, err := ScanRow4[string, int, int, int](rows)
+	require.NoError(t, err)
+	require.Equal(t, New4("1", 2, 3, 4), got)
+}
+
+func TestT4_ScanRow_ColumnsMismatch(t *testing.T) {
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{"c1", "c2", "c3", "c4", "extra"},
+		rows: [][]driver.Value{
+			{int64(1), int64(2), int64(3), int64(4), int64(0)},
+		},
+	})
+
+	require.True(t, rows.Next())
+	_, err := ScanRow4[int, int, int, int](rows)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.EqualError(t, err, "row columns count 5 must match number of tuple values 4")
+}
+
+func TestT4_CollectRows(t *testing.T) {
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{"c1", "c2", "c3", "c4"},
+		rows: [][]driver.Value{
+			{int64(1), int64(2), int64(3), int64(4)},
+			{int64(2), int64(3), int64(4), int64(5)},
+		},
+	})
+
+	got, err := CollectRows4[int, int, int, int](rows)
+	require.NoError(t, err)
+	require.Equal(t, []T4[int, int, int, int]{
+		New4(1, 2, 3, 4),
+		New4(2, 3, 4, 5),
+	}, got)
+
+	rows = fakeQuery(t, fakeResult{columns: []string{"c1", "c2", "c3", "c4"}})
+	got, err = CollectRows4[int, int, int, int](rows)
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
+
+func TestT4_ReadCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		opts    CSVOptions
+		want    []T4[int, int, int, int]
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			data: "",
+			want: nil,
+		},
+		{
+			name: "records",
+			data: "1,2,3,4\n2,3,4,5\n",
+			want: []T4[int, int, int, int]{
+				New4(1, 2, 3, 4),
+				New4(2, 3, 4, 5),
+			},
+		},
+		{
+			name: "header",
+			data: "v1,v2,v3,v4\n1,2,3,4\n",
+			opts: CSVOptions{Header: []string{"v1", "v2", "v3", "v4"}},
+			want: []T4[int, int, int, int]{New4(1, 2, 3, 4)},
+		},
+		{
+			name: "skip header",
+			data: "c1,c2,c3,c4\n1,2,3,4\n",
+			opts: CSVOptions{SkipHeader: true},
+			want: []T4[int, int, int, int]{New4(1, 2, 3, 4)},
+		},
+		{
+			name:    "mismatching header",
+			data:    "c1,c2,c3,c4\n1,2,3,4\n",
+			opts:    CSVOptions{Header: []string{"v1", "v2", "v3", "v4"}},
+			wantErr: true,
+		},
+		{
+			name: "semicolon delimiter",
+			data: "1;2;3;4\n",
+			opts: CSVOptions{Comma: ';'},
+			want: []T4[int, int, int, int]{New4(1, 2, 3, 4)},
+		},
+		{
+			name:    "too many fields",
+			data:    "1,2,3,4,0\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid field",
+			data:    "1,2,3,x\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadCSV4[int, int, int, int](strings.NewReader(tt.data), tt.opts)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT4_ReadCSV_Error(t *testing.T) {
+	data := "1,2,3,4\n1,2,3,x\n"
+
+	_, err := ReadCSV4[int, int, int, int](strings.NewReader(data), CSVOptions{})
+	var csvErr *CSVError
+	require.ErrorAs(t, err, &csvErr)
+	require.Equal(t, 2, csvErr.Line)
+	require.Equal(t, 4, csvErr.Column)
+
+	var elementErr *ElementUnmarshalError
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, "x", elementErr.Value)
+}
+
+func TestT4_WriteCSV(t *testing.T) {
+	tups := []T4[string, string, string, string]{
+		New4("1", "2", "3", "4"),
+		New4("1,\"1\"", "2,\"2\"", "3,\"3\"", "4,\"4\""),
+	}
+
+	var buf strings.Builder
+	err := WriteCSV4(&buf, tups, CSVOptions{Header: []string{"v1", "v2", "v3", "v4"}})
+	require.NoError(t, err)
+	require.Equal(t, "v1,v2,v3,v4\n1,2,3,4\n\"1,\"\"1\"\"\",\"2,\"\"2\"\"\",\"3,\"\"3\"\"\",\"4,\"\"4\"\"\"\n", buf.String())
+
+	got, err := ReadCSV4[string, string, string, string](strings.NewReader(buf.String()), CSVOptions{SkipHeader: true})
+	require.NoError(t, err)
+	require.Equal(t, tups, got)
+}
+
+func TestT4_WriteCSV_Error(t *testing.T) {
+	tups := []T4[string, string, string, []int]{
+		{V1: "a\nb", V2: "a\nb", V3: "a\nb", V4: []int{4}},
+	}
+
+	var buf strings.Builder
+	err := WriteCSV4(&buf, tups, CSVOptions{Header: []string{"v1", "v2", "v3", "v4"}})
+	var csvErr *CSVError
+	require.ErrorAs(t, err, &csvErr)
+	require.Equal(t, 2, csvErr.Line)
+	require.Equal(t, 4, csvErr.Column)
+
+	err = WriteCSV4(&buf, tups, CSVOptions{Header: []string{"v1", "v2", "v3", "v4", "extra"}})
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+}
+
+func TestT4_Hash(t *testing.T) {
+	seed := maphash.MakeSeed()
+	tup := New4("1", "2", "3", "4")
+
+	require.Equal(t, tup.Hash(seed), New4("1", "2", "3", "4").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New4("2", "2", "3", "4").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New4("1", "3", "3", "4").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New4("1", "2", "4", "4").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New4("1", "2", "3", "5").Hash(seed))
+}
+
+func TestT4_Hash_Nested(t *testing.T) {
+	seed := maphash.MakeSeed()
+	tup := New4(New2("1", 1), New2("2", 2), New2("3", 3), New2("4", 4))
+
+	require.Equal(t, tup.Hash(seed), New4(New2("1", 1), New2("2", 2), New2("3", 3), New2("4", 4)).Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New4(New2("1", 2), New2("2", 3), New2("3", 4), New2("4", 5)).Hash(seed))
+}
+
+func TestT4_Hash_Unsupported(t *testing.T) {
+	seed := maphash.MakeSeed()
+	tup := New4([]int{1}, []int{2}, []int{3}, []int{4})
+	require.NotPanics(t, func() {
+		tup.Hash(seed)
+	})
+	require.Equal(t, tup.Hash(seed), New4([]int{1}, []int{2}, []int{3}, []int{4}).Hash(seed))
+
+	var nilTup T4[any, any, any, any]
+	require.NotPanics(t, func() {
+		nilTup.Hash(seed)
+	})
+	require.Panics(t, func() {
+		HashValue(seed, tup.V1)
+	})
+}
+
+func TestT4_HashFunc(t *testing.T) {
+	seed := maphash.MakeSeed()
+	hashSlice := func(seed maphash.Seed, v []int) uint64 {
+		return HashValue(seed, len(v))
+	}
+
+	hash := HashFunc4(hashSlice, hashSlice, hashSlice, hashSlice)
+	tup := New4([]int{1}, []int{2}, []int{3}, []int{4})
+
+	require.Equal(t, hash(seed, tup), hash(seed, New4([]int{2}, []int{3}, []int{4}, []int{5})))
+	require.NotEqual(t, hash(seed, tup), hash(seed, New4([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5})))
+}
+
 func TestT4_MarshalJSON(t *testing.T) {
 	tup := New4("1", "2", "3", "4")
 
@@ -623,3 +1370,615 @@ func TestT4_Marshal_Unmarshal(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, tup, unmarshalled)
 }
+
+func TestT4_UnmarshalJSONWith(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		opts    JSONDecodeOptions
+		want    T4[string, string, string, string]
+		wantErr bool
+	}{
+		{
+			name:    "empty json array",
+			data:    `[]`,
+			wantErr: true,
+		},
+		{
+			name: "allow shorter empty json array",
+			data: `[]`,
+			opts: JSONDecodeOptions{AllowShorter: true},
+			want: T4[string, string, string, string]{},
+		},
+		{
+			name: "allow shorter partial json array",
+			data: `["1"]`,
+			opts: JSONDecodeOptions{AllowShorter: true},
+			want: T4[string, string, string, string]{V1: "1"},
+		},
+		{
+			name:    "allow shorter longer json array",
+			data:    `["1","2","3","4","10"]`,
+			opts:    JSONDecodeOptions{AllowShorter: true},
+			wantErr: true,
+		},
+		{
+			name: "json array with null values",
+			data: `[null,null,null,null]`,
+			want: T4[string, string, string, string]{},
+		},
+		{
+			name:    "disallow null json array with null values",
+			data:    `[null,null,null,null]`,
+			opts:    JSONDecodeOptions{DisallowNull: true},
+			wantErr: true,
+		},
+		{
+			name: "disallow null json array of valid types",
+			data: `["1","2","3","4"]`,
+			opts: JSONDecodeOptions{DisallowNull: true},
+			want: New4("1", "2", "3", "4"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T4[string, string, string, string]
+			err := got.UnmarshalJSONWith([]byte(tt.data), tt.opts)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT4_UnmarshalJSONWith_Nested(t *testing.T) {
+	type Custom struct {
+		Name string `json:"name"`
+	}
+
+	data := []byte(`[[1, {"name": "1", "age": 1}],[2, {"name": "2", "age": 2}],[3, {"name": "3", "age": 3}],[4, {"name": "4", "age": 4}]]`)
+
+	var got T4[T2[any, Custom], T2[any, Custom], T2[any, Custom], T2[any, Custom]]
+	err := got.UnmarshalJSONWith(data, JSONDecodeOptions{UseNumber: true})
+	require.NoError(t, err)
+	require.Equal(t, New4(New2[any](json.Number("1"), Custom{Name: "1"}), New2[any](json.Number("2"), Custom{Name: "2"}), New2[any](json.Number("3"), Custom{Name: "3"}), New2[any](json.Number("4"), Custom{Name: "4"})), got)
+
+	err = got.UnmarshalJSONWith(data, JSONDecodeOptions{DisallowUnknownFields: true})
+	require.Error(t, err)
+}
+
+func TestT4_MarshalJSONLabeled(t *testing.T) {
+	tup := New4(1, 2, 3, 4)
+	labels := WithLabels("v1", "v2", "v3", "v4")
+
+	got, err := tup.MarshalJSONLabeled(labels)
+	require.NoError(t, err)
+	require.Equal(t, `{"v1":1,"v2":2,"v3":3,"v4":4}`, string(got))
+
+	_, err = tup.MarshalJSONLabeled(WithLabels("v1", "v2", "v3", "v4", "extra"))
+	require.Error(t, err)
+}
+
+func TestT4_UnmarshalJSONLabeled(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		labels  Labels
+		want    T4[int, int, int, int]
+		wantErr bool
+	}{
+		{
+			name:   "all keys",
+			data:   `{"v1":1,"v2":2,"v3":3,"v4":4}`,
+			labels: WithLabels("v1", "v2", "v3", "v4"),
+			want:   New4(1, 2, 3, 4),
+		},
+		{
+			name:   "missing keys",
+			data:   `{}`,
+			labels: WithLabels("v1", "v2", "v3", "v4"),
+			want:   T4[int, int, int, int]{},
+		},
+		{
+			name:    "strict missing keys",
+			data:    `{}`,
+			labels:  WithLabels("v1", "v2", "v3", "v4").Strict(),
+			wantErr: true,
+		},
+		{
+			name:   "unknown keys",
+			data:   `{"v1":1,"v2":2,"v3":3,"v4":4,"extra":0}`,
+			labels: WithLabels("v1", "v2", "v3", "v4"),
+			want:   New4(1, 2, 3, 4),
+		},
+		{
+			name:    "strict unknown keys",
+			data:    `{"v1":1,"v2":2,"v3":3,"v4":4,"extra":0}`,
+			labels:  WithLabels("v1", "v2", "v3", "v4").Strict(),
+			wantErr: true,
+		},
+		{
+			name:    "json array",
+			data:    `[1,2,3,4]`,
+			labels:  WithLabels("v1", "v2", "v3", "v4"),
+			wantErr: true,
+		},
+		{
+			name:    "labels count mismatch",
+			data:    `{}`,
+			labels:  WithLabels("v1", "v2", "v3", "v4", "extra"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T4[int, int, int, int]
+			err := got.UnmarshalJSONLabeled([]byte(tt.data), tt.labels)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT4_MarshalText(t *testing.T) {
+	tup := New4("1", "2", "3", "4")
+
+	got, err := tup.MarshalText()
+	require.NoError(t, err)
+	require.Equal(t, `1,2,3,4`, string(got))
+}
+
+func TestT4_MarshalText_Unsupported(t *testing.T) {
+	tup := New4([]int{1}, []int{2}, []int{3}, []int{4})
+
+	_, err := tup.MarshalText()
+	require.Error(t, err)
+}
+
+func TestT4_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    T4[string, string, string, string]
+		wantErr bool
+	}{
+		{
+			name: "valid values",
+			data: `1,2,3,4`,
+			want: New4("1", "2", "3", "4"),
+		},
+		{
+			name: "escaped values",
+			data: `a\,1\\,a\,2\\,a\,3\\,a\,4\\`,
+			want: New4(`a,1\`, `a,2\`, `a,3\`, `a,4\`),
+		},
+		{
+			name:    "too few values",
+			data:    `2,3,4`,
+			wantErr: true,
+		},
+		{
+			name:    "too many values",
+			data:    `1,2,3,4,extra`,
+			wantErr: true,
+		},
+		{
+			name:    "dangling escape",
+			data:    `1,2,3,4\`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T4[string, string, string, string]
+			err := got.UnmarshalText([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT4_MarshalText_UnmarshalText(t *testing.T) {
+	tup := New4(New2("a,1\\\\", 1), New2("a,2\\\\", 2), New2("a,3\\\\", 3), New2("a,4\\\\", 4))
+
+	marshalled, err := tup.MarshalText()
+	require.NoError(t, err)
+
+	var unmarshalled T4[T2[string, int], T2[string, int], T2[string, int], T2[string, int]]
+	err = unmarshalled.UnmarshalText(marshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT4_GobEncode_GobDecode(t *testing.T) {
+	t.Run("strings", func(t *testing.T) {
+		requireGobRoundTrip(t, New4("1", "2", "3", "4"))
+	})
+
+	t.Run("zero values", func(t *testing.T) {
+		requireGobRoundTrip(t, T4[int, int, int, int]{})
+	})
+
+	t.Run("nested tuples", func(t *testing.T) {
+		requireGobRoundTrip(t, New4(New2("1", 1), New2("2", 2), New2("3", 3), New2("4", 4)))
+	})
+
+	t.Run("pointers", func(t *testing.T) {
+		requireGobRoundTrip(t, New4(ptr(1), ptr(2), ptr(3), ptr(4)))
+	})
+
+	t.Run("pointers to zero values", func(t *testing.T) {
+		requireGobRoundTrip(t, New4(ptr(0), ptr(0), ptr(0), ptr(0)))
+	})
+
+	t.Run("nil pointers", func(t *testing.T) {
+		requireGobRoundTrip(t, T4[*int, *int, *int, *int]{})
+	})
+
+	t.Run("nested tuple pointers", func(t *testing.T) {
+		requireGobRoundTrip(t, New4((*T2[string, *int])(nil), &T2[string, *int]{V1: "2", V2: ptr(2)}, &T2[string, *int]{V1: "3", V2: ptr(3)}, &T2[string, *int]{V1: "4", V2: ptr(4)}))
+	})
+
+	t.Run("interface values", func(t *testing.T) {
+		requireGobRoundTrip(t, T4[any, any, any, any]{V1: nil, V2: "2", V3: 3, V4: 4})
+	})
+
+	t.Run("struct field", func(t *testing.T) {
+		type Custom struct {
+			Tup T4[int, int, int, int]
+		}
+
+		requireGobRoundTrip(t, Custom{Tup: New4(1, 2, 3, 4)})
+	})
+}
+
+func TestT4_GobDecode_Invalid(t *testing.T) {
+	encoded, err := New1("1").GobEncode()
+	require.NoError(t, err)
+
+	var tup T4[string, string, string, string]
+	err = tup.GobDecode(encoded)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, 4, lengthErr.Expected)
+
+	encoded, err = New4("1", "2", "3", "4").GobEncode()
+	require.NoError(t, err)
+
+	var mismatched T4[int, int, int, int]
+	require.Error(t, mismatched.GobDecode(encoded))
+	require.Error(t, tup.GobDecode(nil))
+}
+
+func TestT4_MarshalComposite(t *testing.T) {
+	got, err := New4("foo bar", 2, 3, 4).MarshalComposite()
+	require.NoError(t, err)
+	require.Equal(t, `("foo bar",2,3,4)`, string(got))
+
+	got, err = T4[*int, *int, *int, *int]{}.MarshalComposite()
+	require.NoError(t, err)
+	require.Equal(t, `(,,,)`, string(got))
+
+	got, err = New4(New2("1", 1), New2("2", 2), New2("3", 3), New2("4", 4)).MarshalComposite()
+	require.NoError(t, err)
+	require.Equal(t, `("(1,1)","(2,2)","(3,3)","(4,4)")`, string(got))
+}
+
+func TestT4_UnmarshalComposite(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    T4[string, string, string, string]
+		wantErr bool
+	}{
+		{
+			name: "unquoted values",
+			data: `(1,2,3,4)`,
+			want: New4("1", "2", "3", "4"),
+		},
+		{
+			name: "quoted values",
+			data: `("1, \\""","2, \\""","3, \\""","4, \\""")`,
+			want: New4("1, \\\"", "2, \\\"", "3, \\\"", "4, \\\""),
+		},
+		{
+			name: "empty values",
+			data: `("","","","")`,
+			want: T4[string, string, string, string]{},
+		},
+		{
+			name:    "null values",
+			data:    `(,,,)`,
+			wantErr: true,
+		},
+		{
+			name:    "too many fields",
+			data:    `(1,2,3,4,0)`,
+			wantErr: true,
+		},
+		{
+			name:    "missing parentheses",
+			data:    `1,2,3,4`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T4[string, string, string, string]
+			err := got.UnmarshalComposite([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT4_MarshalComposite_UnmarshalComposite(t *testing.T) {
+	tup := New4((*T2[string, *int])(nil), &T2[string, *int]{V1: "(2, \"2\\\")", V2: ptr(2)}, &T2[string, *int]{V1: "(3, \"3\\\")", V2: ptr(3)}, &T2[string, *int]{V1: "(4, \"4\\\")", V2: ptr(4)})
+
+	marshalled, err := tup.MarshalComposite()
+	require.NoError(t, err)
+
+	var unmarshalled T4[*T2[string, *int], *T2[string, *int], *T2[string, *int], *T2[string, *int]]
+	err = unmarshalled.UnmarshalComposite(marshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT4_MarshalXML(t *testing.T) {
+	got, err := xml.Marshal(New4(1, 2, 3, 4))
+	require.NoError(t, err)
+	require.Equal(t, `<T4><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4></T4>`, string(got))
+
+	type Custom struct {
+		XMLName xml.Name                           `xml:"custom"`
+		Tup     T4[string, string, string, string] `xml:"tup"`
+	}
+
+	got, err = xml.Marshal(Custom{Tup: New4("<1>", "<2>", "<3>", "<4>")})
+	require.NoError(t, err)
+	require.Equal(t, `<custom><tup><v1>&lt;1&gt;</v1><v2>&lt;2&gt;</v2><v3>&lt;3&gt;</v3><v4>&lt;4&gt;</v4></tup></custom>`, string(got))
+}
+
+func TestT4_UnmarshalXML(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    T4[int, int, int, int]
+		wantErr bool
+	}{
+		{
+			name: "all elements",
+			data: `<T4><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4></T4>`,
+			want: New4(1, 2, 3, 4),
+		},
+		{
+			name: "whitespace and comments",
+			data: `<T4>
+				<!-- v1 --> <v1> 1 </v1>
+				<!-- v2 --> <v2> 2 </v2>
+				<!-- v3 --> <v3> 3 </v3>
+				<!-- v4 --> <v4> 4 </v4>
+			</T4>`,
+			want: New4(1, 2, 3, 4),
+		},
+		{
+			name:    "no elements",
+			data:    `<T4></T4>`,
+			wantErr: true,
+		},
+		{
+			name:    "too many elements",
+			data:    `<T4><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v0>0</v0></T4>`,
+			wantErr: true,
+		},
+		{
+			name:    "wrong element names",
+			data:    `<T4><V1>1</V1><V2>2</V2><V3>3</V3><V4>4</V4></T4>`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid value",
+			data:    `<T4><v1>1</v1><v2>2</v2><v3>3</v3><v4>x</v4></T4>`,
+			wantErr: true,
+		},
+		{
+			name:    "unterminated",
+			data:    `<T4><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4>`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T4[int, int, int, int]
+			err := xml.Unmarshal([]byte(tt.data), &got)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT4_UnmarshalXML_Errors(t *testing.T) {
+	var tup T4[int, int, int, int]
+
+	err := xml.Unmarshal([]byte(`<T4><v1>1</v1><v2>2</v2><v3>3</v3></T4>`), &tup)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.EqualError(t, err, "unmarshalled xml elements count 3 must match number of tuple values 4")
+
+	err = xml.Unmarshal([]byte(`<T4><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v0>0</v0><v0>0</v0></T4>`), &tup)
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, 6, lengthErr.Actual)
+
+	err = xml.Unmarshal([]byte(`<T4><v1>1</v1><v2>2</v2><v3>3</v3><v4>x</v4></T4>`), &tup)
+	var elementErr *ElementUnmarshalError
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, 3, elementErr.Index)
+	require.Equal(t, "v4", elementErr.Label)
+}
+
+func TestT4_MarshalXML_UnmarshalXML_NilPointers(t *testing.T) {
+	tup := New4((*int)(nil), ptr(2), ptr(3), ptr(4))
+
+	got, err := xml.Marshal(tup)
+	require.NoError(t, err)
+	require.Equal(t, `<T4><v1 nil="true"></v1><v2>2</v2><v3>3</v3><v4>4</v4></T4>`, string(got))
+
+	unmarshalled := New4(ptr(0), ptr(0), ptr(0), ptr(0))
+	err = xml.Unmarshal(got, &unmarshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT4_MarshalXML_UnmarshalXML_Slices(t *testing.T) {
+	tup := New4([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5})
+
+	got, err := xml.Marshal(tup)
+	require.NoError(t, err)
+	require.Equal(t, `<T4><v1><item>1</item><item>2</item></v1><v2><item>2</item><item>3</item></v2><v3><item>3</item><item>4</item></v3><v4><item>4</item><item>5</item></v4></T4>`, string(got))
+
+	var unmarshalled T4[[]int, []int, []int, []int]
+	err = xml.Unmarshal(got, &unmarshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT4_MarshalXMLLabeled_UnmarshalXMLLabeled(t *testing.T) {
+	tup := New4(New2("1", ptr(1)), New2("2", ptr(2)), New2("3", ptr(3)), New2("4", ptr(4)))
+	labels := WithLabels("item1", "item2", "item3", "item4")
+
+	var buf strings.Builder
+	encoder := xml.NewEncoder(&buf)
+	err := tup.MarshalXMLLabeled(encoder, xml.StartElement{Name: xml.Name{Local: "items"}}, labels)
+	require.NoError(t, err)
+	require.NoError(t, encoder.Flush())
+	require.Equal(t, `<items><item1><v1>1</v1><v2>1</v2></item1><item2><v1>2</v1><v2>2</v2></item2><item3><v1>3</v1><v2>3</v2></item3><item4><v1>4</v1><v2>4</v2></item4></items>`, buf.String())
+
+	decoder := xml.NewDecoder(strings.NewReader(buf.String()))
+	start, err := decoder.Token()
+	require.NoError(t, err)
+
+	var unmarshalled T4[T2[string, *int], T2[string, *int], T2[string, *int], T2[string, *int]]
+	err = unmarshalled.UnmarshalXMLLabeled(decoder, start.(xml.StartElement), labels)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+
+	err = tup.MarshalXMLLabeled(encoder, xml.StartElement{Name: xml.Name{Local: "items"}}, WithLabels("item1", "item2", "item3", "item4", "extra"))
+	require.Error(t, err)
+}
+
+func TestT4_MarshalBinary(t *testing.T) {
+	tup := New4(uint16(1), uint16(2), uint16(3), uint16(4))
+
+	got, err := tup.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 0, 2, 0, 3, 0, 4, 0}, got)
+
+	got, err = tup.AppendBinaryOrder([]byte{0xff}, binary.BigEndian)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0xff, 0, 1, 0, 2, 0, 3, 0, 4}, got)
+
+	_, err = New4(1, 2, 3, []int{4}).MarshalBinary()
+	require.Error(t, err)
+}
+
+func TestT4_MarshalBinary_UnmarshalBinary(t *testing.T) {
+	tup := New4(
+		int64(-1),
+		"foo2",
+		true,
+		[]byte("bar4"),
+	)
+
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		marshalled, err := tup.AppendBinaryOrder(nil, order.(binary.AppendByteOrder))
+		require.NoError(t, err)
+
+		var unmarshalled T4[int64, string, bool, []byte]
+		err = unmarshalled.UnmarshalBinaryOrder(marshalled, order)
+		require.NoError(t, err)
+		require.Equal(t, tup, unmarshalled)
+	}
+}
+
+func TestT4_UnmarshalBinary_Invalid(t *testing.T) {
+	marshalled, err := New4(uint16(1), uint16(2), uint16(3), uint16(4)).MarshalBinary()
+	require.NoError(t, err)
+
+	var tup T4[uint16, uint16, uint16, uint16]
+	err = tup.UnmarshalBinary(marshalled[:len(marshalled)-1])
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	require.EqualError(t, err, "value at index 3 failed to unmarshal: unexpected EOF")
+
+	err = tup.UnmarshalBinary(append(marshalled, 0))
+	require.EqualError(t, err, "binary data has 1 trailing bytes")
+}
+
+func TestT4_BinarySlice(t *testing.T) {
+	tups := []T4[int, int, int, int]{
+		New4(1, 2, 3, 4),
+		New4(-1, -2, -3, -4),
+	}
+
+	marshalled, err := AppendBinarySlice4(nil, binary.LittleEndian, tups)
+	require.NoError(t, err)
+	require.Len(t, marshalled, 1+2*4*8)
+
+	got, err := UnmarshalBinarySlice4[int, int, int, int](marshalled, binary.LittleEndian, nil)
+	require.NoError(t, err)
+	require.Equal(t, tups, got)
+
+	got, err = UnmarshalBinarySlice4(marshalled, binary.LittleEndian, got)
+	require.NoError(t, err)
+	require.Equal(t, append(tups, tups...), got)
+
+	empty, err := AppendBinarySlice4[int, int, int, int](nil, binary.LittleEndian, nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0}, empty)
+
+	_, err = AppendBinarySlice4(nil, binary.LittleEndian, []T4[[]int, []int, []int, []int]{{}})
+	require.Error(t, err)
+}
+
+func TestT4_MarshalJSON_MapKey(t *testing.T) {
+	m := map[T4[string, string, string, string]]int{
+		New4("1", "2", "3", "4"): 1,
+	}
+
+	marshalled, err := json.Marshal(m)
+	require.NoError(t, err)
+	require.Equal(t, `{"1,2,3,4":1}`, string(marshalled))
+
+	var unmarshalled map[T4[string, string, string, string]]int
+	err = json.Unmarshal(marshalled, &unmarshalled)
+	require.NoError(t, err)
+	require.Equal(t, m, unmarshalled)
+}
@@ -1,10 +1,14 @@
 package tuple
 
 import (
+	"cmp"
+	"database/sql"
+	"encoding/binary"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
-
-	"golang.org/x/exp/constraints"
+	"hash/maphash"
+	"io"
 )
 
 // T5 is a tuple type holding 5 generic values.
@@ -69,23 +73,23 @@ func New5[Ty1, Ty2, Ty3, Ty4, Ty5 any](v1 Ty1, v2 Ty2, v3 Ty3, v4 Ty4, v5 Ty5) T
 func FromArray5[Ty1, Ty2, Ty3, Ty4, Ty5 any](arr [5]any) (T5[Ty1, Ty2, Ty3, Ty4, Ty5], error) {
 	v1, ok := arr[0].(Ty1)
 	if !ok {
-		return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{}, fmt.Errorf("value at array index 0 expected to have type %s but has type %T", typeName[Ty1](), arr[0])
+		return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{}, newElementTypeError[Ty1]("array", 0, arr[0])
 	}
 	v2, ok := arr[1].(Ty2)
 	if !ok {
-		return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{}, fmt.Errorf("value at array index 1 expected to have type %s but has type %T", typeName[Ty2](), arr[1])
+		return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{}, newElementTypeError[Ty2]("array", 1, arr[1])
 	}
 	v3, ok := arr[2].(Ty3)
 	if !ok {
-		return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{}, fmt.Errorf("value at array index 2 expected to have type %s but has type %T", typeName[Ty3](), arr[2])
+		return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{}, newElementTypeError[Ty3]("array", 2, arr[2])
 	}
 	v4, ok := arr[3].(Ty4)
 	if !ok {
-		return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{}, fmt.Errorf("value at array index 3 expected to have type %s but has type %T", typeName[Ty4](), arr[3])
+		return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{}, newElementTypeError[Ty4]("array", 3, arr[3])
 	}
 	v5, ok := arr[4].(Ty5)
 	if !ok {
-		return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{}, fmt.Errorf("value at array index 4 expected to have type %s but has type %T", typeName[Ty5](), arr[4])
+		return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{}, newElementTypeError[Ty5]("array", 4, arr[4])
 	}
 
 	return New5(v1, v2, v3, v4, v5), nil
@@ -101,28 +105,28 @@ func FromArray5X[Ty1, Ty2, Ty3, Ty4, Ty5 any](arr [5]any) T5[Ty1, Ty2, Ty3, Ty4,
 // If the length of the slice doesn't match, or any of the values can not be converted to the generic type, an error is returned.
 func FromSlice5[Ty1, Ty2, Ty3, Ty4, Ty5 any](values []any) (T5[Ty1, Ty2, Ty3, Ty4, Ty5], error) {
 	if len(values) != 5 {
-		return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{}, fmt.Errorf("slice length %d must match number of tuple values 5", len(values))
+		return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{}, &LengthMismatchError{Source: "slice", Expected: 5, Actual: len(values)}
 	}
 
 	v1, ok := values[0].(Ty1)
 	if !ok {
-		return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{}, fmt.Errorf("value at slice index 0 expected to have type %s but has type %T", typeName[Ty1](), values[0])
+		return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{}, newElementTypeError[Ty1]("slice", 0, values[0])
 	}
 	v2, ok := values[1].(Ty2)
 	if !ok {
-		return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{}, fmt.Errorf("value at slice index 1 expected to have type %s but has type %T", typeName[Ty2](), values[1])
+		return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{}, newElementTypeError[Ty2]("slice", 1, values[1])
 	}
 	v3, ok := values[2].(Ty3)
 	if !ok {
-		return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{}, fmt.Errorf("value at slice index 2 expected to have type %s but has type %T", typeName[Ty3](), values[2])
+		return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{}, newElementTypeError[Ty3]("slice", 2, values[2])
 	}
 	v4, ok := values[3].(Ty4)
 	if !ok {
-		return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{}, fmt.Errorf("value at slice index 3 expected to have type %s but has type %T", typeName[Ty4](), values[3])
+		return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{}, newElementTypeError[Ty4]("slice", 3, values[3])
 	}
 	v5, ok := values[4].(Ty5)
 	if !ok {
-		return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{}, fmt.Errorf("value at slice index 4 expected to have type %s but has type %T", typeName[Ty5](), values[4])
+		return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{}, newElementTypeError[Ty5]("slice", 4, values[4])
 	}
 
 	return New5(v1, v2, v3, v4, v5), nil
@@ -132,7 +136,7 @@ func FromSlice5[Ty1, Ty2, Ty3, Ty4, Ty5 any](values []any) (T5[Ty1, Ty2, Ty3, Ty
 // If the length of the slice doesn't match, or any of the values can not be converted to the generic type, the function panics.
 func FromSlice5X[Ty1, Ty2, Ty3, Ty4, Ty5 any](values []any) T5[Ty1, Ty2, Ty3, Ty4, Ty5] {
 	if len(values) != 5 {
-		panic(fmt.Errorf("slice length %d must match number of tuple values 5", len(values)))
+		panic(&LengthMismatchError{Source: "slice", Expected: 5, Actual: len(values)})
 	}
 
 	v1 := values[0].(Ty1)
@@ -144,11 +148,439 @@ func FromSlice5X[Ty1, Ty2, Ty3, Ty4, Ty5 any](values []any) T5[Ty1, Ty2, Ty3, Ty
 	return New5(v1, v2, v3, v4, v5)
 }
 
+// ConvertSlice5 returns a tuple from a slice of length 5, converting the values to the generic types when needed.
+// Unlike FromSlice5, numeric values are converted between numeric types as long as they fit the generic type,
+// json.Number values are parsed into numeric types, strings and byte slices are converted to each other,
+// and strings and byte slices are unmarshalled into types implementing encoding.TextUnmarshaler.
+// This enables creating tuples from slices decoded by encoding/json, which hold float64 values for numbers.
+// If the length of the slice doesn't match, a *LengthMismatchError is returned.
+// If any of the values can not be converted to the generic type, a *ElementTypeError is returned.
+// If the conversion of any of the values fails, for example when it overflows the generic type, a *ElementConversionError is returned.
+func ConvertSlice5[Ty1, Ty2, Ty3, Ty4, Ty5 any](values []any) (T5[Ty1, Ty2, Ty3, Ty4, Ty5], error) {
+	if len(values) != 5 {
+		return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{}, &LengthMismatchError{Source: "slice", Expected: 5, Actual: len(values)}
+	}
+
+	var t T5[Ty1, Ty2, Ty3, Ty4, Ty5]
+	if err := convertSliceValue(0, values[0], &t.V1); err != nil {
+		return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{}, err
+	}
+	if err := convertSliceValue(1, values[1], &t.V2); err != nil {
+		return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{}, err
+	}
+	if err := convertSliceValue(2, values[2], &t.V3); err != nil {
+		return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{}, err
+	}
+	if err := convertSliceValue(3, values[3], &t.V4); err != nil {
+		return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{}, err
+	}
+	if err := convertSliceValue(4, values[4], &t.V5); err != nil {
+		return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{}, err
+	}
+
+	return t, nil
+}
+
+// ScanRow5 scans the current row of rows into a tuple, with each of the columns scanned into the matching tuple value.
+// If the number of columns doesn't match the number of tuple values, a *LengthMismatchError is returned.
+func ScanRow5[Ty1, Ty2, Ty3, Ty4, Ty5 any](rows *sql.Rows) (T5[Ty1, Ty2, Ty3, Ty4, Ty5], error) {
+	var t T5[Ty1, Ty2, Ty3, Ty4, Ty5]
+	if err := scanRow(rows, []any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+		&t.V5,
+	}); err != nil {
+		return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{}, err
+	}
+
+	return t, nil
+}
+
+// CollectRows5 scans all the rows into a slice of tuples using ScanRow5, and closes the rows.
+func CollectRows5[Ty1, Ty2, Ty3, Ty4, Ty5 any](rows *sql.Rows) ([]T5[Ty1, Ty2, Ty3, Ty4, Ty5], error) {
+	return collectRows(rows, ScanRow5[Ty1, Ty2, Ty3, Ty4, Ty5])
+}
+
+// ReadCSV5 reads all the CSV records of r into tuples, with each of the fields converted to the matching tuple value.
+// Fields are converted using the UnmarshalText method of values implementing encoding.TextUnmarshaler,
+// and otherwise must be of boolean, numeric or string kinds.
+// If a record fails to convert, a *CSVError holding its line and column numbers is returned.
+// If a record fails to parse, including records with a number of fields that doesn't match, a *csv.ParseError is returned.
+func ReadCSV5[Ty1, Ty2, Ty3, Ty4, Ty5 any](r io.Reader, opts CSVOptions) ([]T5[Ty1, Ty2, Ty3, Ty4, Ty5], error) {
+	return readCSV(r, opts, func(t *T5[Ty1, Ty2, Ty3, Ty4, Ty5]) []any {
+		return []any{
+			&t.V1,
+			&t.V2,
+			&t.V3,
+			&t.V4,
+			&t.V5,
+		}
+	})
+}
+
+// WriteCSV5 writes the tuples into w as CSV records, with each of the tuple values converted to the matching field.
+// Values are converted the same way as MarshalText does.
+// If a tuple fails to convert, a *CSVError holding the line and column numbers of its record is returned.
+func WriteCSV5[Ty1, Ty2, Ty3, Ty4, Ty5 any](w io.Writer, tuples []T5[Ty1, Ty2, Ty3, Ty4, Ty5], opts CSVOptions) error {
+	return writeCSV(w, tuples, opts, T5[Ty1, Ty2, Ty3, Ty4, Ty5].Slice)
+}
+
+// Parse5 returns a tuple from its string representation, as returned by the String method.
+// Supported values are booleans, numbers, quoted strings and nested tuples.
+// If the string fails to parse, a *ParseError holding the offset of the failure is returned.
+func Parse5[Ty1, Ty2, Ty3, Ty4, Ty5 any](s string) (T5[Ty1, Ty2, Ty3, Ty4, Ty5], error) {
+	var t T5[Ty1, Ty2, Ty3, Ty4, Ty5]
+	if err := parseTuple(s, &t); err != nil {
+		return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{}, err
+	}
+
+	return t, nil
+}
+
+// Map5At1 returns a new tuple with the function f applied to the value at position 1 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map5At1[Ty1, Ty2, Ty3, Ty4, Ty5, R any](t T5[Ty1, Ty2, Ty3, Ty4, Ty5], f func(Ty1) R) T5[R, Ty2, Ty3, Ty4, Ty5] {
+	return T5[R, Ty2, Ty3, Ty4, Ty5]{
+		V1: f(t.V1),
+		V2: t.V2,
+		V3: t.V3,
+		V4: t.V4,
+		V5: t.V5,
+	}
+}
+
+// Map5At2 returns a new tuple with the function f applied to the value at position 2 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map5At2[Ty1, Ty2, Ty3, Ty4, Ty5, R any](t T5[Ty1, Ty2, Ty3, Ty4, Ty5], f func(Ty2) R) T5[Ty1, R, Ty3, Ty4, Ty5] {
+	return T5[Ty1, R, Ty3, Ty4, Ty5]{
+		V1: t.V1,
+		V2: f(t.V2),
+		V3: t.V3,
+		V4: t.V4,
+		V5: t.V5,
+	}
+}
+
+// Map5At3 returns a new tuple with the function f applied to the value at position 3 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map5At3[Ty1, Ty2, Ty3, Ty4, Ty5, R any](t T5[Ty1, Ty2, Ty3, Ty4, Ty5], f func(Ty3) R) T5[Ty1, Ty2, R, Ty4, Ty5] {
+	return T5[Ty1, Ty2, R, Ty4, Ty5]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: f(t.V3),
+		V4: t.V4,
+		V5: t.V5,
+	}
+}
+
+// Map5At4 returns a new tuple with the function f applied to the value at position 4 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map5At4[Ty1, Ty2, Ty3, Ty4, Ty5, R any](t T5[Ty1, Ty2, Ty3, Ty4, Ty5], f func(Ty4) R) T5[Ty1, Ty2, Ty3, R, Ty5] {
+	return T5[Ty1, Ty2, Ty3, R, Ty5]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+		V4: f(t.V4),
+		V5: t.V5,
+	}
+}
+
+// Map5At5 returns a new tuple with the function f applied to the value at position 5 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map5At5[Ty1, Ty2, Ty3, Ty4, Ty5, R any](t T5[Ty1, Ty2, Ty3, Ty4, Ty5], f func(Ty5) R) T5[Ty1, Ty2, Ty3, Ty4, R] {
+	return T5[Ty1, Ty2, Ty3, Ty4, R]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+		V4: t.V4,
+		V5: f(t.V5),
+	}
+}
+
+// Map5 returns a new tuple with each of the functions applied to the tuple value at the matching position.
+func Map5[Ty1, Ty2, Ty3, Ty4, Ty5, R1, R2, R3, R4, R5 any](t T5[Ty1, Ty2, Ty3, Ty4, Ty5], f1 func(Ty1) R1, f2 func(Ty2) R2, f3 func(Ty3) R3, f4 func(Ty4) R4, f5 func(Ty5) R5) T5[R1, R2, R3, R4, R5] {
+	return T5[R1, R2, R3, R4, R5]{
+		V1: f1(t.V1),
+		V2: f2(t.V2),
+		V3: f3(t.V3),
+		V4: f4(t.V4),
+		V5: f5(t.V5),
+	}
+}
+
+// Append5 returns a new tuple holding the tuple values followed by the value v.
+func Append5[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](t T5[Ty1, Ty2, Ty3, Ty4, Ty5], v Ty6) T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6] {
+	return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+		V4: t.V4,
+		V5: t.V5,
+		V6: v,
+	}
+}
+
+// Prepend5 returns a new tuple holding the value v followed by the tuple values.
+func Prepend5[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](t T5[Ty2, Ty3, Ty4, Ty5, Ty6], v Ty1) T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6] {
+	return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{
+		V1: v,
+		V2: t.V1,
+		V3: t.V2,
+		V4: t.V3,
+		V5: t.V4,
+		V6: t.V5,
+	}
+}
+
+// Concat5_1 returns a new tuple holding the values of t1 followed by the values of t2.
+func Concat5_1[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](t1 T5[Ty1, Ty2, Ty3, Ty4, Ty5], t2 T1[Ty6]) T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6] {
+	return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{
+		V1: t1.V1,
+		V2: t1.V2,
+		V3: t1.V3,
+		V4: t1.V4,
+		V5: t1.V5,
+		V6: t2.V1,
+	}
+}
+
+// Concat5_2 returns a new tuple holding the values of t1 followed by the values of t2.
+func Concat5_2[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](t1 T5[Ty1, Ty2, Ty3, Ty4, Ty5], t2 T2[Ty6, Ty7]) T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7] {
+	return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{
+		V1: t1.V1,
+		V2: t1.V2,
+		V3: t1.V3,
+		V4: t1.V4,
+		V5: t1.V5,
+		V6: t2.V1,
+		V7: t2.V2,
+	}
+}
+
+// Concat5_3 returns a new tuple holding the values of t1 followed by the values of t2.
+func Concat5_3[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8 any](t1 T5[Ty1, Ty2, Ty3, Ty4, Ty5], t2 T3[Ty6, Ty7, Ty8]) T8[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8] {
+	return T8[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8]{
+		V1: t1.V1,
+		V2: t1.V2,
+		V3: t1.V3,
+		V4: t1.V4,
+		V5: t1.V5,
+		V6: t2.V1,
+		V7: t2.V2,
+		V8: t2.V3,
+	}
+}
+
+// Concat5_4 returns a new tuple holding the values of t1 followed by the values of t2.
+func Concat5_4[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](t1 T5[Ty1, Ty2, Ty3, Ty4, Ty5], t2 T4[Ty6, Ty7, Ty8, Ty9]) T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9] {
+	return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{
+		V1: t1.V1,
+		V2: t1.V2,
+		V3: t1.V3,
+		V4: t1.V4,
+		V5: t1.V5,
+		V6: t2.V1,
+		V7: t2.V2,
+		V8: t2.V3,
+		V9: t2.V4,
+	}
+}
+
+// Take5_1 returns a new tuple holding the first 1 values of the tuple.
+func Take5_1[Ty1, Ty2, Ty3, Ty4, Ty5 any](t T5[Ty1, Ty2, Ty3, Ty4, Ty5]) T1[Ty1] {
+	return T1[Ty1]{
+		V1: t.V1,
+	}
+}
+
+// Drop5_1 returns a new tuple holding the values of the tuple without the first 1 values.
+func Drop5_1[Ty1, Ty2, Ty3, Ty4, Ty5 any](t T5[Ty1, Ty2, Ty3, Ty4, Ty5]) T4[Ty2, Ty3, Ty4, Ty5] {
+	return T4[Ty2, Ty3, Ty4, Ty5]{
+		V1: t.V2,
+		V2: t.V3,
+		V3: t.V4,
+		V4: t.V5,
+	}
+}
+
+// Split5_1 splits the tuple into a tuple of its first 1 values and a tuple of the rest of its values.
+func Split5_1[Ty1, Ty2, Ty3, Ty4, Ty5 any](t T5[Ty1, Ty2, Ty3, Ty4, Ty5]) T2[T1[Ty1], T4[Ty2, Ty3, Ty4, Ty5]] {
+	return T2[T1[Ty1], T4[Ty2, Ty3, Ty4, Ty5]]{
+		V1: Take5_1(t),
+		V2: Drop5_1(t),
+	}
+}
+
+// Take5_2 returns a new tuple holding the first 2 values of the tuple.
+func Take5_2[Ty1, Ty2, Ty3, Ty4, Ty5 any](t T5[Ty1, Ty2, Ty3, Ty4, Ty5]) T2[Ty1, Ty2] {
+	return T2[Ty1, Ty2]{
+		V1: t.V1,
+		V2: t.V2,
+	}
+}
+
+// Drop5_2 returns a new tuple holding the values of the tuple without the first 2 values.
+func Drop5_2[Ty1, Ty2, Ty3, Ty4, Ty5 any](t T5[Ty1, Ty2, Ty3, Ty4, Ty5]) T3[Ty3, Ty4, Ty5] {
+	return T3[Ty3, Ty4, Ty5]{
+		V1: t.V3,
+		V2: t.V4,
+		V3: t.V5,
+	}
+}
+
+// Split5_2 splits the tuple into a tuple of its first 2 values and a tuple of the rest of its values.
+func Split5_2[Ty1, Ty2, Ty3, Ty4, Ty5 any](t T5[Ty1, Ty2, Ty3, Ty4, Ty5]) T2[T2[Ty1, Ty2], T3[Ty3, Ty4, Ty5]] {
+	return T2[T2[Ty1, Ty2], T3[Ty3, Ty4, Ty5]]{
+		V1: Take5_2(t),
+		V2: Drop5_2(t),
+	}
+}
+
+// Take5_3 returns a new tuple holding the first 3 values of the tuple.
+func Take5_3[Ty1, Ty2, Ty3, Ty4, Ty5 any](t T5[Ty1, Ty2, Ty3, Ty4, Ty5]) T3[Ty1, Ty2, Ty3] {
+	return T3[Ty1, Ty2, Ty3]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+	}
+}
+
+// Drop5_3 returns a new tuple holding the values of the tuple without the first 3 values.
+func Drop5_3[Ty1, Ty2, Ty3, Ty4, Ty5 any](t T5[Ty1, Ty2, Ty3, Ty4, Ty5]) T2[Ty4, Ty5] {
+	return T2[Ty4, Ty5]{
+		V1: t.V4,
+		V2: t.V5,
+	}
+}
+
+// Split5_3 splits the tuple into a tuple of its first 3 values and a tuple of the rest of its values.
+func Split5_3[Ty1, Ty2, Ty3, Ty4, Ty5 any](t T5[Ty1, Ty2, Ty3, Ty4, Ty5]) T2[T3[Ty1, Ty2, Ty3], T2[Ty4, Ty5]] {
+	return T2[T3[Ty1, Ty2, Ty3], T2[Ty4, Ty5]]{
+		V1: Take5_3(t),
+		V2: Drop5_3(t),
+	}
+}
+
+// Take5_4 returns a new tuple holding the first 4 values of the tuple.
+func Take5_4[Ty1, Ty2, Ty3, Ty4, Ty5 any](t T5[Ty1, Ty2, Ty3, Ty4, Ty5]) T4[Ty1, Ty2, Ty3, Ty4] {
+	return T4[Ty1, Ty2, Ty3, Ty4]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+		V4: t.V4,
+	}
+}
+
+// Drop5_4 returns a new tuple holding the values of the tuple without the first 4 values.
+func Drop5_4[Ty1, Ty2, Ty3, Ty4, Ty5 any](t T5[Ty1, Ty2, Ty3, Ty4, Ty5]) T1[Ty5] {
+	return T1[Ty5]{
+		V1: t.V5,
+	}
+}
+
+// Split5_4 splits the tuple into a tuple of its first 4 values and a tuple of the rest of its values.
+func Split5_4[Ty1, Ty2, Ty3, Ty4, Ty5 any](t T5[Ty1, Ty2, Ty3, Ty4, Ty5]) T2[T4[Ty1, Ty2, Ty3, Ty4], T1[Ty5]] {
+	return T2[T4[Ty1, Ty2, Ty3, Ty4], T1[Ty5]]{
+		V1: Take5_4(t),
+		V2: Drop5_4(t),
+	}
+}
+
+// Reverse5 returns a new tuple holding the tuple values in reverse order.
+func Reverse5[Ty1, Ty2, Ty3, Ty4, Ty5 any](t T5[Ty1, Ty2, Ty3, Ty4, Ty5]) T5[Ty5, Ty4, Ty3, Ty2, Ty1] {
+	return T5[Ty5, Ty4, Ty3, Ty2, Ty1]{
+		V1: t.V5,
+		V2: t.V4,
+		V3: t.V3,
+		V4: t.V2,
+		V5: t.V1,
+	}
+}
+
+// Apply5 calls the function f with the tuple values as arguments and returns its result.
+func Apply5[Ty1, Ty2, Ty3, Ty4, Ty5, R any](t T5[Ty1, Ty2, Ty3, Ty4, Ty5], f func(Ty1, Ty2, Ty3, Ty4, Ty5) R) R {
+	return f(t.Values())
+}
+
+// Tupled5 returns a function that accepts a tuple and calls f with the tuple values as arguments.
+func Tupled5[Ty1, Ty2, Ty3, Ty4, Ty5, R any](f func(Ty1, Ty2, Ty3, Ty4, Ty5) R) func(T5[Ty1, Ty2, Ty3, Ty4, Ty5]) R {
+	return func(t T5[Ty1, Ty2, Ty3, Ty4, Ty5]) R {
+		return f(t.Values())
+	}
+}
+
+// Untupled5 returns a function that accepts 5 arguments and calls g with a tuple holding them.
+func Untupled5[Ty1, Ty2, Ty3, Ty4, Ty5, R any](g func(T5[Ty1, Ty2, Ty3, Ty4, Ty5]) R) func(Ty1, Ty2, Ty3, Ty4, Ty5) R {
+	return func(v1 Ty1, v2 Ty2, v3 Ty3, v4 Ty4, v5 Ty5) R {
+		return g(New5(v1, v2, v3, v4, v5))
+	}
+}
+
+// Zip5 returns a slice of tuples, where each tuple holds the values at the same index of each of the given slices.
+// If the slices have different lengths, the returned slice is truncated to the length of the shortest slice.
+// To require all slices to have the same length, use the Zip5Strict function.
+func Zip5[Ty1, Ty2, Ty3, Ty4, Ty5 any](s1 []Ty1, s2 []Ty2, s3 []Ty3, s4 []Ty4, s5 []Ty5) []T5[Ty1, Ty2, Ty3, Ty4, Ty5] {
+	length := minLen(len(s1), len(s2), len(s3), len(s4), len(s5))
+
+	zipped := make([]T5[Ty1, Ty2, Ty3, Ty4, Ty5], length)
+	for i := range zipped {
+		zipped[i] = T5[Ty1, Ty2, Ty3, Ty4, Ty5]{
+			V1: s1[i],
+			V2: s2[i],
+			V3: s3[i],
+			V4: s4[i],
+			V5: s5[i],
+		}
+	}
+
+	return zipped
+}
+
+// Zip5Strict returns a slice of tuples, where each tuple holds the values at the same index of each of the given slices.
+// If the slices have different lengths, an error is returned.
+// To truncate the result to the length of the shortest slice instead, use the Zip5 function.
+func Zip5Strict[Ty1, Ty2, Ty3, Ty4, Ty5 any](s1 []Ty1, s2 []Ty2, s3 []Ty3, s4 []Ty4, s5 []Ty5) ([]T5[Ty1, Ty2, Ty3, Ty4, Ty5], error) {
+	if len(s2) != len(s1) {
+		return nil, &LengthMismatchError{Source: "slice 2", Expected: len(s1), Actual: len(s2), expectedSource: "slice 1"}
+	}
+	if len(s3) != len(s1) {
+		return nil, &LengthMismatchError{Source: "slice 3", Expected: len(s1), Actual: len(s3), expectedSource: "slice 1"}
+	}
+	if len(s4) != len(s1) {
+		return nil, &LengthMismatchError{Source: "slice 4", Expected: len(s1), Actual: len(s4), expectedSource: "slice 1"}
+	}
+	if len(s5) != len(s1) {
+		return nil, &LengthMismatchError{Source: "slice 5", Expected: len(s1), Actual: len(s5), expectedSource: "slice 1"}
+	}
+
+	return Zip5(s1, s2, s3, s4, s5), nil
+}
+
+// Unzip5 returns 5 slices, where each slice holds the tuple values at the matching position of each of the given tuples.
+func Unzip5[Ty1, Ty2, Ty3, Ty4, Ty5 any](ts []T5[Ty1, Ty2, Ty3, Ty4, Ty5]) ([]Ty1, []Ty2, []Ty3, []Ty4, []Ty5) {
+	s1 := make([]Ty1, len(ts))
+	s2 := make([]Ty2, len(ts))
+	s3 := make([]Ty3, len(ts))
+	s4 := make([]Ty4, len(ts))
+	s5 := make([]Ty5, len(ts))
+	for i, t := range ts {
+		s1[i] = t.V1
+		s2[i] = t.V2
+		s3[i] = t.V3
+		s4[i] = t.V4
+		s5[i] = t.V5
+	}
+
+	return s1, s2, s3, s4, s5
+}
+
 // Equal5 returns whether the host tuple is equal to the other tuple.
 // All tuple elements of the host and guest parameters must match the "comparable" built-in constraint.
 // To test equality of tuples that hold custom Equalable values, use the Equal5E function.
 // To test equality of tuples that hold custom Comparable values, use the Equal5C function.
-// Otherwise, use Equal or reflect.DeepEqual to test tuples of any types.
+// Otherwise, use the Equal method or the EqualFunc5 function to test tuples of any types.
 func Equal5[Ty1, Ty2, Ty3, Ty4, Ty5 comparable](host, guest T5[Ty1, Ty2, Ty3, Ty4, Ty5]) bool {
 	return host.V1 == guest.V1 && host.V2 == guest.V2 && host.V3 == guest.V3 && host.V4 == guest.V4 && host.V5 == guest.V5
 }
@@ -157,7 +589,7 @@ func Equal5[Ty1, Ty2, Ty3, Ty4, Ty5 comparable](host, guest T5[Ty1, Ty2, Ty3, Ty
 // All tuple elements of the host and guest parameters must match the Equalable constraint.
 // To test equality of tuples that hold built-in "comparable" values, use the Equal5 function.
 // To test equality of tuples that hold custom Comparable values, use the Equal5C function.
-// Otherwise, use Equal or reflect.DeepEqual to test tuples of any types.
+// Otherwise, use the Equal method or the EqualFunc5 function to test tuples of any types.
 func Equal5E[Ty1 Equalable[Ty1], Ty2 Equalable[Ty2], Ty3 Equalable[Ty3], Ty4 Equalable[Ty4], Ty5 Equalable[Ty5]](host, guest T5[Ty1, Ty2, Ty3, Ty4, Ty5]) bool {
 	return host.V1.Equal(guest.V1) && host.V2.Equal(guest.V2) && host.V3.Equal(guest.V3) && host.V4.Equal(guest.V4) && host.V5.Equal(guest.V5)
 }
@@ -166,15 +598,28 @@ func Equal5E[Ty1 Equalable[Ty1], Ty2 Equalable[Ty2], Ty3 Equalable[Ty3], Ty4 Equ
 // All tuple elements of the host and guest parameters must match the Comparable constraint.
 // To test equality of tuples that hold built-in "comparable" values, use the Equal5 function.
 // To test equality of tuples that hold custom Equalable values, use the Equal5E function.
-// Otherwise, use Equal or reflect.DeepEqual to test tuples of any types.
+// Otherwise, use the Equal method or the EqualFunc5 function to test tuples of any types.
 func Equal5C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[Ty3], Ty4 Comparable[Ty4], Ty5 Comparable[Ty5]](host, guest T5[Ty1, Ty2, Ty3, Ty4, Ty5]) bool {
 	return host.V1.CompareTo(guest.V1).EQ() && host.V2.CompareTo(guest.V2).EQ() && host.V3.CompareTo(guest.V3).EQ() && host.V4.CompareTo(guest.V4).EQ() && host.V5.CompareTo(guest.V5).EQ()
 }
 
+// EqualFunc5 returns whether the host tuple is equal to the guest tuple, using the given equality function for each element.
+// EqualFunc5 short-circuits once one of the elements is not equal, and the rest of the equality functions are not called.
+func EqualFunc5[Ty1, Ty2, Ty3, Ty4, Ty5 any](host, guest T5[Ty1, Ty2, Ty3, Ty4, Ty5], eq1 func(a, b Ty1) bool, eq2 func(a, b Ty2) bool, eq3 func(a, b Ty3) bool, eq4 func(a, b Ty4) bool, eq5 func(a, b Ty5) bool) bool {
+	return eq1(host.V1, guest.V1) && eq2(host.V2, guest.V2) && eq3(host.V3, guest.V3) && eq4(host.V4, guest.V4) && eq5(host.V5, guest.V5)
+}
+
+// Equal returns whether the tuple is equal to the guest tuple.
+// Each element is tested using the Equalable constraint if it is implemented, then the Comparable constraint,
+// then the "==" operator if the element is comparable, and otherwise using reflect.DeepEqual.
+func (t T5[Ty1, Ty2, Ty3, Ty4, Ty5]) Equal(guest T5[Ty1, Ty2, Ty3, Ty4, Ty5]) bool {
+	return equalValues(t.V1, guest.V1) && equalValues(t.V2, guest.V2) && equalValues(t.V3, guest.V3) && equalValues(t.V4, guest.V4) && equalValues(t.V5, guest.V5)
+}
+
 // Compare5 returns whether the host tuple is semantically less than, equal to, or greater than the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the Compare5C function.
-func Compare5[Ty1, Ty2, Ty3, Ty4, Ty5 constraints.Ordered](host, guest T5[Ty1, Ty2, Ty3, Ty4, Ty5]) OrderedComparisonResult {
+func Compare5[Ty1, Ty2, Ty3, Ty4, Ty5 cmp.Ordered](host, guest T5[Ty1, Ty2, Ty3, Ty4, Ty5]) OrderedComparisonResult {
 	return multiCompare(
 		func() OrderedComparisonResult { return compareOrdered(host.V1, guest.V1) },
 
@@ -205,10 +650,60 @@ func Compare5C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[Ty3], Ty
 	)
 }
 
+// CmpFunc5 returns a comparison function of tuples, which returns a negative number when a < b, a positive number when a > b,
+// and zero when a == b. The returned function can be used with slices.SortFunc, slices.BinarySearchFunc and similar functions.
+// All tuple elements must match the "Ordered" constraint.
+// To compare tuples that hold custom comparable values, use the CmpFunc5C function.
+func CmpFunc5[Ty1, Ty2, Ty3, Ty4, Ty5 cmp.Ordered]() func(a, b T5[Ty1, Ty2, Ty3, Ty4, Ty5]) int {
+	return func(a, b T5[Ty1, Ty2, Ty3, Ty4, Ty5]) int {
+		return int(Compare5(a, b))
+	}
+}
+
+// CmpFunc5C returns a comparison function of tuples, which returns a negative number when a < b, a positive number when a > b,
+// and zero when a == b. The returned function can be used with slices.SortFunc, slices.BinarySearchFunc and similar functions.
+// All tuple elements must match the Comparable constraint.
+// To compare tuples that hold built-in "Ordered" values, use the CmpFunc5 function.
+func CmpFunc5C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[Ty3], Ty4 Comparable[Ty4], Ty5 Comparable[Ty5]]() func(a, b T5[Ty1, Ty2, Ty3, Ty4, Ty5]) int {
+	return func(a, b T5[Ty1, Ty2, Ty3, Ty4, Ty5]) int {
+		return int(Compare5C(a, b))
+	}
+}
+
+// Comparator5 returns a comparison function of tuples, which compares the tuple elements by order using the given
+// element comparison functions. Each element comparison function must return a negative number when a < b, a positive number
+// when a > b, and zero when a == b, such as cmp.Compare, CompareOrdered and CompareComparable.
+// The returned function short-circuits once one of the elements is not equal, and the rest of the element comparison functions
+// are not called.
+func Comparator5[Ty1, Ty2, Ty3, Ty4, Ty5 any](c1 func(a, b Ty1) int, c2 func(a, b Ty2) int, c3 func(a, b Ty3) int, c4 func(a, b Ty4) int, c5 func(a, b Ty5) int) func(a, b T5[Ty1, Ty2, Ty3, Ty4, Ty5]) int {
+	return func(a, b T5[Ty1, Ty2, Ty3, Ty4, Ty5]) int {
+		return int(multiCompare(
+			func() OrderedComparisonResult { return OrderedComparisonResult(c1(a.V1, b.V1)) },
+
+			func() OrderedComparisonResult { return OrderedComparisonResult(c2(a.V2, b.V2)) },
+
+			func() OrderedComparisonResult { return OrderedComparisonResult(c3(a.V3, b.V3)) },
+
+			func() OrderedComparisonResult { return OrderedComparisonResult(c4(a.V4, b.V4)) },
+
+			func() OrderedComparisonResult { return OrderedComparisonResult(c5(a.V5, b.V5)) },
+		))
+	}
+}
+
+// OrderBy5 returns a comparison function of tuples, which compares the tuple elements by order according to the
+// sort order given for each element, such as OrderBy5(Asc, Desc, ...).
+// All tuple elements must match the "Ordered" constraint.
+// To order tuples that hold other element types, such as pointers, use the Comparator5 function with
+// the OrderFunc and OrderPtrFunc element comparison functions.
+func OrderBy5[Ty1, Ty2, Ty3, Ty4, Ty5 cmp.Ordered](o1, o2, o3, o4, o5 SortOrder) func(a, b T5[Ty1, Ty2, Ty3, Ty4, Ty5]) int {
+	return Comparator5(OrderedBy[Ty1](o1), OrderedBy[Ty2](o2), OrderedBy[Ty3](o3), OrderedBy[Ty4](o4), OrderedBy[Ty5](o5))
+}
+
 // LessThan5 returns whether the host tuple is semantically less than the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the LessThan5C function.
-func LessThan5[Ty1, Ty2, Ty3, Ty4, Ty5 constraints.Ordered](host, guest T5[Ty1, Ty2, Ty3, Ty4, Ty5]) bool {
+func LessThan5[Ty1, Ty2, Ty3, Ty4, Ty5 cmp.Ordered](host, guest T5[Ty1, Ty2, Ty3, Ty4, Ty5]) bool {
 	return Compare5(host, guest).LT()
 }
 
@@ -222,7 +717,7 @@ func LessThan5C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[Ty3], T
 // LessOrEqual5 returns whether the host tuple is semantically less than or equal to the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the LessOrEqual5C function.
-func LessOrEqual5[Ty1, Ty2, Ty3, Ty4, Ty5 constraints.Ordered](host, guest T5[Ty1, Ty2, Ty3, Ty4, Ty5]) bool {
+func LessOrEqual5[Ty1, Ty2, Ty3, Ty4, Ty5 cmp.Ordered](host, guest T5[Ty1, Ty2, Ty3, Ty4, Ty5]) bool {
 	return Compare5(host, guest).LE()
 }
 
@@ -236,7 +731,7 @@ func LessOrEqual5C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[Ty3]
 // GreaterThan5 returns whether the host tuple is semantically greater than the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the GreaterThan5C function.
-func GreaterThan5[Ty1, Ty2, Ty3, Ty4, Ty5 constraints.Ordered](host, guest T5[Ty1, Ty2, Ty3, Ty4, Ty5]) bool {
+func GreaterThan5[Ty1, Ty2, Ty3, Ty4, Ty5 cmp.Ordered](host, guest T5[Ty1, Ty2, Ty3, Ty4, Ty5]) bool {
 	return Compare5(host, guest).GT()
 }
 
@@ -250,7 +745,7 @@ func GreaterThan5C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[Ty3]
 // GreaterOrEqual5 returns whether the host tuple is semantically greater than or equal to the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the GreaterOrEqual5C function.
-func GreaterOrEqual5[Ty1, Ty2, Ty3, Ty4, Ty5 constraints.Ordered](host, guest T5[Ty1, Ty2, Ty3, Ty4, Ty5]) bool {
+func GreaterOrEqual5[Ty1, Ty2, Ty3, Ty4, Ty5 cmp.Ordered](host, guest T5[Ty1, Ty2, Ty3, Ty4, Ty5]) bool {
 	return Compare5(host, guest).GE()
 }
 
@@ -261,6 +756,37 @@ func GreaterOrEqual5C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[T
 	return Compare5C(host, guest).GE()
 }
 
+// Hash returns a hash of the tuple values using the given seed.
+// Equal tuples return equal hashes for the same seed.
+// Elements supported by the HashValue function are hashed by their values. Other elements, such as slices, maps,
+// functions, nil pointers and nil interfaces, are hashed by their type only, so tuples differing only in these elements collide.
+// To hash tuples holding other element types by their values, use the HashFunc5 function.
+func (t T5[Ty1, Ty2, Ty3, Ty4, Ty5]) Hash(seed maphash.Seed) uint64 {
+	var h maphash.Hash
+	h.SetSeed(seed)
+	writeHashElement(&h, seed, t.V1)
+	writeHashElement(&h, seed, t.V2)
+	writeHashElement(&h, seed, t.V3)
+	writeHashElement(&h, seed, t.V4)
+	writeHashElement(&h, seed, t.V5)
+	return h.Sum64()
+}
+
+// HashFunc5 returns a hash function of tuples, which hashes the tuple values using the given element hash functions.
+// Each element hash function must return equal hashes for equal values and the same seed, such as HashValue.
+func HashFunc5[Ty1, Ty2, Ty3, Ty4, Ty5 any](h1 func(seed maphash.Seed, v Ty1) uint64, h2 func(seed maphash.Seed, v Ty2) uint64, h3 func(seed maphash.Seed, v Ty3) uint64, h4 func(seed maphash.Seed, v Ty4) uint64, h5 func(seed maphash.Seed, v Ty5) uint64) func(seed maphash.Seed, t T5[Ty1, Ty2, Ty3, Ty4, Ty5]) uint64 {
+	return func(seed maphash.Seed, t T5[Ty1, Ty2, Ty3, Ty4, Ty5]) uint64 {
+		var h maphash.Hash
+		h.SetSeed(seed)
+		writeHashUint64(&h, h1(seed, t.V1))
+		writeHashUint64(&h, h2(seed, t.V2))
+		writeHashUint64(&h, h3(seed, t.V3))
+		writeHashUint64(&h, h4(seed, t.V4))
+		writeHashUint64(&h, h5(seed, t.V5))
+		return h.Sum64()
+	}
+}
+
 // MarshalJSON marshals the tuple into a JSON array.
 func (t T5[Ty1, Ty2, Ty3, Ty4, Ty5]) MarshalJSON() ([]byte, error) {
 	return json.Marshal(t.Slice())
@@ -268,33 +794,257 @@ func (t T5[Ty1, Ty2, Ty3, Ty4, Ty5]) MarshalJSON() ([]byte, error) {
 
 // MarshalJSON unmarshals the tuple from a JSON array.
 func (t *T5[Ty1, Ty2, Ty3, Ty4, Ty5]) UnmarshalJSON(data []byte) error {
-	// Working with json.RawMessage instead of any enables custom struct support.
-	var slice []json.RawMessage
-	if err := json.Unmarshal(data, &slice); err != nil {
-		return fmt.Errorf("unable to unmarshal json array for tuple: %w", err)
+	return t.UnmarshalJSONWith(data, JSONDecodeOptions{})
+}
+
+// UnmarshalJSONWith unmarshals the tuple from a JSON array using the given decode options.
+// The options are applied to nested tuples as well, including tuples within slices, arrays, maps, pointers and struct fields.
+func (t *T5[Ty1, Ty2, Ty3, Ty4, Ty5]) UnmarshalJSONWith(data []byte, opts JSONDecodeOptions) error {
+	return unmarshalJSONArray(data, opts, []any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+		&t.V5,
+	})
+}
+
+// MarshalJSONLabeled marshals the tuple into a JSON object, where each of the tuple values is keyed by its matching label.
+// The number of labels must match the number of tuple values.
+func (t T5[Ty1, Ty2, Ty3, Ty4, Ty5]) MarshalJSONLabeled(labels Labels) ([]byte, error) {
+	return marshalJSONLabeled(labels, t.Slice())
+}
+
+// UnmarshalJSONLabeled unmarshals the tuple from a JSON object, where each of the tuple values is keyed by its matching label.
+// The number of labels must match the number of tuple values.
+// Unless the labels are strict, missing keys leave the matching tuple values unchanged, and unknown keys are ignored.
+func (t *T5[Ty1, Ty2, Ty3, Ty4, Ty5]) UnmarshalJSONLabeled(data []byte, labels Labels) error {
+	return unmarshalJSONLabeled(data, labels, []any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+		&t.V5,
+	})
+}
+
+// MarshalText marshals the tuple into a text of comma separated values.
+// Commas and backslashes within the values are escaped by a backslash.
+// Values implementing encoding.TextMarshaler are marshalled using their MarshalText method.
+// Otherwise, the values must be of boolean, numeric or string kinds.
+func (t T5[Ty1, Ty2, Ty3, Ty4, Ty5]) MarshalText() ([]byte, error) {
+	var values [5]string
+	var err error
+	if values[0], err = marshalTextValue(t.V1); err != nil {
+		return nil, fmt.Errorf("value at index 0 failed to marshal: %w", err)
+	}
+	if values[1], err = marshalTextValue(t.V2); err != nil {
+		return nil, fmt.Errorf("value at index 1 failed to marshal: %w", err)
+	}
+	if values[2], err = marshalTextValue(t.V3); err != nil {
+		return nil, fmt.Errorf("value at index 2 failed to marshal: %w", err)
+	}
+	if values[3], err = marshalTextValue(t.V4); err != nil {
+		return nil, fmt.Errorf("value at index 3 failed to marshal: %w", err)
+	}
+	if values[4], err = marshalTextValue(t.V5); err != nil {
+		return nil, fmt.Errorf("value at index 4 failed to marshal: %w", err)
 	}
 
-	if len(slice) != 5 {
-		return fmt.Errorf("unmarshalled json array length %d must match number of tuple values 5", len(slice))
+	return []byte(joinText(values[:])), nil
+}
+
+// UnmarshalText unmarshals the tuple from a text of comma separated values, as created by MarshalText.
+func (t *T5[Ty1, Ty2, Ty3, Ty4, Ty5]) UnmarshalText(data []byte) error {
+	values, err := splitText(string(data))
+	if err != nil {
+		return fmt.Errorf("unable to unmarshal text for tuple: %w", err)
 	}
-	if err := json.Unmarshal(slice[0], &t.V1); err != nil {
-		return fmt.Errorf("value %q at slice index 0 failed to unmarshal: %w", string(slice[0]), err)
+
+	if len(values) != 5 {
+		return &LengthMismatchError{Source: "unmarshalled text values", Expected: 5, Actual: len(values), count: true}
+	}
+	if err := unmarshalTextValue(values[0], &t.V1); err != nil {
+		return &ElementUnmarshalError{Index: 0, Value: values[0], Err: err, location: locationIndex}
 	}
 
-	if err := json.Unmarshal(slice[1], &t.V2); err != nil {
-		return fmt.Errorf("value %q at slice index 1 failed to unmarshal: %w", string(slice[1]), err)
+	if err := unmarshalTextValue(values[1], &t.V2); err != nil {
+		return &ElementUnmarshalError{Index: 1, Value: values[1], Err: err, location: locationIndex}
 	}
 
-	if err := json.Unmarshal(slice[2], &t.V3); err != nil {
-		return fmt.Errorf("value %q at slice index 2 failed to unmarshal: %w", string(slice[2]), err)
+	if err := unmarshalTextValue(values[2], &t.V3); err != nil {
+		return &ElementUnmarshalError{Index: 2, Value: values[2], Err: err, location: locationIndex}
 	}
 
-	if err := json.Unmarshal(slice[3], &t.V4); err != nil {
-		return fmt.Errorf("value %q at slice index 3 failed to unmarshal: %w", string(slice[3]), err)
+	if err := unmarshalTextValue(values[3], &t.V4); err != nil {
+		return &ElementUnmarshalError{Index: 3, Value: values[3], Err: err, location: locationIndex}
 	}
 
-	if err := json.Unmarshal(slice[4], &t.V5); err != nil {
-		return fmt.Errorf("value %q at slice index 4 failed to unmarshal: %w", string(slice[4]), err)
+	if err := unmarshalTextValue(values[4], &t.V5); err != nil {
+		return &ElementUnmarshalError{Index: 4, Value: values[4], Err: err, location: locationIndex}
 	}
 	return nil
 }
+
+// GobEncode encodes the tuple for encoding/gob, implementing the gob.GobEncoder interface.
+// Nil pointer and interface values are preserved as nil, and pointers to zero values are preserved as non-nil.
+// See the package documentation for the wire format.
+func (t T5[Ty1, Ty2, Ty3, Ty4, Ty5]) GobEncode() ([]byte, error) {
+	return gobEncodeValues([]any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+		&t.V5,
+	})
+}
+
+// GobDecode decodes the tuple from encoding/gob, as encoded by GobEncode, implementing the gob.GobDecoder interface.
+// If the number of encoded values doesn't match, a *LengthMismatchError is returned.
+func (t *T5[Ty1, Ty2, Ty3, Ty4, Ty5]) GobDecode(data []byte) error {
+	return gobDecodeValues(data, []any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+		&t.V5,
+	})
+}
+
+// MarshalComposite marshals the tuple into a PostgreSQL composite literal, such as (1,"foo bar",).
+// Values are quoted and escaped the same way PostgreSQL does, and nil values and pointers are marshalled as NULL.
+// Nested tuples are marshalled as nested composite values, and other values are marshalled the same way as MarshalText does.
+func (t T5[Ty1, Ty2, Ty3, Ty4, Ty5]) MarshalComposite() ([]byte, error) {
+	return marshalComposite(t.Slice())
+}
+
+// UnmarshalComposite unmarshals the tuple from a PostgreSQL composite literal, as created by MarshalComposite or by PostgreSQL.
+// NULL values can only be unmarshalled into pointers, interfaces, slices and maps, which are set to nil.
+// If the number of composite fields doesn't match, a *LengthMismatchError is returned.
+func (t *T5[Ty1, Ty2, Ty3, Ty4, Ty5]) UnmarshalComposite(data []byte) error {
+	return unmarshalComposite(data, []any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+		&t.V5,
+	})
+}
+
+// MarshalXML marshals the tuple into a sequence of xml child elements named v1 to v5, implementing the xml.Marshaler interface.
+// Nil values are marshalled as empty child elements with a nil="true" attribute,
+// and slice values are marshalled as child elements holding an item element for each of the slice items.
+// Use MarshalXMLLabeled to name the child elements differently.
+func (t T5[Ty1, Ty2, Ty3, Ty4, Ty5]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return t.MarshalXMLLabeled(e, start, indexLabels(5))
+}
+
+// UnmarshalXML unmarshals the tuple from a sequence of xml child elements named v1 to v5, implementing the xml.Unmarshaler interface.
+// The number of child elements must match the number of tuple values, the same way as UnmarshalJSON requires.
+func (t *T5[Ty1, Ty2, Ty3, Ty4, Ty5]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return t.UnmarshalXMLLabeled(d, start, indexLabels(5))
+}
+
+// MarshalXMLLabeled marshals the tuple into a sequence of xml child elements, named by the labels matching each of the tuple values.
+// The number of labels must match the number of tuple values.
+func (t T5[Ty1, Ty2, Ty3, Ty4, Ty5]) MarshalXMLLabeled(e *xml.Encoder, start xml.StartElement, labels Labels) error {
+	return marshalXML(e, start, labels, t.Slice())
+}
+
+// UnmarshalXMLLabeled unmarshals the tuple from a sequence of xml child elements, named by the labels matching each of the tuple values.
+// The child elements must be named by the labels in the same order, and their number must match the number of tuple values.
+// If the number of child elements doesn't match, a *LengthMismatchError is returned.
+func (t *T5[Ty1, Ty2, Ty3, Ty4, Ty5]) UnmarshalXMLLabeled(d *xml.Decoder, start xml.StartElement, labels Labels) error {
+	return unmarshalXML(d, start, labels, []any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+		&t.V5,
+	})
+}
+
+// MarshalBinary marshals the tuple into its binary encoding using little-endian byte order,
+// implementing the encoding.BinaryMarshaler interface.
+// The tuple values must be of boolean, fixed-size numeric, string or byte slice kinds, or nested tuples.
+// See the package documentation for the binary encoding.
+func (t T5[Ty1, Ty2, Ty3, Ty4, Ty5]) MarshalBinary() ([]byte, error) {
+	return t.AppendBinary(nil)
+}
+
+// AppendBinary appends the binary encoding of the tuple to b using little-endian byte order,
+// the same way as MarshalBinary does.
+func (t T5[Ty1, Ty2, Ty3, Ty4, Ty5]) AppendBinary(b []byte) ([]byte, error) {
+	return t.AppendBinaryOrder(b, binary.LittleEndian)
+}
+
+// AppendBinaryOrder appends the binary encoding of the tuple to b using the given byte order.
+// Values of common types are encoded without allocations, other than the ones needed to grow b.
+func (t T5[Ty1, Ty2, Ty3, Ty4, Ty5]) AppendBinaryOrder(b []byte, order binary.AppendByteOrder) ([]byte, error) {
+	var err error
+	if b, err = appendBinaryValue(b, order, t.V1); err != nil {
+		return nil, fmt.Errorf("value at index 0 failed to marshal: %w", err)
+	}
+	if b, err = appendBinaryValue(b, order, t.V2); err != nil {
+		return nil, fmt.Errorf("value at index 1 failed to marshal: %w", err)
+	}
+	if b, err = appendBinaryValue(b, order, t.V3); err != nil {
+		return nil, fmt.Errorf("value at index 2 failed to marshal: %w", err)
+	}
+	if b, err = appendBinaryValue(b, order, t.V4); err != nil {
+		return nil, fmt.Errorf("value at index 3 failed to marshal: %w", err)
+	}
+	if b, err = appendBinaryValue(b, order, t.V5); err != nil {
+		return nil, fmt.Errorf("value at index 4 failed to marshal: %w", err)
+	}
+
+	return b, nil
+}
+
+// UnmarshalBinary unmarshals the tuple from its binary encoding using little-endian byte order,
+// implementing the encoding.BinaryUnmarshaler interface.
+func (t *T5[Ty1, Ty2, Ty3, Ty4, Ty5]) UnmarshalBinary(data []byte) error {
+	return t.UnmarshalBinaryOrder(data, binary.LittleEndian)
+}
+
+// UnmarshalBinaryOrder unmarshals the tuple from its binary encoding using the given byte order.
+// The data must hold exactly the binary encoding of the tuple.
+func (t *T5[Ty1, Ty2, Ty3, Ty4, Ty5]) UnmarshalBinaryOrder(data []byte, order binary.ByteOrder) error {
+	return unmarshalBinary(data, order, t)
+}
+
+// decodeBinary decodes the tuple from the beginning of data using the given byte order, and returns the rest of the data.
+func (t *T5[Ty1, Ty2, Ty3, Ty4, Ty5]) decodeBinary(data []byte, order binary.ByteOrder) ([]byte, error) {
+	var err error
+	if data, err = decodeBinaryValue(data, order, &t.V1); err != nil {
+		return nil, fmt.Errorf("value at index 0 failed to unmarshal: %w", err)
+	}
+	if data, err = decodeBinaryValue(data, order, &t.V2); err != nil {
+		return nil, fmt.Errorf("value at index 1 failed to unmarshal: %w", err)
+	}
+	if data, err = decodeBinaryValue(data, order, &t.V3); err != nil {
+		return nil, fmt.Errorf("value at index 2 failed to unmarshal: %w", err)
+	}
+	if data, err = decodeBinaryValue(data, order, &t.V4); err != nil {
+		return nil, fmt.Errorf("value at index 3 failed to unmarshal: %w", err)
+	}
+	if data, err = decodeBinaryValue(data, order, &t.V5); err != nil {
+		return nil, fmt.Errorf("value at index 4 failed to unmarshal: %w", err)
+	}
+
+	return data, nil
+}
+
+// AppendBinarySlice5 appends the binary encoding of a slice of tuples to b using the given byte order.
+// The slice is encoded as its length followed by the binary encoding of each of the tuples.
+func AppendBinarySlice5[Ty1, Ty2, Ty3, Ty4, Ty5 any](b []byte, order binary.AppendByteOrder, tuples []T5[Ty1, Ty2, Ty3, Ty4, Ty5]) ([]byte, error) {
+	return appendBinarySlice(b, order, tuples)
+}
+
+// UnmarshalBinarySlice5 unmarshals a slice of tuples from its binary encoding using the given byte order,
+// as encoded by AppendBinarySlice5, and appends the tuples to dst.
+// Tuples are decoded in place, so decoding into a dst with enough capacity, such as a previously decoded slice truncated to zero length,
+// doesn't allocate unless the tuples hold strings, or byte slices longer than the ones previously held in that capacity.
+func UnmarshalBinarySlice5[Ty1, Ty2, Ty3, Ty4, Ty5 any](data []byte, order binary.ByteOrder, dst []T5[Ty1, Ty2, Ty3, Ty4, Ty5]) ([]T5[Ty1, Ty2, Ty3, Ty4, Ty5], error) {
+	return unmarshalBinarySlice(data, order, dst)
+}
@@ -0,0 +1,82 @@
+//go:build go1.23
+
+package tuple
+
+import (
+	"io"
+	"iter"
+)
+
+// All returns an iterator over the index and value of each of the tuple values.
+func (t T5[Ty1, Ty2, Ty3, Ty4, Ty5]) All() iter.Seq2[int, any] {
+	return func(yield func(int, any) bool) {
+		if !yield(0, t.V1) {
+			return
+		}
+		if !yield(1, t.V2) {
+			return
+		}
+		if !yield(2, t.V3) {
+			return
+		}
+		if !yield(3, t.V4) {
+			return
+		}
+		if !yield(4, t.V5) {
+			return
+		}
+	}
+}
+
+// ZipSeq5 returns an iterator over tuples, where each tuple holds the next value of each of the given iterators.
+// The returned iterator stops once any of the given iterators is exhausted.
+func ZipSeq5[Ty1, Ty2, Ty3, Ty4, Ty5 any](s1 iter.Seq[Ty1], s2 iter.Seq[Ty2], s3 iter.Seq[Ty3], s4 iter.Seq[Ty4], s5 iter.Seq[Ty5]) iter.Seq[T5[Ty1, Ty2, Ty3, Ty4, Ty5]] {
+	return func(yield func(T5[Ty1, Ty2, Ty3, Ty4, Ty5]) bool) {
+		next2, stop2 := iter.Pull(s2)
+		defer stop2()
+		next3, stop3 := iter.Pull(s3)
+		defer stop3()
+		next4, stop4 := iter.Pull(s4)
+		defer stop4()
+		next5, stop5 := iter.Pull(s5)
+		defer stop5()
+
+		for v1 := range s1 {
+			v2, ok := next2()
+			if !ok {
+				return
+			}
+			v3, ok := next3()
+			if !ok {
+				return
+			}
+			v4, ok := next4()
+			if !ok {
+				return
+			}
+			v5, ok := next5()
+			if !ok {
+				return
+			}
+
+			if !yield(New5(v1, v2, v3, v4, v5)) {
+				return
+			}
+		}
+	}
+}
+
+// ReadCSVSeq5 returns an iterator over the CSV records of r converted into tuples, the same way as ReadCSV5 does.
+// Records that fail to parse or convert are yielded along with their errors, and the iteration continues with the next record.
+// Other errors, such as read errors, are yielded once and stop the iteration.
+func ReadCSVSeq5[Ty1, Ty2, Ty3, Ty4, Ty5 any](r io.Reader, opts CSVOptions) iter.Seq2[T5[Ty1, Ty2, Ty3, Ty4, Ty5], error] {
+	return readCSVSeq(r, opts, func(t *T5[Ty1, Ty2, Ty3, Ty4, Ty5]) []any {
+		return []any{
+			&t.V1,
+			&t.V2,
+			&t.V3,
+			&t.V4,
+			&t.V5,
+		}
+	})
+}
@@ -0,0 +1,86 @@
+//go:build go1.23
+
+package tuple
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestT5_All(t *testing.T) {
+	tup := New5("1", "2", "3", "4", "5")
+
+	var indexes []int
+	var values []any
+	for index, value := range tup.All() {
+		indexes = append(indexes, index)
+		values = append(values, value)
+	}
+
+	require.Equal(t, []int{0, 1, 2, 3, 4}, indexes)
+	require.Equal(t, tup.Slice(), values)
+}
+
+func TestT5_All_Break(t *testing.T) {
+	tup := New5("1", "2", "3", "4", "5")
+
+	var values []any
+	for _, value := range tup.All() {
+		values = append(values, value)
+		break
+	}
+
+	require.Equal(t, []any{"1"}, values)
+}
+
+func TestT5_ZipSeq(t *testing.T) {
+	want := []T5[int, int, int, int, int]{
+		New5(1, 2, 3, 4, 5),
+		New5(2, 3, 4, 5, 6),
+	}
+
+	require.Equal(t, want, slices.Collect(ZipSeq5(slices.Values([]int{1, 2}), slices.Values([]int{2, 3}), slices.Values([]int{3, 4}), slices.Values([]int{4, 5}), slices.Values([]int{5, 6}))))
+	require.Equal(t, want[:1], slices.Collect(ZipSeq5(slices.Values([]int{1}), slices.Values([]int{2, 3}), slices.Values([]int{3, 4}), slices.Values([]int{4, 5}), slices.Values([]int{5, 6}))))
+	require.Equal(t, want[:1], slices.Collect(ZipSeq5(slices.Values([]int{1, 2}), slices.Values([]int{2}), slices.Values([]int{3, 4}), slices.Values([]int{4, 5}), slices.Values([]int{5, 6}))))
+	require.Equal(t, want[:1], slices.Collect(ZipSeq5(slices.Values([]int{1, 2}), slices.Values([]int{2, 3}), slices.Values([]int{3}), slices.Values([]int{4, 5}), slices.Values([]int{5, 6}))))
+	require.Equal(t, want[:1], slices.Collect(ZipSeq5(slices.Values([]int{1, 2}), slices.Values([]int{2, 3}), slices.Values([]int{3, 4}), slices.Values([]int{4}), slices.Values([]int{5, 6}))))
+	require.Equal(t, want[:1], slices.Collect(ZipSeq5(slices.Values([]int{1, 2}), slices.Values([]int{2, 3}), slices.Values([]int{3, 4}), slices.Values([]int{4, 5}), slices.Values([]int{5}))))
+}
+
+func TestT5_ZipSeq_Break(t *testing.T) {
+	var got []T5[int, int, int, int, int]
+	for tup := range ZipSeq5(slices.Values([]int{1, 2}), slices.Values([]int{2, 3}), slices.Values([]int{3, 4}), slices.Values([]int{4, 5}), slices.Values([]int{5, 6})) {
+		got = append(got, tup)
+		break
+	}
+
+	require.Equal(t, []T5[int, int, int, int, int]{New5(1, 2, 3, 4, 5)}, got)
+}
+
+func TestT5_ReadCSVSeq(t *testing.T) {
+	data := "1,2,3,4,5\nx,x,x,x,x\n2,3,4,5,6\n"
+
+	var got []T5[int, int, int, int, int]
+	var errs []error
+	for tup, err := range ReadCSVSeq5[int, int, int, int, int](strings.NewReader(data), CSVOptions{}) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		got = append(got, tup)
+	}
+
+	require.Equal(t, []T5[int, int, int, int, int]{
+		New5(1, 2, 3, 4, 5),
+		New5(2, 3, 4, 5, 6),
+	}, got)
+	require.Len(t, errs, 1)
+
+	var csvErr *CSVError
+	require.ErrorAs(t, errs[0], &csvErr)
+	require.Equal(t, 2, csvErr.Line)
+	require.Equal(t, 1, csvErr.Column)
+}
@@ -1,7 +1,17 @@
 package tuple
 
 import (
+	"database/sql/driver"
+	"encoding/binary"
 	"encoding/json"
+	"encoding/xml"
+	"hash/maphash"
+	"io"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -33,6 +43,179 @@ func TestT5_Values(t *testing.T) {
 	require.Equal(t, "5", v5)
 }
 
+func TestT5_MapAt(t *testing.T) {
+	tup := New5(1, 2, 3, 4, 5)
+
+	require.Equal(t, New5("1", 2, 3, 4, 5), Map5At1(tup, strconv.Itoa))
+	require.Equal(t, New5(1, "2", 3, 4, 5), Map5At2(tup, strconv.Itoa))
+	require.Equal(t, New5(1, 2, "3", 4, 5), Map5At3(tup, strconv.Itoa))
+	require.Equal(t, New5(1, 2, 3, "4", 5), Map5At4(tup, strconv.Itoa))
+	require.Equal(t, New5(1, 2, 3, 4, "5"), Map5At5(tup, strconv.Itoa))
+}
+
+func TestT5_Map(t *testing.T) {
+	tup := New5(1, 2, 3, 4, 5)
+	got := Map5(tup, strconv.Itoa, strconv.Itoa, strconv.Itoa, strconv.Itoa, strconv.Itoa)
+	require.Equal(t, New5("1", "2", "3", "4", "5"), got)
+}
+
+func TestT5_Append(t *testing.T) {
+	tup := New5("1", "2", "3", "4", "5")
+	require.Equal(t, New6("1", "2", "3", "4", "5", "6"), Append5(tup, "6"))
+}
+
+func TestT5_Prepend(t *testing.T) {
+	tup := New5("2", "3", "4", "5", "6")
+	require.Equal(t, New6("1", "2", "3", "4", "5", "6"), Prepend5(tup, "1"))
+}
+
+func TestT5_Concat(t *testing.T) {
+	tup := New5("1", "2", "3", "4", "5")
+
+	require.Equal(t,
+		New6("1", "2", "3", "4", "5", "6"),
+		Concat5_1(tup, New1("6")),
+	)
+	require.Equal(t,
+		New7("1", "2", "3", "4", "5", "6", "7"),
+		Concat5_2(tup, New2("6", "7")),
+	)
+	require.Equal(t,
+		New8("1", "2", "3", "4", "5", "6", "7", "8"),
+		Concat5_3(tup, New3("6", "7", "8")),
+	)
+	require.Equal(t,
+		New9("1", "2", "3", "4", "5", "6", "7", "8", "9"),
+		Concat5_4(tup, New4("6", "7", "8", "9")),
+	)
+}
+
+func TestT5_Take(t *testing.T) {
+	tup := New5("1", "2", "3", "4", "5")
+
+	require.Equal(t, New1("1"), Take5_1(tup))
+	require.Equal(t, New2("1", "2"), Take5_2(tup))
+	require.Equal(t, New3("1", "2", "3"), Take5_3(tup))
+	require.Equal(t, New4("1", "2", "3", "4"), Take5_4(tup))
+}
+
+func TestT5_Drop(t *testing.T) {
+	tup := New5("1", "2", "3", "4", "5")
+
+	require.Equal(t, New4("2", "3", "4", "5"), Drop5_1(tup))
+	require.Equal(t, New3("3", "4", "5"), Drop5_2(tup))
+	require.Equal(t, New2("4", "5"), Drop5_3(tup))
+	require.Equal(t, New1("5"), Drop5_4(tup))
+}
+
+func TestT5_Split(t *testing.T) {
+	tup := New5("1", "2", "3", "4", "5")
+
+	require.Equal(t,
+		New2(New1("1"), New4("2", "3", "4", "5")),
+		Split5_1(tup),
+	)
+	require.Equal(t,
+		New2(New2("1", "2"), New3("3", "4", "5")),
+		Split5_2(tup),
+	)
+	require.Equal(t,
+		New2(New3("1", "2", "3"), New2("4", "5")),
+		Split5_3(tup),
+	)
+	require.Equal(t,
+		New2(New4("1", "2", "3", "4"), New1("5")),
+		Split5_4(tup),
+	)
+}
+
+func TestT5_Reverse(t *testing.T) {
+	tup := New5(1, 2, 3, 4, 5)
+	require.Equal(t, New5(5, 4, 3, 2, 1), Reverse5(tup))
+}
+
+func TestT5_Apply(t *testing.T) {
+	tup := New5("1", "2", "3", "4", "5")
+	got := Apply5(tup, func(v1, v2, v3, v4, v5 string) string {
+		return v1 + v2 + v3 + v4 + v5
+	})
+	require.Equal(t, "12345", got)
+}
+
+func TestT5_Tupled(t *testing.T) {
+	tup := New5("1", "2", "3", "4", "5")
+	f := Tupled5(func(v1, v2, v3, v4, v5 string) string {
+		return v1 + v2 + v3 + v4 + v5
+	})
+	require.Equal(t, "12345", f(tup))
+}
+
+func TestT5_Untupled(t *testing.T) {
+	f := Untupled5(func(tup T5[string, string, string, string, string]) T5[string, string, string, string, string] {
+		return tup
+	})
+	require.Equal(t, New5("1", "2", "3", "4", "5"), f("1", "2", "3", "4", "5"))
+}
+
+func TestT5_Zip(t *testing.T) {
+	want := []T5[int, int, int, int, int]{
+		New5(1, 2, 3, 4, 5),
+		New5(2, 3, 4, 5, 6),
+	}
+
+	require.Equal(t, want, Zip5([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}))
+	require.Equal(t, want[:1], Zip5([]int{1}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}))
+	require.Equal(t, want[:1], Zip5([]int{1, 2}, []int{2}, []int{3, 4}, []int{4, 5}, []int{5, 6}))
+	require.Equal(t, want[:1], Zip5([]int{1, 2}, []int{2, 3}, []int{3}, []int{4, 5}, []int{5, 6}))
+	require.Equal(t, want[:1], Zip5([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4}, []int{5, 6}))
+	require.Equal(t, want[:1], Zip5([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5}))
+}
+
+func TestT5_ZipStrict(t *testing.T) {
+	want := []T5[int, int, int, int, int]{
+		New5(1, 2, 3, 4, 5),
+		New5(2, 3, 4, 5, 6),
+	}
+
+	got, err := Zip5Strict([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6})
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	_, err = Zip5Strict([]int{1}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6})
+	require.EqualError(t, err, "slice 2 length 2 must match slice 1 length 1")
+	var lengthErr1 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr1)
+	_, err = Zip5Strict([]int{1, 2}, []int{2}, []int{3, 4}, []int{4, 5}, []int{5, 6})
+	require.EqualError(t, err, "slice 2 length 1 must match slice 1 length 2")
+	var lengthErr2 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr2)
+	_, err = Zip5Strict([]int{1, 2}, []int{2, 3}, []int{3}, []int{4, 5}, []int{5, 6})
+	require.EqualError(t, err, "slice 3 length 1 must match slice 1 length 2")
+	var lengthErr3 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr3)
+	_, err = Zip5Strict([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4}, []int{5, 6})
+	require.EqualError(t, err, "slice 4 length 1 must match slice 1 length 2")
+	var lengthErr4 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr4)
+	_, err = Zip5Strict([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5})
+	require.EqualError(t, err, "slice 5 length 1 must match slice 1 length 2")
+	var lengthErr5 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr5)
+}
+
+func TestT5_Unzip(t *testing.T) {
+	s1, s2, s3, s4, s5 := Unzip5([]T5[int, int, int, int, int]{
+		New5(1, 2, 3, 4, 5),
+		New5(2, 3, 4, 5, 6),
+	})
+
+	require.Equal(t, []int{1, 2}, s1)
+	require.Equal(t, []int{2, 3}, s2)
+	require.Equal(t, []int{3, 4}, s3)
+	require.Equal(t, []int{4, 5}, s4)
+	require.Equal(t, []int{5, 6}, s5)
+}
+
 func TestT5_Compare(t *testing.T) {
 	lesser := New5(1, 2, 3, 4, 5)
 	greater := New5(2, 3, 4, 5, 6)
@@ -213,6 +396,151 @@ func TestT5_CompareC(t *testing.T) {
 	}
 }
 
+func TestT5_CmpFunc(t *testing.T) {
+	tups := []T5[int, int, int, int, int]{
+		New5(2, 3, 4, 5, 6),
+		New5(1, 2, 3, 4, 5),
+		New5(3, 4, 5, 6, 7),
+	}
+
+	cmp := CmpFunc5[int, int, int, int, int]()
+	slices.SortFunc(tups, cmp)
+	require.Equal(t, []T5[int, int, int, int, int]{
+		New5(1, 2, 3, 4, 5),
+		New5(2, 3, 4, 5, 6),
+		New5(3, 4, 5, 6, 7),
+	}, tups)
+
+	index, found := slices.BinarySearchFunc(tups, New5(2, 3, 4, 5, 6), cmp)
+	require.True(t, found)
+	require.Equal(t, 1, index)
+}
+
+func TestT5_CmpFuncC(t *testing.T) {
+	tups := []T5[stringComparable, stringComparable, stringComparable, stringComparable, stringComparable]{
+		New5(stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5"), stringComparable("6")),
+		New5(stringComparable("1"), stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5")),
+	}
+
+	slices.SortFunc(tups, CmpFunc5C[stringComparable, stringComparable, stringComparable, stringComparable, stringComparable]())
+	require.Equal(t, []T5[stringComparable, stringComparable, stringComparable, stringComparable, stringComparable]{
+		New5(stringComparable("1"), stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5")),
+		New5(stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5"), stringComparable("6")),
+	}, tups)
+}
+
+func TestT5_Compare_NaN(t *testing.T) {
+	nan := New5(math.NaN(), math.NaN(), math.NaN(), math.NaN(), math.NaN())
+	num := New5(1.0, 2.0, 3.0, 4.0, 5.0)
+
+	require.True(t, Compare5(nan, nan).EQ())
+	require.True(t, Compare5(nan, num).LT())
+	require.True(t, Compare5(num, nan).GT())
+}
+
+func TestT5_Comparator(t *testing.T) {
+	compare := Comparator5(CompareOrdered[int], CompareOrdered[int], CompareOrdered[int], CompareOrdered[int], CompareOrdered[int])
+	lesser := New5(1, 2, 3, 4, 5)
+
+	require.Equal(t, 0, compare(lesser, lesser))
+
+	greater1 := New5(2, 2, 3, 4, 5)
+	require.Equal(t, -1, compare(lesser, greater1))
+	require.Equal(t, 1, compare(greater1, lesser))
+
+	greater2 := New5(1, 3, 3, 4, 5)
+	require.Equal(t, -1, compare(lesser, greater2))
+	require.Equal(t, 1, compare(greater2, lesser))
+
+	greater3 := New5(1, 2, 4, 4, 5)
+	require.Equal(t, -1, compare(lesser, greater3))
+	require.Equal(t, 1, compare(greater3, lesser))
+
+	greater4 := New5(1, 2, 3, 5, 5)
+	require.Equal(t, -1, compare(lesser, greater4))
+	require.Equal(t, 1, compare(greater4, lesser))
+
+	greater5 := New5(1, 2, 3, 4, 6)
+	require.Equal(t, -1, compare(lesser, greater5))
+	require.Equal(t, 1, compare(greater5, lesser))
+}
+
+func TestT5_Comparator_Comparable(t *testing.T) {
+	compare := Comparator5(CompareComparable[stringComparable], CompareComparable[stringComparable], CompareComparable[stringComparable], CompareComparable[stringComparable], CompareComparable[stringComparable])
+	lesser := New5(stringComparable("1"), stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5"))
+	greater := New5(stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5"), stringComparable("6"))
+
+	require.Equal(t, -1, compare(lesser, greater))
+	require.Equal(t, 1, compare(greater, lesser))
+	require.Equal(t, 0, compare(lesser, lesser))
+}
+
+func TestT5_Comparator_ShortCircuit(t *testing.T) {
+	unreachable := func(a, b int) int {
+		t.Fatal("comparison function should not be called")
+		return 0
+	}
+
+	compare := Comparator5(CompareOrdered[int], unreachable, unreachable, unreachable, unreachable)
+	require.Equal(t, -1, compare(New5(1, 2, 3, 4, 5), New5(2, 3, 4, 5, 6)))
+}
+
+func TestT5_OrderBy(t *testing.T) {
+	lesser := New5(1, 2, 3, 4, 5)
+	asc := OrderBy5[int, int, int, int, int](Asc, Asc, Asc, Asc, Asc)
+
+	greater1 := New5(2, 2, 3, 4, 5)
+	desc1 := OrderBy5[int, int, int, int, int](Desc, Asc, Asc, Asc, Asc)
+	require.Equal(t, -1, asc(lesser, greater1))
+	require.Equal(t, 1, desc1(lesser, greater1))
+	require.Equal(t, 0, desc1(lesser, lesser))
+
+	greater2 := New5(1, 3, 3, 4, 5)
+	desc2 := OrderBy5[int, int, int, int, int](Asc, Desc, Asc, Asc, Asc)
+	require.Equal(t, -1, asc(lesser, greater2))
+	require.Equal(t, 1, desc2(lesser, greater2))
+	require.Equal(t, 0, desc2(lesser, lesser))
+
+	greater3 := New5(1, 2, 4, 4, 5)
+	desc3 := OrderBy5[int, int, int, int, int](Asc, Asc, Desc, Asc, Asc)
+	require.Equal(t, -1, asc(lesser, greater3))
+	require.Equal(t, 1, desc3(lesser, greater3))
+	require.Equal(t, 0, desc3(lesser, lesser))
+
+	greater4 := New5(1, 2, 3, 5, 5)
+	desc4 := OrderBy5[int, int, int, int, int](Asc, Asc, Asc, Desc, Asc)
+	require.Equal(t, -1, asc(lesser, greater4))
+	require.Equal(t, 1, desc4(lesser, greater4))
+	require.Equal(t, 0, desc4(lesser, lesser))
+
+	greater5 := New5(1, 2, 3, 4, 6)
+	desc5 := OrderBy5[int, int, int, int, int](Asc, Asc, Asc, Asc, Desc)
+	require.Equal(t, -1, asc(lesser, greater5))
+	require.Equal(t, 1, desc5(lesser, greater5))
+	require.Equal(t, 0, desc5(lesser, lesser))
+}
+
+func TestT5_Comparator_OrderedPtrBy(t *testing.T) {
+	compare := Comparator5(
+		OrderedBy[int](Asc),
+		OrderedBy[int](Asc),
+		OrderedBy[int](Asc),
+		OrderedBy[int](Asc),
+		OrderedPtrBy[int](Desc.NilsFirst()),
+	)
+
+	tups := []T5[int, int, int, int, *int]{
+		New5(1, 2, 3, 4, ptr(1)),
+		New5(1, 2, 3, 4, ptr(2)),
+		New5(1, 2, 3, 4, (*int)(nil)),
+	}
+	slices.SortFunc(tups, compare)
+
+	require.Nil(t, tups[0].V5)
+	require.Equal(t, 2, *tups[1].V5)
+	require.Equal(t, 1, *tups[2].V5)
+}
+
 func TestT5_EqualE(t *testing.T) {
 	a := New5(intEqualable(1), intEqualable(2), intEqualable(3), intEqualable(4), intEqualable(5))
 	b := New5(intEqualable(2), intEqualable(3), intEqualable(4), intEqualable(5), intEqualable(6))
@@ -221,6 +549,38 @@ func TestT5_EqualE(t *testing.T) {
 	require.True(t, Equal5E(a, a))
 }
 
+func TestT5_EqualFunc(t *testing.T) {
+	a := New5([]int{1}, []int{2}, []int{3}, []int{4}, []int{5})
+	b := New5([]int{1}, []int{2}, []int{3}, []int{4}, []int{5})
+
+	require.True(t, EqualFunc5(a, b, slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc5(a, New5([]int{2}, []int{2}, []int{3}, []int{4}, []int{5}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc5(a, New5([]int{1}, []int{3}, []int{3}, []int{4}, []int{5}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc5(a, New5([]int{1}, []int{2}, []int{4}, []int{4}, []int{5}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc5(a, New5([]int{1}, []int{2}, []int{3}, []int{5}, []int{5}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc5(a, New5([]int{1}, []int{2}, []int{3}, []int{4}, []int{6}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+}
+
+func TestT5_EqualMethod(t *testing.T) {
+	a := New5([]int{1}, []int{2}, []int{3}, []int{4}, []int{5})
+
+	require.True(t, a.Equal(New5([]int{1}, []int{2}, []int{3}, []int{4}, []int{5})))
+	require.False(t, a.Equal(New5([]int{2}, []int{2}, []int{3}, []int{4}, []int{5})))
+	require.False(t, a.Equal(New5([]int{1}, []int{3}, []int{3}, []int{4}, []int{5})))
+	require.False(t, a.Equal(New5([]int{1}, []int{2}, []int{4}, []int{4}, []int{5})))
+	require.False(t, a.Equal(New5([]int{1}, []int{2}, []int{3}, []int{5}, []int{5})))
+	require.False(t, a.Equal(New5([]int{1}, []int{2}, []int{3}, []int{4}, []int{6})))
+}
+
+func TestT5_EqualMethod_Nested(t *testing.T) {
+	a := New5(New1(intEqualable(1)), New1(intEqualable(2)), New1(intEqualable(3)), New1(intEqualable(4)), New1(intEqualable(5)))
+	b := New5(New1(intEqualable(2)), New1(intEqualable(3)), New1(intEqualable(4)), New1(intEqualable(5)), New1(intEqualable(6)))
+
+	require.True(t, a.Equal(a))
+	require.False(t, a.Equal(b))
+	require.True(t, Equal5E(a, a))
+}
+
 func TestT5_String(t *testing.T) {
 	tup := New5("1", "2", "3", "4", "5")
 	require.Equal(t, `["1" "2" "3" "4" "5"]`, tup.String())
@@ -231,6 +591,31 @@ func TestT5_GoString(t *testing.T) {
 	require.Equal(t, `tuple.T5[string, string, string, string, string]{V1: "1", V2: "2", V3: "3", V4: "4", V5: "5"}`, tup.GoString())
 }
 
+func TestT5_Parse(t *testing.T) {
+	tup := New5("1", "2", "3", "4", "5")
+
+	got, err := Parse5[string, string, string, string, string](tup.String())
+	require.NoError(t, err)
+	require.Equal(t, tup, got)
+}
+
+func TestT5_Parse_Mixed(t *testing.T) {
+	tup := New5(New2("1", 1.5), New2("2", 2.5), New2("3", 3.5), New2("4", 4.5), New2("5", 5.5))
+
+	got, err := Parse5[T2[string, float64], T2[string, float64], T2[string, float64], T2[string, float64], T2[string, float64]](tup.String())
+	require.NoError(t, err)
+	require.Equal(t, tup, got)
+}
+
+func TestT5_Parse_Invalid(t *testing.T) {
+	_, err := Parse5[int, int, int, int, int](`["1" "2" "3" "4" "5"]`)
+	require.Error(t, err)
+
+	var parseErr *ParseError
+	require.ErrorAs(t, err, &parseErr)
+	require.Equal(t, 1, parseErr.Offset)
+}
+
 func TestT5_ToArray(t *testing.T) {
 	tup := New5("1", "2", "3", "4", "5")
 	require.Equal(t, [5]any{
@@ -529,89 +914,301 @@ func TestT5_FromSlice(t *testing.T) {
 	}
 }
 
-func TestT5_MarshalJSON(t *testing.T) {
-	tup := New5("1", "2", "3", "4", "5")
+func TestT5_FromSlice_Errors(t *testing.T) {
+	_, err := FromSlice5[string, string, string, string, string]([]any{})
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, &LengthMismatchError{Source: "slice", Expected: 5, Actual: 0}, lengthErr)
+	require.EqualError(t, err, "slice length 0 must match number of tuple values 5")
+
+	_, err = FromSlice5[string, string, string, string, string]([]any{1, "2", "3", "4", "5"})
+	var typeErr1 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr1)
+	require.Equal(t, 0, typeErr1.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr1.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr1.Actual)
+	require.EqualError(t, err, "value at slice index 0 expected to have type string but has type int")
+
+	_, err = FromSlice5[string, string, string, string, string]([]any{"1", 2, "3", "4", "5"})
+	var typeErr2 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr2)
+	require.Equal(t, 1, typeErr2.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr2.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr2.Actual)
+	require.EqualError(t, err, "value at slice index 1 expected to have type string but has type int")
+
+	_, err = FromSlice5[string, string, string, string, string]([]any{"1", "2", 3, "4", "5"})
+	var typeErr3 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr3)
+	require.Equal(t, 2, typeErr3.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr3.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr3.Actual)
+	require.EqualError(t, err, "value at slice index 2 expected to have type string but has type int")
+
+	_, err = FromSlice5[string, string, string, string, string]([]any{"1", "2", "3", 4, "5"})
+	var typeErr4 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr4)
+	require.Equal(t, 3, typeErr4.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr4.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr4.Actual)
+	require.EqualError(t, err, "value at slice index 3 expected to have type string but has type int")
+
+	_, err = FromSlice5[string, string, string, string, string]([]any{"1", "2", "3", "4", 5})
+	var typeErr5 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr5)
+	require.Equal(t, 4, typeErr5.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr5.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr5.Actual)
+	require.EqualError(t, err, "value at slice index 4 expected to have type string but has type int")
+
+	_, err = FromArray5[string, string, string, string, string]([5]any{})
+	var arrayTypeErr *ElementTypeError
+	require.ErrorAs(t, err, &arrayTypeErr)
+	require.Equal(t, &ElementTypeError{Source: "array", Index: 0, Expected: reflect.TypeOf("")}, arrayTypeErr)
+	require.EqualError(t, err, "value at array index 0 expected to have type string but has type <nil>")
+}
 
-	got, err := json.Marshal(tup)
-	require.NoError(t, err)
-	require.Equal(t, got, []byte(`["1","2","3","4","5"]`))
+func TestT5_UnmarshalJSON_Errors(t *testing.T) {
+	var tup T5[string, string, string, string, string]
+
+	err := json.Unmarshal([]byte(`[]`), &tup)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, 5, lengthErr.Expected)
+	require.Equal(t, 0, lengthErr.Actual)
+	require.EqualError(t, err, "unmarshalled json array length 0 must match number of tuple values 5")
+
+	err = tup.UnmarshalJSON([]byte(`["1","2","3","4",5]`))
+	var elementErr *ElementUnmarshalError
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, 4, elementErr.Index)
+	require.Equal(t, "5", elementErr.Value)
+	var typeErr *json.UnmarshalTypeError
+	require.ErrorAs(t, err, &typeErr)
+	require.ErrorContains(t, err, `value "5" at slice index 4 failed to unmarshal: `)
 }
 
-func TestT5_UnmarshalJSON(t *testing.T) {
+func TestT5_ConvertSlice(t *testing.T) {
 	tests := []struct {
 		name    string
-		data    []byte
-		want    T5[string, string, string, string, string]
+		slice   []any
+		want    T5[int, int, int, int, int]
 		wantErr bool
 	}{
 		{
-			name:    "nil data",
-			data:    nil,
+			name:  "all types match",
+			slice: []any{1, 2, 3, 4, 5},
+			want:  New5(1, 2, 3, 4, 5),
+		},
+		{
+			name:  "decoded json numbers",
+			slice: []any{float64(1), float64(2), float64(3), float64(4), float64(5)},
+			want:  New5(1, 2, 3, 4, 5),
+		},
+		{
+			name:  "json numbers",
+			slice: []any{json.Number("1"), json.Number("2"), json.Number("3"), json.Number("4"), json.Number("5")},
+			want:  New5(1, 2, 3, 4, 5),
+		},
+		{
+			name:    "slice empty",
+			slice:   []any{},
 			wantErr: true,
 		},
 		{
-			name:    "empty data",
-			data:    []byte{},
+			name:    "index 1 bad type",
+			slice:   []any{"1", 2, 3, 4, 5},
 			wantErr: true,
 		},
 		{
-			name:    "string data",
-			data:    []byte(`"hi"`),
+			name:    "index 1 fractional number",
+			slice:   []any{1.5, 2, 3, 4, 5},
 			wantErr: true,
 		},
 		{
-			name:    "empty json array",
-			data:    []byte(`[]`),
+			name:    "index 2 bad type",
+			slice:   []any{1, "2", 3, 4, 5},
 			wantErr: true,
 		},
 		{
-			name:    "longer json array",
-			data:    []byte(`["1", "2", "3", "4", "5", "6", "7", "8", "9", "10"]`),
+			name:    "index 2 fractional number",
+			slice:   []any{1, 2.5, 3, 4, 5},
 			wantErr: true,
 		},
-
 		{
-			name:    "json array with invalid type at index 0",
-			data:    []byte(`[1,"2","3","4","5"]`),
+			name:    "index 3 bad type",
+			slice:   []any{1, 2, "3", 4, 5},
 			wantErr: true,
 		},
 		{
-			name:    "json array with invalid type at index 1",
-			data:    []byte(`["1",2,"3","4","5"]`),
+			name:    "index 3 fractional number",
+			slice:   []any{1, 2, 3.5, 4, 5},
 			wantErr: true,
 		},
 		{
-			name:    "json array with invalid type at index 2",
-			data:    []byte(`["1","2",3,"4","5"]`),
+			name:    "index 4 bad type",
+			slice:   []any{1, 2, 3, "4", 5},
 			wantErr: true,
 		},
 		{
-			name:    "json array with invalid type at index 3",
-			data:    []byte(`["1","2","3",4,"5"]`),
+			name:    "index 4 fractional number",
+			slice:   []any{1, 2, 3, 4.5, 5},
 			wantErr: true,
 		},
 		{
-			name:    "json array with invalid type at index 4",
-			data:    []byte(`["1","2","3","4",5]`),
+			name:    "index 5 bad type",
+			slice:   []any{1, 2, 3, 4, "5"},
 			wantErr: true,
 		},
 		{
-			name:    "json array of valid types",
-			data:    []byte(`["1","2","3","4","5"]`),
-			want:    New5("1", "2", "3", "4", "5"),
-			wantErr: false,
+			name:    "index 5 fractional number",
+			slice:   []any{1, 2, 3, 4, 5.5},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tup, err := ConvertSlice5[int, int, int, int, int](tt.slice)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, tup)
+		})
+	}
+}
+
+func TestT5_ConvertSlice_Errors(t *testing.T) {
+	_, err := ConvertSlice5[int8, int8, int8, int8, int8]([]any{})
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+
+	_, err = ConvertSlice5[int8, int8, int8, int8, int8]([]any{1, 2, 3, 4, true})
+	var typeErr *ElementTypeError
+	require.ErrorAs(t, err, &typeErr)
+	require.Equal(t, 4, typeErr.Index)
+
+	_, err = ConvertSlice5[int8, int8, int8, int8, int8]([]any{1, 2, 3, 4, float64(128)})
+	var conversionErr *ElementConversionError
+	require.ErrorAs(t, err, &conversionErr)
+	require.Equal(t, 4, conversionErr.Index)
+	require.Equal(t, reflect.TypeOf(int8(0)), conversionErr.Expected)
+}
+
+func TestT5_ScanRow(t *testing.T) {
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{"c1", "c2", "c3", "c4", "c5"},
+		rows: [][]driver.Value{
+			{int64(1), int64(2), int64(3), int64(4), int64(5)},
+		},
+	})
+
+	require.True(t, rows.Next())
+	got, err := ScanRow5[string, int, int, int, int](rows)
+	require.NoError(t, err)
+	require.Equal(t, New5("1", 2, 3, 4, 5), got)
+}
+
+func TestT5_ScanRow_ColumnsMismatch(t *testing.T) {
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{"c1", "c2", "c3", "c4", "c5", "extra"},
+		rows: [][]driver.Value{
+			{int64(1), int64(2), int64(3), int64(4), int64(5), int64(0)},
+		},
+	})
+
+	require.True(t, rows.Next())
+	_, err := ScanRow5[int, int, int, int, int](rows)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.EqualError(t, err, "row columns count 6 must match number of tuple values 5")
+}
+
+func TestT5_CollectRows(t *testing.T) {
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{"c1", "c2", "c3", "c4", "c5"},
+		rows: [][]driver.Value{
+			{int64(1), int64(2), int64(3), int64(4), int64(5)},
+			{int64(2), int64(3), int64(4), int64(5), int64(6)},
+		},
+	})
+
+	got, err := CollectRows5[int, int, int, int, int](rows)
+	require.NoError(t, err)
+	require.Equal(t, []T5[int, int, int, int, int]{
+		New5(1, 2, 3, 4, 5),
+		New5(2, 3, 4, 5, 6),
+	}, got)
+
+	rows = fakeQuery(t, fakeResult{columns: []string{"c1", "c2", "c3", "c4", "c5"}})
+	got, err = CollectRows5[int, int, int, int, int](rows)
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
+
+func TestT5_ReadCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		opts    CSVOptions
+		want    []T5[int, int, int, int, int]
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			data: "",
+			want: nil,
 		},
 		{
-			name:    "json object of valid types",
-			data:    []byte(`{"V1": "1","V2": "2","V3": "3","V4": "4","V5": "5"}`),
+			name: "records",
+			data: "1,2,3,4,5\n2,3,4,5,6\n",
+			want: []T5[int, int, int, int, int]{
+				New5(1, 2, 3, 4, 5),
+				New5(2, 3, 4, 5, 6),
+			},
+		},
+		{
+			name: "header",
+			data: "v1,v2,v3,v4,v5\n1,2,3,4,5\n",
+			opts: CSVOptions{Header: []string{"v1", "v2", "v3", "v4", "v5"}},
+			want: []T5[int, int, int, int, int]{New5(1, 2, 3, 4, 5)},
+		},
+		{
+			name: "skip header",
+			data: "c1,c2,c3,c4,c5\n1,2,3,4,5\n",
+			opts: CSVOptions{SkipHeader: true},
+			want: []T5[int, int, int, int, int]{New5(1, 2, 3, 4, 5)},
+		},
+		{
+			name:    "mismatching header",
+			data:    "c1,c2,c3,c4,c5\n1,2,3,4,5\n",
+			opts:    CSVOptions{Header: []string{"v1", "v2", "v3", "v4", "v5"}},
+			wantErr: true,
+		},
+		{
+			name: "semicolon delimiter",
+			data: "1;2;3;4;5\n",
+			opts: CSVOptions{Comma: ';'},
+			want: []T5[int, int, int, int, int]{New5(1, 2, 3, 4, 5)},
+		},
+		{
+			name:    "too many fields",
+			data:    "1,2,3,4,5,0\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid field",
+			data:    "1,2,3,4,x\n",
 			wantErr: true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			var got T5[string, string, string, string, string]
-			err := json.Unmarshal(tt.data, &got)
+			got, err := ReadCSV5[int, int, int, int, int](strings.NewReader(tt.data), tt.opts)
 			if tt.wantErr {
 				require.Error(t, err)
 				return
@@ -623,35 +1220,840 @@ func TestT5_UnmarshalJSON(t *testing.T) {
 	}
 }
 
-func TestT5_Unmarshal_CustomStruct(t *testing.T) {
-	type Custom struct {
-		Name string `json:"name"`
-		Age  int    `json:"age"`
+func TestT5_ReadCSV_Error(t *testing.T) {
+	data := "1,2,3,4,5\n1,2,3,4,x\n"
+
+	_, err := ReadCSV5[int, int, int, int, int](strings.NewReader(data), CSVOptions{})
+	var csvErr *CSVError
+	require.ErrorAs(t, err, &csvErr)
+	require.Equal(t, 2, csvErr.Line)
+	require.Equal(t, 5, csvErr.Column)
+
+	var elementErr *ElementUnmarshalError
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, "x", elementErr.Value)
+}
+
+func TestT5_WriteCSV(t *testing.T) {
+	tups := []T5[string, string, string, string, string]{
+		New5("1", "2", "3", "4", "5"),
+		New5("1,\"1\"", "2,\"2\"", "3,\"3\"", "4,\"4\"", "5,\"5\""),
 	}
 
-	want := New5(Custom{Name: "1", Age: 1}, Custom{Name: "2", Age: 2}, Custom{Name: "3", Age: 3}, Custom{Name: "4", Age: 4}, Custom{Name: "5", Age: 5})
-	var got T5[Custom, Custom, Custom, Custom, Custom]
-	err := json.Unmarshal([]byte(`[
-		{ "name": "1", "age": 1 },
-		{ "name": "2", "age": 2 },
-		{ "name": "3", "age": 3 },
-		{ "name": "4", "age": 4 },
-		{ "name": "5", "age": 5 }
-	]`), &got)
+	var buf strings.Builder
+	err := WriteCSV5(&buf, tups, CSVOptions{Header: []string{"v1", "v2", "v3", "v4", "v5"}})
+	require.NoError(t, err)
+	require.Equal(t, "v1,v2,v3,v4,v5\n1,2,3,4,5\n\"1,\"\"1\"\"\",\"2,\"\"2\"\"\",\"3,\"\"3\"\"\",\"4,\"\"4\"\"\",\"5,\"\"5\"\"\"\n", buf.String())
 
+	got, err := ReadCSV5[string, string, string, string, string](strings.NewReader(buf.String()), CSVOptions{SkipHeader: true})
 	require.NoError(t, err)
-	require.Equal(t, want, got)
+	require.Equal(t, tups, got)
 }
 
-func TestT5_Marshal_Unmarshal(t *testing.T) {
+func TestT5_WriteCSV_Error(t *testing.T) {
+	tups := []T5[string, string, string, string, []int]{
+		{V1: "a\nb", V2: "a\nb", V3: "a\nb", V4: "a\nb", V5: []int{5}},
+	}
+
+	var buf strings.Builder
+	err := WriteCSV5(&buf, tups, CSVOptions{Header: []string{"v1", "v2", "v3", "v4", "v5"}})
+	var csvErr *CSVError
+	require.ErrorAs(t, err, &csvErr)
+	require.Equal(t, 2, csvErr.Line)
+	require.Equal(t, 5, csvErr.Column)
+
+	err = WriteCSV5(&buf, tups, CSVOptions{Header: []string{"v1", "v2", "v3", "v4", "v5", "extra"}})
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+}
+
+func TestT5_Hash(t *testing.T) {
+	seed := maphash.MakeSeed()
 	tup := New5("1", "2", "3", "4", "5")
 
-	marshalled, err := json.Marshal(tup)
-	require.NoError(t, err)
+	require.Equal(t, tup.Hash(seed), New5("1", "2", "3", "4", "5").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New5("2", "2", "3", "4", "5").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New5("1", "3", "3", "4", "5").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New5("1", "2", "4", "4", "5").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New5("1", "2", "3", "5", "5").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New5("1", "2", "3", "4", "6").Hash(seed))
+}
 
-	var unmarshalled T5[string, string, string, string, string]
-	err = json.Unmarshal(marshalled, &unmarshalled)
+func TestT5_Hash_Nested(t *testing.T) {
+	seed := maphash.MakeSeed()
+	tup := New5(New2("1", 1), New2("2", 2), New2("3", 3), New2("4", 4), New2("5", 5))
+
+	require.Equal(t, tup.Hash(seed), New5(New2("1", 1), New2("2", 2), New2("3", 3), New2("4", 4), New2("5", 5)).Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New5(New2("1", 2), New2("2", 3), New2("3", 4), New2("4", 5), New2("5", 6)).Hash(seed))
+}
+
+func TestT5_Hash_Unsupported(t *testing.T) {
+	seed := maphash.MakeSeed()
+	tup := New5([]int{1}, []int{2}, []int{3}, []int{4}, []int{5})
+	require.NotPanics(t, func() {
+		tup.Hash(seed)
+	})
+	require.Equal(t, tup.Hash(seed), New5([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}).Hash(seed))
+
+	var nilTup T5[any, any, any, any, any]
+	require.NotPanics(t, func() {
+		nilTup.Hash(seed)
+	})
+	require.Panics(t, func() {
+		HashValue(seed, tup.V1)
+	})
+}
+
+func TestT5_HashFunc(t *testing.T) {
+	seed := maphash.MakeSeed()
+	hashSlice := func(seed maphash.Seed, v []int) uint64 {
+		return HashValue(seed, len(v))
+	}
+
+	hash := HashFunc5(hashSlice, hashSlice, hashSlice, hashSlice, hashSlice)
+	tup := New5([]int{1}, []int{2}, []int{3}, []int{4}, []int{5})
+
+	require.Equal(t, hash(seed, tup), hash(seed, New5([]int{2}, []int{3}, []int{4}, []int{5}, []int{6})))
+	require.NotEqual(t, hash(seed, tup), hash(seed, New5([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6})))
+}
 
+func TestT5_MarshalJSON(t *testing.T) {
+	tup := New5("1", "2", "3", "4", "5")
+
+	got, err := json.Marshal(tup)
+	require.NoError(t, err)
+	require.Equal(t, got, []byte(`["1","2","3","4","5"]`))
+}
+
+func TestT5_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		want    T5[string, string, string, string, string]
+		wantErr bool
+	}{
+		{
+			name:    "nil data",
+			data:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "empty data",
+			data:    []byte{},
+			wantErr: true,
+		},
+		{
+			name:    "string data",
+			data:    []byte(`"hi"`),
+			wantErr: true,
+		},
+		{
+			name:    "empty json array",
+			data:    []byte(`[]`),
+			wantErr: true,
+		},
+		{
+			name:    "longer json array",
+			data:    []byte(`["1", "2", "3", "4", "5", "6", "7", "8", "9", "10"]`),
+			wantErr: true,
+		},
+
+		{
+			name:    "json array with invalid type at index 0",
+			data:    []byte(`[1,"2","3","4","5"]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array with invalid type at index 1",
+			data:    []byte(`["1",2,"3","4","5"]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array with invalid type at index 2",
+			data:    []byte(`["1","2",3,"4","5"]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array with invalid type at index 3",
+			data:    []byte(`["1","2","3",4,"5"]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array with invalid type at index 4",
+			data:    []byte(`["1","2","3","4",5]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array of valid types",
+			data:    []byte(`["1","2","3","4","5"]`),
+			want:    New5("1", "2", "3", "4", "5"),
+			wantErr: false,
+		},
+		{
+			name:    "json object of valid types",
+			data:    []byte(`{"V1": "1","V2": "2","V3": "3","V4": "4","V5": "5"}`),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T5[string, string, string, string, string]
+			err := json.Unmarshal(tt.data, &got)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT5_Unmarshal_CustomStruct(t *testing.T) {
+	type Custom struct {
+		Name string `json:"name"`
+		Age  int    `json:"age"`
+	}
+
+	want := New5(Custom{Name: "1", Age: 1}, Custom{Name: "2", Age: 2}, Custom{Name: "3", Age: 3}, Custom{Name: "4", Age: 4}, Custom{Name: "5", Age: 5})
+	var got T5[Custom, Custom, Custom, Custom, Custom]
+	err := json.Unmarshal([]byte(`[
+		{ "name": "1", "age": 1 },
+		{ "name": "2", "age": 2 },
+		{ "name": "3", "age": 3 },
+		{ "name": "4", "age": 4 },
+		{ "name": "5", "age": 5 }
+	]`), &got)
+
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+func TestT5_Marshal_Unmarshal(t *testing.T) {
+	tup := New5("1", "2", "3", "4", "5")
+
+	marshalled, err := json.Marshal(tup)
+	require.NoError(t, err)
+
+	var unmarshalled T5[string, string, string, string, string]
+	err = json.Unmarshal(marshalled, &unmarshalled)
+
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT5_UnmarshalJSONWith(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		opts    JSONDecodeOptions
+		want    T5[string, string, string, string, string]
+		wantErr bool
+	}{
+		{
+			name:    "empty json array",
+			data:    `[]`,
+			wantErr: true,
+		},
+		{
+			name: "allow shorter empty json array",
+			data: `[]`,
+			opts: JSONDecodeOptions{AllowShorter: true},
+			want: T5[string, string, string, string, string]{},
+		},
+		{
+			name: "allow shorter partial json array",
+			data: `["1"]`,
+			opts: JSONDecodeOptions{AllowShorter: true},
+			want: T5[string, string, string, string, string]{V1: "1"},
+		},
+		{
+			name:    "allow shorter longer json array",
+			data:    `["1","2","3","4","5","10"]`,
+			opts:    JSONDecodeOptions{AllowShorter: true},
+			wantErr: true,
+		},
+		{
+			name: "json array with null values",
+			data: `[null,null,null,null,null]`,
+			want: T5[string, string, string, string, string]{},
+		},
+		{
+			name:    "disallow null json array with null values",
+			data:    `[null,null,null,null,null]`,
+			opts:    JSONDecodeOptions{DisallowNull: true},
+			wantErr: true,
+		},
+		{
+			name: "disallow null json array of valid types",
+			data: `["1","2","3","4","5"]`,
+			opts: JSONDecodeOptions{DisallowNull: true},
+			want: New5("1", "2", "3", "4", "5"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T5[string, string, string, string, string]
+			err := got.UnmarshalJSONWith([]byte(tt.data), tt.opts)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT5_UnmarshalJSONWith_Nested(t *testing.T) {
+	type Custom struct {
+		Name string `json:"name"`
+	}
+
+	data := []byte(`[[1, {"name": "1", "age": 1}],[2, {"name": "2", "age": 2}],[3, {"name": "3", "age": 3}],[4, {"name": "4", "age": 4}],[5, {"name": "5", "age": 5}]]`)
+
+	var got T5[T2[any, Custom], T2[any, Custom], T2[any, Custom], T2[any, Custom], T2[any, Custom]]
+	err := got.UnmarshalJSONWith(data, JSONDecodeOptions{UseNumber: true})
+	require.NoError(t, err)
+	require.Equal(t, New5(New2[any](json.Number("1"), Custom{Name: "1"}), New2[any](json.Number("2"), Custom{Name: "2"}), New2[any](json.Number("3"), Custom{Name: "3"}), New2[any](json.Number("4"), Custom{Name: "4"}), New2[any](json.Number("5"), Custom{Name: "5"})), got)
+
+	err = got.UnmarshalJSONWith(data, JSONDecodeOptions{DisallowUnknownFields: true})
+	require.Error(t, err)
+}
+
+func TestT5_MarshalJSONLabeled(t *testing.T) {
+	tup := New5(1, 2, 3, 4, 5)
+	labels := WithLabels("v1", "v2", "v3", "v4", "v5")
+
+	got, err := tup.MarshalJSONLabeled(labels)
+	require.NoError(t, err)
+	require.Equal(t, `{"v1":1,"v2":2,"v3":3,"v4":4,"v5":5}`, string(got))
+
+	_, err = tup.MarshalJSONLabeled(WithLabels("v1", "v2", "v3", "v4", "v5", "extra"))
+	require.Error(t, err)
+}
+
+func TestT5_UnmarshalJSONLabeled(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		labels  Labels
+		want    T5[int, int, int, int, int]
+		wantErr bool
+	}{
+		{
+			name:   "all keys",
+			data:   `{"v1":1,"v2":2,"v3":3,"v4":4,"v5":5}`,
+			labels: WithLabels("v1", "v2", "v3", "v4", "v5"),
+			want:   New5(1, 2, 3, 4, 5),
+		},
+		{
+			name:   "missing keys",
+			data:   `{}`,
+			labels: WithLabels("v1", "v2", "v3", "v4", "v5"),
+			want:   T5[int, int, int, int, int]{},
+		},
+		{
+			name:    "strict missing keys",
+			data:    `{}`,
+			labels:  WithLabels("v1", "v2", "v3", "v4", "v5").Strict(),
+			wantErr: true,
+		},
+		{
+			name:   "unknown keys",
+			data:   `{"v1":1,"v2":2,"v3":3,"v4":4,"v5":5,"extra":0}`,
+			labels: WithLabels("v1", "v2", "v3", "v4", "v5"),
+			want:   New5(1, 2, 3, 4, 5),
+		},
+		{
+			name:    "strict unknown keys",
+			data:    `{"v1":1,"v2":2,"v3":3,"v4":4,"v5":5,"extra":0}`,
+			labels:  WithLabels("v1", "v2", "v3", "v4", "v5").Strict(),
+			wantErr: true,
+		},
+		{
+			name:    "json array",
+			data:    `[1,2,3,4,5]`,
+			labels:  WithLabels("v1", "v2", "v3", "v4", "v5"),
+			wantErr: true,
+		},
+		{
+			name:    "labels count mismatch",
+			data:    `{}`,
+			labels:  WithLabels("v1", "v2", "v3", "v4", "v5", "extra"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T5[int, int, int, int, int]
+			err := got.UnmarshalJSONLabeled([]byte(tt.data), tt.labels)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT5_MarshalText(t *testing.T) {
+	tup := New5("1", "2", "3", "4", "5")
+
+	got, err := tup.MarshalText()
+	require.NoError(t, err)
+	require.Equal(t, `1,2,3,4,5`, string(got))
+}
+
+func TestT5_MarshalText_Unsupported(t *testing.T) {
+	tup := New5([]int{1}, []int{2}, []int{3}, []int{4}, []int{5})
+
+	_, err := tup.MarshalText()
+	require.Error(t, err)
+}
+
+func TestT5_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    T5[string, string, string, string, string]
+		wantErr bool
+	}{
+		{
+			name: "valid values",
+			data: `1,2,3,4,5`,
+			want: New5("1", "2", "3", "4", "5"),
+		},
+		{
+			name: "escaped values",
+			data: `a\,1\\,a\,2\\,a\,3\\,a\,4\\,a\,5\\`,
+			want: New5(`a,1\`, `a,2\`, `a,3\`, `a,4\`, `a,5\`),
+		},
+		{
+			name:    "too few values",
+			data:    `2,3,4,5`,
+			wantErr: true,
+		},
+		{
+			name:    "too many values",
+			data:    `1,2,3,4,5,extra`,
+			wantErr: true,
+		},
+		{
+			name:    "dangling escape",
+			data:    `1,2,3,4,5\`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T5[string, string, string, string, string]
+			err := got.UnmarshalText([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT5_MarshalText_UnmarshalText(t *testing.T) {
+	tup := New5(New2("a,1\\\\", 1), New2("a,2\\\\", 2), New2("a,3\\\\", 3), New2("a,4\\\\", 4), New2("a,5\\\\", 5))
+
+	marshalled, err := tup.MarshalText()
+	require.NoError(t, err)
+
+	var unmarshalled T5[T2[string, int], T2[string, int], T2[string, int], T2[string, int], T2[string, int]]
+	err = unmarshalled.UnmarshalText(marshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT5_GobEncode_GobDecode(t *testing.T) {
+	t.Run("strings", func(t *testing.T) {
+		requireGobRoundTrip(t, New5("1", "2", "3", "4", "5"))
+	})
+
+	t.Run("zero values", func(t *testing.T) {
+		requireGobRoundTrip(t, T5[int, int, int, int, int]{})
+	})
+
+	t.Run("nested tuples", func(t *testing.T) {
+		requireGobRoundTrip(t, New5(New2("1", 1), New2("2", 2), New2("3", 3), New2("4", 4), New2("5", 5)))
+	})
+
+	t.Run("pointers", func(t *testing.T) {
+		requireGobRoundTrip(t, New5(ptr(1), ptr(2), ptr(3), ptr(4), ptr(5)))
+	})
+
+	t.Run("pointers to zero values", func(t *testing.T) {
+		requireGobRoundTrip(t, New5(ptr(0), ptr(0), ptr(0), ptr(0), ptr(0)))
+	})
+
+	t.Run("nil pointers", func(t *testing.T) {
+		requireGobRoundTrip(t, T5[*int, *int, *int, *int, *int]{})
+	})
+
+	t.Run("nested tuple pointers", func(t *testing.T) {
+		requireGobRoundTrip(t, New5((*T2[string, *int])(nil), &T2[string, *int]{V1: "2", V2: ptr(2)}, &T2[string, *int]{V1: "3", V2: ptr(3)}, &T2[string, *int]{V1: "4", V2: ptr(4)}, &T2[string, *int]{V1: "5", V2: ptr(5)}))
+	})
+
+	t.Run("interface values", func(t *testing.T) {
+		requireGobRoundTrip(t, T5[any, any, any, any, any]{V1: nil, V2: "2", V3: 3, V4: 4, V5: 5})
+	})
+
+	t.Run("struct field", func(t *testing.T) {
+		type Custom struct {
+			Tup T5[int, int, int, int, int]
+		}
+
+		requireGobRoundTrip(t, Custom{Tup: New5(1, 2, 3, 4, 5)})
+	})
+}
+
+func TestT5_GobDecode_Invalid(t *testing.T) {
+	encoded, err := New1("1").GobEncode()
+	require.NoError(t, err)
+
+	var tup T5[string, string, string, string, string]
+	err = tup.GobDecode(encoded)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, 5, lengthErr.Expected)
+
+	encoded, err = New5("1", "2", "3", "4", "5").GobEncode()
+	require.NoError(t, err)
+
+	var mismatched T5[int, int, int, int, int]
+	require.Error(t, mismatched.GobDecode(encoded))
+	require.Error(t, tup.GobDecode(nil))
+}
+
+func TestT5_MarshalComposite(t *testing.T) {
+	got, err := New5("foo bar", 2, 3, 4, 5).MarshalComposite()
+	require.NoError(t, err)
+	require.Equal(t, `("foo bar",2,3,4,5)`, string(got))
+
+	got, err = T5[*int, *int, *int, *int, *int]{}.MarshalComposite()
+	require.NoError(t, err)
+	require.Equal(t, `(,,,,)`, string(got))
+
+	got, err = New5(New2("1", 1), New2("2", 2), New2("3", 3), New2("4", 4), New2("5", 5)).MarshalComposite()
+	require.NoError(t, err)
+	require.Equal(t, `("(1,1)","(2,2)","(3,3)","(4,4)","(5,5)")`, string(got))
+}
+
+func TestT5_UnmarshalComposite(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    T5[string, string, string, string, string]
+		wantErr bool
+	}{
+		{
+			name: "unquoted values",
+			data: `(1,2,3,4,5)`,
+			want: New5("1", "2", "3", "4", "5"),
+		},
+		{
+			name: "quoted values",
+			data: `("1, \\""","2, \\""","3, \\""","4, \\""","5, \\""")`,
+			want: New5("1, \\\"", "2, \\\"", "3, \\\"", "4, \\\"", "5, \\\""),
+		},
+		{
+			name: "empty values",
+			data: `("","","","","")`,
+			want: T5[string, string, string, string, string]{},
+		},
+		{
+			name:    "null values",
+			data:    `(,,,,)`,
+			wantErr: true,
+		},
+		{
+			name:    "too many fields",
+			data:    `(1,2,3,4,5,0)`,
+			wantErr: true,
+		},
+		{
+			name:    "missing parentheses",
+			data:    `1,2,3,4,5`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T5[string, string, string, string, string]
+			err := got.UnmarshalComposite([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT5_MarshalComposite_UnmarshalComposite(t *testing.T) {
+	tup := New5((*T2[string, *int])(nil), &T2[string, *int]{V1: "(2, \"2\\\")", V2: ptr(2)}, &T2[string, *int]{V1: "(3, \"3\\\")", V2: ptr(3)}, &T2[string, *int]{V1: "(4, \"4\\\")", V2: ptr(4)}, &T2[string, *int]{V1: "(5, \"5\\\")", V2: ptr(5)})
+
+	marshalled, err := tup.MarshalComposite()
+	require.NoError(t, err)
+
+	var unmarshalled T5[*T2[string, *int], *T2[string, *int], *T2[string, *int], *T2[string, *int], *T2[string, *int]]
+	err = unmarshalled.UnmarshalComposite(marshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT5_MarshalXML(t *testing.T) {
+	got, err := xml.Marshal(New5(1, 2, 3, 4, 5))
+	require.NoError(t, err)
+	require.Equal(t, `<T5><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5></T5>`, string(got))
+
+	type Custom struct {
+		XMLName xml.Name                                   `xml:"custom"`
+		Tup     T5[string, string, string, string, string] `xml:"tup"`
+	}
+
+	got, err = xml.Marshal(Custom{Tup: New5("<1>", "<2>", "<3>", "<4>", "<5>")})
+	require.NoError(t, err)
+	require.Equal(t, `<custom><tup><v1>&lt;1&gt;</v1><v2>&lt;2&gt;</v2><v3>&lt;3&gt;</v3><v4>&lt;4&gt;</v4><v5>&lt;5&gt;</v5></tup></custom>`, string(got))
+}
+
+func TestT5_UnmarshalXML(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    T5[int, int, int, int, int]
+		wantErr bool
+	}{
+		{
+			name: "all elements",
+			data: `<T5><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5></T5>`,
+			want: New5(1, 2, 3, 4, 5),
+		},
+		{
+			name: "whitespace and comments",
+			data: `<T5>
+				<!-- v1 --> <v1> 1 </v1>
+				<!-- v2 --> <v2> 2 </v2>
+				<!-- v3 --> <v3> 3 </v3>
+				<!-- v4 --> <v4> 4 </v4>
+				<!-- v5 --> <v5> 5 </v5>
+			</T5>`,
+			want: New5(1, 2, 3, 4, 5),
+		},
+		{
+			name:    "no elements",
+			data:    `<T5></T5>`,
+			wantErr: true,
+		},
+		{
+			name:    "too many elements",
+			data:    `<T5><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v0>0</v0></T5>`,
+			wantErr: true,
+		},
+		{
+			name:    "wrong element names",
+			data:    `<T5><V1>1</V1><V2>2</V2><V3>3</V3><V4>4</V4><V5>5</V5></T5>`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid value",
+			data:    `<T5><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>x</v5></T5>`,
+			wantErr: true,
+		},
+		{
+			name:    "unterminated",
+			data:    `<T5><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5>`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T5[int, int, int, int, int]
+			err := xml.Unmarshal([]byte(tt.data), &got)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT5_UnmarshalXML_Errors(t *testing.T) {
+	var tup T5[int, int, int, int, int]
+
+	err := xml.Unmarshal([]byte(`<T5><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4></T5>`), &tup)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.EqualError(t, err, "unmarshalled xml elements count 4 must match number of tuple values 5")
+
+	err = xml.Unmarshal([]byte(`<T5><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v0>0</v0><v0>0</v0></T5>`), &tup)
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, 7, lengthErr.Actual)
+
+	err = xml.Unmarshal([]byte(`<T5><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>x</v5></T5>`), &tup)
+	var elementErr *ElementUnmarshalError
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, 4, elementErr.Index)
+	require.Equal(t, "v5", elementErr.Label)
+}
+
+func TestT5_MarshalXML_UnmarshalXML_NilPointers(t *testing.T) {
+	tup := New5((*int)(nil), ptr(2), ptr(3), ptr(4), ptr(5))
+
+	got, err := xml.Marshal(tup)
+	require.NoError(t, err)
+	require.Equal(t, `<T5><v1 nil="true"></v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5></T5>`, string(got))
+
+	unmarshalled := New5(ptr(0), ptr(0), ptr(0), ptr(0), ptr(0))
+	err = xml.Unmarshal(got, &unmarshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT5_MarshalXML_UnmarshalXML_Slices(t *testing.T) {
+	tup := New5([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6})
+
+	got, err := xml.Marshal(tup)
+	require.NoError(t, err)
+	require.Equal(t, `<T5><v1><item>1</item><item>2</item></v1><v2><item>2</item><item>3</item></v2><v3><item>3</item><item>4</item></v3><v4><item>4</item><item>5</item></v4><v5><item>5</item><item>6</item></v5></T5>`, string(got))
+
+	var unmarshalled T5[[]int, []int, []int, []int, []int]
+	err = xml.Unmarshal(got, &unmarshalled)
 	require.NoError(t, err)
 	require.Equal(t, tup, unmarshalled)
 }
+
+func TestT5_MarshalXMLLabeled_UnmarshalXMLLabeled(t *testing.T) {
+	tup := New5(New2("1", ptr(1)), New2("2", ptr(2)), New2("3", ptr(3)), New2("4", ptr(4)), New2("5", ptr(5)))
+	labels := WithLabels("item1", "item2", "item3", "item4", "item5")
+
+	var buf strings.Builder
+	encoder := xml.NewEncoder(&buf)
+	err := tup.MarshalXMLLabeled(encoder, xml.StartElement{Name: xml.Name{Local: "items"}}, labels)
+	require.NoError(t, err)
+	require.NoError(t, encoder.Flush())
+	require.Equal(t, `<items><item1><v1>1</v1><v2>1</v2></item1><item2><v1>2</v1><v2>2</v2></item2><item3><v1>3</v1><v2>3</v2></item3><item4><v1>4</v1><v2>4</v2></item4><item5><v1>5</v1><v2>5</v2></item5></items>`, buf.String())
+
+	decoder := xml.NewDecoder(strings.NewReader(buf.String()))
+	start, err := decoder.Token()
+	require.NoError(t, err)
+
+	var unmarshalled T5[T2[string, *int], T2[string, *int], T2[string, *int], T2[string, *int], T2[string, *int]]
+	err = unmarshalled.UnmarshalXMLLabeled(decoder, start.(xml.StartElement), labels)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+
+	err = tup.MarshalXMLLabeled(encoder, xml.StartElement{Name: xml.Name{Local: "items"}}, WithLabels("item1", "item2", "item3", "item4", "item5", "extra"))
+	require.Error(t, err)
+}
+
+func TestT5_MarshalBinary(t *testing.T) {
+	tup := New5(uint16(1), uint16(2), uint16(3), uint16(4), uint16(5))
+
+	got, err := tup.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 0, 2, 0, 3, 0, 4, 0, 5, 0}, got)
+
+	got, err = tup.AppendBinaryOrder([]byte{0xff}, binary.BigEndian)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0xff, 0, 1, 0, 2, 0, 3, 0, 4, 0, 5}, got)
+
+	_, err = New5(1, 2, 3, 4, []int{5}).MarshalBinary()
+	require.Error(t, err)
+}
+
+func TestT5_MarshalBinary_UnmarshalBinary(t *testing.T) {
+	tup := New5(
+		int64(-1),
+		"foo2",
+		true,
+		[]byte("bar4"),
+		New2(float32(5.5), "baz"),
+	)
+
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		marshalled, err := tup.AppendBinaryOrder(nil, order.(binary.AppendByteOrder))
+		require.NoError(t, err)
+
+		var unmarshalled T5[int64, string, bool, []byte, T2[float32, string]]
+		err = unmarshalled.UnmarshalBinaryOrder(marshalled, order)
+		require.NoError(t, err)
+		require.Equal(t, tup, unmarshalled)
+	}
+}
+
+func TestT5_UnmarshalBinary_Invalid(t *testing.T) {
+	marshalled, err := New5(uint16(1), uint16(2), uint16(3), uint16(4), uint16(5)).MarshalBinary()
+	require.NoError(t, err)
+
+	var tup T5[uint16, uint16, uint16, uint16, uint16]
+	err = tup.UnmarshalBinary(marshalled[:len(marshalled)-1])
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	require.EqualError(t, err, "value at index 4 failed to unmarshal: unexpected EOF")
+
+	err = tup.UnmarshalBinary(append(marshalled, 0))
+	require.EqualError(t, err, "binary data has 1 trailing bytes")
+}
+
+func TestT5_BinarySlice(t *testing.T) {
+	tups := []T5[int, int, int, int, int]{
+		New5(1, 2, 3, 4, 5),
+		New5(-1, -2, -3, -4, -5),
+	}
+
+	marshalled, err := AppendBinarySlice5(nil, binary.LittleEndian, tups)
+	require.NoError(t, err)
+	require.Len(t, marshalled, 1+2*5*8)
+
+	got, err := UnmarshalBinarySlice5[int, int, int, int, int](marshalled, binary.LittleEndian, nil)
+	require.NoError(t, err)
+	require.Equal(t, tups, got)
+
+	got, err = UnmarshalBinarySlice5(marshalled, binary.LittleEndian, got)
+	require.NoError(t, err)
+	require.Equal(t, append(tups, tups...), got)
+
+	empty, err := AppendBinarySlice5[int, int, int, int, int](nil, binary.LittleEndian, nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0}, empty)
+
+	_, err = AppendBinarySlice5(nil, binary.LittleEndian, []T5[[]int, []int, []int, []int, []int]{{}})
+	require.Error(t, err)
+}
+
+func TestT5_MarshalJSON_MapKey(t *testing.T) {
+	m := map[T5[string, string, string, string, string]]int{
+		New5("1", "2", "3", "4", "5"): 1,
+	}
+
+	marshalled, err := json.Marshal(m)
+	require.NoError(t, err)
+	require.Equal(t, `{"1,2,3,4,5":1}`, string(marshalled))
+
+	var unmarshalled map[T5[string, string, string, string, string]]int
+	err = json.Unmarshal(marshalled, &unmarshalled)
+	require.NoError(t, err)
+	require.Equal(t, m, unmarshalled)
+}
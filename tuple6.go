@@ -1,10 +1,14 @@
 package tuple
 
 import (
+	"cmp"
+	"database/sql"
+	"encoding/binary"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
-
-	"golang.org/x/exp/constraints"
+	"hash/maphash"
+	"io"
 )
 
 // T6 is a tuple type holding 6 generic values.
@@ -72,27 +76,27 @@ func New6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](v1 Ty1, v2 Ty2, v3 Ty3, v4 Ty4, v5 T
 func FromArray6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](arr [6]any) (T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6], error) {
 	v1, ok := arr[0].(Ty1)
 	if !ok {
-		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, fmt.Errorf("value at array index 0 expected to have type %s but has type %T", typeName[Ty1](), arr[0])
+		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, newElementTypeError[Ty1]("array", 0, arr[0])
 	}
 	v2, ok := arr[1].(Ty2)
 	if !ok {
-		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, fmt.Errorf("value at array index 1 expected to have type %s but has type %T", typeName[Ty2](), arr[1])
+		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, newElementTypeError[Ty2]("array", 1, arr[1])
 	}
 	v3, ok := arr[2].(Ty3)
 	if !ok {
-		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, fmt.Errorf("value at array index 2 expected to have type %s but has type %T", typeName[Ty3](), arr[2])
+		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, newElementTypeError[Ty3]("array", 2, arr[2])
 	}
 	v4, ok := arr[3].(Ty4)
 	if !ok {
-		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, fmt.Errorf("value at array index 3 expected to have type %s but has type %T", typeName[Ty4](), arr[3])
+		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, newElementTypeError[Ty4]("array", 3, arr[3])
 	}
 	v5, ok := arr[4].(Ty5)
 	if !ok {
-		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, fmt.Errorf("value at array index 4 expected to have type %s but has type %T", typeName[Ty5](), arr[4])
+		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, newElementTypeError[Ty5]("array", 4, arr[4])
 	}
 	v6, ok := arr[5].(Ty6)
 	if !ok {
-		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, fmt.Errorf("value at array index 5 expected to have type %s but has type %T", typeName[Ty6](), arr[5])
+		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, newElementTypeError[Ty6]("array", 5, arr[5])
 	}
 
 	return New6(v1, v2, v3, v4, v5, v6), nil
@@ -108,32 +112,32 @@ func FromArray6X[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](arr [6]any) T6[Ty1, Ty2, Ty3,
 // If the length of the slice doesn't match, or any of the values can not be converted to the generic type, an error is returned.
 func FromSlice6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](values []any) (T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6], error) {
 	if len(values) != 6 {
-		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, fmt.Errorf("slice length %d must match number of tuple values 6", len(values))
+		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, &LengthMismatchError{Source: "slice", Expected: 6, Actual: len(values)}
 	}
 
 	v1, ok := values[0].(Ty1)
 	if !ok {
-		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, fmt.Errorf("value at slice index 0 expected to have type %s but has type %T", typeName[Ty1](), values[0])
+		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, newElementTypeError[Ty1]("slice", 0, values[0])
 	}
 	v2, ok := values[1].(Ty2)
 	if !ok {
-		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, fmt.Errorf("value at slice index 1 expected to have type %s but has type %T", typeName[Ty2](), values[1])
+		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, newElementTypeError[Ty2]("slice", 1, values[1])
 	}
 	v3, ok := values[2].(Ty3)
 	if !ok {
-		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, fmt.Errorf("value at slice index 2 expected to have type %s but has type %T", typeName[Ty3](), values[2])
+		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, newElementTypeError[Ty3]("slice", 2, values[2])
 	}
 	v4, ok := values[3].(Ty4)
 	if !ok {
-		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, fmt.Errorf("value at slice index 3 expected to have type %s but has type %T", typeName[Ty4](), values[3])
+		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, newElementTypeError[Ty4]("slice", 3, values[3])
 	}
 	v5, ok := values[4].(Ty5)
 	if !ok {
-		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, fmt.Errorf("value at slice index 4 expected to have type %s but has type %T", typeName[Ty5](), values[4])
+		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, newElementTypeError[Ty5]("slice", 4, values[4])
 	}
 	v6, ok := values[5].(Ty6)
 	if !ok {
-		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, fmt.Errorf("value at slice index 5 expected to have type %s but has type %T", typeName[Ty6](), values[5])
+		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, newElementTypeError[Ty6]("slice", 5, values[5])
 	}
 
 	return New6(v1, v2, v3, v4, v5, v6), nil
@@ -143,7 +147,7 @@ func FromSlice6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](values []any) (T6[Ty1, Ty2, Ty
 // If the length of the slice doesn't match, or any of the values can not be converted to the generic type, the function panics.
 func FromSlice6X[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](values []any) T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6] {
 	if len(values) != 6 {
-		panic(fmt.Errorf("slice length %d must match number of tuple values 6", len(values)))
+		panic(&LengthMismatchError{Source: "slice", Expected: 6, Actual: len(values)})
 	}
 
 	v1 := values[0].(Ty1)
@@ -156,11 +160,490 @@ func FromSlice6X[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](values []any) T6[Ty1, Ty2, Ty
 	return New6(v1, v2, v3, v4, v5, v6)
 }
 
+// ConvertSlice6 returns a tuple from a slice of length 6, converting the values to the generic types when needed.
+// Unlike FromSlice6, numeric values are converted between numeric types as long as they fit the generic type,
+// json.Number values are parsed into numeric types, strings and byte slices are converted to each other,
+// and strings and byte slices are unmarshalled into types implementing encoding.TextUnmarshaler.
+// This enables creating tuples from slices decoded by encoding/json, which hold float64 values for numbers.
+// If the length of the slice doesn't match, a *LengthMismatchError is returned.
+// If any of the values can not be converted to the generic type, a *ElementTypeError is returned.
+// If the conversion of any of the values fails, for example when it overflows the generic type, a *ElementConversionError is returned.
+func ConvertSlice6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](values []any) (T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6], error) {
+	if len(values) != 6 {
+		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, &LengthMismatchError{Source: "slice", Expected: 6, Actual: len(values)}
+	}
+
+	var t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]
+	if err := convertSliceValue(0, values[0], &t.V1); err != nil {
+		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, err
+	}
+	if err := convertSliceValue(1, values[1], &t.V2); err != nil {
+		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, err
+	}
+	if err := convertSliceValue(2, values[2], &t.V3); err != nil {
+		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, err
+	}
+	if err := convertSliceValue(3, values[3], &t.V4); err != nil {
+		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, err
+	}
+	if err := convertSliceValue(4, values[4], &t.V5); err != nil {
+		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, err
+	}
+	if err := convertSliceValue(5, values[5], &t.V6); err != nil {
+		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, err
+	}
+
+	return t, nil
+}
+
+// ScanRow6 scans the current row of rows into a tuple, with each of the columns scanned into the matching tuple value.
+// If the number of columns doesn't match the number of tuple values, a *LengthMismatchError is returned.
+func ScanRow6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](rows *sql.Rows) (T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6], error) {
+	var t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]
+	if err := scanRow(rows, []any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+		&t.V5,
+		&t.V6,
+	}); err != nil {
+		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, err
+	}
+
+	return t, nil
+}
+
+// CollectRows6 scans all the rows into a slice of tuples using ScanRow6, and closes the rows.
+func CollectRows6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](rows *sql.Rows) ([]T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6], error) {
+	return collectRows(rows, ScanRow6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6])
+}
+
+// ReadCSV6 reads all the CSV records of r into tuples, with each of the fields converted to the matching tuple value.
+// Fields are converted using the UnmarshalText method of values implementing encoding.TextUnmarshaler,
+// and otherwise must be of boolean, numeric or string kinds.
+// If a record fails to convert, a *CSVError holding its line and column numbers is returned.
+// If a record fails to parse, including records with a number of fields that doesn't match, a *csv.ParseError is returned.
+func ReadCSV6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](r io.Reader, opts CSVOptions) ([]T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6], error) {
+	return readCSV(r, opts, func(t *T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) []any {
+		return []any{
+			&t.V1,
+			&t.V2,
+			&t.V3,
+			&t.V4,
+			&t.V5,
+			&t.V6,
+		}
+	})
+}
+
+// WriteCSV6 writes the tuples into w as CSV records, with each of the tuple values converted to the matching field.
+// Values are converted the same way as MarshalText does.
+// If a tuple fails to convert, a *CSVError holding the line and column numbers of its record is returned.
+func WriteCSV6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](w io.Writer, tuples []T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6], opts CSVOptions) error {
+	return writeCSV(w, tuples, opts, T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6].Slice)
+}
+
+// Parse6 returns a tuple from its string representation, as returned by the String method.
+// Supported values are booleans, numbers, quoted strings and nested tuples.
+// If the string fails to parse, a *ParseError holding the offset of the failure is returned.
+func Parse6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](s string) (T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6], error) {
+	var t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]
+	if err := parseTuple(s, &t); err != nil {
+		return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{}, err
+	}
+
+	return t, nil
+}
+
+// Map6At1 returns a new tuple with the function f applied to the value at position 1 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map6At1[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, R any](t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6], f func(Ty1) R) T6[R, Ty2, Ty3, Ty4, Ty5, Ty6] {
+	return T6[R, Ty2, Ty3, Ty4, Ty5, Ty6]{
+		V1: f(t.V1),
+		V2: t.V2,
+		V3: t.V3,
+		V4: t.V4,
+		V5: t.V5,
+		V6: t.V6,
+	}
+}
+
+// Map6At2 returns a new tuple with the function f applied to the value at position 2 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map6At2[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, R any](t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6], f func(Ty2) R) T6[Ty1, R, Ty3, Ty4, Ty5, Ty6] {
+	return T6[Ty1, R, Ty3, Ty4, Ty5, Ty6]{
+		V1: t.V1,
+		V2: f(t.V2),
+		V3: t.V3,
+		V4: t.V4,
+		V5: t.V5,
+		V6: t.V6,
+	}
+}
+
+// Map6At3 returns a new tuple with the function f applied to the value at position 3 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map6At3[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, R any](t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6], f func(Ty3) R) T6[Ty1, Ty2, R, Ty4, Ty5, Ty6] {
+	return T6[Ty1, Ty2, R, Ty4, Ty5, Ty6]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: f(t.V3),
+		V4: t.V4,
+		V5: t.V5,
+		V6: t.V6,
+	}
+}
+
+// Map6At4 returns a new tuple with the function f applied to the value at position 4 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map6At4[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, R any](t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6], f func(Ty4) R) T6[Ty1, Ty2, Ty3, R, Ty5, Ty6] {
+	return T6[Ty1, Ty2, Ty3, R, Ty5, Ty6]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+		V4: f(t.V4),
+		V5: t.V5,
+		V6: t.V6,
+	}
+}
+
+// Map6At5 returns a new tuple with the function f applied to the value at position 5 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map6At5[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, R any](t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6], f func(Ty5) R) T6[Ty1, Ty2, Ty3, Ty4, R, Ty6] {
+	return T6[Ty1, Ty2, Ty3, Ty4, R, Ty6]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+		V4: t.V4,
+		V5: f(t.V5),
+		V6: t.V6,
+	}
+}
+
+// Map6At6 returns a new tuple with the function f applied to the value at position 6 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map6At6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, R any](t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6], f func(Ty6) R) T6[Ty1, Ty2, Ty3, Ty4, Ty5, R] {
+	return T6[Ty1, Ty2, Ty3, Ty4, Ty5, R]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+		V4: t.V4,
+		V5: t.V5,
+		V6: f(t.V6),
+	}
+}
+
+// Map6 returns a new tuple with each of the functions applied to the tuple value at the matching position.
+func Map6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, R1, R2, R3, R4, R5, R6 any](t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6], f1 func(Ty1) R1, f2 func(Ty2) R2, f3 func(Ty3) R3, f4 func(Ty4) R4, f5 func(Ty5) R5, f6 func(Ty6) R6) T6[R1, R2, R3, R4, R5, R6] {
+	return T6[R1, R2, R3, R4, R5, R6]{
+		V1: f1(t.V1),
+		V2: f2(t.V2),
+		V3: f3(t.V3),
+		V4: f4(t.V4),
+		V5: f5(t.V5),
+		V6: f6(t.V6),
+	}
+}
+
+// Append6 returns a new tuple holding the tuple values followed by the value v.
+func Append6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6], v Ty7) T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7] {
+	return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+		V4: t.V4,
+		V5: t.V5,
+		V6: t.V6,
+		V7: v,
+	}
+}
+
+// Prepend6 returns a new tuple holding the value v followed by the tuple values.
+func Prepend6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](t T6[Ty2, Ty3, Ty4, Ty5, Ty6, Ty7], v Ty1) T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7] {
+	return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{
+		V1: v,
+		V2: t.V1,
+		V3: t.V2,
+		V4: t.V3,
+		V5: t.V4,
+		V6: t.V5,
+		V7: t.V6,
+	}
+}
+
+// Concat6_1 returns a new tuple holding the values of t1 followed by the values of t2.
+func Concat6_1[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](t1 T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6], t2 T1[Ty7]) T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7] {
+	return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{
+		V1: t1.V1,
+		V2: t1.V2,
+		V3: t1.V3,
+		V4: t1.V4,
+		V5: t1.V5,
+		V6: t1.V6,
+		V7: t2.V1,
+	}
+}
+
+// Concat6_2 returns a new tuple holding the values of t1 followed by the values of t2.
+func Concat6_2[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8 any](t1 T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6], t2 T2[Ty7, Ty8]) T8[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8] {
+	return T8[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8]{
+		V1: t1.V1,
+		V2: t1.V2,
+		V3: t1.V3,
+		V4: t1.V4,
+		V5: t1.V5,
+		V6: t1.V6,
+		V7: t2.V1,
+		V8: t2.V2,
+	}
+}
+
+// Concat6_3 returns a new tuple holding the values of t1 followed by the values of t2.
+func Concat6_3[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](t1 T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6], t2 T3[Ty7, Ty8, Ty9]) T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9] {
+	return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{
+		V1: t1.V1,
+		V2: t1.V2,
+		V3: t1.V3,
+		V4: t1.V4,
+		V5: t1.V5,
+		V6: t1.V6,
+		V7: t2.V1,
+		V8: t2.V2,
+		V9: t2.V3,
+	}
+}
+
+// Take6_1 returns a new tuple holding the first 1 values of the tuple.
+func Take6_1[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) T1[Ty1] {
+	return T1[Ty1]{
+		V1: t.V1,
+	}
+}
+
+// Drop6_1 returns a new tuple holding the values of the tuple without the first 1 values.
+func Drop6_1[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) T5[Ty2, Ty3, Ty4, Ty5, Ty6] {
+	return T5[Ty2, Ty3, Ty4, Ty5, Ty6]{
+		V1: t.V2,
+		V2: t.V3,
+		V3: t.V4,
+		V4: t.V5,
+		V5: t.V6,
+	}
+}
+
+// Split6_1 splits the tuple into a tuple of its first 1 values and a tuple of the rest of its values.
+func Split6_1[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) T2[T1[Ty1], T5[Ty2, Ty3, Ty4, Ty5, Ty6]] {
+	return T2[T1[Ty1], T5[Ty2, Ty3, Ty4, Ty5, Ty6]]{
+		V1: Take6_1(t),
+		V2: Drop6_1(t),
+	}
+}
+
+// Take6_2 returns a new tuple holding the first 2 values of the tuple.
+func Take6_2[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) T2[Ty1, Ty2] {
+	return T2[Ty1, Ty2]{
+		V1: t.V1,
+		V2: t.V2,
+	}
+}
+
+// Drop6_2 returns a new tuple holding the values of the tuple without the first 2 values.
+func Drop6_2[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) T4[Ty3, Ty4, Ty5, Ty6] {
+	return T4[Ty3, Ty4, Ty5, Ty6]{
+		V1: t.V3,
+		V2: t.V4,
+		V3: t.V5,
+		V4: t.V6,
+	}
+}
+
+// Split6_2 splits the tuple into a tuple of its first 2 values and a tuple of the rest of its values.
+func Split6_2[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) T2[T2[Ty1, Ty2], T4[Ty3, Ty4, Ty5, Ty6]] {
+	return T2[T2[Ty1, Ty2], T4[Ty3, Ty4, Ty5, Ty6]]{
+		V1: Take6_2(t),
+		V2: Drop6_2(t),
+	}
+}
+
+// Take6_3 returns a new tuple holding the first 3 values of the tuple.
+func Take6_3[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) T3[Ty1, Ty2, Ty3] {
+	return T3[Ty1, Ty2, Ty3]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+	}
+}
+
+// Drop6_3 returns a new tuple holding the values of the tuple without the first 3 values.
+func Drop6_3[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) T3[Ty4, Ty5, Ty6] {
+	return T3[Ty4, Ty5, Ty6]{
+		V1: t.V4,
+		V2: t.V5,
+		V3: t.V6,
+	}
+}
+
+// Split6_3 splits the tuple into a tuple of its first 3 values and a tuple of the rest of its values.
+func Split6_3[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) T2[T3[Ty1, Ty2, Ty3], T3[Ty4, Ty5, Ty6]] {
+	return T2[T3[Ty1, Ty2, Ty3], T3[Ty4, Ty5, Ty6]]{
+		V1: Take6_3(t),
+		V2: Drop6_3(t),
+	}
+}
+
+// Take6_4 returns a new tuple holding the first 4 values of the tuple.
+func Take6_4[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) T4[Ty1, Ty2, Ty3, Ty4] {
+	return T4[Ty1, Ty2, Ty3, Ty4]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+		V4: t.V4,
+	}
+}
+
+// Drop6_4 returns a new tuple holding the values of the tuple without the first 4 values.
+func Drop6_4[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) T2[Ty5, Ty6] {
+	return T2[Ty5, Ty6]{
+		V1: t.V5,
+		V2: t.V6,
+	}
+}
+
+// Split6_4 splits the tuple into a tuple of its first 4 values and a tuple of the rest of its values.
+func Split6_4[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) T2[T4[Ty1, Ty2, Ty3, Ty4], T2[Ty5, Ty6]] {
+	return T2[T4[Ty1, Ty2, Ty3, Ty4], T2[Ty5, Ty6]]{
+		V1: Take6_4(t),
+		V2: Drop6_4(t),
+	}
+}
+
+// Take6_5 returns a new tuple holding the first 5 values of the tuple.
+func Take6_5[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) T5[Ty1, Ty2, Ty3, Ty4, Ty5] {
+	return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+		V4: t.V4,
+		V5: t.V5,
+	}
+}
+
+// Drop6_5 returns a new tuple holding the values of the tuple without the first 5 values.
+func Drop6_5[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) T1[Ty6] {
+	return T1[Ty6]{
+		V1: t.V6,
+	}
+}
+
+// Split6_5 splits the tuple into a tuple of its first 5 values and a tuple of the rest of its values.
+func Split6_5[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) T2[T5[Ty1, Ty2, Ty3, Ty4, Ty5], T1[Ty6]] {
+	return T2[T5[Ty1, Ty2, Ty3, Ty4, Ty5], T1[Ty6]]{
+		V1: Take6_5(t),
+		V2: Drop6_5(t),
+	}
+}
+
+// Reverse6 returns a new tuple holding the tuple values in reverse order.
+func Reverse6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) T6[Ty6, Ty5, Ty4, Ty3, Ty2, Ty1] {
+	return T6[Ty6, Ty5, Ty4, Ty3, Ty2, Ty1]{
+		V1: t.V6,
+		V2: t.V5,
+		V3: t.V4,
+		V4: t.V3,
+		V5: t.V2,
+		V6: t.V1,
+	}
+}
+
+// Apply6 calls the function f with the tuple values as arguments and returns its result.
+func Apply6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, R any](t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6], f func(Ty1, Ty2, Ty3, Ty4, Ty5, Ty6) R) R {
+	return f(t.Values())
+}
+
+// Tupled6 returns a function that accepts a tuple and calls f with the tuple values as arguments.
+func Tupled6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, R any](f func(Ty1, Ty2, Ty3, Ty4, Ty5, Ty6) R) func(T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) R {
+	return func(t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) R {
+		return f(t.Values())
+	}
+}
+
+// Untupled6 returns a function that accepts 6 arguments and calls g with a tuple holding them.
+func Untupled6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, R any](g func(T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) R) func(Ty1, Ty2, Ty3, Ty4, Ty5, Ty6) R {
+	return func(v1 Ty1, v2 Ty2, v3 Ty3, v4 Ty4, v5 Ty5, v6 Ty6) R {
+		return g(New6(v1, v2, v3, v4, v5, v6))
+	}
+}
+
+// Zip6 returns a slice of tuples, where each tuple holds the values at the same index of each of the given slices.
+// If the slices have different lengths, the returned slice is truncated to the length of the shortest slice.
+// To require all slices to have the same length, use the Zip6Strict function.
+func Zip6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](s1 []Ty1, s2 []Ty2, s3 []Ty3, s4 []Ty4, s5 []Ty5, s6 []Ty6) []T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6] {
+	length := minLen(len(s1), len(s2), len(s3), len(s4), len(s5), len(s6))
+
+	zipped := make([]T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6], length)
+	for i := range zipped {
+		zipped[i] = T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{
+			V1: s1[i],
+			V2: s2[i],
+			V3: s3[i],
+			V4: s4[i],
+			V5: s5[i],
+			V6: s6[i],
+		}
+	}
+
+	return zipped
+}
+
+// Zip6Strict returns a slice of tuples, where each tuple holds the values at the same index of each of the given slices.
+// If the slices have different lengths, an error is returned.
+// To truncate the result to the length of the shortest slice instead, use the Zip6 function.
+func Zip6Strict[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](s1 []Ty1, s2 []Ty2, s3 []Ty3, s4 []Ty4, s5 []Ty5, s6 []Ty6) ([]T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6], error) {
+	if len(s2) != len(s1) {
+		return nil, &LengthMismatchError{Source: "slice 2", Expected: len(s1), Actual: len(s2), expectedSource: "slice 1"}
+	}
+	if len(s3) != len(s1) {
+		return nil, &LengthMismatchError{Source: "slice 3", Expected: len(s1), Actual: len(s3), expectedSource: "slice 1"}
+	}
+	if len(s4) != len(s1) {
+		return nil, &LengthMismatchError{Source: "slice 4", Expected: len(s1), Actual: len(s4), expectedSource: "slice 1"}
+	}
+	if len(s5) != len(s1) {
+		return nil, &LengthMismatchError{Source: "slice 5", Expected: len(s1), Actual: len(s5), expectedSource: "slice 1"}
+	}
+	if len(s6) != len(s1) {
+		return nil, &LengthMismatchError{Source: "slice 6", Expected: len(s1), Actual: len(s6), expectedSource: "slice 1"}
+	}
+
+	return Zip6(s1, s2, s3, s4, s5, s6), nil
+}
+
+// Unzip6 returns 6 slices, where each slice holds the tuple values at the matching position of each of the given tuples.
+func Unzip6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](ts []T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) ([]Ty1, []Ty2, []Ty3, []Ty4, []Ty5, []Ty6) {
+	s1 := make([]Ty1, len(ts))
+	s2 := make([]Ty2, len(ts))
+	s3 := make([]Ty3, len(ts))
+	s4 := make([]Ty4, len(ts))
+	s5 := make([]Ty5, len(ts))
+	s6 := make([]Ty6, len(ts))
+	for i, t := range ts {
+		s1[i] = t.V1
+		s2[i] = t.V2
+		s3[i] = t.V3
+		s4[i] = t.V4
+		s5[i] = t.V5
+		s6[i] = t.V6
+	}
+
+	return s1, s2, s3, s4, s5, s6
+}
+
 // Equal6 returns whether the host tuple is equal to the other tuple.
 // All tuple elements of the host and guest parameters must match the "comparable" built-in constraint.
 // To test equality of tuples that hold custom Equalable values, use the Equal6E function.
 // To test equality of tuples that hold custom Comparable values, use the Equal6C function.
-// Otherwise, use Equal or reflect.DeepEqual to test tuples of any types.
+// Otherwise, use the Equal method or the EqualFunc6 function to test tuples of any types.
 func Equal6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 comparable](host, guest T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) bool {
 	return host.V1 == guest.V1 && host.V2 == guest.V2 && host.V3 == guest.V3 && host.V4 == guest.V4 && host.V5 == guest.V5 && host.V6 == guest.V6
 }
@@ -169,7 +652,7 @@ func Equal6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 comparable](host, guest T6[Ty1, Ty2, Ty
 // All tuple elements of the host and guest parameters must match the Equalable constraint.
 // To test equality of tuples that hold built-in "comparable" values, use the Equal6 function.
 // To test equality of tuples that hold custom Comparable values, use the Equal6C function.
-// Otherwise, use Equal or reflect.DeepEqual to test tuples of any types.
+// Otherwise, use the Equal method or the EqualFunc6 function to test tuples of any types.
 func Equal6E[Ty1 Equalable[Ty1], Ty2 Equalable[Ty2], Ty3 Equalable[Ty3], Ty4 Equalable[Ty4], Ty5 Equalable[Ty5], Ty6 Equalable[Ty6]](host, guest T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) bool {
 	return host.V1.Equal(guest.V1) && host.V2.Equal(guest.V2) && host.V3.Equal(guest.V3) && host.V4.Equal(guest.V4) && host.V5.Equal(guest.V5) && host.V6.Equal(guest.V6)
 }
@@ -178,15 +661,28 @@ func Equal6E[Ty1 Equalable[Ty1], Ty2 Equalable[Ty2], Ty3 Equalable[Ty3], Ty4 Equ
 // All tuple elements of the host and guest parameters must match the Comparable constraint.
 // To test equality of tuples that hold built-in "comparable" values, use the Equal6 function.
 // To test equality of tuples that hold custom Equalable values, use the Equal6E function.
-// Otherwise, use Equal or reflect.DeepEqual to test tuples of any types.
+// Otherwise, use the Equal method or the EqualFunc6 function to test tuples of any types.
 func Equal6C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[Ty3], Ty4 Comparable[Ty4], Ty5 Comparable[Ty5], Ty6 Comparable[Ty6]](host, guest T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) bool {
 	return host.V1.CompareTo(guest.V1).EQ() && host.V2.CompareTo(guest.V2).EQ() && host.V3.CompareTo(guest.V3).EQ() && host.V4.CompareTo(guest.V4).EQ() && host.V5.CompareTo(guest.V5).EQ() && host.V6.CompareTo(guest.V6).EQ()
 }
 
+// EqualFunc6 returns whether the host tuple is equal to the guest tuple, using the given equality function for each element.
+// EqualFunc6 short-circuits once one of the elements is not equal, and the rest of the equality functions are not called.
+func EqualFunc6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](host, guest T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6], eq1 func(a, b Ty1) bool, eq2 func(a, b Ty2) bool, eq3 func(a, b Ty3) bool, eq4 func(a, b Ty4) bool, eq5 func(a, b Ty5) bool, eq6 func(a, b Ty6) bool) bool {
+	return eq1(host.V1, guest.V1) && eq2(host.V2, guest.V2) && eq3(host.V3, guest.V3) && eq4(host.V4, guest.V4) && eq5(host.V5, guest.V5) && eq6(host.V6, guest.V6)
+}
+
+// Equal returns whether the tuple is equal to the guest tuple.
+// Each element is tested using the Equalable constraint if it is implemented, then the Comparable constraint,
+// then the "==" operator if the element is comparable, and otherwise using reflect.DeepEqual.
+func (t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) Equal(guest T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) bool {
+	return equalValues(t.V1, guest.V1) && equalValues(t.V2, guest.V2) && equalValues(t.V3, guest.V3) && equalValues(t.V4, guest.V4) && equalValues(t.V5, guest.V5) && equalValues(t.V6, guest.V6)
+}
+
 // Compare6 returns whether the host tuple is semantically less than, equal to, or greater than the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the Compare6C function.
-func Compare6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 constraints.Ordered](host, guest T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) OrderedComparisonResult {
+func Compare6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 cmp.Ordered](host, guest T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) OrderedComparisonResult {
 	return multiCompare(
 		func() OrderedComparisonResult { return compareOrdered(host.V1, guest.V1) },
 
@@ -221,10 +717,62 @@ func Compare6C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[Ty3], Ty
 	)
 }
 
+// CmpFunc6 returns a comparison function of tuples, which returns a negative number when a < b, a positive number when a > b,
+// and zero when a == b. The returned function can be used with slices.SortFunc, slices.BinarySearchFunc and similar functions.
+// All tuple elements must match the "Ordered" constraint.
+// To compare tuples that hold custom comparable values, use the CmpFunc6C function.
+func CmpFunc6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 cmp.Ordered]() func(a, b T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) int {
+	return func(a, b T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) int {
+		return int(Compare6(a, b))
+	}
+}
+
+// CmpFunc6C returns a comparison function of tuples, which returns a negative number when a < b, a positive number when a > b,
+// and zero when a == b. The returned function can be used with slices.SortFunc, slices.BinarySearchFunc and similar functions.
+// All tuple elements must match the Comparable constraint.
+// To compare tuples that hold built-in "Ordered" values, use the CmpFunc6 function.
+func CmpFunc6C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[Ty3], Ty4 Comparable[Ty4], Ty5 Comparable[Ty5], Ty6 Comparable[Ty6]]() func(a, b T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) int {
+	return func(a, b T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) int {
+		return int(Compare6C(a, b))
+	}
+}
+
+// Comparator6 returns a comparison function of tuples, which compares the tuple elements by order using the given
+// element comparison functions. Each element comparison function must return a negative number when a < b, a positive number
+// when a > b, and zero when a == b, such as cmp.Compare, CompareOrdered and CompareComparable.
+// The returned function short-circuits once one of the elements is not equal, and the rest of the element comparison functions
+// are not called.
+func Comparator6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](c1 func(a, b Ty1) int, c2 func(a, b Ty2) int, c3 func(a, b Ty3) int, c4 func(a, b Ty4) int, c5 func(a, b Ty5) int, c6 func(a, b Ty6) int) func(a, b T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) int {
+	return func(a, b T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) int {
+		return int(multiCompare(
+			func() OrderedComparisonResult { return OrderedComparisonResult(c1(a.V1, b.V1)) },
+
+			func() OrderedComparisonResult { return OrderedComparisonResult(c2(a.V2, b.V2)) },
+
+			func() OrderedComparisonResult { return OrderedComparisonResult(c3(a.V3, b.V3)) },
+
+			func() OrderedComparisonResult { return OrderedComparisonResult(c4(a.V4, b.V4)) },
+
+			func() OrderedComparisonResult { return OrderedComparisonResult(c5(a.V5, b.V5)) },
+
+			func() OrderedComparisonResult { return OrderedComparisonResult(c6(a.V6, b.V6)) },
+		))
+	}
+}
+
+// OrderBy6 returns a comparison function of tuples, which compares the tuple elements by order according to the
+// sort order given for each element, such as OrderBy6(Asc, Desc, ...).
+// All tuple elements must match the "Ordered" constraint.
+// To order tuples that hold other element types, such as pointers, use the Comparator6 function with
+// the OrderFunc and OrderPtrFunc element comparison functions.
+func OrderBy6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 cmp.Ordered](o1, o2, o3, o4, o5, o6 SortOrder) func(a, b T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) int {
+	return Comparator6(OrderedBy[Ty1](o1), OrderedBy[Ty2](o2), OrderedBy[Ty3](o3), OrderedBy[Ty4](o4), OrderedBy[Ty5](o5), OrderedBy[Ty6](o6))
+}
+
 // LessThan6 returns whether the host tuple is semantically less than the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the LessThan6C function.
-func LessThan6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 constraints.Ordered](host, guest T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) bool {
+func LessThan6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 cmp.Ordered](host, guest T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) bool {
 	return Compare6(host, guest).LT()
 }
 
@@ -238,7 +786,7 @@ func LessThan6C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[Ty3], T
 // LessOrEqual6 returns whether the host tuple is semantically less than or equal to the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the LessOrEqual6C function.
-func LessOrEqual6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 constraints.Ordered](host, guest T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) bool {
+func LessOrEqual6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 cmp.Ordered](host, guest T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) bool {
 	return Compare6(host, guest).LE()
 }
 
@@ -252,7 +800,7 @@ func LessOrEqual6C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[Ty3]
 // GreaterThan6 returns whether the host tuple is semantically greater than the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the GreaterThan6C function.
-func GreaterThan6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 constraints.Ordered](host, guest T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) bool {
+func GreaterThan6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 cmp.Ordered](host, guest T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) bool {
 	return Compare6(host, guest).GT()
 }
 
@@ -266,7 +814,7 @@ func GreaterThan6C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[Ty3]
 // GreaterOrEqual6 returns whether the host tuple is semantically greater than or equal to the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the GreaterOrEqual6C function.
-func GreaterOrEqual6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 constraints.Ordered](host, guest T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) bool {
+func GreaterOrEqual6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 cmp.Ordered](host, guest T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) bool {
 	return Compare6(host, guest).GE()
 }
 
@@ -277,6 +825,39 @@ func GreaterOrEqual6C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[T
 	return Compare6C(host, guest).GE()
 }
 
+// Hash returns a hash of the tuple values using the given seed.
+// Equal tuples return equal hashes for the same seed.
+// Elements supported by the HashValue function are hashed by their values. Other elements, such as slices, maps,
+// functions, nil pointers and nil interfaces, are hashed by their type only, so tuples differing only in these elements collide.
+// To hash tuples holding other element types by their values, use the HashFunc6 function.
+func (t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) Hash(seed maphash.Seed) uint64 {
+	var h maphash.Hash
+	h.SetSeed(seed)
+	writeHashElement(&h, seed, t.V1)
+	writeHashElement(&h, seed, t.V2)
+	writeHashElement(&h, seed, t.V3)
+	writeHashElement(&h, seed, t.V4)
+	writeHashElement(&h, seed, t.V5)
+	writeHashElement(&h, seed, t.V6)
+	return h.Sum64()
+}
+
+// HashFunc6 returns a hash function of tuples, which hashes the tuple values using the given element hash functions.
+// Each element hash function must return equal hashes for equal values and the same seed, such as HashValue.
+func HashFunc6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](h1 func(seed maphash.Seed, v Ty1) uint64, h2 func(seed maphash.Seed, v Ty2) uint64, h3 func(seed maphash.Seed, v Ty3) uint64, h4 func(seed maphash.Seed, v Ty4) uint64, h5 func(seed maphash.Seed, v Ty5) uint64, h6 func(seed maphash.Seed, v Ty6) uint64) func(seed maphash.Seed, t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) uint64 {
+	return func(seed maphash.Seed, t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) uint64 {
+		var h maphash.Hash
+		h.SetSeed(seed)
+		writeHashUint64(&h, h1(seed, t.V1))
+		writeHashUint64(&h, h2(seed, t.V2))
+		writeHashUint64(&h, h3(seed, t.V3))
+		writeHashUint64(&h, h4(seed, t.V4))
+		writeHashUint64(&h, h5(seed, t.V5))
+		writeHashUint64(&h, h6(seed, t.V6))
+		return h.Sum64()
+	}
+}
+
 // MarshalJSON marshals the tuple into a JSON array.
 func (t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) MarshalJSON() ([]byte, error) {
 	return json.Marshal(t.Slice())
@@ -284,37 +865,276 @@ func (t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) MarshalJSON() ([]byte, error) {
 
 // MarshalJSON unmarshals the tuple from a JSON array.
 func (t *T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) UnmarshalJSON(data []byte) error {
-	// Working with json.RawMessage instead of any enables custom struct support.
-	var slice []json.RawMessage
-	if err := json.Unmarshal(data, &slice); err != nil {
-		return fmt.Errorf("unable to unmarshal json array for tuple: %w", err)
+	return t.UnmarshalJSONWith(data, JSONDecodeOptions{})
+}
+
+// UnmarshalJSONWith unmarshals the tuple from a JSON array using the given decode options.
+// The options are applied to nested tuples as well, including tuples within slices, arrays, maps, pointers and struct fields.
+func (t *T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) UnmarshalJSONWith(data []byte, opts JSONDecodeOptions) error {
+	return unmarshalJSONArray(data, opts, []any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+		&t.V5,
+		&t.V6,
+	})
+}
+
+// MarshalJSONLabeled marshals the tuple into a JSON object, where each of the tuple values is keyed by its matching label.
+// The number of labels must match the number of tuple values.
+func (t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) MarshalJSONLabeled(labels Labels) ([]byte, error) {
+	return marshalJSONLabeled(labels, t.Slice())
+}
+
+// UnmarshalJSONLabeled unmarshals the tuple from a JSON object, where each of the tuple values is keyed by its matching label.
+// The number of labels must match the number of tuple values.
+// Unless the labels are strict, missing keys leave the matching tuple values unchanged, and unknown keys are ignored.
+func (t *T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) UnmarshalJSONLabeled(data []byte, labels Labels) error {
+	return unmarshalJSONLabeled(data, labels, []any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+		&t.V5,
+		&t.V6,
+	})
+}
+
+// MarshalText marshals the tuple into a text of comma separated values.
+// Commas and backslashes within the values are escaped by a backslash.
+// Values implementing encoding.TextMarshaler are marshalled using their MarshalText method.
+// Otherwise, the values must be of boolean, numeric or string kinds.
+func (t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) MarshalText() ([]byte, error) {
+	var values [6]string
+	var err error
+	if values[0], err = marshalTextValue(t.V1); err != nil {
+		return nil, fmt.Errorf("value at index 0 failed to marshal: %w", err)
 	}
+	if values[1], err = marshalTextValue(t.V2); err != nil {
+		return nil, fmt.Errorf("value at index 1 failed to marshal: %w", err)
+	}
+	if values[2], err = marshalTextValue(t.V3); err != nil {
+		return nil, fmt.Errorf("value at index 2 failed to marshal: %w", err)
+	}
+	if values[3], err = marshalTextValue(t.V4); err != nil {
+		return nil, fmt.Errorf("value at index 3 failed to marshal: %w", err)
+	}
+	if values[4], err = marshalTextValue(t.V5); err != nil {
+		return nil, fmt.Errorf("value at index 4 failed to marshal: %w", err)
+	}
+	if values[5], err = marshalTextValue(t.V6); err != nil {
+		return nil, fmt.Errorf("value at index 5 failed to marshal: %w", err)
+	}
+
+	return []byte(joinText(values[:])), nil
+}
 
-	if len(slice) != 6 {
-		return fmt.Errorf("unmarshalled json array length %d must match number of tuple values 6", len(slice))
+// UnmarshalText unmarshals the tuple from a text of comma separated values, as created by MarshalText.
+func (t *T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) UnmarshalText(data []byte) error {
+	values, err := splitText(string(data))
+	if err != nil {
+		return fmt.Errorf("unable to unmarshal text for tuple: %w", err)
 	}
-	if err := json.Unmarshal(slice[0], &t.V1); err != nil {
-		return fmt.Errorf("value %q at slice index 0 failed to unmarshal: %w", string(slice[0]), err)
+
+	if len(values) != 6 {
+		return &LengthMismatchError{Source: "unmarshalled text values", Expected: 6, Actual: len(values), count: true}
+	}
+	if err := unmarshalTextValue(values[0], &t.V1); err != nil {
+		return &ElementUnmarshalError{Index: 0, Value: values[0], Err: err, location: locationIndex}
 	}
 
-	if err := json.Unmarshal(slice[1], &t.V2); err != nil {
-		return fmt.Errorf("value %q at slice index 1 failed to unmarshal: %w", string(slice[1]), err)
+	if err := unmarshalTextValue(values[1], &t.V2); err != nil {
+		return &ElementUnmarshalError{Index: 1, Value: values[1], Err: err, location: locationIndex}
 	}
 
-	if err := json.Unmarshal(slice[2], &t.V3); err != nil {
-		return fmt.Errorf("value %q at slice index 2 failed to unmarshal: %w", string(slice[2]), err)
+	if err := unmarshalTextValue(values[2], &t.V3); err != nil {
+		return &ElementUnmarshalError{Index: 2, Value: values[2], Err: err, location: locationIndex}
 	}
 
-	if err := json.Unmarshal(slice[3], &t.V4); err != nil {
-		return fmt.Errorf("value %q at slice index 3 failed to unmarshal: %w", string(slice[3]), err)
+	if err := unmarshalTextValue(values[3], &t.V4); err != nil {
+		return &ElementUnmarshalError{Index: 3, Value: values[3], Err: err, location: locationIndex}
 	}
 
-	if err := json.Unmarshal(slice[4], &t.V5); err != nil {
-		return fmt.Errorf("value %q at slice index 4 failed to unmarshal: %w", string(slice[4]), err)
+	if err := unmarshalTextValue(values[4], &t.V5); err != nil {
+		return &ElementUnmarshalError{Index: 4, Value: values[4], Err: err, location: locationIndex}
 	}
 
-	if err := json.Unmarshal(slice[5], &t.V6); err != nil {
-		return fmt.Errorf("value %q at slice index 5 failed to unmarshal: %w", string(slice[5]), err)
+	if err := unmarshalTextValue(values[5], &t.V6); err != nil {
+		return &ElementUnmarshalError{Index: 5, Value: values[5], Err: err, location: locationIndex}
 	}
 	return nil
 }
+
+// GobEncode encodes the tuple for encoding/gob, implementing the gob.GobEncoder interface.
+// Nil pointer and interface values are preserved as nil, and pointers to zero values are preserved as non-nil.
+// See the package documentation for the wire format.
+func (t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) GobEncode() ([]byte, error) {
+	return gobEncodeValues([]any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+		&t.V5,
+		&t.V6,
+	})
+}
+
+// GobDecode decodes the tuple from encoding/gob, as encoded by GobEncode, implementing the gob.GobDecoder interface.
+// If the number of encoded values doesn't match, a *LengthMismatchError is returned.
+func (t *T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) GobDecode(data []byte) error {
+	return gobDecodeValues(data, []any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+		&t.V5,
+		&t.V6,
+	})
+}
+
+// MarshalComposite marshals the tuple into a PostgreSQL composite literal, such as (1,"foo bar",).
+// Values are quoted and escaped the same way PostgreSQL does, and nil values and pointers are marshalled as NULL.
+// Nested tuples are marshalled as nested composite values, and other values are marshalled the same way as MarshalText does.
+func (t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) MarshalComposite() ([]byte, error) {
+	return marshalComposite(t.Slice())
+}
+
+// UnmarshalComposite unmarshals the tuple from a PostgreSQL composite literal, as created by MarshalComposite or by PostgreSQL.
+// NULL values can only be unmarshalled into pointers, interfaces, slices and maps, which are set to nil.
+// If the number of composite fields doesn't match, a *LengthMismatchError is returned.
+func (t *T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) UnmarshalComposite(data []byte) error {
+	return unmarshalComposite(data, []any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+		&t.V5,
+		&t.V6,
+	})
+}
+
+// MarshalXML marshals the tuple into a sequence of xml child elements named v1 to v6, implementing the xml.Marshaler interface.
+// Nil values are marshalled as empty child elements with a nil="true" attribute,
+// and slice values are marshalled as child elements holding an item element for each of the slice items.
+// Use MarshalXMLLabeled to name the child elements differently.
+func (t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return t.MarshalXMLLabeled(e, start, indexLabels(6))
+}
+
+// UnmarshalXML unmarshals the tuple from a sequence of xml child elements named v1 to v6, implementing the xml.Unmarshaler interface.
+// The number of child elements must match the number of tuple values, the same way as UnmarshalJSON requires.
+func (t *T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return t.UnmarshalXMLLabeled(d, start, indexLabels(6))
+}
+
+// MarshalXMLLabeled marshals the tuple into a sequence of xml child elements, named by the labels matching each of the tuple values.
+// The number of labels must match the number of tuple values.
+func (t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) MarshalXMLLabeled(e *xml.Encoder, start xml.StartElement, labels Labels) error {
+	return marshalXML(e, start, labels, t.Slice())
+}
+
+// UnmarshalXMLLabeled unmarshals the tuple from a sequence of xml child elements, named by the labels matching each of the tuple values.
+// The child elements must be named by the labels in the same order, and their number must match the number of tuple values.
+// If the number of child elements doesn't match, a *LengthMismatchError is returned.
+func (t *T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) UnmarshalXMLLabeled(d *xml.Decoder, start xml.StartElement, labels Labels) error {
+	return unmarshalXML(d, start, labels, []any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+		&t.V5,
+		&t.V6,
+	})
+}
+
+// MarshalBinary marshals the tuple into its binary encoding using little-endian byte order,
+// implementing the encoding.BinaryMarshaler interface.
+// The tuple values must be of boolean, fixed-size numeric, string or byte slice kinds, or nested tuples.
+// See the package documentation for the binary encoding.
+func (t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) MarshalBinary() ([]byte, error) {
+	return t.AppendBinary(nil)
+}
+
+// AppendBinary appends the binary encoding of the tuple to b using little-endian byte order,
+// the same way as MarshalBinary does.
+func (t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) AppendBinary(b []byte) ([]byte, error) {
+	return t.AppendBinaryOrder(b, binary.LittleEndian)
+}
+
+// AppendBinaryOrder appends the binary encoding of the tuple to b using the given byte order.
+// Values of common types are encoded without allocations, other than the ones needed to grow b.
+func (t T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) AppendBinaryOrder(b []byte, order binary.AppendByteOrder) ([]byte, error) {
+	var err error
+	if b, err = appendBinaryValue(b, order, t.V1); err != nil {
+		return nil, fmt.Errorf("value at index 0 failed to marshal: %w", err)
+	}
+	if b, err = appendBinaryValue(b, order, t.V2); err != nil {
+		return nil, fmt.Errorf("value at index 1 failed to marshal: %w", err)
+	}
+	if b, err = appendBinaryValue(b, order, t.V3); err != nil {
+		return nil, fmt.Errorf("value at index 2 failed to marshal: %w", err)
+	}
+	if b, err = appendBinaryValue(b, order, t.V4); err != nil {
+		return nil, fmt.Errorf("value at index 3 failed to marshal: %w", err)
+	}
+	if b, err = appendBinaryValue(b, order, t.V5); err != nil {
+		return nil, fmt.Errorf("value at index 4 failed to marshal: %w", err)
+	}
+	if b, err = appendBinaryValue(b, order, t.V6); err != nil {
+		return nil, fmt.Errorf("value at index 5 failed to marshal: %w", err)
+	}
+
+	return b, nil
+}
+
+// UnmarshalBinary unmarshals the tuple from its binary encoding using little-endian byte order,
+// implementing the encoding.BinaryUnmarshaler interface.
+func (t *T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) UnmarshalBinary(data []byte) error {
+	return t.UnmarshalBinaryOrder(data, binary.LittleEndian)
+}
+
+// UnmarshalBinaryOrder unmarshals the tuple from its binary encoding using the given byte order.
+// The data must hold exactly the binary encoding of the tuple.
+func (t *T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) UnmarshalBinaryOrder(data []byte, order binary.ByteOrder) error {
+	return unmarshalBinary(data, order, t)
+}
+
+// decodeBinary decodes the tuple from the beginning of data using the given byte order, and returns the rest of the data.
+func (t *T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) decodeBinary(data []byte, order binary.ByteOrder) ([]byte, error) {
+	var err error
+	if data, err = decodeBinaryValue(data, order, &t.V1); err != nil {
+		return nil, fmt.Errorf("value at index 0 failed to unmarshal: %w", err)
+	}
+	if data, err = decodeBinaryValue(data, order, &t.V2); err != nil {
+		return nil, fmt.Errorf("value at index 1 failed to unmarshal: %w", err)
+	}
+	if data, err = decodeBinaryValue(data, order, &t.V3); err != nil {
+		return nil, fmt.Errorf("value at index 2 failed to unmarshal: %w", err)
+	}
+	if data, err = decodeBinaryValue(data, order, &t.V4); err != nil {
+		return nil, fmt.Errorf("value at index 3 failed to unmarshal: %w", err)
+	}
+	if data, err = decodeBinaryValue(data, order, &t.V5); err != nil {
+		return nil, fmt.Errorf("value at index 4 failed to unmarshal: %w", err)
+	}
+	if data, err = decodeBinaryValue(data, order, &t.V6); err != nil {
+		return nil, fmt.Errorf("value at index 5 failed to unmarshal: %w", err)
+	}
+
+	return data, nil
+}
+
+// AppendBinarySlice6 appends the binary encoding of a slice of tuples to b using the given byte order.
+// The slice is encoded as its length followed by the binary encoding of each of the tuples.
+func AppendBinarySlice6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](b []byte, order binary.AppendByteOrder, tuples []T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) ([]byte, error) {
+	return appendBinarySlice(b, order, tuples)
+}
+
+// UnmarshalBinarySlice6 unmarshals a slice of tuples from its binary encoding using the given byte order,
+// as encoded by AppendBinarySlice6, and appends the tuples to dst.
+// Tuples are decoded in place, so decoding into a dst with enough capacity, such as a previously decoded slice truncated to zero length,
+// doesn't allocate unless the tuples hold strings, or byte slices longer than the ones previously held in that capacity.
+func UnmarshalBinarySlice6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6 any](data []byte, order binary.ByteOrder, dst []T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]) ([]T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6], error) {
+	return unmarshalBinarySlice(data, order, dst)
+}
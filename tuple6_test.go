@@ -1,7 +1,17 @@
 package tuple
 
 import (
+	"database/sql/driver"
+	"encoding/binary"
 	"encoding/json"
+	"encoding/xml"
+	"hash/maphash"
+	"io"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -35,6 +45,188 @@ func TestT6_Values(t *testing.T) {
 	require.Equal(t, "6", v6)
 }
 
+func TestT6_MapAt(t *testing.T) {
+	tup := New6(1, 2, 3, 4, 5, 6)
+
+	require.Equal(t, New6("1", 2, 3, 4, 5, 6), Map6At1(tup, strconv.Itoa))
+	require.Equal(t, New6(1, "2", 3, 4, 5, 6), Map6At2(tup, strconv.Itoa))
+	require.Equal(t, New6(1, 2, "3", 4, 5, 6), Map6At3(tup, strconv.Itoa))
+	require.Equal(t, New6(1, 2, 3, "4", 5, 6), Map6At4(tup, strconv.Itoa))
+	require.Equal(t, New6(1, 2, 3, 4, "5", 6), Map6At5(tup, strconv.Itoa))
+	require.Equal(t, New6(1, 2, 3, 4, 5, "6"), Map6At6(tup, strconv.Itoa))
+}
+
+func TestT6_Map(t *testing.T) {
+	tup := New6(1, 2, 3, 4, 5, 6)
+	got := Map6(tup, strconv.Itoa, strconv.Itoa, strconv.Itoa, strconv.Itoa, strconv.Itoa, strconv.Itoa)
+	require.Equal(t, New6("1", "2", "3", "4", "5", "6"), got)
+}
+
+func TestT6_Append(t *testing.T) {
+	tup := New6("1", "2", "3", "4", "5", "6")
+	require.Equal(t, New7("1", "2", "3", "4", "5", "6", "7"), Append6(tup, "7"))
+}
+
+func TestT6_Prepend(t *testing.T) {
+	tup := New6("2", "3", "4", "5", "6", "7")
+	require.Equal(t, New7("1", "2", "3", "4", "5", "6", "7"), Prepend6(tup, "1"))
+}
+
+func TestT6_Concat(t *testing.T) {
+	tup := New6("1", "2", "3", "4", "5", "6")
+
+	require.Equal(t,
+		New7("1", "2", "3", "4", "5", "6", "7"),
+		Concat6_1(tup, New1("7")),
+	)
+	require.Equal(t,
+		New8("1", "2", "3", "4", "5", "6", "7", "8"),
+		Concat6_2(tup, New2("7", "8")),
+	)
+	require.Equal(t,
+		New9("1", "2", "3", "4", "5", "6", "7", "8", "9"),
+		Concat6_3(tup, New3("7", "8", "9")),
+	)
+}
+
+func TestT6_Take(t *testing.T) {
+	tup := New6("1", "2", "3", "4", "5", "6")
+
+	require.Equal(t, New1("1"), Take6_1(tup))
+	require.Equal(t, New2("1", "2"), Take6_2(tup))
+	require.Equal(t, New3("1", "2", "3"), Take6_3(tup))
+	require.Equal(t, New4("1", "2", "3", "4"), Take6_4(tup))
+	require.Equal(t, New5("1", "2", "3", "4", "5"), Take6_5(tup))
+}
+
+func TestT6_Drop(t *testing.T) {
+	tup := New6("1", "2", "3", "4", "5", "6")
+
+	require.Equal(t, New5("2", "3", "4", "5", "6"), Drop6_1(tup))
+	require.Equal(t, New4("3", "4", "5", "6"), Drop6_2(tup))
+	require.Equal(t, New3("4", "5", "6"), Drop6_3(tup))
+	require.Equal(t, New2("5", "6"), Drop6_4(tup))
+	require.Equal(t, New1("6"), Drop6_5(tup))
+}
+
+func TestT6_Split(t *testing.T) {
+	tup := New6("1", "2", "3", "4", "5", "6")
+
+	require.Equal(t,
+		New2(New1("1"), New5("2", "3", "4", "5", "6")),
+		Split6_1(tup),
+	)
+	require.Equal(t,
+		New2(New2("1", "2"), New4("3", "4", "5", "6")),
+		Split6_2(tup),
+	)
+	require.Equal(t,
+		New2(New3("1", "2", "3"), New3("4", "5", "6")),
+		Split6_3(tup),
+	)
+	require.Equal(t,
+		New2(New4("1", "2", "3", "4"), New2("5", "6")),
+		Split6_4(tup),
+	)
+	require.Equal(t,
+		New2(New5("1", "2", "3", "4", "5"), New1("6")),
+		Split6_5(tup),
+	)
+}
+
+func TestT6_Reverse(t *testing.T) {
+	tup := New6(1, 2, 3, 4, 5, 6)
+	require.Equal(t, New6(6, 5, 4, 3, 2, 1), Reverse6(tup))
+}
+
+func TestT6_Apply(t *testing.T) {
+	tup := New6("1", "2", "3", "4", "5", "6")
+	got := Apply6(tup, func(v1, v2, v3, v4, v5, v6 string) string {
+		return v1 + v2 + v3 + v4 + v5 + v6
+	})
+	require.Equal(t, "123456", got)
+}
+
+func TestT6_Tupled(t *testing.T) {
+	tup := New6("1", "2", "3", "4", "5", "6")
+	f := Tupled6(func(v1, v2, v3, v4, v5, v6 string) string {
+		return v1 + v2 + v3 + v4 + v5 + v6
+	})
+	require.Equal(t, "123456", f(tup))
+}
+
+func TestT6_Untupled(t *testing.T) {
+	f := Untupled6(func(tup T6[string, string, string, string, string, string]) T6[string, string, string, string, string, string] {
+		return tup
+	})
+	require.Equal(t, New6("1", "2", "3", "4", "5", "6"), f("1", "2", "3", "4", "5", "6"))
+}
+
+func TestT6_Zip(t *testing.T) {
+	want := []T6[int, int, int, int, int, int]{
+		New6(1, 2, 3, 4, 5, 6),
+		New6(2, 3, 4, 5, 6, 7),
+	}
+
+	require.Equal(t, want, Zip6([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}))
+	require.Equal(t, want[:1], Zip6([]int{1}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}))
+	require.Equal(t, want[:1], Zip6([]int{1, 2}, []int{2}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}))
+	require.Equal(t, want[:1], Zip6([]int{1, 2}, []int{2, 3}, []int{3}, []int{4, 5}, []int{5, 6}, []int{6, 7}))
+	require.Equal(t, want[:1], Zip6([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4}, []int{5, 6}, []int{6, 7}))
+	require.Equal(t, want[:1], Zip6([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5}, []int{6, 7}))
+	require.Equal(t, want[:1], Zip6([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6}))
+}
+
+func TestT6_ZipStrict(t *testing.T) {
+	want := []T6[int, int, int, int, int, int]{
+		New6(1, 2, 3, 4, 5, 6),
+		New6(2, 3, 4, 5, 6, 7),
+	}
+
+	got, err := Zip6Strict([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7})
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	_, err = Zip6Strict([]int{1}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7})
+	require.EqualError(t, err, "slice 2 length 2 must match slice 1 length 1")
+	var lengthErr1 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr1)
+	_, err = Zip6Strict([]int{1, 2}, []int{2}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7})
+	require.EqualError(t, err, "slice 2 length 1 must match slice 1 length 2")
+	var lengthErr2 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr2)
+	_, err = Zip6Strict([]int{1, 2}, []int{2, 3}, []int{3}, []int{4, 5}, []int{5, 6}, []int{6, 7})
+	require.EqualError(t, err, "slice 3 length 1 must match slice 1 length 2")
+	var lengthErr3 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr3)
+	_, err = Zip6Strict([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4}, []int{5, 6}, []int{6, 7})
+	require.EqualError(t, err, "slice 4 length 1 must match slice 1 length 2")
+	var lengthErr4 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr4)
+	_, err = Zip6Strict([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5}, []int{6, 7})
+	require.EqualError(t, err, "slice 5 length 1 must match slice 1 length 2")
+	var lengthErr5 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr5)
+	_, err = Zip6Strict([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6})
+	require.EqualError(t, err, "slice 6 length 1 must match slice 1 length 2")
+	var lengthErr6 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr6)
+}
+
+func TestT6_Unzip(t *testing.T) {
+	s1, s2, s3, s4, s5, s6 := Unzip6([]T6[int, int, int, int, int, int]{
+		New6(1, 2, 3, 4, 5, 6),
+		New6(2, 3, 4, 5, 6, 7),
+	})
+
+	require.Equal(t, []int{1, 2}, s1)
+	require.Equal(t, []int{2, 3}, s2)
+	require.Equal(t, []int{3, 4}, s3)
+	require.Equal(t, []int{4, 5}, s4)
+	require.Equal(t, []int{5, 6}, s5)
+	require.Equal(t, []int{6, 7}, s6)
+}
+
 func TestT6_Compare(t *testing.T) {
 	lesser := New6(1, 2, 3, 4, 5, 6)
 	greater := New6(2, 3, 4, 5, 6, 7)
@@ -215,6 +407,162 @@ func TestT6_CompareC(t *testing.T) {
 	}
 }
 
+func TestT6_CmpFunc(t *testing.T) {
+	tups := []T6[int, int, int, int, int, int]{
+		New6(2, 3, 4, 5, 6, 7),
+		New6(1, 2, 3, 4, 5, 6),
+		New6(3, 4, 5, 6, 7, 8),
+	}
+
+	cmp := CmpFunc6[int, int, int, int, int, int]()
+	slices.SortFunc(tups, cmp)
+	require.Equal(t, []T6[int, int, int, int, int, int]{
+		New6(1, 2, 3, 4, 5, 6),
+		New6(2, 3, 4, 5, 6, 7),
+		New6(3, 4, 5, 6, 7, 8),
+	}, tups)
+
+	index, found := slices.BinarySearchFunc(tups, New6(2, 3, 4, 5, 6, 7), cmp)
+	require.True(t, found)
+	require.Equal(t, 1, index)
+}
+
+func TestT6_CmpFuncC(t *testing.T) {
+	tups := []T6[stringComparable, stringComparable, stringComparable, stringComparable, stringComparable, stringComparable]{
+		New6(stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5"), stringComparable("6"), stringComparable("7")),
+		New6(stringComparable("1"), stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5"), stringComparable("6")),
+	}
+
+	slices.SortFunc(tups, CmpFunc6C[stringComparable, stringComparable, stringComparable, stringComparable, stringComparable, stringComparable]())
+	require.Equal(t, []T6[stringComparable, stringComparable, stringComparable, stringComparable, stringComparable, stringComparable]{
+		New6(stringComparable("1"), stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5"), stringComparable("6")),
+		New6(stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5"), stringComparable("6"), stringComparable("7")),
+	}, tups)
+}
+
+func TestT6_Compare_NaN(t *testing.T) {
+	nan := New6(math.NaN(), math.NaN(), math.NaN(), math.NaN(), math.NaN(), math.NaN())
+	num := New6(1.0, 2.0, 3.0, 4.0, 5.0, 6.0)
+
+	require.True(t, Compare6(nan, nan).EQ())
+	require.True(t, Compare6(nan, num).LT())
+	require.True(t, Compare6(num, nan).GT())
+}
+
+func TestT6_Comparator(t *testing.T) {
+	compare := Comparator6(CompareOrdered[int], CompareOrdered[int], CompareOrdered[int], CompareOrdered[int], CompareOrdered[int], CompareOrdered[int])
+	lesser := New6(1, 2, 3, 4, 5, 6)
+
+	require.Equal(t, 0, compare(lesser, lesser))
+
+	greater1 := New6(2, 2, 3, 4, 5, 6)
+	require.Equal(t, -1, compare(lesser, greater1))
+	require.Equal(t, 1, compare(greater1, lesser))
+
+	greater2 := New6(1, 3, 3, 4, 5, 6)
+	require.Equal(t, -1, compare(lesser, greater2))
+	require.Equal(t, 1, compare(greater2, lesser))
+
+	greater3 := New6(1, 2, 4, 4, 5, 6)
+	require.Equal(t, -1, compare(lesser, greater3))
+	require.Equal(t, 1, compare(greater3, lesser))
+
+	greater4 := New6(1, 2, 3, 5, 5, 6)
+	require.Equal(t, -1, compare(lesser, greater4))
+	require.Equal(t, 1, compare(greater4, lesser))
+
+	greater5 := New6(1, 2, 3, 4, 6, 6)
+	require.Equal(t, -1, compare(lesser, greater5))
+	require.Equal(t, 1, compare(greater5, lesser))
+
+	greater6 := New6(1, 2, 3, 4, 5, 7)
+	require.Equal(t, -1, compare(lesser, greater6))
+	require.Equal(t, 1, compare(greater6, lesser))
+}
+
+func TestT6_Comparator_Comparable(t *testing.T) {
+	compare := Comparator6(CompareComparable[stringComparable], CompareComparable[stringComparable], CompareComparable[stringComparable], CompareComparable[stringComparable], CompareComparable[stringComparable], CompareComparable[stringComparable])
+	lesser := New6(stringComparable("1"), stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5"), stringComparable("6"))
+	greater := New6(stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5"), stringComparable("6"), stringComparable("7"))
+
+	require.Equal(t, -1, compare(lesser, greater))
+	require.Equal(t, 1, compare(greater, lesser))
+	require.Equal(t, 0, compare(lesser, lesser))
+}
+
+func TestT6_Comparator_ShortCircuit(t *testing.T) {
+	unreachable := func(a, b int) int {
+		t.Fatal("comparison function should not be called")
+		return 0
+	}
+
+	compare := Comparator6(CompareOrdered[int], unreachable, unreachable, unreachable, unreachable, unreachable)
+	require.Equal(t, -1, compare(New6(1, 2, 3, 4, 5, 6), New6(2, 3, 4, 5, 6, 7)))
+}
+
+func TestT6_OrderBy(t *testing.T) {
+	lesser := New6(1, 2, 3, 4, 5, 6)
+	asc := OrderBy6[int, int, int, int, int, int](Asc, Asc, Asc, Asc, Asc, Asc)
+
+	greater1 := New6(2, 2, 3, 4, 5, 6)
+	desc1 := OrderBy6[int, int, int, int, int, int](Desc, Asc, Asc, Asc, Asc, Asc)
+	require.Equal(t, -1, asc(lesser, greater1))
+	require.Equal(t, 1, desc1(lesser, greater1))
+	require.Equal(t, 0, desc1(lesser, lesser))
+
+	greater2 := New6(1, 3, 3, 4, 5, 6)
+	desc2 := OrderBy6[int, int, int, int, int, int](Asc, Desc, Asc, Asc, Asc, Asc)
+	require.Equal(t, -1, asc(lesser, greater2))
+	require.Equal(t, 1, desc2(lesser, greater2))
+	require.Equal(t, 0, desc2(lesser, lesser))
+
+	greater3 := New6(1, 2, 4, 4, 5, 6)
+	desc3 := OrderBy6[int, int, int, int, int, int](Asc, Asc, Desc, Asc, Asc, Asc)
+	require.Equal(t, -1, asc(lesser, greater3))
+	require.Equal(t, 1, desc3(lesser, greater3))
+	require.Equal(t, 0, desc3(lesser, lesser))
+
+	greater4 := New6(1, 2, 3, 5, 5, 6)
+	desc4 := OrderBy6[int, int, int, int, int, int](Asc, Asc, Asc, Desc, Asc, Asc)
+	require.Equal(t, -1, asc(lesser, greater4))
+	require.Equal(t, 1, desc4(lesser, greater4))
+	require.Equal(t, 0, desc4(lesser, lesser))
+
+	greater5 := New6(1, 2, 3, 4, 6, 6)
+	desc5 := OrderBy6[int, int, int, int, int, int](Asc, Asc, Asc, Asc, Desc, Asc)
+	require.Equal(t, -1, asc(lesser, greater5))
+	require.Equal(t, 1, desc5(lesser, greater5))
+	require.Equal(t, 0, desc5(lesser, lesser))
+
+	greater6 := New6(1, 2, 3, 4, 5, 7)
+	desc6 := OrderBy6[int, int, int, int, int, int](Asc, Asc, Asc, Asc, Asc, Desc)
+	require.Equal(t, -1, asc(lesser, greater6))
+	require.Equal(t, 1, desc6(lesser, greater6))
+	require.Equal(t, 0, desc6(lesser, lesser))
+}
+
+func TestT6_Comparator_OrderedPtrBy(t *testing.T) {
+	compare := Comparator6(
+		OrderedBy[int](Asc),
+		OrderedBy[int](Asc),
+		OrderedBy[int](Asc),
+		OrderedBy[int](Asc),
+		OrderedBy[int](Asc),
+		OrderedPtrBy[int](Desc.NilsFirst()),
+	)
+
+	tups := []T6[int, int, int, int, int, *int]{
+		New6(1, 2, 3, 4, 5, ptr(1)),
+		New6(1, 2, 3, 4, 5, ptr(2)),
+		New6(1, 2, 3, 4, 5, (*int)(nil)),
+	}
+	slices.SortFunc(tups, compare)
+
+	require.Nil(t, tups[0].V6)
+	require.Equal(t, 2, *tups[1].V6)
+	require.Equal(t, 1, *tups[2].V6)
+}
+
 func TestT6_EqualE(t *testing.T) {
 	a := New6(intEqualable(1), intEqualable(2), intEqualable(3), intEqualable(4), intEqualable(5), intEqualable(6))
 	b := New6(intEqualable(2), intEqualable(3), intEqualable(4), intEqualable(5), intEqualable(6), intEqualable(7))
@@ -223,6 +571,40 @@ func TestT6_EqualE(t *testing.T) {
 	require.True(t, Equal6E(a, a))
 }
 
+func TestT6_EqualFunc(t *testing.T) {
+	a := New6([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6})
+	b := New6([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6})
+
+	require.True(t, EqualFunc6(a, b, slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc6(a, New6([]int{2}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc6(a, New6([]int{1}, []int{3}, []int{3}, []int{4}, []int{5}, []int{6}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc6(a, New6([]int{1}, []int{2}, []int{4}, []int{4}, []int{5}, []int{6}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc6(a, New6([]int{1}, []int{2}, []int{3}, []int{5}, []int{5}, []int{6}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc6(a, New6([]int{1}, []int{2}, []int{3}, []int{4}, []int{6}, []int{6}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc6(a, New6([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{7}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+}
+
+func TestT6_EqualMethod(t *testing.T) {
+	a := New6([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6})
+
+	require.True(t, a.Equal(New6([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6})))
+	require.False(t, a.Equal(New6([]int{2}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6})))
+	require.False(t, a.Equal(New6([]int{1}, []int{3}, []int{3}, []int{4}, []int{5}, []int{6})))
+	require.False(t, a.Equal(New6([]int{1}, []int{2}, []int{4}, []int{4}, []int{5}, []int{6})))
+	require.False(t, a.Equal(New6([]int{1}, []int{2}, []int{3}, []int{5}, []int{5}, []int{6})))
+	require.False(t, a.Equal(New6([]int{1}, []int{2}, []int{3}, []int{4}, []int{6}, []int{6})))
+	require.False(t, a.Equal(New6([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{7})))
+}
+
+func TestT6_EqualMethod_Nested(t *testing.T) {
+	a := New6(New1(intEqualable(1)), New1(intEqualable(2)), New1(intEqualable(3)), New1(intEqualable(4)), New1(intEqualable(5)), New1(intEqualable(6)))
+	b := New6(New1(intEqualable(2)), New1(intEqualable(3)), New1(intEqualable(4)), New1(intEqualable(5)), New1(intEqualable(6)), New1(intEqualable(7)))
+
+	require.True(t, a.Equal(a))
+	require.False(t, a.Equal(b))
+	require.True(t, Equal6E(a, a))
+}
+
 func TestT6_String(t *testing.T) {
 	tup := New6("1", "2", "3", "4", "5", "6")
 	require.Equal(t, `["1" "2" "3" "4" "5" "6"]`, tup.String())
@@ -233,6 +615,31 @@ func TestT6_GoString(t *testing.T) {
 	require.Equal(t, `tuple.T6[string, string, string, string, string, string]{V1: "1", V2: "2", V3: "3", V4: "4", V5: "5", V6: "6"}`, tup.GoString())
 }
 
+func TestT6_Parse(t *testing.T) {
+	tup := New6("1", "2", "3", "4", "5", "6")
+
+	got, err := Parse6[string, string, string, string, string, string](tup.String())
+	require.NoError(t, err)
+	require.Equal(t, tup, got)
+}
+
+func TestT6_Parse_Mixed(t *testing.T) {
+	tup := New6(New2("1", 1.5), New2("2", 2.5), New2("3", 3.5), New2("4", 4.5), New2("5", 5.5), New2("6", 6.5))
+
+	got, err := Parse6[T2[string, float64], T2[string, float64], T2[string, float64], T2[string, float64], T2[string, float64], T2[string, float64]](tup.String())
+	require.NoError(t, err)
+	require.Equal(t, tup, got)
+}
+
+func TestT6_Parse_Invalid(t *testing.T) {
+	_, err := Parse6[int, int, int, int, int, int](`["1" "2" "3" "4" "5" "6"]`)
+	require.Error(t, err)
+
+	var parseErr *ParseError
+	require.ErrorAs(t, err, &parseErr)
+	require.Equal(t, 1, parseErr.Offset)
+}
+
 func TestT6_ToArray(t *testing.T) {
 	tup := New6("1", "2", "3", "4", "5", "6")
 	require.Equal(t, [6]any{
@@ -555,94 +962,319 @@ func TestT6_FromSlice(t *testing.T) {
 	}
 }
 
-func TestT6_MarshalJSON(t *testing.T) {
-	tup := New6("1", "2", "3", "4", "5", "6")
+func TestT6_FromSlice_Errors(t *testing.T) {
+	_, err := FromSlice6[string, string, string, string, string, string]([]any{})
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, &LengthMismatchError{Source: "slice", Expected: 6, Actual: 0}, lengthErr)
+	require.EqualError(t, err, "slice length 0 must match number of tuple values 6")
+
+	_, err = FromSlice6[string, string, string, string, string, string]([]any{1, "2", "3", "4", "5", "6"})
+	var typeErr1 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr1)
+	require.Equal(t, 0, typeErr1.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr1.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr1.Actual)
+	require.EqualError(t, err, "value at slice index 0 expected to have type string but has type int")
+
+	_, err = FromSlice6[string, string, string, string, string, string]([]any{"1", 2, "3", "4", "5", "6"})
+	var typeErr2 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr2)
+	require.Equal(t, 1, typeErr2.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr2.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr2.Actual)
+	require.EqualError(t, err, "value at slice index 1 expected to have type string but has type int")
+
+	_, err = FromSlice6[string, string, string, string, string, string]([]any{"1", "2", 3, "4", "5", "6"})
+	var typeErr3 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr3)
+	require.Equal(t, 2, typeErr3.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr3.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr3.Actual)
+	require.EqualError(t, err, "value at slice index 2 expected to have type string but has type int")
+
+	_, err = FromSlice6[string, string, string, string, string, string]([]any{"1", "2", "3", 4, "5", "6"})
+	var typeErr4 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr4)
+	require.Equal(t, 3, typeErr4.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr4.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr4.Actual)
+	require.EqualError(t, err, "value at slice index 3 expected to have type string but has type int")
+
+	_, err = FromSlice6[string, string, string, string, string, string]([]any{"1", "2", "3", "4", 5, "6"})
+	var typeErr5 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr5)
+	require.Equal(t, 4, typeErr5.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr5.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr5.Actual)
+	require.EqualError(t, err, "value at slice index 4 expected to have type string but has type int")
+
+	_, err = FromSlice6[string, string, string, string, string, string]([]any{"1", "2", "3", "4", "5", 6})
+	var typeErr6 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr6)
+	require.Equal(t, 5, typeErr6.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr6.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr6.Actual)
+	require.EqualError(t, err, "value at slice index 5 expected to have type string but has type int")
+
+	_, err = FromArray6[string, string, string, string, string, string]([6]any{})
+	var arrayTypeErr *ElementTypeError
+	require.ErrorAs(t, err, &arrayTypeErr)
+	require.Equal(t, &ElementTypeError{Source: "array", Index: 0, Expected: reflect.TypeOf("")}, arrayTypeErr)
+	require.EqualError(t, err, "value at array index 0 expected to have type string but has type <nil>")
+}
 
-	got, err := json.Marshal(tup)
-	require.NoError(t, err)
-	require.Equal(t, got, []byte(`["1","2","3","4","5","6"]`))
+func TestT6_UnmarshalJSON_Errors(t *testing.T) {
+	var tup T6[string, string, string, string, string, string]
+
+	err := json.Unmarshal([]byte(`[]`), &tup)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, 6, lengthErr.Expected)
+	require.Equal(t, 0, lengthErr.Actual)
+	require.EqualError(t, err, "unmarshalled json array length 0 must match number of tuple values 6")
+
+	err = tup.UnmarshalJSON([]byte(`["1","2","3","4","5",6]`))
+	var elementErr *ElementUnmarshalError
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, 5, elementErr.Index)
+	require.Equal(t, "6", elementErr.Value)
+	var typeErr *json.UnmarshalTypeError
+	require.ErrorAs(t, err, &typeErr)
+	require.ErrorContains(t, err, `value "6" at slice index 5 failed to unmarshal: `)
 }
 
-func TestT6_UnmarshalJSON(t *testing.T) {
+func TestT6_ConvertSlice(t *testing.T) {
 	tests := []struct {
 		name    string
-		data    []byte
-		want    T6[string, string, string, string, string, string]
+		slice   []any
+		want    T6[int, int, int, int, int, int]
 		wantErr bool
 	}{
 		{
-			name:    "nil data",
-			data:    nil,
+			name:  "all types match",
+			slice: []any{1, 2, 3, 4, 5, 6},
+			want:  New6(1, 2, 3, 4, 5, 6),
+		},
+		{
+			name:  "decoded json numbers",
+			slice: []any{float64(1), float64(2), float64(3), float64(4), float64(5), float64(6)},
+			want:  New6(1, 2, 3, 4, 5, 6),
+		},
+		{
+			name:  "json numbers",
+			slice: []any{json.Number("1"), json.Number("2"), json.Number("3"), json.Number("4"), json.Number("5"), json.Number("6")},
+			want:  New6(1, 2, 3, 4, 5, 6),
+		},
+		{
+			name:    "slice empty",
+			slice:   []any{},
 			wantErr: true,
 		},
 		{
-			name:    "empty data",
-			data:    []byte{},
+			name:    "index 1 bad type",
+			slice:   []any{"1", 2, 3, 4, 5, 6},
 			wantErr: true,
 		},
 		{
-			name:    "string data",
-			data:    []byte(`"hi"`),
+			name:    "index 1 fractional number",
+			slice:   []any{1.5, 2, 3, 4, 5, 6},
 			wantErr: true,
 		},
 		{
-			name:    "empty json array",
-			data:    []byte(`[]`),
+			name:    "index 2 bad type",
+			slice:   []any{1, "2", 3, 4, 5, 6},
 			wantErr: true,
 		},
 		{
-			name:    "longer json array",
-			data:    []byte(`["1", "2", "3", "4", "5", "6", "7", "8", "9", "10"]`),
+			name:    "index 2 fractional number",
+			slice:   []any{1, 2.5, 3, 4, 5, 6},
 			wantErr: true,
 		},
-
 		{
-			name:    "json array with invalid type at index 0",
-			data:    []byte(`[1,"2","3","4","5","6"]`),
+			name:    "index 3 bad type",
+			slice:   []any{1, 2, "3", 4, 5, 6},
 			wantErr: true,
 		},
 		{
-			name:    "json array with invalid type at index 1",
-			data:    []byte(`["1",2,"3","4","5","6"]`),
+			name:    "index 3 fractional number",
+			slice:   []any{1, 2, 3.5, 4, 5, 6},
 			wantErr: true,
 		},
 		{
-			name:    "json array with invalid type at index 2",
-			data:    []byte(`["1","2",3,"4","5","6"]`),
+			name:    "index 4 bad type",
+			slice:   []any{1, 2, 3, "4", 5, 6},
 			wantErr: true,
 		},
 		{
-			name:    "json array with invalid type at index 3",
-			data:    []byte(`["1","2","3",4,"5","6"]`),
+			name:    "index 4 fractional number",
+			slice:   []any{1, 2, 3, 4.5, 5, 6},
 			wantErr: true,
 		},
 		{
-			name:    "json array with invalid type at index 4",
-			data:    []byte(`["1","2","3","4",5,"6"]`),
+			name:    "index 5 bad type",
+			slice:   []any{1, 2, 3, 4, "5", 6},
 			wantErr: true,
 		},
 		{
-			name:    "json array with invalid type at index 5",
-			data:    []byte(`["1","2","3","4","5",6]`),
+			name:    "index 5 fractional number",
+			slice:   []any{1, 2, 3, 4, 5.5, 6},
 			wantErr: true,
 		},
 		{
-			name:    "json array of valid types",
-			data:    []byte(`["1","2","3","4","5","6"]`),
-			want:    New6("1", "2", "3", "4", "5", "6"),
-			wantErr: false,
+			name:    "index 6 bad type",
+			slice:   []any{1, 2, 3, 4, 5, "6"},
+			wantErr: true,
 		},
 		{
-			name:    "json object of valid types",
-			data:    []byte(`{"V1": "1","V2": "2","V3": "3","V4": "4","V5": "5","V6": "6"}`),
+			name:    "index 6 fractional number",
+			slice:   []any{1, 2, 3, 4, 5, 6.5},
 			wantErr: true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			var got T6[string, string, string, string, string, string]
-			err := json.Unmarshal(tt.data, &got)
+			tup, err := ConvertSlice6[int, int, int, int, int, int](tt.slice)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, tup)
+		})
+	}
+}
+
+func TestT6_ConvertSlice_Errors(t *testing.T) {
+	_, err := ConvertSlice6[int8, int8, int8, int8, int8, int8]([]any{})
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+
+	_, err = ConvertSlice6[int8, int8, int8, int8, int8, int8]([]any{1, 2, 3, 4, 5, true})
+	var typeErr *ElementTypeError
+	require.ErrorAs(t, err, &typeErr)
+	require.Equal(t, 5, typeErr.Index)
+
+	_, err = ConvertSlice6[int8, int8, int8, int8, int8, int8]([]any{1, 2, 3, 4, 5, float64(128)})
+	var conversionErr *ElementConversionError
+	require.ErrorAs(t, err, &conversionErr)
+	require.Equal(t, 5, conversionErr.Index)
+	require.Equal(t, reflect.TypeOf(int8(0)), conversionErr.Expected)
+}
+
+func TestT6_ScanRow(t *testing.T) {
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{"c1", "c2", "c3", "c4", "c5", "c6"},
+		rows: [][]driver.Value{
+			{int64(1), int64(2), int64(3), int64(4), int64(5), int64(6)},
+		},
+	})
+
+	require.True(t, rows.Next())
+	got, err := ScanRow6[string, int, int, int, int, int](rows)
+	require.NoError(t, err)
+	require.Equal(t, New6("1", 2, 3, 4, 5, 6), got)
+}
+
+func TestT6_ScanRow_ColumnsMismatch(t *testing.T) {
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{"c1", "c2", "c3", "c4", "c5", "c6", "extra"},
+		rows: [][]driver.Value{
+			{int64(1), int64(2), int64(3), int64(4), int64(5), int64(6), int64(0)},
+		},
+	})
+
+	require.True(t, rows.Next())
+	_, err := ScanRow6[int, int, int, int, int, int](rows)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.EqualError(t, err, "row columns count 7 must match number of tuple values 6")
+}
+
+func TestT6_CollectRows(t *testing.T) {
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{"c1", "c2", "c3", "c4", "c5", "c6"},
+		rows: [][]driver.Value{
+			{int64(1), int64(2), int64(3), int64(4), int64(5), int64(6)},
+			{int64(2), int64(3), int64(4), int64(5), int64(6), int64(7)},
+		},
+	})
+
+	got, err := CollectRows6[int, int, int, int, int, int](rows)
+	require.NoError(t, err)
+	require.Equal(t, []T6[int, int, int, int, int, int]{
+		New6(1, 2, 3, 4, 5, 6),
+		New6(2, 3, 4, 5, 6, 7),
+	}, got)
+
+	rows = fakeQuery(t, fakeResult{columns: []string{"c1", "c2", "c3", "c4", "c5", "c6"}})
+	got, err = CollectRows6[int, int, int, int, int, int](rows)
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
+
+func TestT6_ReadCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		opts    CSVOptions
+		want    []T6[int, int, int, int, int, int]
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			data: "",
+			want: nil,
+		},
+		{
+			name: "records",
+			data: "1,2,3,4,5,6\n2,3,4,5,6,7\n",
+			want: []T6[int, int, int, int, int, int]{
+				New6(1, 2, 3, 4, 5, 6),
+				New6(2, 3, 4, 5, 6, 7),
+			},
+		},
+		{
+			name: "header",
+			data: "v1,v2,v3,v4,v5,v6\n1,2,3,4,5,6\n",
+			opts: CSVOptions{Header: []string{"v1", "v2", "v3", "v4", "v5", "v6"}},
+			want: []T6[int, int, int, int, int, int]{New6(1, 2, 3, 4, 5, 6)},
+		},
+		{
+			name: "skip header",
+			data: "c1,c2,c3,c4,c5,c6\n1,2,3,4,5,6\n",
+			opts: CSVOptions{SkipHeader: true},
+			want: []T6[int, int, int, int, int, int]{New6(1, 2, 3, 4, 5, 6)},
+		},
+		{
+			name:    "mismatching header",
+			data:    "c1,c2,c3,c4,c5,c6\n1,2,3,4,5,6\n",
+			opts:    CSVOptions{Header: []string{"v1", "v2", "v3", "v4", "v5", "v6"}},
+			wantErr: true,
+		},
+		{
+			name: "semicolon delimiter",
+			data: "1;2;3;4;5;6\n",
+			opts: CSVOptions{Comma: ';'},
+			want: []T6[int, int, int, int, int, int]{New6(1, 2, 3, 4, 5, 6)},
+		},
+		{
+			name:    "too many fields",
+			data:    "1,2,3,4,5,6,0\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid field",
+			data:    "1,2,3,4,5,x\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadCSV6[int, int, int, int, int, int](strings.NewReader(tt.data), tt.opts)
 			if tt.wantErr {
 				require.Error(t, err)
 				return
@@ -654,36 +1286,849 @@ func TestT6_UnmarshalJSON(t *testing.T) {
 	}
 }
 
-func TestT6_Unmarshal_CustomStruct(t *testing.T) {
-	type Custom struct {
-		Name string `json:"name"`
-		Age  int    `json:"age"`
+func TestT6_ReadCSV_Error(t *testing.T) {
+	data := "1,2,3,4,5,6\n1,2,3,4,5,x\n"
+
+	_, err := ReadCSV6[int, int, int, int, int, int](strings.NewReader(data), CSVOptions{})
+	var csvErr *CSVError
+	require.ErrorAs(t, err, &csvErr)
+	require.Equal(t, 2, csvErr.Line)
+	require.Equal(t, 6, csvErr.Column)
+
+	var elementErr *ElementUnmarshalError
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, "x", elementErr.Value)
+}
+
+func TestT6_WriteCSV(t *testing.T) {
+	tups := []T6[string, string, string, string, string, string]{
+		New6("1", "2", "3", "4", "5", "6"),
+		New6("1,\"1\"", "2,\"2\"", "3,\"3\"", "4,\"4\"", "5,\"5\"", "6,\"6\""),
 	}
 
-	want := New6(Custom{Name: "1", Age: 1}, Custom{Name: "2", Age: 2}, Custom{Name: "3", Age: 3}, Custom{Name: "4", Age: 4}, Custom{Name: "5", Age: 5}, Custom{Name: "6", Age: 6})
-	var got T6[Custom, Custom, Custom, Custom, Custom, Custom]
-	err := json.Unmarshal([]byte(`[
-		{ "name": "1", "age": 1 },
-		{ "name": "2", "age": 2 },
-		{ "name": "3", "age": 3 },
-		{ "name": "4", "age": 4 },
-		{ "name": "5", "age": 5 },
-		{ "name": "6", "age": 6 }
-	]`), &got)
+	var buf strings.Builder
+	err := WriteCSV6(&buf, tups, CSVOptions{Header: []string{"v1", "v2", "v3", "v4", "v5", "v6"}})
+	require.NoError(t, err)
+	require.Equal(t, "v1,v2,v3,v4,v5,v6\n1,2,3,4,5,6\n\"1,\"\"1\"\"\",\"2,\"\"2\"\"\",\"3,\"\"3\"\"\",\"4,\"\"4\"\"\",\"5,\"\"5\"\"\",\"6,\"\"6\"\"\"\n", buf.String())
 
+	got, err := ReadCSV6[string, string, string, string, string, string](strings.NewReader(buf.String()), CSVOptions{SkipHeader: true})
 	require.NoError(t, err)
-	require.Equal(t, want, got)
+	require.Equal(t, tups, got)
 }
 
-func TestT6_Marshal_Unmarshal(t *testing.T) {
+func TestT6_WriteCSV_Error(t *testing.T) {
+	tups := []T6[string, string, string, string, string, []int]{
+		{V1: "a\nb", V2: "a\nb", V3: "a\nb", V4: "a\nb", V5: "a\nb", V6: []int{6}},
+	}
+
+	var buf strings.Builder
+	err := WriteCSV6(&buf, tups, CSVOptions{Header: []string{"v1", "v2", "v3", "v4", "v5", "v6"}})
+	var csvErr *CSVError
+	require.ErrorAs(t, err, &csvErr)
+	require.Equal(t, 2, csvErr.Line)
+	require.Equal(t, 6, csvErr.Column)
+
+	err = WriteCSV6(&buf, tups, CSVOptions{Header: []string{"v1", "v2", "v3", "v4", "v5", "v6", "extra"}})
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+}
+
+func TestT6_Hash(t *testing.T) {
+	seed := maphash.MakeSeed()
 	tup := New6("1", "2", "3", "4", "5", "6")
 
-	marshalled, err := json.Marshal(tup)
-	require.NoError(t, err)
+	require.Equal(t, tup.Hash(seed), New6("1", "2", "3", "4", "5", "6").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New6("2", "2", "3", "4", "5", "6").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New6("1", "3", "3", "4", "5", "6").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New6("1", "2", "4", "4", "5", "6").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New6("1", "2", "3", "5", "5", "6").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New6("1", "2", "3", "4", "6", "6").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New6("1", "2", "3", "4", "5", "7").Hash(seed))
+}
 
-	var unmarshalled T6[string, string, string, string, string, string]
-	err = json.Unmarshal(marshalled, &unmarshalled)
+func TestT6_Hash_Nested(t *testing.T) {
+	seed := maphash.MakeSeed()
+	tup := New6(New2("1", 1), New2("2", 2), New2("3", 3), New2("4", 4), New2("5", 5), New2("6", 6))
 
-	require.NoError(t, err)
-	require.Equal(t, tup, unmarshalled)
+	require.Equal(t, tup.Hash(seed), New6(New2("1", 1), New2("2", 2), New2("3", 3), New2("4", 4), New2("5", 5), New2("6", 6)).Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New6(New2("1", 2), New2("2", 3), New2("3", 4), New2("4", 5), New2("5", 6), New2("6", 7)).Hash(seed))
+}
+
+func TestT6_Hash_Unsupported(t *testing.T) {
+	seed := maphash.MakeSeed()
+	tup := New6([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6})
+	require.NotPanics(t, func() {
+		tup.Hash(seed)
+	})
+	require.Equal(t, tup.Hash(seed), New6([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}).Hash(seed))
+
+	var nilTup T6[any, any, any, any, any, any]
+	require.NotPanics(t, func() {
+		nilTup.Hash(seed)
+	})
+	require.Panics(t, func() {
+		HashValue(seed, tup.V1)
+	})
+}
+
+func TestT6_HashFunc(t *testing.T) {
+	seed := maphash.MakeSeed()
+	hashSlice := func(seed maphash.Seed, v []int) uint64 {
+		return HashValue(seed, len(v))
+	}
+
+	hash := HashFunc6(hashSlice, hashSlice, hashSlice, hashSlice, hashSlice, hashSlice)
+	tup := New6([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6})
+
+	require.Equal(t, hash(seed, tup), hash(seed, New6([]int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7})))
+	require.NotEqual(t, hash(seed, tup), hash(seed, New6([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7})))
+}
+
+func TestT6_MarshalJSON(t *testing.T) {
+	tup := New6("1", "2", "3", "4", "5", "6")
+
+	got, err := json.Marshal(tup)
+	require.NoError(t, err)
+	require.Equal(t, got, []byte(`["1","2","3","4","5","6"]`))
+}
+
+func TestT6_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		want    T6[string, string, string, string, string, string]
+		wantErr bool
+	}{
+		{
+			name:    "nil data",
+			data:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "empty data",
+			data:    []byte{},
+			wantErr: true,
+		},
+		{
+			name:    "string data",
+			data:    []byte(`"hi"`),
+			wantErr: true,
+		},
+		{
+			name:    "empty json array",
+			data:    []byte(`[]`),
+			wantErr: true,
+		},
+		{
+			name:    "longer json array",
+			data:    []byte(`["1", "2", "3", "4", "5", "6", "7", "8", "9", "10"]`),
+			wantErr: true,
+		},
+
+		{
+			name:    "json array with invalid type at index 0",
+			data:    []byte(`[1,"2","3","4","5","6"]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array with invalid type at index 1",
+			data:    []byte(`["1",2,"3","4","5","6"]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array with invalid type at index 2",
+			data:    []byte(`["1","2",3,"4","5","6"]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array with invalid type at index 3",
+			data:    []byte(`["1","2","3",4,"5","6"]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array with invalid type at index 4",
+			data:    []byte(`["1","2","3","4",5,"6"]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array with invalid type at index 5",
+			data:    []byte(`["1","2","3","4","5",6]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array of valid types",
+			data:    []byte(`["1","2","3","4","5","6"]`),
+			want:    New6("1", "2", "3", "4", "5", "6"),
+			wantErr: false,
+		},
+		{
+			name:    "json object of valid types",
+			data:    []byte(`{"V1": "1","V2": "2","V3": "3","V4": "4","V5": "5","V6": "6"}`),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T6[string, string, string, string, string, string]
+			err := json.Unmarshal(tt.data, &got)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT6_Unmarshal_CustomStruct(t *testing.T) {
+	type Custom struct {
+		Name string `json:"name"`
+		Age  int    `json:"age"`
+	}
+
+	want := New6(Custom{Name: "1", Age: 1}, Custom{Name: "2", Age: 2}, Custom{Name: "3", Age: 3}, Custom{Name: "4", Age: 4}, Custom{Name: "5", Age: 5}, Custom{Name: "6", Age: 6})
+	var got T6[Custom, Custom, Custom, Custom, Custom, Custom]
+	err := json.Unmarshal([]byte(`[
+		{ "name": "1", "age": 1 },
+		{ "name": "2", "age": 2 },
+		{ "name": "3", "age": 3 },
+		{ "name": "4", "age": 4 },
+		{ "name": "5", "age": 5 },
+		{ "name": "6", "age": 6 }
+	]`), &got)
+
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+func TestT6_Marshal_Unmarshal(t *testing.T) {
+	tup := New6("1", "2", "3", "4", "5", "6")
+
+	marshalled, err := json.Marshal(tup)
+	require.NoError(t, err)
+
+	var unmarshalled T6[string, string, string, string, string, string]
+	err = json.Unmarshal(marshalled, &unmarshalled)
+
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT6_UnmarshalJSONWith(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		opts    JSONDecodeOptions
+		want    T6[string, string, string, string, string, string]
+		wantErr bool
+	}{
+		{
+			name:    "empty json array",
+			data:    `[]`,
+			wantErr: true,
+		},
+		{
+			name: "allow shorter empty json array",
+			data: `[]`,
+			opts: JSONDecodeOptions{AllowShorter: true},
+			want: T6[string, string, string, string, string, string]{},
+		},
+		{
+			name: "allow shorter partial json array",
+			data: `["1"]`,
+			opts: JSONDecodeOptions{AllowShorter: true},
+			want: T6[string, string, string, string, string, string]{V1: "1"},
+		},
+		{
+			name:    "allow shorter longer json array",
+			data:    `["1","2","3","4","5","6","10"]`,
+			opts:    JSONDecodeOptions{AllowShorter: true},
+			wantErr: true,
+		},
+		{
+			name: "json array with null values",
+			data: `[null,null,null,null,null,null]`,
+			want: T6[string, string, string, string, string, string]{},
+		},
+		{
+			name:    "disallow null json array with null values",
+			data:    `[null,null,null,null,null,null]`,
+			opts:    JSONDecodeOptions{DisallowNull: true},
+			wantErr: true,
+		},
+		{
+			name: "disallow null json array of valid types",
+			data: `["1","2","3","4","5","6"]`,
+			opts: JSONDecodeOptions{DisallowNull: true},
+			want: New6("1", "2", "3", "4", "5", "6"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T6[string, string, string, string, string, string]
+			err := got.UnmarshalJSONWith([]byte(tt.data), tt.opts)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT6_UnmarshalJSONWith_Nested(t *testing.T) {
+	type Custom struct {
+		Name string `json:"name"`
+	}
+
+	data := []byte(`[[1, {"name": "1", "age": 1}],[2, {"name": "2", "age": 2}],[3, {"name": "3", "age": 3}],[4, {"name": "4", "age": 4}],[5, {"name": "5", "age": 5}],[6, {"name": "6", "age": 6}]]`)
+
+	var got T6[T2[any, Custom], T2[any, Custom], T2[any, Custom], T2[any, Custom], T2[any, Custom], T2[any, Custom]]
+	err := got.UnmarshalJSONWith(data, JSONDecodeOptions{UseNumber: true})
+	require.NoError(t, err)
+	require.Equal(t, New6(New2[any](json.Number("1"), Custom{Name: "1"}), New2[any](json.Number("2"), Custom{Name: "2"}), New2[any](json.Number("3"), Custom{Name: "3"}), New2[any](json.Number("4"), Custom{Name: "4"}), New2[any](json.Number("5"), Custom{Name: "5"}), New2[any](json.Number("6"), Custom{Name: "6"})), got)
+
+	err = got.UnmarshalJSONWith(data, JSONDecodeOptions{DisallowUnknownFields: true})
+	require.Error(t, err)
+}
+
+func TestT6_MarshalJSONLabeled(t *testing.T) {
+	tup := New6(1, 2, 3, 4, 5, 6)
+	labels := WithLabels("v1", "v2", "v3", "v4", "v5", "v6")
+
+	got, err := tup.MarshalJSONLabeled(labels)
+	require.NoError(t, err)
+	require.Equal(t, `{"v1":1,"v2":2,"v3":3,"v4":4,"v5":5,"v6":6}`, string(got))
+
+	_, err = tup.MarshalJSONLabeled(WithLabels("v1", "v2", "v3", "v4", "v5", "v6", "extra"))
+	require.Error(t, err)
+}
+
+func TestT6_UnmarshalJSONLabeled(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		labels  Labels
+		want    T6[int, int, int, int, int, int]
+		wantErr bool
+	}{
+		{
+			name:   "all keys",
+			data:   `{"v1":1,"v2":2,"v3":3,"v4":4,"v5":5,"v6":6}`,
+			labels: WithLabels("v1", "v2", "v3", "v4", "v5", "v6"),
+			want:   New6(1, 2, 3, 4, 5, 6),
+		},
+		{
+			name:   "missing keys",
+			data:   `{}`,
+			labels: WithLabels("v1", "v2", "v3", "v4", "v5", "v6"),
+			want:   T6[int, int, int, int, int, int]{},
+		},
+		{
+			name:    "strict missing keys",
+			data:    `{}`,
+			labels:  WithLabels("v1", "v2", "v3", "v4", "v5", "v6").Strict(),
+			wantErr: true,
+		},
+		{
+			name:   "unknown keys",
+			data:   `{"v1":1,"v2":2,"v3":3,"v4":4,"v5":5,"v6":6,"extra":0}`,
+			labels: WithLabels("v1", "v2", "v3", "v4", "v5", "v6"),
+			want:   New6(1, 2, 3, 4, 5, 6),
+		},
+		{
+			name:    "strict unknown keys",
+			data:    `{"v1":1,"v2":2,"v3":3,"v4":4,"v5":5,"v6":6,"extra":0}`,
+			labels:  WithLabels("v1", "v2", "v3", "v4", "v5", "v6").Strict(),
+			wantErr: true,
+		},
+		{
+			name:    "json array",
+			data:    `[1,2,3,4,5,6]`,
+			labels:  WithLabels("v1", "v2", "v3", "v4", "v5", "v6"),
+			wantErr: true,
+		},
+		{
+			name:    "labels count mismatch",
+			data:    `{}`,
+			labels:  WithLabels("v1", "v2", "v3", "v4", "v5", "v6", "extra"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T6[int, int, int, int, int, int]
+			err := got.UnmarshalJSONLabeled([]byte(tt.data), tt.labels)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT6_MarshalText(t *testing.T) {
+	tup := New6("1", "2", "3", "4", "5", "6")
+
+	got, err := tup.MarshalText()
+	require.NoError(t, err)
+	require.Equal(t, `1,2,3,4,5,6`, string(got))
+}
+
+func TestT6_MarshalText_Unsupported(t *testing.T) {
+	tup := New6([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6})
+
+	_, err := tup.MarshalText()
+	require.Error(t, err)
+}
+
+func TestT6_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    T6[string, string, string, string, string, string]
+		wantErr bool
+	}{
+		{
+			name: "valid values",
+			data: `1,2,3,4,5,6`,
+			want: New6("1", "2", "3", "4", "5", "6"),
+		},
+		{
+			name: "escaped values",
+			data: `a\,1\\,a\,2\\,a\,3\\,a\,4\\,a\,5\\,a\,6\\`,
+			want: New6(`a,1\`, `a,2\`, `a,3\`, `a,4\`, `a,5\`, `a,6\`),
+		},
+		{
+			name:    "too few values",
+			data:    `2,3,4,5,6`,
+			wantErr: true,
+		},
+		{
+			name:    "too many values",
+			data:    `1,2,3,4,5,6,extra`,
+			wantErr: true,
+		},
+		{
+			name:    "dangling escape",
+			data:    `1,2,3,4,5,6\`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T6[string, string, string, string, string, string]
+			err := got.UnmarshalText([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT6_MarshalText_UnmarshalText(t *testing.T) {
+	tup := New6(New2("a,1\\\\", 1), New2("a,2\\\\", 2), New2("a,3\\\\", 3), New2("a,4\\\\", 4), New2("a,5\\\\", 5), New2("a,6\\\\", 6))
+
+	marshalled, err := tup.MarshalText()
+	require.NoError(t, err)
+
+	var unmarshalled T6[T2[string, int], T2[string, int], T2[string, int], T2[string, int], T2[string, int], T2[string, int]]
+	err = unmarshalled.UnmarshalText(marshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT6_GobEncode_GobDecode(t *testing.T) {
+	t.Run("strings", func(t *testing.T) {
+		requireGobRoundTrip(t, New6("1", "2", "3", "4", "5", "6"))
+	})
+
+	t.Run("zero values", func(t *testing.T) {
+		requireGobRoundTrip(t, T6[int, int, int, int, int, int]{})
+	})
+
+	t.Run("nested tuples", func(t *testing.T) {
+		requireGobRoundTrip(t, New6(New2("1", 1), New2("2", 2), New2("3", 3), New2("4", 4), New2("5", 5), New2("6", 6)))
+	})
+
+	t.Run("pointers", func(t *testing.T) {
+		requireGobRoundTrip(t, New6(ptr(1), ptr(2), ptr(3), ptr(4), ptr(5), ptr(6)))
+	})
+
+	t.Run("pointers to zero values", func(t *testing.T) {
+		requireGobRoundTrip(t, New6(ptr(0), ptr(0), ptr(0), ptr(0), ptr(0), ptr(0)))
+	})
+
+	t.Run("nil pointers", func(t *testing.T) {
+		requireGobRoundTrip(t, T6[*int, *int, *int, *int, *int, *int]{})
+	})
+
+	t.Run("nested tuple pointers", func(t *testing.T) {
+		requireGobRoundTrip(t, New6((*T2[string, *int])(nil), &T2[string, *int]{V1: "2", V2: ptr(2)}, &T2[string, *int]{V1: "3", V2: ptr(3)}, &T2[string, *int]{V1: "4", V2: ptr(4)}, &T2[string, *int]{V1: "5", V2: ptr(5)}, &T2[string, *int]{V1: "6", V2: ptr(6)}))
+	})
+
+	t.Run("interface values", func(t *testing.T) {
+		requireGobRoundTrip(t, T6[any, any, any, any, any, any]{V1: nil, V2: "2", V3: 3, V4: 4, V5: 5, V6: 6})
+	})
+
+	t.Run("struct field", func(t *testing.T) {
+		type Custom struct {
+			Tup T6[int, int, int, int, int, int]
+		}
+
+		requireGobRoundTrip(t, Custom{Tup: New6(1, 2, 3, 4, 5, 6)})
+	})
+}
+
+func TestT6_GobDecode_Invalid(t *testing.T) {
+	encoded, err := New1("1").GobEncode()
+	require.NoError(t, err)
+
+	var tup T6[string, string, string, string, string, string]
+	err = tup.GobDecode(encoded)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, 6, lengthErr.Expected)
+
+	encoded, err = New6("1", "2", "3", "4", "5", "6").GobEncode()
+	require.NoError(t, err)
+
+	var mismatched T6[int, int, int, int, int, int]
+	require.Error(t, mismatched.GobDecode(encoded))
+	require.Error(t, tup.GobDecode(nil))
+}
+
+func TestT6_MarshalComposite(t *testing.T) {
+	got, err := New6("foo bar", 2, 3, 4, 5, 6).MarshalComposite()
+	require.NoError(t, err)
+	require.Equal(t, `("foo bar",2,3,4,5,6)`, string(got))
+
+	got, err = T6[*int, *int, *int, *int, *int, *int]{}.MarshalComposite()
+	require.NoError(t, err)
+	require.Equal(t, `(,,,,,)`, string(got))
+
+	got, err = New6(New2("1", 1), New2("2", 2), New2("3", 3), New2("4", 4), New2("5", 5), New2("6", 6)).MarshalComposite()
+	require.NoError(t, err)
+	require.Equal(t, `("(1,1)","(2,2)","(3,3)","(4,4)","(5,5)","(6,6)")`, string(got))
+}
+
+func TestT6_UnmarshalComposite(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    T6[string, string, string, string, string, string]
+		wantErr bool
+	}{
+		{
+			name: "unquoted values",
+			data: `(1,2,3,4,5,6)`,
+			want: New6("1", "2", "3", "4", "5", "6"),
+		},
+		{
+			name: "quoted values",
+			data: `("1, \\""","2, \\""","3, \\""","4, \\""","5, \\""","6, \\""")`,
+			want: New6("1, \\\"", "2, \\\"", "3, \\\"", "4, \\\"", "5, \\\"", "6, \\\""),
+		},
+		{
+			name: "empty values",
+			data: `("","","","","","")`,
+			want: T6[string, string, string, string, string, string]{},
+		},
+		{
+			name:    "null values",
+			data:    `(,,,,,)`,
+			wantErr: true,
+		},
+		{
+			name:    "too many fields",
+			data:    `(1,2,3,4,5,6,0)`,
+			wantErr: true,
+		},
+		{
+			name:    "missing parentheses",
+			data:    `1,2,3,4,5,6`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T6[string, string, string, string, string, string]
+			err := got.UnmarshalComposite([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT6_MarshalComposite_UnmarshalComposite(t *testing.T) {
+	tup := New6((*T2[string, *int])(nil), &T2[string, *int]{V1: "(2, \"2\\\")", V2: ptr(2)}, &T2[string, *int]{V1: "(3, \"3\\\")", V2: ptr(3)}, &T2[string, *int]{V1: "(4, \"4\\\")", V2: ptr(4)}, &T2[string, *int]{V1: "(5, \"5\\\")", V2: ptr(5)}, &T2[string, *int]{V1: "(6, \"6\\\")", V2: ptr(6)})
+
+	marshalled, err := tup.MarshalComposite()
+	require.NoError(t, err)
+
+	var unmarshalled T6[*T2[string, *int], *T2[string, *int], *T2[string, *int], *T2[string, *int], *T2[string, *int], *T2[string, *int]]
+	err = unmarshalled.UnmarshalComposite(marshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT6_MarshalXML(t *testing.T) {
+	got, err := xml.Marshal(New6(1, 2, 3, 4, 5, 6))
+	require.NoError(t, err)
+	require.Equal(t, `<T6><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6></T6>`, string(got))
+
+	type Custom struct {
+		XMLName xml.Name                                           `xml:"custom"`
+		Tup     T6[string, string, string, string, string, string] `xml:"tup"`
+	}
+
+	got, err = xml.Marshal(Custom{Tup: New6("<1>", "<2>", "<3>", "<4>", "<5>", "<6>")})
+	require.NoError(t, err)
+	require.Equal(t, `<custom><tup><v1>&lt;1&gt;</v1><v2>&lt;2&gt;</v2><v3>&lt;3&gt;</v3><v4>&lt;4&gt;</v4><v5>&lt;5&gt;</v5><v6>&lt;6&gt;</v6></tup></custom>`, string(got))
+}
+
+func TestT6_UnmarshalXML(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    T6[int, int, int, int, int, int]
+		wantErr bool
+	}{
+		{
+			name: "all elements",
+			data: `<T6><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6></T6>`,
+			want: New6(1, 2, 3, 4, 5, 6),
+		},
+		{
+			name: "whitespace and comments",
+			data: `<T6>
+				<!-- v1 --> <v1> 1 </v1>
+				<!-- v2 --> <v2> 2 </v2>
+				<!-- v3 --> <v3> 3 </v3>
+				<!-- v4 --> <v4> 4 </v4>
+				<!-- v5 --> <v5> 5 </v5>
+				<!-- v6 --> <v6> 6 </v6>
+			</T6>`,
+			want: New6(1, 2, 3, 4, 5, 6),
+		},
+		{
+			name:    "no elements",
+			data:    `<T6></T6>`,
+			wantErr: true,
+		},
+		{
+			name:    "too many elements",
+			data:    `<T6><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6><v0>0</v0></T6>`,
+			wantErr: true,
+		},
+		{
+			name:    "wrong element names",
+			data:    `<T6><V1>1</V1><V2>2</V2><V3>3</V3><V4>4</V4><V5>5</V5><V6>6</V6></T6>`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid value",
+			data:    `<T6><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>x</v6></T6>`,
+			wantErr: true,
+		},
+		{
+			name:    "unterminated",
+			data:    `<T6><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6>`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T6[int, int, int, int, int, int]
+			err := xml.Unmarshal([]byte(tt.data), &got)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT6_UnmarshalXML_Errors(t *testing.T) {
+	var tup T6[int, int, int, int, int, int]
+
+	err := xml.Unmarshal([]byte(`<T6><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5></T6>`), &tup)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.EqualError(t, err, "unmarshalled xml elements count 5 must match number of tuple values 6")
+
+	err = xml.Unmarshal([]byte(`<T6><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6><v0>0</v0><v0>0</v0></T6>`), &tup)
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, 8, lengthErr.Actual)
+
+	err = xml.Unmarshal([]byte(`<T6><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>x</v6></T6>`), &tup)
+	var elementErr *ElementUnmarshalError
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, 5, elementErr.Index)
+	require.Equal(t, "v6", elementErr.Label)
+}
+
+func TestT6_MarshalXML_UnmarshalXML_NilPointers(t *testing.T) {
+	tup := New6((*int)(nil), ptr(2), ptr(3), ptr(4), ptr(5), ptr(6))
+
+	got, err := xml.Marshal(tup)
+	require.NoError(t, err)
+	require.Equal(t, `<T6><v1 nil="true"></v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6></T6>`, string(got))
+
+	unmarshalled := New6(ptr(0), ptr(0), ptr(0), ptr(0), ptr(0), ptr(0))
+	err = xml.Unmarshal(got, &unmarshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT6_MarshalXML_UnmarshalXML_Slices(t *testing.T) {
+	tup := New6([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7})
+
+	got, err := xml.Marshal(tup)
+	require.NoError(t, err)
+	require.Equal(t, `<T6><v1><item>1</item><item>2</item></v1><v2><item>2</item><item>3</item></v2><v3><item>3</item><item>4</item></v3><v4><item>4</item><item>5</item></v4><v5><item>5</item><item>6</item></v5><v6><item>6</item><item>7</item></v6></T6>`, string(got))
+
+	var unmarshalled T6[[]int, []int, []int, []int, []int, []int]
+	err = xml.Unmarshal(got, &unmarshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT6_MarshalXMLLabeled_UnmarshalXMLLabeled(t *testing.T) {
+	tup := New6(New2("1", ptr(1)), New2("2", ptr(2)), New2("3", ptr(3)), New2("4", ptr(4)), New2("5", ptr(5)), New2("6", ptr(6)))
+	labels := WithLabels("item1", "item2", "item3", "item4", "item5", "item6")
+
+	var buf strings.Builder
+	encoder := xml.NewEncoder(&buf)
+	err := tup.MarshalXMLLabeled(encoder, xml.StartElement{Name: xml.Name{Local: "items"}}, labels)
+	require.NoError(t, err)
+	require.NoError(t, encoder.Flush())
+	require.Equal(t, `<items><item1><v1>1</v1><v2>1</v2></item1><item2><v1>2</v1><v2>2</v2></item2><item3><v1>3</v1><v2>3</v2></item3><item4><v1>4</v1><v2>4</v2></item4><item5><v1>5</v1><v2>5</v2></item5><item6><v1>6</v1><v2>6</v2></item6></items>`, buf.String())
+
+	decoder := xml.NewDecoder(strings.NewReader(buf.String()))
+	start, err := decoder.Token()
+	require.NoError(t, err)
+
+	var unmarshalled T6[T2[string, *int], T2[string, *int], T2[string, *int], T2[string, *int], T2[string, *int], T2[string, *int]]
+	err = unmarshalled.UnmarshalXMLLabeled(decoder, start.(xml.StartElement), labels)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+
+	err = tup.MarshalXMLLabeled(encoder, xml.StartElement{Name: xml.Name{Local: "items"}}, WithLabels("item1", "item2", "item3", "item4", "item5", "item6", "extra"))
+	require.Error(t, err)
+}
+
+func TestT6_MarshalBinary(t *testing.T) {
+	tup := New6(uint16(1), uint16(2), uint16(3), uint16(4), uint16(5), uint16(6))
+
+	got, err := tup.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 0, 2, 0, 3, 0, 4, 0, 5, 0, 6, 0}, got)
+
+	got, err = tup.AppendBinaryOrder([]byte{0xff}, binary.BigEndian)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0xff, 0, 1, 0, 2, 0, 3, 0, 4, 0, 5, 0, 6}, got)
+
+	_, err = New6(1, 2, 3, 4, 5, []int{6}).MarshalBinary()
+	require.Error(t, err)
+}
+
+func TestT6_MarshalBinary_UnmarshalBinary(t *testing.T) {
+	tup := New6(
+		int64(-1),
+		"foo2",
+		true,
+		[]byte("bar4"),
+		New2(float32(5.5), "baz"),
+		float64(6.5),
+	)
+
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		marshalled, err := tup.AppendBinaryOrder(nil, order.(binary.AppendByteOrder))
+		require.NoError(t, err)
+
+		var unmarshalled T6[int64, string, bool, []byte, T2[float32, string], float64]
+		err = unmarshalled.UnmarshalBinaryOrder(marshalled, order)
+		require.NoError(t, err)
+		require.Equal(t, tup, unmarshalled)
+	}
+}
+
+func TestT6_UnmarshalBinary_Invalid(t *testing.T) {
+	marshalled, err := New6(uint16(1), uint16(2), uint16(3), uint16(4), uint16(5), uint16(6)).MarshalBinary()
+	require.NoError(t, err)
+
+	var tup T6[uint16, uint16, uint16, uint16, uint16, uint16]
+	err = tup.UnmarshalBinary(marshalled[:len(marshalled)-1])
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	require.EqualError(t, err, "value at index 5 failed to unmarshal: unexpected EOF")
+
+	err = tup.UnmarshalBinary(append(marshalled, 0))
+	require.EqualError(t, err, "binary data has 1 trailing bytes")
+}
+
+func TestT6_BinarySlice(t *testing.T) {
+	tups := []T6[int, int, int, int, int, int]{
+		New6(1, 2, 3, 4, 5, 6),
+		New6(-1, -2, -3, -4, -5, -6),
+	}
+
+	marshalled, err := AppendBinarySlice6(nil, binary.LittleEndian, tups)
+	require.NoError(t, err)
+	require.Len(t, marshalled, 1+2*6*8)
+
+	got, err := UnmarshalBinarySlice6[int, int, int, int, int, int](marshalled, binary.LittleEndian, nil)
+	require.NoError(t, err)
+	require.Equal(t, tups, got)
+
+	got, err = UnmarshalBinarySlice6(marshalled, binary.LittleEndian, got)
+	require.NoError(t, err)
+	require.Equal(t, append(tups, tups...), got)
+
+	empty, err := AppendBinarySlice6[int, int, int, int, int, int](nil, binary.LittleEndian, nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0}, empty)
+
+	_, err = AppendBinarySlice6(nil, binary.LittleEndian, []T6[[]int, []int, []int, []int, []int, []int]{{}})
+	require.Error(t, err)
+}
+
+func TestT6_MarshalJSON_MapKey(t *testing.T) {
+	m := map[T6[string, string, string, string, string, string]]int{
+		New6("1", "2", "3", "4", "5", "6"): 1,
+	}
+
+	marshalled, err := json.Marshal(m)
+	require.NoError(t, err)
+	require.Equal(t, `{"1,2,3,4,5,6":1}`, string(marshalled))
+
+	var unmarshalled map[T6[string, string, string, string, string, string]]int
+	err = json.Unmarshal(marshalled, &unmarshalled)
+	require.NoError(t, err)
+	require.Equal(t, m, unmarshalled)
 }
@@ -1,10 +1,14 @@
 package tuple
 
 import (
+	"cmp"
+	"database/sql"
+	"encoding/binary"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
-
-	"golang.org/x/exp/constraints"
+	"hash/maphash"
+	"io"
 )
 
 // T7 is a tuple type holding 7 generic values.
@@ -75,31 +79,31 @@ func New7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](v1 Ty1, v2 Ty2, v3 Ty3, v4 Ty4,
 func FromArray7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](arr [7]any) (T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7], error) {
 	v1, ok := arr[0].(Ty1)
 	if !ok {
-		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, fmt.Errorf("value at array index 0 expected to have type %s but has type %T", typeName[Ty1](), arr[0])
+		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, newElementTypeError[Ty1]("array", 0, arr[0])
 	}
 	v2, ok := arr[1].(Ty2)
 	if !ok {
-		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, fmt.Errorf("value at array index 1 expected to have type %s but has type %T", typeName[Ty2](), arr[1])
+		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, newElementTypeError[Ty2]("array", 1, arr[1])
 	}
 	v3, ok := arr[2].(Ty3)
 	if !ok {
-		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, fmt.Errorf("value at array index 2 expected to have type %s but has type %T", typeName[Ty3](), arr[2])
+		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, newElementTypeError[Ty3]("array", 2, arr[2])
 	}
 	v4, ok := arr[3].(Ty4)
 	if !ok {
-		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, fmt.Errorf("value at array index 3 expected to have type %s but has type %T", typeName[Ty4](), arr[3])
+		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, newElementTypeError[Ty4]("array", 3, arr[3])
 	}
 	v5, ok := arr[4].(Ty5)
 	if !ok {
-		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, fmt.Errorf("value at array index 4 expected to have type %s but has type %T", typeName[Ty5](), arr[4])
+		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, newElementTypeError[Ty5]("array", 4, arr[4])
 	}
 	v6, ok := arr[5].(Ty6)
 	if !ok {
-		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, fmt.Errorf("value at array index 5 expected to have type %s but has type %T", typeName[Ty6](), arr[5])
+		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, newElementTypeError[Ty6]("array", 5, arr[5])
 	}
 	v7, ok := arr[6].(Ty7)
 	if !ok {
-		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, fmt.Errorf("value at array index 6 expected to have type %s but has type %T", typeName[Ty7](), arr[6])
+		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, newElementTypeError[Ty7]("array", 6, arr[6])
 	}
 
 	return New7(v1, v2, v3, v4, v5, v6, v7), nil
@@ -115,36 +119,36 @@ func FromArray7X[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](arr [7]any) T7[Ty1, Ty2,
 // If the length of the slice doesn't match, or any of the values can not be converted to the generic type, an error is returned.
 func FromSlice7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](values []any) (T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7], error) {
 	if len(values) != 7 {
-		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, fmt.Errorf("slice length %d must match number of tuple values 7", len(values))
+		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, &LengthMismatchError{Source: "slice", Expected: 7, Actual: len(values)}
 	}
 
 	v1, ok := values[0].(Ty1)
 	if !ok {
-		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, fmt.Errorf("value at slice index 0 expected to have type %s but has type %T", typeName[Ty1](), values[0])
+		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, newElementTypeError[Ty1]("slice", 0, values[0])
 	}
 	v2, ok := values[1].(Ty2)
 	if !ok {
-		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, fmt.Errorf("value at slice index 1 expected to have type %s but has type %T", typeName[Ty2](), values[1])
+		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, newElementTypeError[Ty2]("slice", 1, values[1])
 	}
 	v3, ok := values[2].(Ty3)
 	if !ok {
-		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, fmt.Errorf("value at slice index 2 expected to have type %s but has type %T", typeName[Ty3](), values[2])
+		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, newElementTypeError[Ty3]("slice", 2, values[2])
 	}
 	v4, ok := values[3].(Ty4)
 	if !ok {
-		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, fmt.Errorf("value at slice index 3 expected to have type %s but has type %T", typeName[Ty4](), values[3])
+		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, newElementTypeError[Ty4]("slice", 3, values[3])
 	}
 	v5, ok := values[4].(Ty5)
 	if !ok {
-		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, fmt.Errorf("value at slice index 4 expected to have type %s but has type %T", typeName[Ty5](), values[4])
+		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, newElementTypeError[Ty5]("slice", 4, values[4])
 	}
 	v6, ok := values[5].(Ty6)
 	if !ok {
-		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, fmt.Errorf("value at slice index 5 expected to have type %s but has type %T", typeName[Ty6](), values[5])
+		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, newElementTypeError[Ty6]("slice", 5, values[5])
 	}
 	v7, ok := values[6].(Ty7)
 	if !ok {
-		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, fmt.Errorf("value at slice index 6 expected to have type %s but has type %T", typeName[Ty7](), values[6])
+		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, newElementTypeError[Ty7]("slice", 6, values[6])
 	}
 
 	return New7(v1, v2, v3, v4, v5, v6, v7), nil
@@ -154,7 +158,7 @@ func FromSlice7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](values []any) (T7[Ty1, Ty
 // If the length of the slice doesn't match, or any of the values can not be converted to the generic type, the function panics.
 func FromSlice7X[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](values []any) T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7] {
 	if len(values) != 7 {
-		panic(fmt.Errorf("slice length %d must match number of tuple values 7", len(values)))
+		panic(&LengthMismatchError{Source: "slice", Expected: 7, Actual: len(values)})
 	}
 
 	v1 := values[0].(Ty1)
@@ -168,11 +172,544 @@ func FromSlice7X[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](values []any) T7[Ty1, Ty
 	return New7(v1, v2, v3, v4, v5, v6, v7)
 }
 
+// ConvertSlice7 returns a tuple from a slice of length 7, converting the values to the generic types when needed.
+// Unlike FromSlice7, numeric values are converted between numeric types as long as they fit the generic type,
+// json.Number values are parsed into numeric types, strings and byte slices are converted to each other,
+// and strings and byte slices are unmarshalled into types implementing encoding.TextUnmarshaler.
+// This enables creating tuples from slices decoded by encoding/json, which hold float64 values for numbers.
+// If the length of the slice doesn't match, a *LengthMismatchError is returned.
+// If any of the values can not be converted to the generic type, a *ElementTypeError is returned.
+// If the conversion of any of the values fails, for example when it overflows the generic type, a *ElementConversionError is returned.
+func ConvertSlice7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](values []any) (T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7], error) {
+	if len(values) != 7 {
+		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, &LengthMismatchError{Source: "slice", Expected: 7, Actual: len(values)}
+	}
+
+	var t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]
+	if err := convertSliceValue(0, values[0], &t.V1); err != nil {
+		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, err
+	}
+	if err := convertSliceValue(1, values[1], &t.V2); err != nil {
+		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, err
+	}
+	if err := convertSliceValue(2, values[2], &t.V3); err != nil {
+		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, err
+	}
+	if err := convertSliceValue(3, values[3], &t.V4); err != nil {
+		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, err
+	}
+	if err := convertSliceValue(4, values[4], &t.V5); err != nil {
+		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, err
+	}
+	if err := convertSliceValue(5, values[5], &t.V6); err != nil {
+		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, err
+	}
+	if err := convertSliceValue(6, values[6], &t.V7); err != nil {
+		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, err
+	}
+
+	return t, nil
+}
+
+// ScanRow7 scans the current row of rows into a tuple, with each of the columns scanned into the matching tuple value.
+// If the number of columns doesn't match the number of tuple values, a *LengthMismatchError is returned.
+func ScanRow7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](rows *sql.Rows) (T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7], error) {
+	var t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]
+	if err := scanRow(rows, []any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+		&t.V5,
+		&t.V6,
+		&t.V7,
+	}); err != nil {
+		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, err
+	}
+
+	return t, nil
+}
+
+// CollectRows7 scans all the rows into a slice of tuples using ScanRow7, and closes the rows.
+func CollectRows7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](rows *sql.Rows) ([]T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7], error) {
+	return collectRows(rows, ScanRow7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7])
+}
+
+// ReadCSV7 reads all the CSV records of r into tuples, with each of the fields converted to the matching tuple value.
+// Fields are converted using the UnmarshalText method of values implementing encoding.TextUnmarshaler,
+// and otherwise must be of boolean, numeric or string kinds.
+// If a record fails to convert, a *CSVError holding its line and column numbers is returned.
+// If a record fails to parse, including records with a number of fields that doesn't match, a *csv.ParseError is returned.
+func ReadCSV7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](r io.Reader, opts CSVOptions) ([]T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7], error) {
+	return readCSV(r, opts, func(t *T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) []any {
+		return []any{
+			&t.V1,
+			&t.V2,
+			&t.V3,
+			&t.V4,
+			&t.V5,
+			&t.V6,
+			&t.V7,
+		}
+	})
+}
+
+// WriteCSV7 writes the tuples into w as CSV records, with each of the tuple values converted to the matching field.
+// Values are converted the same way as MarshalText does.
+// If a tuple fails to convert, a *CSVError holding the line and column numbers of its record is returned.
+func WriteCSV7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](w io.Writer, tuples []T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7], opts CSVOptions) error {
+	return writeCSV(w, tuples, opts, T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7].Slice)
+}
+
+// Parse7 returns a tuple from its string representation, as returned by the String method.
+// Supported values are booleans, numbers, quoted strings and nested tuples.
+// If the string fails to parse, a *ParseError holding the offset of the failure is returned.
+func Parse7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](s string) (T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7], error) {
+	var t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]
+	if err := parseTuple(s, &t); err != nil {
+		return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{}, err
+	}
+
+	return t, nil
+}
+
+// Map7At1 returns a new tuple with the function f applied to the value at position 1 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map7At1[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, R any](t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7], f func(Ty1) R) T7[R, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7] {
+	return T7[R, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{
+		V1: f(t.V1),
+		V2: t.V2,
+		V3: t.V3,
+		V4: t.V4,
+		V5: t.V5,
+		V6: t.V6,
+		V7: t.V7,
+	}
+}
+
+// Map7At2 returns a new tuple with the function f applied to the value at position 2 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map7At2[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, R any](t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7], f func(Ty2) R) T7[Ty1, R, Ty3, Ty4, Ty5, Ty6, Ty7] {
+	return T7[Ty1, R, Ty3, Ty4, Ty5, Ty6, Ty7]{
+		V1: t.V1,
+		V2: f(t.V2),
+		V3: t.V3,
+		V4: t.V4,
+		V5: t.V5,
+		V6: t.V6,
+		V7: t.V7,
+	}
+}
+
+// Map7At3 returns a new tuple with the function f applied to the value at position 3 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map7At3[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, R any](t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7], f func(Ty3) R) T7[Ty1, Ty2, R, Ty4, Ty5, Ty6, Ty7] {
+	return T7[Ty1, Ty2, R, Ty4, Ty5, Ty6, Ty7]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: f(t.V3),
+		V4: t.V4,
+		V5: t.V5,
+		V6: t.V6,
+		V7: t.V7,
+	}
+}
+
+// Map7At4 returns a new tuple with the function f applied to the value at position 4 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map7At4[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, R any](t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7], f func(Ty4) R) T7[Ty1, Ty2, Ty3, R, Ty5, Ty6, Ty7] {
+	return T7[Ty1, Ty2, Ty3, R, Ty5, Ty6, Ty7]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+		V4: f(t.V4),
+		V5: t.V5,
+		V6: t.V6,
+		V7: t.V7,
+	}
+}
+
+// Map7At5 returns a new tuple with the function f applied to the value at position 5 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map7At5[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, R any](t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7], f func(Ty5) R) T7[Ty1, Ty2, Ty3, Ty4, R, Ty6, Ty7] {
+	return T7[Ty1, Ty2, Ty3, Ty4, R, Ty6, Ty7]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+		V4: t.V4,
+		V5: f(t.V5),
+		V6: t.V6,
+		V7: t.V7,
+	}
+}
+
+// Map7At6 returns a new tuple with the function f applied to the value at position 6 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map7At6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, R any](t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7], f func(Ty6) R) T7[Ty1, Ty2, Ty3, Ty4, Ty5, R, Ty7] {
+	return T7[Ty1, Ty2, Ty3, Ty4, Ty5, R, Ty7]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+		V4: t.V4,
+		V5: t.V5,
+		V6: f(t.V6),
+		V7: t.V7,
+	}
+}
+
+// Map7At7 returns a new tuple with the function f applied to the value at position 7 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map7At7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, R any](t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7], f func(Ty7) R) T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, R] {
+	return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, R]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+		V4: t.V4,
+		V5: t.V5,
+		V6: t.V6,
+		V7: f(t.V7),
+	}
+}
+
+// Map7 returns a new tuple with each of the functions applied to the tuple value at the matching position.
+func Map7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, R1, R2, R3, R4, R5, R6, R7 any](t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7], f1 func(Ty1) R1, f2 func(Ty2) R2, f3 func(Ty3) R3, f4 func(Ty4) R4, f5 func(Ty5) R5, f6 func(Ty6) R6, f7 func(Ty7) R7) T7[R1, R2, R3, R4, R5, R6, R7] {
+	return T7[R1, R2, R3, R4, R5, R6, R7]{
+		V1: f1(t.V1),
+		V2: f2(t.V2),
+		V3: f3(t.V3),
+		V4: f4(t.V4),
+		V5: f5(t.V5),
+		V6: f6(t.V6),
+		V7: f7(t.V7),
+	}
+}
+
+// Append7 returns a new tuple holding the tuple values followed by the value v.
+func Append7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8 any](t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7], v Ty8) T8[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8] {
+	return T8[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+		V4: t.V4,
+		V5: t.V5,
+		V6: t.V6,
+		V7: t.V7,
+		V8: v,
+	}
+}
+
+// Prepend7 returns a new tuple holding the value v followed by the tuple values.
+func Prepend7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8 any](t T7[Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8], v Ty1) T8[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8] {
+	return T8[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8]{
+		V1: v,
+		V2: t.V1,
+		V3: t.V2,
+		V4: t.V3,
+		V5: t.V4,
+		V6: t.V5,
+		V7: t.V6,
+		V8: t.V7,
+	}
+}
+
+// Concat7_1 returns a new tuple holding the values of t1 followed by the values of t2.
+func Concat7_1[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8 any](t1 T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7], t2 T1[Ty8]) T8[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8] {
+	return T8[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8]{
+		V1: t1.V1,
+		V2: t1.V2,
+		V3: t1.V3,
+		V4: t1.V4,
+		V5: t1.V5,
+		V6: t1.V6,
+		V7: t1.V7,
+		V8: t2.V1,
+	}
+}
+
+// Concat7_2 returns a new tuple holding the values of t1 followed by the values of t2.
+func Concat7_2[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](t1 T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7], t2 T2[Ty8, Ty9]) T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9] {
+	return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{
+		V1: t1.V1,
+		V2: t1.V2,
+		V3: t1.V3,
+		V4: t1.V4,
+		V5: t1.V5,
+		V6: t1.V6,
+		V7: t1.V7,
+		V8: t2.V1,
+		V9: t2.V2,
+	}
+}
+
+// Take7_1 returns a new tuple holding the first 1 values of the tuple.
+func Take7_1[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) T1[Ty1] {
+	return T1[Ty1]{
+		V1: t.V1,
+	}
+}
+
+// Drop7_1 returns a new tuple holding the values of the tuple without the first 1 values.
+func Drop7_1[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) T6[Ty2, Ty3, Ty4, Ty5, Ty6, Ty7] {
+	return T6[Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{
+		V1: t.V2,
+		V2: t.V3,
+		V3: t.V4,
+		V4: t.V5,
+		V5: t.V6,
+		V6: t.V7,
+	}
+}
+
+// Split7_1 splits the tuple into a tuple of its first 1 values and a tuple of the rest of its values.
+func Split7_1[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) T2[T1[Ty1], T6[Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]] {
+	return T2[T1[Ty1], T6[Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]]{
+		V1: Take7_1(t),
+		V2: Drop7_1(t),
+	}
+}
+
+// Take7_2 returns a new tuple holding the first 2 values of the tuple.
+func Take7_2[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) T2[Ty1, Ty2] {
+	return T2[Ty1, Ty2]{
+		V1: t.V1,
+		V2: t.V2,
+	}
+}
+
+// Drop7_2 returns a new tuple holding the values of the tuple without the first 2 values.
+func Drop7_2[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) T5[Ty3, Ty4, Ty5, Ty6, Ty7] {
+	return T5[Ty3, Ty4, Ty5, Ty6, Ty7]{
+		V1: t.V3,
+		V2: t.V4,
+		V3: t.V5,
+		V4: t.V6,
+		V5: t.V7,
+	}
+}
+
+// Split7_2 splits the tuple into a tuple of its first 2 values and a tuple of the rest of its values.
+func Split7_2[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) T2[T2[Ty1, Ty2], T5[Ty3, Ty4, Ty5, Ty6, Ty7]] {
+	return T2[T2[Ty1, Ty2], T5[Ty3, Ty4, Ty5, Ty6, Ty7]]{
+		V1: Take7_2(t),
+		V2: Drop7_2(t),
+	}
+}
+
+// Take7_3 returns a new tuple holding the first 3 values of the tuple.
+func Take7_3[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) T3[Ty1, Ty2, Ty3] {
+	return T3[Ty1, Ty2, Ty3]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+	}
+}
+
+// Drop7_3 returns a new tuple holding the values of the tuple without the first 3 values.
+func Drop7_3[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) T4[Ty4, Ty5, Ty6, Ty7] {
+	return T4[Ty4, Ty5, Ty6, Ty7]{
+		V1: t.V4,
+		V2: t.V5,
+		V3: t.V6,
+		V4: t.V7,
+	}
+}
+
+// Split7_3 splits the tuple into a tuple of its first 3 values and a tuple of the rest of its values.
+func Split7_3[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) T2[T3[Ty1, Ty2, Ty3], T4[Ty4, Ty5, Ty6, Ty7]] {
+	return T2[T3[Ty1, Ty2, Ty3], T4[Ty4, Ty5, Ty6, Ty7]]{
+		V1: Take7_3(t),
+		V2: Drop7_3(t),
+	}
+}
+
+// Take7_4 returns a new tuple holding the first 4 values of the tuple.
+func Take7_4[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) T4[Ty1, Ty2, Ty3, Ty4] {
+	return T4[Ty1, Ty2, Ty3, Ty4]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+		V4: t.V4,
+	}
+}
+
+// Drop7_4 returns a new tuple holding the values of the tuple without the first 4 values.
+func Drop7_4[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) T3[Ty5, Ty6, Ty7] {
+	return T3[Ty5, Ty6, Ty7]{
+		V1: t.V5,
+		V2: t.V6,
+		V3: t.V7,
+	}
+}
+
+// Split7_4 splits the tuple into a tuple of its first 4 values and a tuple of the rest of its values.
+func Split7_4[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) T2[T4[Ty1, Ty2, Ty3, Ty4], T3[Ty5, Ty6, Ty7]] {
+	return T2[T4[Ty1, Ty2, Ty3, Ty4], T3[Ty5, Ty6, Ty7]]{
+		V1: Take7_4(t),
+		V2: Drop7_4(t),
+	}
+}
+
+// Take7_5 returns a new tuple holding the first 5 values of the tuple.
+func Take7_5[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) T5[Ty1, Ty2, Ty3, Ty4, Ty5] {
+	return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+		V4: t.V4,
+		V5: t.V5,
+	}
+}
+
+// Drop7_5 returns a new tuple holding the values of the tuple without the first 5 values.
+func Drop7_5[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) T2[Ty6, Ty7] {
+	return T2[Ty6, Ty7]{
+		V1: t.V6,
+		V2: t.V7,
+	}
+}
+
+// Split7_5 splits the tuple into a tuple of its first 5 values and a tuple of the rest of its values.
+func Split7_5[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) T2[T5[Ty1, Ty2, Ty3, Ty4, Ty5], T2[Ty6, Ty7]] {
+	return T2[T5[Ty1, Ty2, Ty3, Ty4, Ty5], T2[Ty6, Ty7]]{
+		V1: Take7_5(t),
+		V2: Drop7_5(t),
+	}
+}
+
+// Take7_6 returns a new tuple holding the first 6 values of the tuple.
+func Take7_6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6] {
+	return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+		V4: t.V4,
+		V5: t.V5,
+		V6: t.V6,
+	}
+}
+
+// Drop7_6 returns a new tuple holding the values of the tuple without the first 6 values.
+func Drop7_6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) T1[Ty7] {
+	return T1[Ty7]{
+		V1: t.V7,
+	}
+}
+
+// Split7_6 splits the tuple into a tuple of its first 6 values and a tuple of the rest of its values.
+func Split7_6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) T2[T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6], T1[Ty7]] {
+	return T2[T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6], T1[Ty7]]{
+		V1: Take7_6(t),
+		V2: Drop7_6(t),
+	}
+}
+
+// Reverse7 returns a new tuple holding the tuple values in reverse order.
+func Reverse7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) T7[Ty7, Ty6, Ty5, Ty4, Ty3, Ty2, Ty1] {
+	return T7[Ty7, Ty6, Ty5, Ty4, Ty3, Ty2, Ty1]{
+		V1: t.V7,
+		V2: t.V6,
+		V3: t.V5,
+		V4: t.V4,
+		V5: t.V3,
+		V6: t.V2,
+		V7: t.V1,
+	}
+}
+
+// Apply7 calls the function f with the tuple values as arguments and returns its result.
+func Apply7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, R any](t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7], f func(Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7) R) R {
+	return f(t.Values())
+}
+
+// Tupled7 returns a function that accepts a tuple and calls f with the tuple values as arguments.
+func Tupled7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, R any](f func(Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7) R) func(T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) R {
+	return func(t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) R {
+		return f(t.Values())
+	}
+}
+
+// Untupled7 returns a function that accepts 7 arguments and calls g with a tuple holding them.
+func Untupled7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, R any](g func(T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) R) func(Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7) R {
+	return func(v1 Ty1, v2 Ty2, v3 Ty3, v4 Ty4, v5 Ty5, v6 Ty6, v7 Ty7) R {
+		return g(New7(v1, v2, v3, v4, v5, v6, v7))
+	}
+}
+
+// Zip7 returns a slice of tuples, where each tuple holds the values at the same index of each of the given slices.
+// If the slices have different lengths, the returned slice is truncated to the length of the shortest slice.
+// To require all slices to have the same length, use the Zip7Strict function.
+func Zip7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](s1 []Ty1, s2 []Ty2, s3 []Ty3, s4 []Ty4, s5 []Ty5, s6 []Ty6, s7 []Ty7) []T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7] {
+	length := minLen(len(s1), len(s2), len(s3), len(s4), len(s5), len(s6), len(s7))
+
+	zipped := make([]T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7], length)
+	for i := range zipped {
+		zipped[i] = T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{
+			V1: s1[i],
+			V2: s2[i],
+			V3: s3[i],
+			V4: s4[i],
+			V5: s5[i],
+			V6: s6[i],
+			V7: s7[i],
+		}
+	}
+
+	return zipped
+}
+
+// Zip7Strict returns a slice of tuples, where each tuple holds the values at the same index of each of the given slices.
+// If the slices have different lengths, an error is returned.
+// To truncate the result to the length of the shortest slice instead, use the Zip7 function.
+func Zip7Strict[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](s1 []Ty1, s2 []Ty2, s3 []Ty3, s4 []Ty4, s5 []Ty5, s6 []Ty6, s7 []Ty7) ([]T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7], error) {
+	if len(s2) != len(s1) {
+		return nil, &LengthMismatchError{Source: "slice 2", Expected: len(s1), Actual: len(s2), expectedSource: "slice 1"}
+	}
+	if len(s3) != len(s1) {
+		return nil, &LengthMismatchError{Source: "slice 3", Expected: len(s1), Actual: len(s3), expectedSource: "slice 1"}
+	}
+	if len(s4) != len(s1) {
+		return nil, &LengthMismatchError{Source: "slice 4", Expected: len(s1), Actual: len(s4), expectedSource: "slice 1"}
+	}
+	if len(s5) != len(s1) {
+		return nil, &LengthMismatchError{Source: "slice 5", Expected: len(s1), Actual: len(s5), expectedSource: "slice 1"}
+	}
+	if len(s6) != len(s1) {
+		return nil, &LengthMismatchError{Source: "slice 6", Expected: len(s1), Actual: len(s6), expectedSource: "slice 1"}
+	}
+	if len(s7) != len(s1) {
+		return nil, &LengthMismatchError{Source: "slice 7", Expected: len(s1), Actual: len(s7), expectedSource: "slice 1"}
+	}
+
+	return Zip7(s1, s2, s3, s4, s5, s6, s7), nil
+}
+
+// Unzip7 returns 7 slices, where each slice holds the tuple values at the matching position of each of the given tuples.
+func Unzip7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](ts []T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) ([]Ty1, []Ty2, []Ty3, []Ty4, []Ty5, []Ty6, []Ty7) {
+	s1 := make([]Ty1, len(ts))
+	s2 := make([]Ty2, len(ts))
+	s3 := make([]Ty3, len(ts))
+	s4 := make([]Ty4, len(ts))
+	s5 := make([]Ty5, len(ts))
+	s6 := make([]Ty6, len(ts))
+	s7 := make([]Ty7, len(ts))
+	for i, t := range ts {
+		s1[i] = t.V1
+		s2[i] = t.V2
+		s3[i] = t.V3
+		s4[i] = t.V4
+		s5[i] = t.V5
+		s6[i] = t.V6
+		s7[i] = t.V7
+	}
+
+	return s1, s2, s3, s4, s5, s6, s7
+}
+
 // Equal7 returns whether the host tuple is equal to the other tuple.
 // All tuple elements of the host and guest parameters must match the "comparable" built-in constraint.
 // To test equality of tuples that hold custom Equalable values, use the Equal7E function.
 // To test equality of tuples that hold custom Comparable values, use the Equal7C function.
-// Otherwise, use Equal or reflect.DeepEqual to test tuples of any types.
+// Otherwise, use the Equal method or the EqualFunc7 function to test tuples of any types.
 func Equal7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 comparable](host, guest T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) bool {
 	return host.V1 == guest.V1 && host.V2 == guest.V2 && host.V3 == guest.V3 && host.V4 == guest.V4 && host.V5 == guest.V5 && host.V6 == guest.V6 && host.V7 == guest.V7
 }
@@ -181,7 +718,7 @@ func Equal7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 comparable](host, guest T7[Ty1, Ty
 // All tuple elements of the host and guest parameters must match the Equalable constraint.
 // To test equality of tuples that hold built-in "comparable" values, use the Equal7 function.
 // To test equality of tuples that hold custom Comparable values, use the Equal7C function.
-// Otherwise, use Equal or reflect.DeepEqual to test tuples of any types.
+// Otherwise, use the Equal method or the EqualFunc7 function to test tuples of any types.
 func Equal7E[Ty1 Equalable[Ty1], Ty2 Equalable[Ty2], Ty3 Equalable[Ty3], Ty4 Equalable[Ty4], Ty5 Equalable[Ty5], Ty6 Equalable[Ty6], Ty7 Equalable[Ty7]](host, guest T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) bool {
 	return host.V1.Equal(guest.V1) && host.V2.Equal(guest.V2) && host.V3.Equal(guest.V3) && host.V4.Equal(guest.V4) && host.V5.Equal(guest.V5) && host.V6.Equal(guest.V6) && host.V7.Equal(guest.V7)
 }
@@ -190,15 +727,28 @@ func Equal7E[Ty1 Equalable[Ty1], Ty2 Equalable[Ty2], Ty3 Equalable[Ty3], Ty4 Equ
 // All tuple elements of the host and guest parameters must match the Comparable constraint.
 // To test equality of tuples that hold built-in "comparable" values, use the Equal7 function.
 // To test equality of tuples that hold custom Equalable values, use the Equal7E function.
-// Otherwise, use Equal or reflect.DeepEqual to test tuples of any types.
+// Otherwise, use the Equal method or the EqualFunc7 function to test tuples of any types.
 func Equal7C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[Ty3], Ty4 Comparable[Ty4], Ty5 Comparable[Ty5], Ty6 Comparable[Ty6], Ty7 Comparable[Ty7]](host, guest T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) bool {
 	return host.V1.CompareTo(guest.V1).EQ() && host.V2.CompareTo(guest.V2).EQ() && host.V3.CompareTo(guest.V3).EQ() && host.V4.CompareTo(guest.V4).EQ() && host.V5.CompareTo(guest.V5).EQ() && host.V6.CompareTo(guest.V6).EQ() && host.V7.CompareTo(guest.V7).EQ()
 }
 
+// EqualFunc7 returns whether the host tuple is equal to the guest tuple, using the given equality function for each element.
+// EqualFunc7 short-circuits once one of the elements is not equal, and the rest of the equality functions are not called.
+func EqualFunc7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](host, guest T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7], eq1 func(a, b Ty1) bool, eq2 func(a, b Ty2) bool, eq3 func(a, b Ty3) bool, eq4 func(a, b Ty4) bool, eq5 func(a, b Ty5) bool, eq6 func(a, b Ty6) bool, eq7 func(a, b Ty7) bool) bool {
+	return eq1(host.V1, guest.V1) && eq2(host.V2, guest.V2) && eq3(host.V3, guest.V3) && eq4(host.V4, guest.V4) && eq5(host.V5, guest.V5) && eq6(host.V6, guest.V6) && eq7(host.V7, guest.V7)
+}
+
+// Equal returns whether the tuple is equal to the guest tuple.
+// Each element is tested using the Equalable constraint if it is implemented, then the Comparable constraint,
+// then the "==" operator if the element is comparable, and otherwise using reflect.DeepEqual.
+func (t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) Equal(guest T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) bool {
+	return equalValues(t.V1, guest.V1) && equalValues(t.V2, guest.V2) && equalValues(t.V3, guest.V3) && equalValues(t.V4, guest.V4) && equalValues(t.V5, guest.V5) && equalValues(t.V6, guest.V6) && equalValues(t.V7, guest.V7)
+}
+
 // Compare7 returns whether the host tuple is semantically less than, equal to, or greater than the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the Compare7C function.
-func Compare7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 constraints.Ordered](host, guest T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) OrderedComparisonResult {
+func Compare7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 cmp.Ordered](host, guest T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) OrderedComparisonResult {
 	return multiCompare(
 		func() OrderedComparisonResult { return compareOrdered(host.V1, guest.V1) },
 
@@ -237,10 +787,64 @@ func Compare7C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[Ty3], Ty
 	)
 }
 
+// CmpFunc7 returns a comparison function of tuples, which returns a negative number when a < b, a positive number when a > b,
+// and zero when a == b. The returned function can be used with slices.SortFunc, slices.BinarySearchFunc and similar functions.
+// All tuple elements must match the "Ordered" constraint.
+// To compare tuples that hold custom comparable values, use the CmpFunc7C function.
+func CmpFunc7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 cmp.Ordered]() func(a, b T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) int {
+	return func(a, b T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) int {
+		return int(Compare7(a, b))
+	}
+}
+
+// CmpFunc7C returns a comparison function of tuples, which returns a negative number when a < b, a positive number when a > b,
+// and zero when a == b. The returned function can be used with slices.SortFunc, slices.BinarySearchFunc and similar functions.
+// All tuple elements must match the Comparable constraint.
+// To compare tuples that hold built-in "Ordered" values, use the CmpFunc7 function.
+func CmpFunc7C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[Ty3], Ty4 Comparable[Ty4], Ty5 Comparable[Ty5], Ty6 Comparable[Ty6], Ty7 Comparable[Ty7]]() func(a, b T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) int {
+	return func(a, b T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) int {
+		return int(Compare7C(a, b))
+	}
+}
+
+// Comparator7 returns a comparison function of tuples, which compares the tuple elements by order using the given
+// element comparison functions. Each element comparison function must return a negative number when a < b, a positive number
+// when a > b, and zero when a == b, such as cmp.Compare, CompareOrdered and CompareComparable.
+// The returned function short-circuits once one of the elements is not equal, and the rest of the element comparison functions
+// are not called.
+func Comparator7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](c1 func(a, b Ty1) int, c2 func(a, b Ty2) int, c3 func(a, b Ty3) int, c4 func(a, b Ty4) int, c5 func(a, b Ty5) int, c6 func(a, b Ty6) int, c7 func(a, b Ty7) int) func(a, b T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) int {
+	return func(a, b T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) int {
+		return int(multiCompare(
+			func() OrderedComparisonResult { return OrderedComparisonResult(c1(a.V1, b.V1)) },
+
+			func() OrderedComparisonResult { return OrderedComparisonResult(c2(a.V2, b.V2)) },
+
+			func() OrderedComparisonResult { return OrderedComparisonResult(c3(a.V3, b.V3)) },
+
+			func() OrderedComparisonResult { return OrderedComparisonResult(c4(a.V4, b.V4)) },
+
+			func() OrderedComparisonResult { return OrderedComparisonResult(c5(a.V5, b.V5)) },
+
+			func() OrderedComparisonResult { return OrderedComparisonResult(c6(a.V6, b.V6)) },
+
+			func() OrderedComparisonResult { return OrderedComparisonResult(c7(a.V7, b.V7)) },
+		))
+	}
+}
+
+// OrderBy7 returns a comparison function of tuples, which compares the tuple elements by order according to the
+// sort order given for each element, such as OrderBy7(Asc, Desc, ...).
+// All tuple elements must match the "Ordered" constraint.
+// To order tuples that hold other element types, such as pointers, use the Comparator7 function with
+// the OrderFunc and OrderPtrFunc element comparison functions.
+func OrderBy7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 cmp.Ordered](o1, o2, o3, o4, o5, o6, o7 SortOrder) func(a, b T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) int {
+	return Comparator7(OrderedBy[Ty1](o1), OrderedBy[Ty2](o2), OrderedBy[Ty3](o3), OrderedBy[Ty4](o4), OrderedBy[Ty5](o5), OrderedBy[Ty6](o6), OrderedBy[Ty7](o7))
+}
+
 // LessThan7 returns whether the host tuple is semantically less than the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the LessThan7C function.
-func LessThan7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 constraints.Ordered](host, guest T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) bool {
+func LessThan7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 cmp.Ordered](host, guest T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) bool {
 	return Compare7(host, guest).LT()
 }
 
@@ -254,7 +858,7 @@ func LessThan7C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[Ty3], T
 // LessOrEqual7 returns whether the host tuple is semantically less than or equal to the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the LessOrEqual7C function.
-func LessOrEqual7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 constraints.Ordered](host, guest T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) bool {
+func LessOrEqual7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 cmp.Ordered](host, guest T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) bool {
 	return Compare7(host, guest).LE()
 }
 
@@ -268,7 +872,7 @@ func LessOrEqual7C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[Ty3]
 // GreaterThan7 returns whether the host tuple is semantically greater than the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the GreaterThan7C function.
-func GreaterThan7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 constraints.Ordered](host, guest T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) bool {
+func GreaterThan7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 cmp.Ordered](host, guest T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) bool {
 	return Compare7(host, guest).GT()
 }
 
@@ -282,7 +886,7 @@ func GreaterThan7C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[Ty3]
 // GreaterOrEqual7 returns whether the host tuple is semantically greater than or equal to the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the GreaterOrEqual7C function.
-func GreaterOrEqual7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 constraints.Ordered](host, guest T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) bool {
+func GreaterOrEqual7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 cmp.Ordered](host, guest T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) bool {
 	return Compare7(host, guest).GE()
 }
 
@@ -293,6 +897,41 @@ func GreaterOrEqual7C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[T
 	return Compare7C(host, guest).GE()
 }
 
+// Hash returns a hash of the tuple values using the given seed.
+// Equal tuples return equal hashes for the same seed.
+// Elements supported by the HashValue function are hashed by their values. Other elements, such as slices, maps,
+// functions, nil pointers and nil interfaces, are hashed by their type only, so tuples differing only in these elements collide.
+// To hash tuples holding other element types by their values, use the HashFunc7 function.
+func (t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) Hash(seed maphash.Seed) uint64 {
+	var h maphash.Hash
+	h.SetSeed(seed)
+	writeHashElement(&h, seed, t.V1)
+	writeHashElement(&h, seed, t.V2)
+	writeHashElement(&h, seed, t.V3)
+	writeHashElement(&h, seed, t.V4)
+	writeHashElement(&h, seed, t.V5)
+	writeHashElement(&h, seed, t.V6)
+	writeHashElement(&h, seed, t.V7)
+	return h.Sum64()
+}
+
+// HashFunc7 returns a hash function of tuples, which hashes the tuple values using the given element hash functions.
+// Each element hash function must return equal hashes for equal values and the same seed, such as HashValue.
+func HashFunc7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](h1 func(seed maphash.Seed, v Ty1) uint64, h2 func(seed maphash.Seed, v Ty2) uint64, h3 func(seed maphash.Seed, v Ty3) uint64, h4 func(seed maphash.Seed, v Ty4) uint64, h5 func(seed maphash.Seed, v Ty5) uint64, h6 func(seed maphash.Seed, v Ty6) uint64, h7 func(seed maphash.Seed, v Ty7) uint64) func(seed maphash.Seed, t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) uint64 {
+	return func(seed maphash.Seed, t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) uint64 {
+		var h maphash.Hash
+		h.SetSeed(seed)
+		writeHashUint64(&h, h1(seed, t.V1))
+		writeHashUint64(&h, h2(seed, t.V2))
+		writeHashUint64(&h, h3(seed, t.V3))
+		writeHashUint64(&h, h4(seed, t.V4))
+		writeHashUint64(&h, h5(seed, t.V5))
+		writeHashUint64(&h, h6(seed, t.V6))
+		writeHashUint64(&h, h7(seed, t.V7))
+		return h.Sum64()
+	}
+}
+
 // MarshalJSON marshals the tuple into a JSON array.
 func (t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) MarshalJSON() ([]byte, error) {
 	return json.Marshal(t.Slice())
@@ -300,41 +939,295 @@ func (t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) MarshalJSON() ([]byte, error) {
 
 // MarshalJSON unmarshals the tuple from a JSON array.
 func (t *T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) UnmarshalJSON(data []byte) error {
-	// Working with json.RawMessage instead of any enables custom struct support.
-	var slice []json.RawMessage
-	if err := json.Unmarshal(data, &slice); err != nil {
-		return fmt.Errorf("unable to unmarshal json array for tuple: %w", err)
+	return t.UnmarshalJSONWith(data, JSONDecodeOptions{})
+}
+
+// UnmarshalJSONWith unmarshals the tuple from a JSON array using the given decode options.
+// The options are applied to nested tuples as well, including tuples within slices, arrays, maps, pointers and struct fields.
+func (t *T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) UnmarshalJSONWith(data []byte, opts JSONDecodeOptions) error {
+	return unmarshalJSONArray(data, opts, []any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+		&t.V5,
+		&t.V6,
+		&t.V7,
+	})
+}
+
+// MarshalJSONLabeled marshals the tuple into a JSON object, where each of the tuple values is keyed by its matching label.
+// The number of labels must match the number of tuple values.
+func (t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) MarshalJSONLabeled(labels Labels) ([]byte, error) {
+	return marshalJSONLabeled(labels, t.Slice())
+}
+
+// UnmarshalJSONLabeled unmarshals the tuple from a JSON object, where each of the tuple values is keyed by its matching label.
+// The number of labels must match the number of tuple values.
+// Unless the labels are strict, missing keys leave the matching tuple values unchanged, and unknown keys are ignored.
+func (t *T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) UnmarshalJSONLabeled(data []byte, labels Labels) error {
+	return unmarshalJSONLabeled(data, labels, []any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+		&t.V5,
+		&t.V6,
+		&t.V7,
+	})
+}
+
+// MarshalText marshals the tuple into a text of comma separated values.
+// Commas and backslashes within the values are escaped by a backslash.
+// Values implementing encoding.TextMarshaler are marshalled using their MarshalText method.
+// Otherwise, the values must be of boolean, numeric or string kinds.
+func (t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) MarshalText() ([]byte, error) {
+	var values [7]string
+	var err error
+	if values[0], err = marshalTextValue(t.V1); err != nil {
+		return nil, fmt.Errorf("value at index 0 failed to marshal: %w", err)
+	}
+	if values[1], err = marshalTextValue(t.V2); err != nil {
+		return nil, fmt.Errorf("value at index 1 failed to marshal: %w", err)
+	}
+	if values[2], err = marshalTextValue(t.V3); err != nil {
+		return nil, fmt.Errorf("value at index 2 failed to marshal: %w", err)
+	}
+	if values[3], err = marshalTextValue(t.V4); err != nil {
+		return nil, fmt.Errorf("value at index 3 failed to marshal: %w", err)
+	}
+	if values[4], err = marshalTextValue(t.V5); err != nil {
+		return nil, fmt.Errorf("value at index 4 failed to marshal: %w", err)
+	}
+	if values[5], err = marshalTextValue(t.V6); err != nil {
+		return nil, fmt.Errorf("value at index 5 failed to marshal: %w", err)
+	}
+	if values[6], err = marshalTextValue(t.V7); err != nil {
+		return nil, fmt.Errorf("value at index 6 failed to marshal: %w", err)
 	}
 
-	if len(slice) != 7 {
-		return fmt.Errorf("unmarshalled json array length %d must match number of tuple values 7", len(slice))
+	return []byte(joinText(values[:])), nil
+}
+
+// UnmarshalText unmarshals the tuple from a text of comma separated values, as created by MarshalText.
+func (t *T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) UnmarshalText(data []byte) error {
+	values, err := splitText(string(data))
+	if err != nil {
+		return fmt.Errorf("unable to unmarshal text for tuple: %w", err)
+	}
+
+	if len(values) != 7 {
+		return &LengthMismatchError{Source: "unmarshalled text values", Expected: 7, Actual: len(values), count: true}
 	}
-	if err := json.Unmarshal(slice[0], &t.V1); err != nil {
-		return fmt.Errorf("value %q at slice index 0 failed to unmarshal: %w", string(slice[0]), err)
+	if err := unmarshalTextValue(values[0], &t.V1); err != nil {
+		return &ElementUnmarshalError{Index: 0, Value: values[0], Err: err, location: locationIndex}
 	}
 
-	if err := json.Unmarshal(slice[1], &t.V2); err != nil {
-		return fmt.Errorf("value %q at slice index 1 failed to unmarshal: %w", string(slice[1]), err)
+	if err := unmarshalTextValue(values[1], &t.V2); err != nil {
+		return &ElementUnmarshalError{Index: 1, Value: values[1], Err: err, location: locationIndex}
 	}
 
-	if err := json.Unmarshal(slice[2], &t.V3); err != nil {
-		return fmt.Errorf("value %q at slice index 2 failed to unmarshal: %w", string(slice[2]), err)
+	if err := unmarshalTextValue(values[2], &t.V3); err != nil {
+		return &ElementUnmarshalError{Index: 2, Value: values[2], Err: err, location: locationIndex}
 	}
 
-	if err := json.Unmarshal(slice[3], &t.V4); err != nil {
-		return fmt.Errorf("value %q at slice index 3 failed to unmarshal: %w", string(slice[3]), err)
+	if err := unmarshalTextValue(values[3], &t.V4); err != nil {
+		return &ElementUnmarshalError{Index: 3, Value: values[3], Err: err, location: locationIndex}
 	}
 
-	if err := json.Unmarshal(slice[4], &t.V5); err != nil {
-		return fmt.Errorf("value %q at slice index 4 failed to unmarshal: %w", string(slice[4]), err)
+	if err := unmarshalTextValue(values[4], &t.V5); err != nil {
+		return &ElementUnmarshalError{Index: 4, Value: values[4], Err: err, location: locationIndex}
 	}
 
-	if err := json.Unmarshal(slice[5], &t.V6); err != nil {
-		return fmt.Errorf("value %q at slice index 5 failed to unmarshal: %w", string(slice[5]), err)
+	if err := unmarshalTextValue(values[5], &t.V6); err != nil {
+		return &ElementUnmarshalError{Index: 5, Value: values[5], Err: err, location: locationIndex}
 	}
 
-	if err := json.Unmarshal(slice[6], &t.V7); err != nil {
-		return fmt.Errorf("value %q at slice index 6 failed to unmarshal: %w", string(slice[6]), err)
+	if err := unmarshalTextValue(values[6], &t.V7); err != nil {
+		return &ElementUnmarshalError{Index: 6, Value: values[6], Err: err, location: locationIndex}
 	}
 	return nil
 }
+
+// GobEncode encodes the tuple for encoding/gob, implementing the gob.GobEncoder interface.
+// Nil pointer and interface values are preserved as nil, and pointers to zero values are preserved as non-nil.
+// See the package documentation for the wire format.
+func (t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) GobEncode() ([]byte, error) {
+	return gobEncodeValues([]any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+		&t.V5,
+		&t.V6,
+		&t.V7,
+	})
+}
+
+// GobDecode decodes the tuple from encoding/gob, as encoded by GobEncode, implementing the gob.GobDecoder interface.
+// If the number of encoded values doesn't match, a *LengthMismatchError is returned.
+func (t *T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) GobDecode(data []byte) error {
+	return gobDecodeValues(data, []any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+		&t.V5,
+		&t.V6,
+		&t.V7,
+	})
+}
+
+// MarshalComposite marshals the tuple into a PostgreSQL composite literal, such as (1,"foo bar",).
+// Values are quoted and escaped the same way PostgreSQL does, and nil values and pointers are marshalled as NULL.
+// Nested tuples are marshalled as nested composite values, and other values are marshalled the same way as MarshalText does.
+func (t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) MarshalComposite() ([]byte, error) {
+	return marshalComposite(t.Slice())
+}
+
+// UnmarshalComposite unmarshals the tuple from a PostgreSQL composite literal, as created by MarshalComposite or by PostgreSQL.
+// NULL values can only be unmarshalled into pointers, interfaces, slices and maps, which are set to nil.
+// If the number of composite fields doesn't match, a *LengthMismatchError is returned.
+func (t *T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) UnmarshalComposite(data []byte) error {
+	return unmarshalComposite(data, []any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+		&t.V5,
+		&t.V6,
+		&t.V7,
+	})
+}
+
+// MarshalXML marshals the tuple into a sequence of xml child elements named v1 to v7, implementing the xml.Marshaler interface.
+// Nil values are marshalled as empty child elements with a nil="true" attribute,
+// and slice values are marshalled as child elements holding an item element for each of the slice items.
+// Use MarshalXMLLabeled to name the child elements differently.
+func (t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return t.MarshalXMLLabeled(e, start, indexLabels(7))
+}
+
+// UnmarshalXML unmarshals the tuple from a sequence of xml child elements named v1 to v7, implementing the xml.Unmarshaler interface.
+// The number of child elements must match the number of tuple values, the same way as UnmarshalJSON requires.
+func (t *T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return t.UnmarshalXMLLabeled(d, start, indexLabels(7))
+}
+
+// MarshalXMLLabeled marshals the tuple into a sequence of xml child elements, named by the labels matching each of the tuple values.
+// The number of labels must match the number of tuple values.
+func (t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) MarshalXMLLabeled(e *xml.Encoder, start xml.StartElement, labels Labels) error {
+	return marshalXML(e, start, labels, t.Slice())
+}
+
+// UnmarshalXMLLabeled unmarshals the tuple from a sequence of xml child elements, named by the labels matching each of the tuple values.
+// The child elements must be named by the labels in the same order, and their number must match the number of tuple values.
+// If the number of child elements doesn't match, a *LengthMismatchError is returned.
+func (t *T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) UnmarshalXMLLabeled(d *xml.Decoder, start xml.StartElement, labels Labels) error {
+	return unmarshalXML(d, start, labels, []any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+		&t.V5,
+		&t.V6,
+		&t.V7,
+	})
+}
+
+// MarshalBinary marshals the tuple into its binary encoding using little-endian byte order,
+// implementing the encoding.BinaryMarshaler interface.
+// The tuple values must be of boolean, fixed-size numeric, string or byte slice kinds, or nested tuples.
+// See the package documentation for the binary encoding.
+func (t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) MarshalBinary() ([]byte, error) {
+	return t.AppendBinary(nil)
+}
+
+// AppendBinary appends the binary encoding of the tuple to b using little-endian byte order,
+// the same way as MarshalBinary does.
+func (t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) AppendBinary(b []byte) ([]byte, error) {
+	return t.AppendBinaryOrder(b, binary.LittleEndian)
+}
+
+// AppendBinaryOrder appends the binary encoding of the tuple to b using the given byte order.
+// Values of common types are encoded without allocations, other than the ones needed to grow b.
+func (t T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) AppendBinaryOrder(b []byte, order binary.AppendByteOrder) ([]byte, error) {
+	var err error
+	if b, err = appendBinaryValue(b, order, t.V1); err != nil {
+		return nil, fmt.Errorf("value at index 0 failed to marshal: %w", err)
+	}
+	if b, err = appendBinaryValue(b, order, t.V2); err != nil {
+		return nil, fmt.Errorf("value at index 1 failed to marshal: %w", err)
+	}
+	if b, err = appendBinaryValue(b, order, t.V3); err != nil {
+		return nil, fmt.Errorf("value at index 2 failed to marshal: %w", err)
+	}
+	if b, err = appendBinaryValue(b, order, t.V4); err != nil {
+		return nil, fmt.Errorf("value at index 3 failed to marshal: %w", err)
+	}
+	if b, err = appendBinaryValue(b, order, t.V5); err != nil {
+		return nil, fmt.Errorf("value at index 4 failed to marshal: %w", err)
+	}
+	if b, err = appendBinaryValue(b, order, t.V6); err != nil {
+		return nil, fmt.Errorf("value at index 5 failed to marshal: %w", err)
+	}
+	if b, err = appendBinaryValue(b, order, t.V7); err != nil {
+		return nil, fmt.Errorf("value at index 6 failed to marshal: %w", err)
+	}
+
+	return b, nil
+}
+
+// UnmarshalBinary unmarshals the tuple from its binary encoding using little-endian byte order,
+// implementing the encoding.BinaryUnmarshaler interface.
+func (t *T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) UnmarshalBinary(data []byte) error {
+	return t.UnmarshalBinaryOrder(data, binary.LittleEndian)
+}
+
+// UnmarshalBinaryOrder unmarshals the tuple from its binary encoding using the given byte order.
+// The data must hold exactly the binary encoding of the tuple.
+func (t *T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) UnmarshalBinaryOrder(data []byte, order binary.ByteOrder) error {
+	return unmarshalBinary(data, order, t)
+}
+
+// decodeBinary decodes the tuple from the beginning of data using the given byte order, and returns the rest of the data.
+func (t *T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) decodeBinary(data []byte, order binary.ByteOrder) ([]byte, error) {
+	var err error
+	if data, err = decodeBinaryValue(data, order, &t.V1); err != nil {
+		return nil, fmt.Errorf("value at index 0 failed to unmarshal: %w", err)
+	}
+	if data, err = decodeBinaryValue(data, order, &t.V2); err != nil {
+		return nil, fmt.Errorf("value at index 1 failed to unmarshal: %w", err)
+	}
+	if data, err = decodeBinaryValue(data, order, &t.V3); err != nil {
+		return nil, fmt.Errorf("value at index 2 failed to unmarshal: %w", err)
+	}
+	if data, err = decodeBinaryValue(data, order, &t.V4); err != nil {
+		return nil, fmt.Errorf("value at index 3 failed to unmarshal: %w", err)
+	}
+	if data, err = decodeBinaryValue(data, order, &t.V5); err != nil {
+		return nil, fmt.Errorf("value at index 4 failed to unmarshal: %w", err)
+	}
+	if data, err = decodeBinaryValue(data, order, &t.V6); err != nil {
+		return nil, fmt.Errorf("value at index 5 failed to unmarshal: %w", err)
+	}
+	if data, err = decodeBinaryValue(data, order, &t.V7); err != nil {
+		return nil, fmt.Errorf("value at index 6 failed to unmarshal: %w", err)
+	}
+
+	return data, nil
+}
+
+// AppendBinarySlice7 appends the binary encoding of a slice of tuples to b using the given byte order.
+// The slice is encoded as its length followed by the binary encoding of each of the tuples.
+func AppendBinarySlice7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](b []byte, order binary.AppendByteOrder, tuples []T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) ([]byte, error) {
+	return appendBinarySlice(b, order, tuples)
+}
+
+// UnmarshalBinarySlice7 unmarshals a slice of tuples from its binary encoding using the given byte order,
+// as encoded by AppendBinarySlice7, and appends the tuples to dst.
+// Tuples are decoded in place, so decoding into a dst with enough capacity, such as a previously decoded slice truncated to zero length,
+// doesn't allocate unless the tuples hold strings, or byte slices longer than the ones previously held in that capacity.
+func UnmarshalBinarySlice7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7 any](data []byte, order binary.ByteOrder, dst []T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]) ([]T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7], error) {
+	return unmarshalBinarySlice(data, order, dst)
+}
@@ -0,0 +1,88 @@
+//go:build go1.23
+
+package tuple
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestT7_All(t *testing.T) {
+	tup := New7("1", "2", "3", "4", "5", "6", "7")
+
+	var indexes []int
+	var values []any
+	for index, value := range tup.All() {
+		indexes = append(indexes, index)
+		values = append(values, value)
+	}
+
+	require.Equal(t, []int{0, 1, 2, 3, 4, 5, 6}, indexes)
+	require.Equal(t, tup.Slice(), values)
+}
+
+func TestT7_All_Break(t *testing.T) {
+	tup := New7("1", "2", "3", "4", "5", "6", "7")
+
+	var values []any
+	for _, value := range tup.All() {
+		values = append(values, value)
+		break
+	}
+
+	require.Equal(t, []any{"1"}, values)
+}
+
+func TestT7_ZipSeq(t *testing.T) {
+	want := []T7[int, int, int, int, int, int, int]{
+		New7(1, 2, 3, 4, 5, 6, 7),
+		New7(2, 3, 4, 5, 6, 7, 8),
+	}
+
+	require.Equal(t, want, slices.Collect(ZipSeq7(slices.Values([]int{1, 2}), slices.Values([]int{2, 3}), slices.Values([]int{3, 4}), slices.Values([]int{4, 5}), slices.Values([]int{5, 6}), slices.Values([]int{6, 7}), slices.Values([]int{7, 8}))))
+	require.Equal(t, want[:1], slices.Collect(ZipSeq7(slices.Values([]int{1}), slices.Values([]int{2, 3}), slices.Values([]int{3, 4}), slices.Values([]int{4, 5}), slices.Values([]int{5, 6}), slices.Values([]int{6, 7}), slices.Values([]int{7, 8}))))
+	require.Equal(t, want[:1], slices.Collect(ZipSeq7(slices.Values([]int{1, 2}), slices.Values([]int{2}), slices.Values([]int{3, 4}), slices.Values([]int{4, 5}), slices.Values([]int{5, 6}), slices.Values([]int{6, 7}), slices.Values([]int{7, 8}))))
+	require.Equal(t, want[:1], slices.Collect(ZipSeq7(slices.Values([]int{1, 2}), slices.Values([]int{2, 3}), slices.Values([]int{3}), slices.Values([]int{4, 5}), slices.Values([]int{5, 6}), slices.Values([]int{6, 7}), slices.Values([]int{7, 8}))))
+	require.Equal(t, want[:1], slices.Collect(ZipSeq7(slices.Values([]int{1, 2}), slices.Values([]int{2, 3}), slices.Values([]int{3, 4}), slices.Values([]int{4}), slices.Values([]int{5, 6}), slices.Values([]int{6, 7}), slices.Values([]int{7, 8}))))
+	require.Equal(t, want[:1], slices.Collect(ZipSeq7(slices.Values([]int{1, 2}), slices.Values([]int{2, 3}), slices.Values([]int{3, 4}), slices.Values([]int{4, 5}), slices.Values([]int{5}), slices.Values([]int{6, 7}), slices.Values([]int{7, 8}))))
+	require.Equal(t, want[:1], slices.Collect(ZipSeq7(slices.Values([]int{1, 2}), slices.Values([]int{2, 3}), slices.Values([]int{3, 4}), slices.Values([]int{4, 5}), slices.Values([]int{5, 6}), slices.Values([]int{6}), slices.Values([]int{7, 8}))))
+	require.Equal(t, want[:1], slices.Collect(ZipSeq7(slices.Values([]int{1, 2}), slices.Values([]int{2, 3}), slices.Values([]int{3, 4}), slices.Values([]int{4, 5}), slices.Values([]int{5, 6}), slices.Values([]int{6, 7}), slices.Values([]int{7}))))
+}
+
+func TestT7_ZipSeq_Break(t *testing.T) {
+	var got []T7[int, int, int, int, int, int, int]
+	for tup := range ZipSeq7(slices.Values([]int{1, 2}), slices.Values([]int{2, 3}), slices.Values([]int{3, 4}), slices.Values([]int{4, 5}), slices.Values([]int{5, 6}), slices.Values([]int{6, 7}), slices.Values([]int{7, 8})) {
+		got = append(got, tup)
+		break
+	}
+
+	require.Equal(t, []T7[int, int, int, int, int, int, int]{New7(1, 2, 3, 4, 5, 6, 7)}, got)
+}
+
+func TestT7_ReadCSVSeq(t *testing.T) {
+	data := "1,2,3,4,5,6,7\nx,x,x,x,x,x,x\n2,3,4,5,6,7,8\n"
+
+	var got []T7[int, int, int, int, int, int, int]
+	var errs []error
+	for tup, err := range ReadCSVSeq7[int, int, int, int, int, int, int](strings.NewReader(data), CSVOptions{}) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		got = append(got, tup)
+	}
+
+	require.Equal(t, []T7[int, int, int, int, int, int, int]{
+		New7(1, 2, 3, 4, 5, 6, 7),
+		New7(2, 3, 4, 5, 6, 7, 8),
+	}, got)
+	require.Len(t, errs, 1)
+
+	var csvErr *CSVError
+	require.ErrorAs(t, errs[0], &csvErr)
+	require.Equal(t, 2, csvErr.Line)
+	require.Equal(t, 1, csvErr.Column)
+}
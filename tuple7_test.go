@@ -1,7 +1,17 @@
 package tuple
 
 import (
+	"database/sql/driver"
+	"encoding/binary"
 	"encoding/json"
+	"encoding/xml"
+	"hash/maphash"
+	"io"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -37,6 +47,197 @@ func TestT7_Values(t *testing.T) {
 	require.Equal(t, "7", v7)
 }
 
+func TestT7_MapAt(t *testing.T) {
+	tup := New7(1, 2, 3, 4, 5, 6, 7)
+
+	require.Equal(t, New7("1", 2, 3, 4, 5, 6, 7), Map7At1(tup, strconv.Itoa))
+	require.Equal(t, New7(1, "2", 3, 4, 5, 6, 7), Map7At2(tup, strconv.Itoa))
+	require.Equal(t, New7(1, 2, "3", 4, 5, 6, 7), Map7At3(tup, strconv.Itoa))
+	require.Equal(t, New7(1, 2, 3, "4", 5, 6, 7), Map7At4(tup, strconv.Itoa))
+	require.Equal(t, New7(1, 2, 3, 4, "5", 6, 7), Map7At5(tup, strconv.Itoa))
+	require.Equal(t, New7(1, 2, 3, 4, 5, "6", 7), Map7At6(tup, strconv.Itoa))
+	require.Equal(t, New7(1, 2, 3, 4, 5, 6, "7"), Map7At7(tup, strconv.Itoa))
+}
+
+func TestT7_Map(t *testing.T) {
+	tup := New7(1, 2, 3, 4, 5, 6, 7)
+	got := Map7(tup, strconv.Itoa, strconv.Itoa, strconv.Itoa, strconv.Itoa, strconv.Itoa, strconv.Itoa, strconv.Itoa)
+	require.Equal(t, New7("1", "2", "3", "4", "5", "6", "7"), got)
+}
+
+func TestT7_Append(t *testing.T) {
+	tup := New7("1", "2", "3", "4", "5", "6", "7")
+	require.Equal(t, New8("1", "2", "3", "4", "5", "6", "7", "8"), Append7(tup, "8"))
+}
+
+func TestT7_Prepend(t *testing.T) {
+	tup := New7("2", "3", "4", "5", "6", "7", "8")
+	require.Equal(t, New8("1", "2", "3", "4", "5", "6", "7", "8"), Prepend7(tup, "1"))
+}
+
+func TestT7_Concat(t *testing.T) {
+	tup := New7("1", "2", "3", "4", "5", "6", "7")
+
+	require.Equal(t,
+		New8("1", "2", "3", "4", "5", "6", "7", "8"),
+		Concat7_1(tup, New1("8")),
+	)
+	require.Equal(t,
+		New9("1", "2", "3", "4", "5", "6", "7", "8", "9"),
+		Concat7_2(tup, New2("8", "9")),
+	)
+}
+
+func TestT7_Take(t *testing.T) {
+	tup := New7("1", "2", "3", "4", "5", "6", "7")
+
+	require.Equal(t, New1("1"), Take7_1(tup))
+	require.Equal(t, New2("1", "2"), Take7_2(tup))
+	require.Equal(t, New3("1", "2", "3"), Take7_3(tup))
+	require.Equal(t, New4("1", "2", "3", "4"), Take7_4(tup))
+	require.Equal(t, New5("1", "2", "3", "4", "5"), Take7_5(tup))
+	require.Equal(t, New6("1", "2", "3", "4", "5", "6"), Take7_6(tup))
+}
+
+func TestT7_Drop(t *testing.T) {
+	tup := New7("1", "2", "3", "4", "5", "6", "7")
+
+	require.Equal(t, New6("2", "3", "4", "5", "6", "7"), Drop7_1(tup))
+	require.Equal(t, New5("3", "4", "5", "6", "7"), Drop7_2(tup))
+	require.Equal(t, New4("4", "5", "6", "7"), Drop7_3(tup))
+	require.Equal(t, New3("5", "6", "7"), Drop7_4(tup))
+	require.Equal(t, New2("6", "7"), Drop7_5(tup))
+	require.Equal(t, New1("7"), Drop7_6(tup))
+}
+
+func TestT7_Split(t *testing.T) {
+	tup := New7("1", "2", "3", "4", "5", "6", "7")
+
+	require.Equal(t,
+		New2(New1("1"), New6("2", "3", "4", "5", "6", "7")),
+		Split7_1(tup),
+	)
+	require.Equal(t,
+		New2(New2("1", "2"), New5("3", "4", "5", "6", "7")),
+		Split7_2(tup),
+	)
+	require.Equal(t,
+		New2(New3("1", "2", "3"), New4("4", "5", "6", "7")),
+		Split7_3(tup),
+	)
+	require.Equal(t,
+		New2(New4("1", "2", "3", "4"), New3("5", "6", "7")),
+		Split7_4(tup),
+	)
+	require.Equal(t,
+		New2(New5("1", "2", "3", "4", "5"), New2("6", "7")),
+		Split7_5(tup),
+	)
+	require.Equal(t,
+		New2(New6("1", "2", "3", "4", "5", "6"), New1("7")),
+		Split7_6(tup),
+	)
+}
+
+func TestT7_Reverse(t *testing.T) {
+	tup := New7(1, 2, 3, 4, 5, 6, 7)
+	require.Equal(t, New7(7, 6, 5, 4, 3, 2, 1), Reverse7(tup))
+}
+
+func TestT7_Apply(t *testing.T) {
+	tup := New7("1", "2", "3", "4", "5", "6", "7")
+	got := Apply7(tup, func(v1, v2, v3, v4, v5, v6, v7 string) string {
+		return v1 + v2 + v3 + v4 + v5 + v6 + v7
+	})
+	require.Equal(t, "1234567", got)
+}
+
+func TestT7_Tupled(t *testing.T) {
+	tup := New7("1", "2", "3", "4", "5", "6", "7")
+	f := Tupled7(func(v1, v2, v3, v4, v5, v6, v7 string) string {
+		return v1 + v2 + v3 + v4 + v5 + v6 + v7
+	})
+	require.Equal(t, "1234567", f(tup))
+}
+
+func TestT7_Untupled(t *testing.T) {
+	f := Untupled7(func(tup T7[string, string, string, string, string, string, string]) T7[string, string, string, string, string, string, string] {
+		return tup
+	})
+	require.Equal(t, New7("1", "2", "3", "4", "5", "6", "7"), f("1", "2", "3", "4", "5", "6", "7"))
+}
+
+func TestT7_Zip(t *testing.T) {
+	want := []T7[int, int, int, int, int, int, int]{
+		New7(1, 2, 3, 4, 5, 6, 7),
+		New7(2, 3, 4, 5, 6, 7, 8),
+	}
+
+	require.Equal(t, want, Zip7([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8}))
+	require.Equal(t, want[:1], Zip7([]int{1}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8}))
+	require.Equal(t, want[:1], Zip7([]int{1, 2}, []int{2}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8}))
+	require.Equal(t, want[:1], Zip7([]int{1, 2}, []int{2, 3}, []int{3}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8}))
+	require.Equal(t, want[:1], Zip7([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4}, []int{5, 6}, []int{6, 7}, []int{7, 8}))
+	require.Equal(t, want[:1], Zip7([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5}, []int{6, 7}, []int{7, 8}))
+	require.Equal(t, want[:1], Zip7([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6}, []int{7, 8}))
+	require.Equal(t, want[:1], Zip7([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7}))
+}
+
+func TestT7_ZipStrict(t *testing.T) {
+	want := []T7[int, int, int, int, int, int, int]{
+		New7(1, 2, 3, 4, 5, 6, 7),
+		New7(2, 3, 4, 5, 6, 7, 8),
+	}
+
+	got, err := Zip7Strict([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8})
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	_, err = Zip7Strict([]int{1}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8})
+	require.EqualError(t, err, "slice 2 length 2 must match slice 1 length 1")
+	var lengthErr1 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr1)
+	_, err = Zip7Strict([]int{1, 2}, []int{2}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8})
+	require.EqualError(t, err, "slice 2 length 1 must match slice 1 length 2")
+	var lengthErr2 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr2)
+	_, err = Zip7Strict([]int{1, 2}, []int{2, 3}, []int{3}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8})
+	require.EqualError(t, err, "slice 3 length 1 must match slice 1 length 2")
+	var lengthErr3 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr3)
+	_, err = Zip7Strict([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4}, []int{5, 6}, []int{6, 7}, []int{7, 8})
+	require.EqualError(t, err, "slice 4 length 1 must match slice 1 length 2")
+	var lengthErr4 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr4)
+	_, err = Zip7Strict([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5}, []int{6, 7}, []int{7, 8})
+	require.EqualError(t, err, "slice 5 length 1 must match slice 1 length 2")
+	var lengthErr5 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr5)
+	_, err = Zip7Strict([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6}, []int{7, 8})
+	require.EqualError(t, err, "slice 6 length 1 must match slice 1 length 2")
+	var lengthErr6 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr6)
+	_, err = Zip7Strict([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7})
+	require.EqualError(t, err, "slice 7 length 1 must match slice 1 length 2")
+	var lengthErr7 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr7)
+}
+
+func TestT7_Unzip(t *testing.T) {
+	s1, s2, s3, s4, s5, s6, s7 := Unzip7([]T7[int, int, int, int, int, int, int]{
+		New7(1, 2, 3, 4, 5, 6, 7),
+		New7(2, 3, 4, 5, 6, 7, 8),
+	})
+
+	require.Equal(t, []int{1, 2}, s1)
+	require.Equal(t, []int{2, 3}, s2)
+	require.Equal(t, []int{3, 4}, s3)
+	require.Equal(t, []int{4, 5}, s4)
+	require.Equal(t, []int{5, 6}, s5)
+	require.Equal(t, []int{6, 7}, s6)
+	require.Equal(t, []int{7, 8}, s7)
+}
+
 func TestT7_Compare(t *testing.T) {
 	lesser := New7(1, 2, 3, 4, 5, 6, 7)
 	greater := New7(2, 3, 4, 5, 6, 7, 8)
@@ -217,6 +418,173 @@ func TestT7_CompareC(t *testing.T) {
 	}
 }
 
+func TestT7_CmpFunc(t *testing.T) {
+	tups := []T7[int, int, int, int, int, int, int]{
+		New7(2, 3, 4, 5, 6, 7, 8),
+		New7(1, 2, 3, 4, 5, 6, 7),
+		New7(3, 4, 5, 6, 7, 8, 9),
+	}
+
+	cmp := CmpFunc7[int, int, int, int, int, int, int]()
+	slices.SortFunc(tups, cmp)
+	require.Equal(t, []T7[int, int, int, int, int, int, int]{
+		New7(1, 2, 3, 4, 5, 6, 7),
+		New7(2, 3, 4, 5, 6, 7, 8),
+		New7(3, 4, 5, 6, 7, 8, 9),
+	}, tups)
+
+	index, found := slices.BinarySearchFunc(tups, New7(2, 3, 4, 5, 6, 7, 8), cmp)
+	require.True(t, found)
+	require.Equal(t, 1, index)
+}
+
+func TestT7_CmpFuncC(t *testing.T) {
+	tups := []T7[stringComparable, stringComparable, stringComparable, stringComparable, stringComparable, stringComparable, stringComparable]{
+		New7(stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5"), stringComparable("6"), stringComparable("7"), stringComparable("8")),
+		New7(stringComparable("1"), stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5"), stringComparable("6"), stringComparable("7")),
+	}
+
+	slices.SortFunc(tups, CmpFunc7C[stringComparable, stringComparable, stringComparable, stringComparable, stringComparable, stringComparable, stringComparable]())
+	require.Equal(t, []T7[stringComparable, stringComparable, stringComparable, stringComparable, stringComparable, stringComparable, stringComparable]{
+		New7(stringComparable("1"), stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5"), stringComparable("6"), stringComparable("7")),
+		New7(stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5"), stringComparable("6"), stringComparable("7"), stringComparable("8")),
+	}, tups)
+}
+
+func TestT7_Compare_NaN(t *testing.T) {
+	nan := New7(math.NaN(), math.NaN(), math.NaN(), math.NaN(), math.NaN(), math.NaN(), math.NaN())
+	num := New7(1.0, 2.0, 3.0, 4.0, 5.0, 6.0, 7.0)
+
+	require.True(t, Compare7(nan, nan).EQ())
+	require.True(t, Compare7(nan, num).LT())
+	require.True(t, Compare7(num, nan).GT())
+}
+
+func TestT7_Comparator(t *testing.T) {
+	compare := Comparator7(CompareOrdered[int], CompareOrdered[int], CompareOrdered[int], CompareOrdered[int], CompareOrdered[int], CompareOrdered[int], CompareOrdered[int])
+	lesser := New7(1, 2, 3, 4, 5, 6, 7)
+
+	require.Equal(t, 0, compare(lesser, lesser))
+
+	greater1 := New7(2, 2, 3, 4, 5, 6, 7)
+	require.Equal(t, -1, compare(lesser, greater1))
+	require.Equal(t, 1, compare(greater1, lesser))
+
+	greater2 := New7(1, 3, 3, 4, 5, 6, 7)
+	require.Equal(t, -1, compare(lesser, greater2))
+	require.Equal(t, 1, compare(greater2, lesser))
+
+	greater3 := New7(1, 2, 4, 4, 5, 6, 7)
+	require.Equal(t, -1, compare(lesser, greater3))
+	require.Equal(t, 1, compare(greater3, lesser))
+
+	greater4 := New7(1, 2, 3, 5, 5, 6, 7)
+	require.Equal(t, -1, compare(lesser, greater4))
+	require.Equal(t, 1, compare(greater4, lesser))
+
+	greater5 := New7(1, 2, 3, 4, 6, 6, 7)
+	require.Equal(t, -1, compare(lesser, greater5))
+	require.Equal(t, 1, compare(greater5, lesser))
+
+	greater6 := New7(1, 2, 3, 4, 5, 7, 7)
+	require.Equal(t, -1, compare(lesser, greater6))
+	require.Equal(t, 1, compare(greater6, lesser))
+
+	greater7 := New7(1, 2, 3, 4, 5, 6, 8)
+	require.Equal(t, -1, compare(lesser, greater7))
+	require.Equal(t, 1, compare(greater7, lesser))
+}
+
+func TestT7_Comparator_Comparable(t *testing.T) {
+	compare := Comparator7(CompareComparable[stringComparable], CompareComparable[stringComparable], CompareComparable[stringComparable], CompareComparable[stringComparable], CompareComparable[stringComparable], CompareComparable[stringComparable], CompareComparable[stringComparable])
+	lesser := New7(stringComparable("1"), stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5"), stringComparable("6"), stringComparable("7"))
+	greater := New7(stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5"), stringComparable("6"), stringComparable("7"), stringComparable("8"))
+
+	require.Equal(t, -1, compare(lesser, greater))
+	require.Equal(t, 1, compare(greater, lesser))
+	require.Equal(t, 0, compare(lesser, lesser))
+}
+
+func TestT7_Comparator_ShortCircuit(t *testing.T) {
+	unreachable := func(a, b int) int {
+		t.Fatal("comparison function should not be called")
+		return 0
+	}
+
+	compare := Comparator7(CompareOrdered[int], unreachable, unreachable, unreachable, unreachable, unreachable, unreachable)
+	require.Equal(t, -1, compare(New7(1, 2, 3, 4, 5, 6, 7), New7(2, 3, 4, 5, 6, 7, 8)))
+}
+
+func TestT7_OrderBy(t *testing.T) {
+	lesser := New7(1, 2, 3, 4, 5, 6, 7)
+	asc := OrderBy7[int, int, int, int, int, int, int](Asc, Asc, Asc, Asc, Asc, Asc, Asc)
+
+	greater1 := New7(2, 2, 3, 4, 5, 6, 7)
+	desc1 := OrderBy7[int, int, int, int, int, int, int](Desc, Asc, Asc, Asc, Asc, Asc, Asc)
+	require.Equal(t, -1, asc(lesser, greater1))
+	require.Equal(t, 1, desc1(lesser, greater1))
+	require.Equal(t, 0, desc1(lesser, lesser))
+
+	greater2 := New7(1, 3, 3, 4, 5, 6, 7)
+	desc2 := OrderBy7[int, int, int, int, int, int, int](Asc, Desc, Asc, Asc, Asc, Asc, Asc)
+	require.Equal(t, -1, asc(lesser, greater2))
+	require.Equal(t, 1, desc2(lesser, greater2))
+	require.Equal(t, 0, desc2(lesser, lesser))
+
+	greater3 := New7(1, 2, 4, 4, 5, 6, 7)
+	desc3 := OrderBy7[int, int, int, int, int, int, int](Asc, Asc, Desc, Asc, Asc, Asc, Asc)
+	require.Equal(t, -1, asc(lesser, greater3))
+	require.Equal(t, 1, desc3(lesser, greater3))
+	require.Equal(t, 0, desc3(lesser, lesser))
+
+	greater4 := New7(1, 2, 3, 5, 5, 6, 7)
+	desc4 := OrderBy7[int, int, int, int, int, int, int](Asc, Asc, Asc, Desc, Asc, Asc, Asc)
+	require.Equal(t, -1, asc(lesser, greater4))
+	require.Equal(t, 1, desc4(lesser, greater4))
+	require.Equal(t, 0, desc4(lesser, lesser))
+
+	greater5 := New7(1, 2, 3, 4, 6, 6, 7)
+	desc5 := OrderBy7[int, int, int, int, int, int, int](Asc, Asc, Asc, Asc, Desc, Asc, Asc)
+	require.Equal(t, -1, asc(lesser, greater5))
+	require.Equal(t, 1, desc5(lesser, greater5))
+	require.Equal(t, 0, desc5(lesser, lesser))
+
+	greater6 := New7(1, 2, 3, 4, 5, 7, 7)
+	desc6 := OrderBy7[int, int, int, int, int, int, int](Asc, Asc, Asc, Asc, Asc, Desc, Asc)
+	require.Equal(t, -1, asc(lesser, greater6))
+	require.Equal(t, 1, desc6(lesser, greater6))
+	require.Equal(t, 0, desc6(lesser, lesser))
+
+	greater7 := New7(1, 2, 3, 4, 5, 6, 8)
+	desc7 := OrderBy7[int, int, int, int, int, int, int](Asc, Asc, Asc, Asc, Asc, Asc, Desc)
+	require.Equal(t, -1, asc(lesser, greater7))
+	require.Equal(t, 1, desc7(lesser, greater7))
+	require.Equal(t, 0, desc7(lesser, lesser))
+}
+
+func TestT7_Comparator_OrderedPtrBy(t *testing.T) {
+	compare := Comparator7(
+		OrderedBy[int](Asc),
+		OrderedBy[int](Asc),
+		OrderedBy[int](Asc),
+		OrderedBy[int](Asc),
+		OrderedBy[int](Asc),
+		OrderedBy[int](Asc),
+		OrderedPtrBy[int](Desc.NilsFirst()),
+	)
+
+	tups := []T7[int, int, int, int, int, int, *int]{
+		New7(1, 2, 3, 4, 5, 6, ptr(1)),
+		New7(1, 2, 3, 4, 5, 6, ptr(2)),
+		New7(1, 2, 3, 4, 5, 6, (*int)(nil)),
+	}
+	slices.SortFunc(tups, compare)
+
+	require.Nil(t, tups[0].V7)
+	require.Equal(t, 2, *tups[1].V7)
+	require.Equal(t, 1, *tups[2].V7)
+}
+
 func TestT7_EqualE(t *testing.T) {
 	a := New7(intEqualable(1), intEqualable(2), intEqualable(3), intEqualable(4), intEqualable(5), intEqualable(6), intEqualable(7))
 	b := New7(intEqualable(2), intEqualable(3), intEqualable(4), intEqualable(5), intEqualable(6), intEqualable(7), intEqualable(8))
@@ -225,6 +593,42 @@ func TestT7_EqualE(t *testing.T) {
 	require.True(t, Equal7E(a, a))
 }
 
+func TestT7_EqualFunc(t *testing.T) {
+	a := New7([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7})
+	b := New7([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7})
+
+	require.True(t, EqualFunc7(a, b, slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc7(a, New7([]int{2}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc7(a, New7([]int{1}, []int{3}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc7(a, New7([]int{1}, []int{2}, []int{4}, []int{4}, []int{5}, []int{6}, []int{7}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc7(a, New7([]int{1}, []int{2}, []int{3}, []int{5}, []int{5}, []int{6}, []int{7}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc7(a, New7([]int{1}, []int{2}, []int{3}, []int{4}, []int{6}, []int{6}, []int{7}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc7(a, New7([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{7}, []int{7}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc7(a, New7([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{8}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+}
+
+func TestT7_EqualMethod(t *testing.T) {
+	a := New7([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7})
+
+	require.True(t, a.Equal(New7([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7})))
+	require.False(t, a.Equal(New7([]int{2}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7})))
+	require.False(t, a.Equal(New7([]int{1}, []int{3}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7})))
+	require.False(t, a.Equal(New7([]int{1}, []int{2}, []int{4}, []int{4}, []int{5}, []int{6}, []int{7})))
+	require.False(t, a.Equal(New7([]int{1}, []int{2}, []int{3}, []int{5}, []int{5}, []int{6}, []int{7})))
+	require.False(t, a.Equal(New7([]int{1}, []int{2}, []int{3}, []int{4}, []int{6}, []int{6}, []int{7})))
+	require.False(t, a.Equal(New7([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{7}, []int{7})))
+	require.False(t, a.Equal(New7([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{8})))
+}
+
+func TestT7_EqualMethod_Nested(t *testing.T) {
+	a := New7(New1(intEqualable(1)), New1(intEqualable(2)), New1(intEqualable(3)), New1(intEqualable(4)), New1(intEqualable(5)), New1(intEqualable(6)), New1(intEqualable(7)))
+	b := New7(New1(intEqualable(2)), New1(intEqualable(3)), New1(intEqualable(4)), New1(intEqualable(5)), New1(intEqualable(6)), New1(intEqualable(7)), New1(intEqualable(8)))
+
+	require.True(t, a.Equal(a))
+	require.False(t, a.Equal(b))
+	require.True(t, Equal7E(a, a))
+}
+
 func TestT7_String(t *testing.T) {
 	tup := New7("1", "2", "3", "4", "5", "6", "7")
 	require.Equal(t, `["1" "2" "3" "4" "5" "6" "7"]`, tup.String())
@@ -235,6 +639,31 @@ func TestT7_GoString(t *testing.T) {
 	require.Equal(t, `tuple.T7[string, string, string, string, string, string, string]{V1: "1", V2: "2", V3: "3", V4: "4", V5: "5", V6: "6", V7: "7"}`, tup.GoString())
 }
 
+func TestT7_Parse(t *testing.T) {
+	tup := New7("1", "2", "3", "4", "5", "6", "7")
+
+	got, err := Parse7[string, string, string, string, string, string, string](tup.String())
+	require.NoError(t, err)
+	require.Equal(t, tup, got)
+}
+
+func TestT7_Parse_Mixed(t *testing.T) {
+	tup := New7(New2("1", 1.5), New2("2", 2.5), New2("3", 3.5), New2("4", 4.5), New2("5", 5.5), New2("6", 6.5), New2("7", 7.5))
+
+	got, err := Parse7[T2[string, float64], T2[string, float64], T2[string, float64], T2[string, float64], T2[string, float64], T2[string, float64], T2[string, float64]](tup.String())
+	require.NoError(t, err)
+	require.Equal(t, tup, got)
+}
+
+func TestT7_Parse_Invalid(t *testing.T) {
+	_, err := Parse7[int, int, int, int, int, int, int](`["1" "2" "3" "4" "5" "6" "7"]`)
+	require.Error(t, err)
+
+	var parseErr *ParseError
+	require.ErrorAs(t, err, &parseErr)
+	require.Equal(t, 1, parseErr.Offset)
+}
+
 func TestT7_ToArray(t *testing.T) {
 	tup := New7("1", "2", "3", "4", "5", "6", "7")
 	require.Equal(t, [7]any{
@@ -581,141 +1010,1200 @@ func TestT7_FromSlice(t *testing.T) {
 	}
 }
 
-func TestT7_MarshalJSON(t *testing.T) {
-	tup := New7("1", "2", "3", "4", "5", "6", "7")
+func TestT7_FromSlice_Errors(t *testing.T) {
+	_, err := FromSlice7[string, string, string, string, string, string, string]([]any{})
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, &LengthMismatchError{Source: "slice", Expected: 7, Actual: 0}, lengthErr)
+	require.EqualError(t, err, "slice length 0 must match number of tuple values 7")
+
+	_, err = FromSlice7[string, string, string, string, string, string, string]([]any{1, "2", "3", "4", "5", "6", "7"})
+	var typeErr1 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr1)
+	require.Equal(t, 0, typeErr1.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr1.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr1.Actual)
+	require.EqualError(t, err, "value at slice index 0 expected to have type string but has type int")
+
+	_, err = FromSlice7[string, string, string, string, string, string, string]([]any{"1", 2, "3", "4", "5", "6", "7"})
+	var typeErr2 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr2)
+	require.Equal(t, 1, typeErr2.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr2.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr2.Actual)
+	require.EqualError(t, err, "value at slice index 1 expected to have type string but has type int")
+
+	_, err = FromSlice7[string, string, string, string, string, string, string]([]any{"1", "2", 3, "4", "5", "6", "7"})
+	var typeErr3 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr3)
+	require.Equal(t, 2, typeErr3.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr3.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr3.Actual)
+	require.EqualError(t, err, "value at slice index 2 expected to have type string but has type int")
+
+	_, err = FromSlice7[string, string, string, string, string, string, string]([]any{"1", "2", "3", 4, "5", "6", "7"})
+	var typeErr4 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr4)
+	require.Equal(t, 3, typeErr4.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr4.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr4.Actual)
+	require.EqualError(t, err, "value at slice index 3 expected to have type string but has type int")
+
+	_, err = FromSlice7[string, string, string, string, string, string, string]([]any{"1", "2", "3", "4", 5, "6", "7"})
+	var typeErr5 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr5)
+	require.Equal(t, 4, typeErr5.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr5.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr5.Actual)
+	require.EqualError(t, err, "value at slice index 4 expected to have type string but has type int")
+
+	_, err = FromSlice7[string, string, string, string, string, string, string]([]any{"1", "2", "3", "4", "5", 6, "7"})
+	var typeErr6 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr6)
+	require.Equal(t, 5, typeErr6.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr6.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr6.Actual)
+	require.EqualError(t, err, "value at slice index 5 expected to have type string but has type int")
+
+	_, err = FromSlice7[string, string, string, string, string, string, string]([]any{"1", "2", "3", "4", "5", "6", 7})
+	var typeErr7 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr7)
+	require.Equal(t, 6, typeErr7.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr7.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr7.Actual)
+	require.EqualError(t, err, "value at slice index 6 expected to have type string but has type int")
+
+	_, err = FromArray7[string, string, string, string, string, string, string]([7]any{})
+	var arrayTypeErr *ElementTypeError
+	require.ErrorAs(t, err, &arrayTypeErr)
+	require.Equal(t, &ElementTypeError{Source: "array", Index: 0, Expected: reflect.TypeOf("")}, arrayTypeErr)
+	require.EqualError(t, err, "value at array index 0 expected to have type string but has type <nil>")
+}
 
-	got, err := json.Marshal(tup)
-	require.NoError(t, err)
-	require.Equal(t, got, []byte(`["1","2","3","4","5","6","7"]`))
+func TestT7_UnmarshalJSON_Errors(t *testing.T) {
+	var tup T7[string, string, string, string, string, string, string]
+
+	err := json.Unmarshal([]byte(`[]`), &tup)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, 7, lengthErr.Expected)
+	require.Equal(t, 0, lengthErr.Actual)
+	require.EqualError(t, err, "unmarshalled json array length 0 must match number of tuple values 7")
+
+	err = tup.UnmarshalJSON([]byte(`["1","2","3","4","5","6",7]`))
+	var elementErr *ElementUnmarshalError
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, 6, elementErr.Index)
+	require.Equal(t, "7", elementErr.Value)
+	var typeErr *json.UnmarshalTypeError
+	require.ErrorAs(t, err, &typeErr)
+	require.ErrorContains(t, err, `value "7" at slice index 6 failed to unmarshal: `)
 }
 
-func TestT7_UnmarshalJSON(t *testing.T) {
+func TestT7_ConvertSlice(t *testing.T) {
 	tests := []struct {
 		name    string
-		data    []byte
-		want    T7[string, string, string, string, string, string, string]
+		slice   []any
+		want    T7[int, int, int, int, int, int, int]
 		wantErr bool
 	}{
 		{
-			name:    "nil data",
-			data:    nil,
+			name:  "all types match",
+			slice: []any{1, 2, 3, 4, 5, 6, 7},
+			want:  New7(1, 2, 3, 4, 5, 6, 7),
+		},
+		{
+			name:  "decoded json numbers",
+			slice: []any{float64(1), float64(2), float64(3), float64(4), float64(5), float64(6), float64(7)},
+			want:  New7(1, 2, 3, 4, 5, 6, 7),
+		},
+		{
+			name:  "json numbers",
+			slice: []any{json.Number("1"), json.Number("2"), json.Number("3"), json.Number("4"), json.Number("5"), json.Number("6"), json.Number("7")},
+			want:  New7(1, 2, 3, 4, 5, 6, 7),
+		},
+		{
+			name:    "slice empty",
+			slice:   []any{},
 			wantErr: true,
 		},
 		{
-			name:    "empty data",
-			data:    []byte{},
+			name:    "index 1 bad type",
+			slice:   []any{"1", 2, 3, 4, 5, 6, 7},
 			wantErr: true,
 		},
 		{
-			name:    "string data",
-			data:    []byte(`"hi"`),
+			name:    "index 1 fractional number",
+			slice:   []any{1.5, 2, 3, 4, 5, 6, 7},
 			wantErr: true,
 		},
 		{
-			name:    "empty json array",
-			data:    []byte(`[]`),
+			name:    "index 2 bad type",
+			slice:   []any{1, "2", 3, 4, 5, 6, 7},
 			wantErr: true,
 		},
 		{
-			name:    "longer json array",
-			data:    []byte(`["1", "2", "3", "4", "5", "6", "7", "8", "9", "10"]`),
+			name:    "index 2 fractional number",
+			slice:   []any{1, 2.5, 3, 4, 5, 6, 7},
 			wantErr: true,
 		},
-
 		{
-			name:    "json array with invalid type at index 0",
-			data:    []byte(`[1,"2","3","4","5","6","7"]`),
+			name:    "index 3 bad type",
+			slice:   []any{1, 2, "3", 4, 5, 6, 7},
 			wantErr: true,
 		},
 		{
-			name:    "json array with invalid type at index 1",
-			data:    []byte(`["1",2,"3","4","5","6","7"]`),
+			name:    "index 3 fractional number",
+			slice:   []any{1, 2, 3.5, 4, 5, 6, 7},
 			wantErr: true,
 		},
 		{
-			name:    "json array with invalid type at index 2",
-			data:    []byte(`["1","2",3,"4","5","6","7"]`),
+			name:    "index 4 bad type",
+			slice:   []any{1, 2, 3, "4", 5, 6, 7},
 			wantErr: true,
 		},
 		{
-			name:    "json array with invalid type at index 3",
-			data:    []byte(`["1","2","3",4,"5","6","7"]`),
+			name:    "index 4 fractional number",
+			slice:   []any{1, 2, 3, 4.5, 5, 6, 7},
 			wantErr: true,
 		},
 		{
-			name:    "json array with invalid type at index 4",
-			data:    []byte(`["1","2","3","4",5,"6","7"]`),
+			name:    "index 5 bad type",
+			slice:   []any{1, 2, 3, 4, "5", 6, 7},
 			wantErr: true,
 		},
 		{
-			name:    "json array with invalid type at index 5",
-			data:    []byte(`["1","2","3","4","5",6,"7"]`),
+			name:    "index 5 fractional number",
+			slice:   []any{1, 2, 3, 4, 5.5, 6, 7},
 			wantErr: true,
 		},
 		{
-			name:    "json array with invalid type at index 6",
-			data:    []byte(`["1","2","3","4","5","6",7]`),
+			name:    "index 6 bad type",
+			slice:   []any{1, 2, 3, 4, 5, "6", 7},
 			wantErr: true,
 		},
 		{
-			name:    "json array of valid types",
-			data:    []byte(`["1","2","3","4","5","6","7"]`),
-			want:    New7("1", "2", "3", "4", "5", "6", "7"),
-			wantErr: false,
+			name:    "index 6 fractional number",
+			slice:   []any{1, 2, 3, 4, 5, 6.5, 7},
+			wantErr: true,
 		},
 		{
-			name:    "json object of valid types",
-			data:    []byte(`{"V1": "1","V2": "2","V3": "3","V4": "4","V5": "5","V6": "6","V7": "7"}`),
+			name:    "index 7 bad type",
+			slice:   []any{1, 2, 3, 4, 5, 6, "7"},
+			wantErr: true,
+		},
+		{
+			name:    "index 7 fractional number",
+			slice:   []any{1, 2, 3, 4, 5, 6, 7.5},
 			wantErr: true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			var got T7[string, string, string, string, string, string, string]
-			err := json.Unmarshal(tt.data, &got)
+			tup, err := ConvertSlice7[int, int, int, int, int, int, int](tt.slice)
 			if tt.wantErr {
 				require.Error(t, err)
 				return
 			}
 
 			require.NoError(t, err)
-			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.want, tup)
 		})
 	}
 }
 
-func TestT7_Unmarshal_CustomStruct(t *testing.T) {
-	type Custom struct {
-		Name string `json:"name"`
-		Age  int    `json:"age"`
-	}
+func TestT7_ConvertSlice_Errors(t *testing.T) {
+	_, err := ConvertSlice7[int8, int8, int8, int8, int8, int8, int8]([]any{})
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+
+	_, err = ConvertSlice7[int8, int8, int8, int8, int8, int8, int8]([]any{1, 2, 3, 4, 5, 6, true})
+	var typeErr *ElementTypeError
+	require.ErrorAs(t, err, &typeErr)
+	require.Equal(t, 6, typeErr.Index)
+
+	_, err = ConvertSlice7[int8, int8, int8, int8, int8, int8, int8]([]any{1, 2, 3, 4, 5, 6, float64(128)})
+	var conversionErr *ElementConversionError
+	require.ErrorAs(t, err, &conversionErr)
+	require.Equal(t, 6, conversionErr.Index)
+	require.Equal(t, reflect.TypeOf(int8(0)), conversionErr.Expected)
+}
 
-	want := New7(Custom{Name: "1", Age: 1}, Custom{Name: "2", Age: 2}, Custom{Name: "3", Age: 3}, Custom{Name: "4", Age: 4}, Custom{Name: "5", Age: 5}, Custom{Name: "6", Age: 6}, Custom{Name: "7", Age: 7})
-	var got T7[Custom, Custom, Custom, Custom, Custom, Custom, Custom]
-	err := json.Unmarshal([]byte(`[
-		{ "name": "1", "age": 1 },
-		{ "name": "2", "age": 2 },
-		{ "name": "3", "age": 3 },
-		{ "name": "4", "age": 4 },
-		{ "name": "5", "age": 5 },
-		{ "name": "6", "age": 6 },
-		{ "name": "7", "age": 7 }
-	]`), &got)
+func TestT7_ScanRow(t *testing.T) {
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{"c1", "c2", "c3", "c4", "c5", "c6", "c7"},
+		rows: [][]driver.Value{
+			{int64(1), int64(2), int64(3), int64(4), int64(5), int64(6), int64(7)},
+		},
+	})
 
+	require.True(t, rows.Next())
+	got, err := ScanRow7[string, int, int, int, int, int, int](rows)
 	require.NoError(t, err)
-	require.Equal(t, want, got)
+	require.Equal(t, New7("1", 2, 3, 4, 5, 6, 7), got)
 }
 
-func TestT7_Marshal_Unmarshal(t *testing.T) {
-	tup := New7("1", "2", "3", "4", "5", "6", "7")
+func TestT7_ScanRow_ColumnsMismatch(t *testing.T) {
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{"c1", "c2", "c3", "c4", "c5", "c6", "c7", "extra"},
+		rows: [][]driver.Value{
+			{int64(1), int64(2), int64(3), int64(4), int64(5), int64(6), int64(7), int64(0)},
+		},
+	})
 
-	marshalled, err := json.Marshal(tup)
+	require.True(t, rows.Next())
+	_, err := ScanRow7[int, int, int, int, int, int, int](rows)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.EqualError(t, err, "row columns count 8 must match number of tuple values 7")
+}
+
+func TestT7_CollectRows(t *testing.T) {
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{"c1", "c2", "c3", "c4", "c5", "c6", "c7"},
+		rows: [][]driver.Value{
+			{int64(1), int64(2), int64(3), int64(4), int64(5), int64(6), int64(7)},
+			{int64(2), int64(3), int64(4), int64(5), int64(6), int64(7), int64(8)},
+		},
+	})
+
+	got, err := CollectRows7[int, int, int, int, int, int, int](rows)
 	require.NoError(t, err)
+	require.Equal(t, []T7[int, int, int, int, int, int, int]{
+		New7(1, 2, 3, 4, 5, 6, 7),
+		New7(2, 3, 4, 5, 6, 7, 8),
+	}, got)
 
-	var unmarshalled T7[string, string, string, string, string, string, string]
-	err = json.Unmarshal(marshalled, &unmarshalled)
+	rows = fakeQuery(t, fakeResult{columns: []string{"c1", "c2", "c3", "c4", "c5", "c6", "c7"}})
+	got, err = CollectRows7[int, int, int, int, int, int, int](rows)
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
+
+func TestT7_ReadCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		opts    CSVOptions
+		want    []T7[int, int, int, int, int, int, int]
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			data: "",
+			want: nil,
+		},
+		{
+			name: "records",
+			data: "1,2,3,4,5,6,7\n2,3,4,5,6,7,8\n",
+			want: []T7[int, int, int, int, int, int, int]{
+				New7(1, 2, 3, 4, 5, 6, 7),
+				New7(2, 3, 4, 5, 6, 7, 8),
+			},
+		},
+		{
+			name: "header",
+			data: "v1,v2,v3,v4,v5,v6,v7\n1,2,3,4,5,6,7\n",
+			opts: CSVOptions{Header: []string{"v1", "v2", "v3", "v4", "v5", "v6", "v7"}},
+			want: []T7[int, int, int, int, int, int, int]{New7(1, 2, 3, 4, 5, 6, 7)},
+		},
+		{
+			name: "skip header",
+			data: "c1,c2,c3,c4,c5,c6,c7\n1,2,3,4,5,6,7\n",
+			opts: CSVOptions{SkipHeader: true},
+			want: []T7[int, int, int, int, int, int, int]{New7(1, 2, 3, 4, 5, 6, 7)},
+		},
+		{
+			name:    "mismatching header",
+			data:    "c1,c2,c3,c4,c5,c6,c7\n1,2,3,4,5,6,7\n",
+			opts:    CSVOptions{Header: []string{"v1", "v2", "v3", "v4", "v5", "v6", "v7"}},
+			wantErr: true,
+		},
+		{
+			name: "semicolon delimiter",
+			data: "1;2;3;4;5;6;7\n",
+			opts: CSVOptions{Comma: ';'},
+			want: []T7[int, int, int, int, int, int, int]{New7(1, 2, 3, 4, 5, 6, 7)},
+		},
+		{
+			name:    "too many fields",
+			data:    "1,2,3,4,5,6,7,0\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid field",
+			data:    "1,2,3,4,5,6,x\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadCSV7[int, int, int, int, int, int, int](strings.NewReader(tt.data), tt.opts)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
 
+func TestT7_ReadCSV_Error(t *testing.T) {
+	data := "1,2,3,4,5,6,7\n1,2,3,4,5,6,x\n"
+
+	_, err := ReadCSV7[int, int, int, int, int, int, int](strings.NewReader(data), CSVOptions{})
+	var csvErr *CSVError
+	require.ErrorAs(t, err, &csvErr)
+	require.Equal(t, 2, csvErr.Line)
+	require.Equal(t, 7, csvErr.Column)
+
+	var elementErr *ElementUnmarshalError
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, "x", elementErr.Value)
+}
+
+func TestT7_WriteCSV(t *testing.T) {
+	tups := []T7[string, string, string, string, string, string, string]{
+		New7("1", "2", "3", "4", "5", "6", "7"),
+		New7("1,\"1\"", "2,\"2\"", "3,\"3\"", "4,\"4\"", "5,\"5\"", "6,\"6\"", "7,\"7\""),
+	}
+
+	var buf strings.Builder
+	err := WriteCSV7(&buf, tups, CSVOptions{Header: []string{"v1", "v2", "v3", "v4", "v5", "v6", "v7"}})
 	require.NoError(t, err)
-	require.Equal(t, tup, unmarshalled)
+	require.Equal(t, "v1,v2,v3,v4,v5,v6,v7\n1,2,3,4,5,6,7\n\"1,\"\"1\"\"\",\"2,\"\"2\"\"\",\"3,\"\"3\"\"\",\"4,\"\"4\"\"\",\"5,\"\"5\"\"\",\"6,\"\"6\"\"\",\"7,\"\"7\"\"\"\n", buf.String())
+
+	got, err := ReadCSV7[string, string, string, string, string, string, string](strings.NewReader(buf.String()), CSVOptions{SkipHeader: true})
+	require.NoError(t, err)
+	require.Equal(t, tups, got)
+}
+
+func TestT7_WriteCSV_Error(t *testing.T) {
+	tups := []T7[string, string, string, string, string, string, []int]{
+		{V1: "a\nb", V2: "a\nb", V3: "a\nb", V4: "a\nb", V5: "a\nb", V6: "a\nb", V7: []int{7}},
+	}
+
+	var buf strings.Builder
+	err := WriteCSV7(&buf, tups, CSVOptions{Header: []string{"v1", "v2", "v3", "v4", "v5", "v6", "v7"}})
+	var csvErr *CSVError
+	require.ErrorAs(t, err, &csvErr)
+	require.Equal(t, 2, csvErr.Line)
+	require.Equal(t, 7, csvErr.Column)
+
+	err = WriteCSV7(&buf, tups, CSVOptions{Header: []string{"v1", "v2", "v3", "v4", "v5", "v6", "v7", "extra"}})
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+}
+
+func TestT7_Hash(t *testing.T) {
+	seed := maphash.MakeSeed()
+	tup := New7("1", "2", "3", "4", "5", "6", "7")
+
+	require.Equal(t, tup.Hash(seed), New7("1", "2", "3", "4", "5", "6", "7").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New7("2", "2", "3", "4", "5", "6", "7").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New7("1", "3", "3", "4", "5", "6", "7").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New7("1", "2", "4", "4", "5", "6", "7").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New7("1", "2", "3", "5", "5", "6", "7").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New7("1", "2", "3", "4", "6", "6", "7").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New7("1", "2", "3", "4", "5", "7", "7").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New7("1", "2", "3", "4", "5", "6", "8").Hash(seed))
+}
+
+func TestT7_Hash_Nested(t *testing.T) {
+	seed := maphash.MakeSeed()
+	tup := New7(New2("1", 1), New2("2", 2), New2("3", 3), New2("4", 4), New2("5", 5), New2("6", 6), New2("7", 7))
+
+	require.Equal(t, tup.Hash(seed), New7(New2("1", 1), New2("2", 2), New2("3", 3), New2("4", 4), New2("5", 5), New2("6", 6), New2("7", 7)).Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New7(New2("1", 2), New2("2", 3), New2("3", 4), New2("4", 5), New2("5", 6), New2("6", 7), New2("7", 8)).Hash(seed))
+}
+
+func TestT7_Hash_Unsupported(t *testing.T) {
+	seed := maphash.MakeSeed()
+	tup := New7([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7})
+	require.NotPanics(t, func() {
+		tup.Hash(seed)
+	})
+	require.Equal(t, tup.Hash(seed), New7([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}).Hash(seed))
+
+	var nilTup T7[any, any, any, any, any, any, any]
+	require.NotPanics(t, func() {
+		nilTup.Hash(seed)
+	})
+	require.Panics(t, func() {
+		HashValue(seed, tup.V1)
+	})
+}
+
+func TestT7_HashFunc(t *testing.T) {
+	seed := maphash.MakeSeed()
+	hashSlice := func(seed maphash.Seed, v []int) uint64 {
+		return HashValue(seed, len(v))
+	}
+
+	hash := HashFunc7(hashSlice, hashSlice, hashSlice, hashSlice, hashSlice, hashSlice, hashSlice)
+	tup := New7([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7})
+
+	require.Equal(t, hash(seed, tup), hash(seed, New7([]int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8})))
+	require.NotEqual(t, hash(seed, tup), hash(seed, New7([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8})))
+}
+
+func TestT7_MarshalJSON(t *testing.T) {
+	tup := New7("1", "2", "3", "4", "5", "6", "7")
+
+	got, err := json.Marshal(tup)
+	require.NoError(t, err)
+	require.Equal(t, got, []byte(`["1","2","3","4","5","6","7"]`))
+}
+
+func TestT7_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		want    T7[string, string, string, string, string, string, string]
+		wantErr bool
+	}{
+		{
+			name:    "nil data",
+			data:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "empty data",
+			data:    []byte{},
+			wantErr: true,
+		},
+		{
+			name:    "string data",
+			data:    []byte(`"hi"`),
+			wantErr: true,
+		},
+		{
+			name:    "empty json array",
+			data:    []byte(`[]`),
+			wantErr: true,
+		},
+		{
+			name:    "longer json array",
+			data:    []byte(`["1", "2", "3", "4", "5", "6", "7", "8", "9", "10"]`),
+			wantErr: true,
+		},
+
+		{
+			name:    "json array with invalid type at index 0",
+			data:    []byte(`[1,"2","3","4","5","6","7"]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array with invalid type at index 1",
+			data:    []byte(`["1",2,"3","4","5","6","7"]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array with invalid type at index 2",
+			data:    []byte(`["1","2",3,"4","5","6","7"]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array with invalid type at index 3",
+			data:    []byte(`["1","2","3",4,"5","6","7"]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array with invalid type at index 4",
+			data:    []byte(`["1","2","3","4",5,"6","7"]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array with invalid type at index 5",
+			data:    []byte(`["1","2","3","4","5",6,"7"]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array with invalid type at index 6",
+			data:    []byte(`["1","2","3","4","5","6",7]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array of valid types",
+			data:    []byte(`["1","2","3","4","5","6","7"]`),
+			want:    New7("1", "2", "3", "4", "5", "6", "7"),
+			wantErr: false,
+		},
+		{
+			name:    "json object of valid types",
+			data:    []byte(`{"V1": "1","V2": "2","V3": "3","V4": "4","V5": "5","V6": "6","V7": "7"}`),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T7[string, string, string, string, string, string, string]
+			err := json.Unmarshal(tt.data, &got)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT7_Unmarshal_CustomStruct(t *testing.T) {
+	type Custom struct {
+		Name string `json:"name"`
+		Age  int    `json:"age"`
+	}
+
+	want := New7(Custom{Name: "1", Age: 1}, Custom{Name: "2", Age: 2}, Custom{Name: "3", Age: 3}, Custom{Name: "4", Age: 4}, Custom{Name: "5", Age: 5}, Custom{Name: "6", Age: 6}, Custom{Name: "7", Age: 7})
+	var got T7[Custom, Custom, Custom, Custom, Custom, Custom, Custom]
+	err := json.Unmarshal([]byte(`[
+		{ "name": "1", "age": 1 },
+		{ "name": "2", "age": 2 },
+		{ "name": "3", "age": 3 },
+		{ "name": "4", "age": 4 },
+		{ "name": "5", "age": 5 },
+		{ "name": "6", "age": 6 },
+		{ "name": "7", "age": 7 }
+	]`), &got)
+
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+func TestT7_Marshal_Unmarshal(t *testing.T) {
+	tup := New7("1", "2", "3", "4", "5", "6", "7")
+
+	marshalled, err := json.Marshal(tup)
+	require.NoError(t, err)
+
+	var unmarshalled T7[string, string, string, string, string, string, string]
+	err = json.Unmarshal(marshalled, &unmarshalled)
+
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT7_UnmarshalJSONWith(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		opts    JSONDecodeOptions
+		want    T7[string, string, string, string, string, string, string]
+		wantErr bool
+	}{
+		{
+			name:    "empty json array",
+			data:    `[]`,
+			wantErr: true,
+		},
+		{
+			name: "allow shorter empty json array",
+			data: `[]`,
+			opts: JSONDecodeOptions{AllowShorter: true},
+			want: T7[string, string, string, string, string, string, string]{},
+		},
+		{
+			name: "allow shorter partial json array",
+			data: `["1"]`,
+			opts: JSONDecodeOptions{AllowShorter: true},
+			want: T7[string, string, string, string, string, string, string]{V1: "1"},
+		},
+		{
+			name:    "allow shorter longer json array",
+			data:    `["1","2","3","4","5","6","7","10"]`,
+			opts:    JSONDecodeOptions{AllowShorter: true},
+			wantErr: true,
+		},
+		{
+			name: "json array with null values",
+			data: `[null,null,null,null,null,null,null]`,
+			want: T7[string, string, string, string, string, string, string]{},
+		},
+		{
+			name:    "disallow null json array with null values",
+			data:    `[null,null,null,null,null,null,null]`,
+			opts:    JSONDecodeOptions{DisallowNull: true},
+			wantErr: true,
+		},
+		{
+			name: "disallow null json array of valid types",
+			data: `["1","2","3","4","5","6","7"]`,
+			opts: JSONDecodeOptions{DisallowNull: true},
+			want: New7("1", "2", "3", "4", "5", "6", "7"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T7[string, string, string, string, string, string, string]
+			err := got.UnmarshalJSONWith([]byte(tt.data), tt.opts)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT7_UnmarshalJSONWith_Nested(t *testing.T) {
+	type Custom struct {
+		Name string `json:"name"`
+	}
+
+	data := []byte(`[[1, {"name": "1", "age": 1}],[2, {"name": "2", "age": 2}],[3, {"name": "3", "age": 3}],[4, {"name": "4", "age": 4}],[5, {"name": "5", "age": 5}],[6, {"name": "6", "age": 6}],[7, {"name": "7", "age": 7}]]`)
+
+	var got T7[T2[any, Custom], T2[any, Custom], T2[any, Custom], T2[any, Custom], T2[any, Custom], T2[any, Custom], T2[any, Custom]]
+	err := got.UnmarshalJSONWith(data, JSONDecodeOptions{UseNumber: true})
+	require.NoError(t, err)
+	require.Equal(t, New7(New2[any](json.Number("1"), Custom{Name: "1"}), New2[any](json.Number("2"), Custom{Name: "2"}), New2[any](json.Number("3"), Custom{Name: "3"}), New2[any](json.Number("4"), Custom{Name: "4"}), New2[any](json.Number("5"), Custom{Name: "5"}), New2[any](json.Number("6"), Custom{Name: "6"}), New2[any](json.Number("7"), Custom{Name: "7"})), got)
+
+	err = got.UnmarshalJSONWith(data, JSONDecodeOptions{DisallowUnknownFields: true})
+	require.Error(t, err)
+}
+
+func TestT7_MarshalJSONLabeled(t *testing.T) {
+	tup := New7(1, 2, 3, 4, 5, 6, 7)
+	labels := WithLabels("v1", "v2", "v3", "v4", "v5", "v6", "v7")
+
+	got, err := tup.MarshalJSONLabeled(labels)
+	require.NoError(t, err)
+	require.Equal(t, `{"v1":1,"v2":2,"v3":3,"v4":4,"v5":5,"v6":6,"v7":7}`, string(got))
+
+	_, err = tup.MarshalJSONLabeled(WithLabels("v1", "v2", "v3", "v4", "v5", "v6", "v7", "extra"))
+	require.Error(t, err)
+}
+
+func TestT7_UnmarshalJSONLabeled(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		labels  Labels
+		want    T7[int, int, int, int, int, int, int]
+		wantErr bool
+	}{
+		{
+			name:   "all keys",
+			data:   `{"v1":1,"v2":2,"v3":3,"v4":4,"v5":5,"v6":6,"v7":7}`,
+			labels: WithLabels("v1", "v2", "v3", "v4", "v5", "v6", "v7"),
+			want:   New7(1, 2, 3, 4, 5, 6, 7),
+		},
+		{
+			name:   "missing keys",
+			data:   `{}`,
+			labels: WithLabels("v1", "v2", "v3", "v4", "v5", "v6", "v7"),
+			want:   T7[int, int, int, int, int, int, int]{},
+		},
+		{
+			name:    "strict missing keys",
+			data:    `{}`,
+			labels:  WithLabels("v1", "v2", "v3", "v4", "v5", "v6", "v7").Strict(),
+			wantErr: true,
+		},
+		{
+			name:   "unknown keys",
+			data:   `{"v1":1,"v2":2,"v3":3,"v4":4,"v5":5,"v6":6,"v7":7,"extra":0}`,
+			labels: WithLabels("v1", "v2", "v3", "v4", "v5", "v6", "v7"),
+			want:   New7(1, 2, 3, 4, 5, 6, 7),
+		},
+		{
+			name:    "strict unknown keys",
+			data:    `{"v1":1,"v2":2,"v3":3,"v4":4,"v5":5,"v6":6,"v7":7,"extra":0}`,
+			labels:  WithLabels("v1", "v2", "v3", "v4", "v5", "v6", "v7").Strict(),
+			wantErr: true,
+		},
+		{
+			name:    "json array",
+			data:    `[1,2,3,4,5,6,7]`,
+			labels:  WithLabels("v1", "v2", "v3", "v4", "v5", "v6", "v7"),
+			wantErr: true,
+		},
+		{
+			name:    "labels count mismatch",
+			data:    `{}`,
+			labels:  WithLabels("v1", "v2", "v3", "v4", "v5", "v6", "v7", "extra"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T7[int, int, int, int, int, int, int]
+			err := got.UnmarshalJSONLabeled([]byte(tt.data), tt.labels)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT7_MarshalText(t *testing.T) {
+	tup := New7("1", "2", "3", "4", "5", "6", "7")
+
+	got, err := tup.MarshalText()
+	require.NoError(t, err)
+	require.Equal(t, `1,2,3,4,5,6,7`, string(got))
+}
+
+func TestT7_MarshalText_Unsupported(t *testing.T) {
+	tup := New7([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7})
+
+	_, err := tup.MarshalText()
+	require.Error(t, err)
+}
+
+func TestT7_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    T7[string, string, string, string, string, string, string]
+		wantErr bool
+	}{
+		{
+			name: "valid values",
+			data: `1,2,3,4,5,6,7`,
+			want: New7("1", "2", "3", "4", "5", "6", "7"),
+		},
+		{
+			name: "escaped values",
+			data: `a\,1\\,a\,2\\,a\,3\\,a\,4\\,a\,5\\,a\,6\\,a\,7\\`,
+			want: New7(`a,1\`, `a,2\`, `a,3\`, `a,4\`, `a,5\`, `a,6\`, `a,7\`),
+		},
+		{
+			name:    "too few values",
+			data:    `2,3,4,5,6,7`,
+			wantErr: true,
+		},
+		{
+			name:    "too many values",
+			data:    `1,2,3,4,5,6,7,extra`,
+			wantErr: true,
+		},
+		{
+			name:    "dangling escape",
+			data:    `1,2,3,4,5,6,7\`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T7[string, string, string, string, string, string, string]
+			err := got.UnmarshalText([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT7_MarshalText_UnmarshalText(t *testing.T) {
+	tup := New7(New2("a,1\\\\", 1), New2("a,2\\\\", 2), New2("a,3\\\\", 3), New2("a,4\\\\", 4), New2("a,5\\\\", 5), New2("a,6\\\\", 6), New2("a,7\\\\", 7))
+
+	marshalled, err := tup.MarshalText()
+	require.NoError(t, err)
+
+	var unmarshalled T7[T2[string, int], T2[string, int], T2[string, int], T2[string, int], T2[string, int], T2[string, int], T2[string, int]]
+	err = unmarshalled.UnmarshalText(marshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT7_GobEncode_GobDecode(t *testing.T) {
+	t.Run("strings", func(t *testing.T) {
+		requireGobRoundTrip(t, New7("1", "2", "3", "4", "5", "6", "7"))
+	})
+
+	t.Run("zero values", func(t *testing.T) {
+		requireGobRoundTrip(t, T7[int, int, int, int, int, int, int]{})
+	})
+
+	t.Run("nested tuples", func(t *testing.T) {
+		requireGobRoundTrip(t, New7(New2("1", 1), New2("2", 2), New2("3", 3), New2("4", 4), New2("5", 5), New2("6", 6), New2("7", 7)))
+	})
+
+	t.Run("pointers", func(t *testing.T) {
+		requireGobRoundTrip(t, New7(ptr(1), ptr(2), ptr(3), ptr(4), ptr(5), ptr(6), ptr(7)))
+	})
+
+	t.Run("pointers to zero values", func(t *testing.T) {
+		requireGobRoundTrip(t, New7(ptr(0), ptr(0), ptr(0), ptr(0), ptr(0), ptr(0), ptr(0)))
+	})
+
+	t.Run("nil pointers", func(t *testing.T) {
+		requireGobRoundTrip(t, T7[*int, *int, *int, *int, *int, *int, *int]{})
+	})
+
+	t.Run("nested tuple pointers", func(t *testing.T) {
+		requireGobRoundTrip(t, New7((*T2[string, *int])(nil), &T2[string, *int]{V1: "2", V2: ptr(2)}, &T2[string, *int]{V1: "3", V2: ptr(3)}, &T2[string, *int]{V1: "4", V2: ptr(4)}, &T2[string, *int]{V1: "5", V2: ptr(5)}, &T2[string, *int]{V1: "6", V2: ptr(6)}, &T2[string, *int]{V1: "7", V2: ptr(7)}))
+	})
+
+	t.Run("interface values", func(t *testing.T) {
+		requireGobRoundTrip(t, T7[any, any, any, any, any, any, any]{V1: nil, V2: "2", V3: 3, V4: 4, V5: 5, V6: 6, V7: 7})
+	})
+
+	t.Run("struct field", func(t *testing.T) {
+		type Custom struct {
+			Tup T7[int, int, int, int, int, int, int]
+		}
+
+		requireGobRoundTrip(t, Custom{Tup: New7(1, 2, 3, 4, 5, 6, 7)})
+	})
+}
+
+func TestT7_GobDecode_Invalid(t *testing.T) {
+	encoded, err := New1("1").GobEncode()
+	require.NoError(t, err)
+
+	var tup T7[string, string, string, string, string, string, string]
+	err = tup.GobDecode(encoded)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, 7, lengthErr.Expected)
+
+	encoded, err = New7("1", "2", "3", "4", "5", "6", "7").GobEncode()
+	require.NoError(t, err)
+
+	var mismatched T7[int, int, int, int, int, int, int]
+	require.Error(t, mismatched.GobDecode(encoded))
+	require.Error(t, tup.GobDecode(nil))
+}
+
+func TestT7_MarshalComposite(t *testing.T) {
+	got, err := New7("foo bar", 2, 3, 4, 5, 6, 7).MarshalComposite()
+	require.NoError(t, err)
+	require.Equal(t, `("foo bar",2,3,4,5,6,7)`, string(got))
+
+	got, err = T7[*int, *int, *int, *int, *int, *int, *int]{}.MarshalComposite()
+	require.NoError(t, err)
+	require.Equal(t, `(,,,,,,)`, string(got))
+
+	got, err = New7(New2("1", 1), New2("2", 2), New2("3", 3), New2("4", 4), New2("5", 5), New2("6", 6), New2("7", 7)).MarshalComposite()
+	require.NoError(t, err)
+	require.Equal(t, `("(1,1)","(2,2)","(3,3)","(4,4)","(5,5)","(6,6)","(7,7)")`, string(got))
+}
+
+func TestT7_UnmarshalComposite(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    T7[string, string, string, string, string, string, string]
+		wantErr bool
+	}{
+		{
+			name: "unquoted values",
+			data: `(1,2,3,4,5,6,7)`,
+			want: New7("1", "2", "3", "4", "5", "6", "7"),
+		},
+		{
+			name: "quoted values",
+			data: `("1, \\""","2, \\""","3, \\""","4, \\""","5, \\""","6, \\""","7, \\""")`,
+			want: New7("1, \\\"", "2, \\\"", "3, \\\"", "4, \\\"", "5, \\\"", "6, \\\"", "7, \\\""),
+		},
+		{
+			name: "empty values",
+			data: `("","","","","","","")`,
+			want: T7[string, string, string, string, string, string, string]{},
+		},
+		{
+			name:    "null values",
+			data:    `(,,,,,,)`,
+			wantErr: true,
+		},
+		{
+			name:    "too many fields",
+			data:    `(1,2,3,4,5,6,7,0)`,
+			wantErr: true,
+		},
+		{
+			name:    "missing parentheses",
+			data:    `1,2,3,4,5,6,7`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T7[string, string, string, string, string, string, string]
+			err := got.UnmarshalComposite([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT7_MarshalComposite_UnmarshalComposite(t *testing.T) {
+	tup := New7((*T2[string, *int])(nil), &T2[string, *int]{V1: "(2, \"2\\\")", V2: ptr(2)}, &T2[string, *int]{V1: "(3, \"3\\\")", V2: ptr(3)}, &T2[string, *int]{V1: "(4, \"4\\\")", V2: ptr(4)}, &T2[string, *int]{V1: "(5, \"5\\\")", V2: ptr(5)}, &T2[string, *int]{V1: "(6, \"6\\\")", V2: ptr(6)}, &T2[string, *int]{V1: "(7, \"7\\\")", V2: ptr(7)})
+
+	marshalled, err := tup.MarshalComposite()
+	require.NoError(t, err)
+
+	var unmarshalled T7[*T2[string, *int], *T2[string, *int], *T2[string, *int], *T2[string, *int], *T2[string, *int], *T2[string, *int], *T2[string, *int]]
+	err = unmarshalled.UnmarshalComposite(marshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT7_MarshalXML(t *testing.T) {
+	got, err := xml.Marshal(New7(1, 2, 3, 4, 5, 6, 7))
+	require.NoError(t, err)
+	require.Equal(t, `<T7><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6><v7>7</v7></T7>`, string(got))
+
+	type Custom struct {
+		XMLName xml.Name                                                   `xml:"custom"`
+		Tup     T7[string, string, string, string, string, string, string] `xml:"tup"`
+	}
+
+	got, err = xml.Marshal(Custom{Tup: New7("<1>", "<2>", "<3>", "<4>", "<5>", "<6>", "<7>")})
+	require.NoError(t, err)
+	require.Equal(t, `<custom><tup><v1>&lt;1&gt;</v1><v2>&lt;2&gt;</v2><v3>&lt;3&gt;</v3><v4>&lt;4&gt;</v4><v5>&lt;5&gt;</v5><v6>&lt;6&gt;</v6><v7>&lt;7&gt;</v7></tup></custom>`, string(got))
+}
+
+func TestT7_UnmarshalXML(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    T7[int, int, int, int, int, int, int]
+		wantErr bool
+	}{
+		{
+			name: "all elements",
+			data: `<T7><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6><v7>7</v7></T7>`,
+			want: New7(1, 2, 3, 4, 5, 6, 7),
+		},
+		{
+			name: "whitespace and comments",
+			data: `<T7>
+				<!-- v1 --> <v1> 1 </v1>
+				<!-- v2 --> <v2> 2 </v2>
+				<!-- v3 --> <v3> 3 </v3>
+				<!-- v4 --> <v4> 4 </v4>
+				<!-- v5 --> <v5> 5 </v5>
+				<!-- v6 --> <v6> 6 </v6>
+				<!-- v7 --> <v7> 7 </v7>
+			</T7>`,
+			want: New7(1, 2, 3, 4, 5, 6, 7),
+		},
+		{
+			name:    "no elements",
+			data:    `<T7></T7>`,
+			wantErr: true,
+		},
+		{
+			name:    "too many elements",
+			data:    `<T7><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6><v7>7</v7><v0>0</v0></T7>`,
+			wantErr: true,
+		},
+		{
+			name:    "wrong element names",
+			data:    `<T7><V1>1</V1><V2>2</V2><V3>3</V3><V4>4</V4><V5>5</V5><V6>6</V6><V7>7</V7></T7>`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid value",
+			data:    `<T7><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6><v7>x</v7></T7>`,
+			wantErr: true,
+		},
+		{
+			name:    "unterminated",
+			data:    `<T7><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6><v7>7</v7>`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T7[int, int, int, int, int, int, int]
+			err := xml.Unmarshal([]byte(tt.data), &got)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT7_UnmarshalXML_Errors(t *testing.T) {
+	var tup T7[int, int, int, int, int, int, int]
+
+	err := xml.Unmarshal([]byte(`<T7><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6></T7>`), &tup)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.EqualError(t, err, "unmarshalled xml elements count 6 must match number of tuple values 7")
+
+	err = xml.Unmarshal([]byte(`<T7><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6><v7>7</v7><v0>0</v0><v0>0</v0></T7>`), &tup)
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, 9, lengthErr.Actual)
+
+	err = xml.Unmarshal([]byte(`<T7><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6><v7>x</v7></T7>`), &tup)
+	var elementErr *ElementUnmarshalError
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, 6, elementErr.Index)
+	require.Equal(t, "v7", elementErr.Label)
+}
+
+func TestT7_MarshalXML_UnmarshalXML_NilPointers(t *testing.T) {
+	tup := New7((*int)(nil), ptr(2), ptr(3), ptr(4), ptr(5), ptr(6), ptr(7))
+
+	got, err := xml.Marshal(tup)
+	require.NoError(t, err)
+	require.Equal(t, `<T7><v1 nil="true"></v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6><v7>7</v7></T7>`, string(got))
+
+	unmarshalled := New7(ptr(0), ptr(0), ptr(0), ptr(0), ptr(0), ptr(0), ptr(0))
+	err = xml.Unmarshal(got, &unmarshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT7_MarshalXML_UnmarshalXML_Slices(t *testing.T) {
+	tup := New7([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8})
+
+	got, err := xml.Marshal(tup)
+	require.NoError(t, err)
+	require.Equal(t, `<T7><v1><item>1</item><item>2</item></v1><v2><item>2</item><item>3</item></v2><v3><item>3</item><item>4</item></v3><v4><item>4</item><item>5</item></v4><v5><item>5</item><item>6</item></v5><v6><item>6</item><item>7</item></v6><v7><item>7</item><item>8</item></v7></T7>`, string(got))
+
+	var unmarshalled T7[[]int, []int, []int, []int, []int, []int, []int]
+	err = xml.Unmarshal(got, &unmarshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT7_MarshalXMLLabeled_UnmarshalXMLLabeled(t *testing.T) {
+	tup := New7(New2("1", ptr(1)), New2("2", ptr(2)), New2("3", ptr(3)), New2("4", ptr(4)), New2("5", ptr(5)), New2("6", ptr(6)), New2("7", ptr(7)))
+	labels := WithLabels("item1", "item2", "item3", "item4", "item5", "item6", "item7")
+
+	var buf strings.Builder
+	encoder := xml.NewEncoder(&buf)
+	err := tup.MarshalXMLLabeled(encoder, xml.StartElement{Name: xml.Name{Local: "items"}}, labels)
+	require.NoError(t, err)
+	require.NoError(t, encoder.Flush())
+	require.Equal(t, `<items><item1><v1>1</v1><v2>1</v2></item1><item2><v1>2</v1><v2>2</v2></item2><item3><v1>3</v1><v2>3</v2></item3><item4><v1>4</v1><v2>4</v2></item4><item5><v1>5</v1><v2>5</v2></item5><item6><v1>6</v1><v2>6</v2></item6><item7><v1>7</v1><v2>7</v2></item7></items>`, buf.String())
+
+	decoder := xml.NewDecoder(strings.NewReader(buf.String()))
+	start, err := decoder.Token()
+	require.NoError(t, err)
+
+	var unmarshalled T7[T2[string, *int], T2[string, *int], T2[string, *int], T2[string, *int], T2[string, *int], T2[string, *int], T2[string, *int]]
+	err = unmarshalled.UnmarshalXMLLabeled(decoder, start.(xml.StartElement), labels)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+
+	err = tup.MarshalXMLLabeled(encoder, xml.StartElement{Name: xml.Name{Local: "items"}}, WithLabels("item1", "item2", "item3", "item4", "item5", "item6", "item7", "extra"))
+	require.Error(t, err)
+}
+
+func TestT7_MarshalBinary(t *testing.T) {
+	tup := New7(uint16(1), uint16(2), uint16(3), uint16(4), uint16(5), uint16(6), uint16(7))
+
+	got, err := tup.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 0, 2, 0, 3, 0, 4, 0, 5, 0, 6, 0, 7, 0}, got)
+
+	got, err = tup.AppendBinaryOrder([]byte{0xff}, binary.BigEndian)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0xff, 0, 1, 0, 2, 0, 3, 0, 4, 0, 5, 0, 6, 0, 7}, got)
+
+	_, err = New7(1, 2, 3, 4, 5, 6, []int{7}).MarshalBinary()
+	require.Error(t, err)
+}
+
+func TestT7_MarshalBinary_UnmarshalBinary(t *testing.T) {
+	tup := New7(
+		int64(-1),
+		"foo2",
+		true,
+		[]byte("bar4"),
+		New2(float32(5.5), "baz"),
+		float64(6.5),
+		float64(7.5),
+	)
+
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		marshalled, err := tup.AppendBinaryOrder(nil, order.(binary.AppendByteOrder))
+		require.NoError(t, err)
+
+		var unmarshalled T7[int64, string, bool, []byte, T2[float32, string], float64, float64]
+		err = unmarshalled.UnmarshalBinaryOrder(marshalled, order)
+		require.NoError(t, err)
+		require.Equal(t, tup, unmarshalled)
+	}
+}
+
+func TestT7_UnmarshalBinary_Invalid(t *testing.T) {
+	marshalled, err := New7(uint16(1), uint16(2), uint16(3), uint16(4), uint16(5), uint16(6), uint16(7)).MarshalBinary()
+	require.NoError(t, err)
+
+	var tup T7[uint16, uint16, uint16, uint16, uint16, uint16, uint16]
+	err = tup.UnmarshalBinary(marshalled[:len(marshalled)-1])
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	require.EqualError(t, err, "value at index 6 failed to unmarshal: unexpected EOF")
+
+	err = tup.UnmarshalBinary(append(marshalled, 0))
+	require.EqualError(t, err, "binary data has 1 trailing bytes")
+}
+
+func TestT7_BinarySlice(t *testing.T) {
+	tups := []T7[int, int, int, int, int, int, int]{
+		New7(1, 2, 3, 4, 5, 6, 7),
+		New7(-1, -2, -3, -4, -5, -6, -7),
+	}
+
+	marshalled, err := AppendBinarySlice7(nil, binary.LittleEndian, tups)
+	require.NoError(t, err)
+	require.Len(t, marshalled, 1+2*7*8)
+
+	got, err := UnmarshalBinarySlice7[int, int, int, int, int, int, int](marshalled, binary.LittleEndian, nil)
+	require.NoError(t, err)
+	require.Equal(t, tups, got)
+
+	got, err = UnmarshalBinarySlice7(marshalled, binary.LittleEndian, got)
+	require.NoError(t, err)
+	require.Equal(t, append(tups, tups...), got)
+
+	empty, err := AppendBinarySlice7[int, int, int, int, int, int, int](nil, binary.LittleEndian, nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0}, empty)
+
+	_, err = AppendBinarySlice7(nil, binary.LittleEndian, []T7[[]int, []int, []int, []int, []int, []int, []int]{{}})
+	require.Error(t, err)
+}
+
+func TestT7_MarshalJSON_MapKey(t *testing.T) {
+	m := map[T7[string, string, string, string, string, string, string]]int{
+		New7("1", "2", "3", "4", "5", "6", "7"): 1,
+	}
+
+	marshalled, err := json.Marshal(m)
+	require.NoError(t, err)
+	require.Equal(t, `{"1,2,3,4,5,6,7":1}`, string(marshalled))
+
+	var unmarshalled map[T7[string, string, string, string, string, string, string]]int
+	err = json.Unmarshal(marshalled, &unmarshalled)
+	require.NoError(t, err)
+	require.Equal(t, m, unmarshalled)
 }
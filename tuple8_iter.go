@@ -0,0 +1,112 @@
+//go:build go1.23
+
+package tuple
+
+import (
+	"io"
+	"iter"
+)
+
+// All returns an iterator over the index and value of each of the tuple values.
+func (t T8[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8]) All() iter.Seq2[int, any] {
+	return func(yield func(int, any) bool) {
+		if !yield(0, t.V1) {
+			return
+		}
+		if !yield(1, t.V2) {
+			return
+		}
+		if !yield(2, t.V3) {
+			return
+		}
+		if !yield(3, t.V4) {
+			return
+		}
+		if !yield(4, t.V5) {
+			return
+		}
+		if !yield(5, t.V6) {
+			return
+		}
+		if !yield(6, t.V7) {
+			return
+		}
+		if !yield(7, t.V8) {
+			return
+		}
+	}
+}
+
+// ZipSeq8 returns an iterator over tuples, where each tuple holds the next value of each of the given iterators.
+// The returned iterator stops once any of the given iterators is exhausted.
+func ZipSeq8[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8 any](s1 iter.Seq[Ty1], s2 iter.Seq[Ty2], s3 iter.Seq[Ty3], s4 iter.Seq[Ty4], s5 iter.Seq[Ty5], s6 iter.Seq[Ty6], s7 iter.Seq[Ty7], s8 iter.Seq[Ty8]) iter.Seq[T8[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8]] {
+	return func(yield func(T8[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8]) bool) {
+		next2, stop2 := iter.Pull(s2)
+		defer stop2()
+		next3, stop3 := iter.Pull(s3)
+		defer stop3()
+		next4, stop4 := iter.Pull(s4)
+		defer stop4()
+		next5, stop5 := iter.Pull(s5)
+		defer stop5()
+		next6, stop6 := iter.Pull(s6)
+		defer stop6()
+		next7, stop7 := iter.Pull(s7)
+		defer stop7()
+		next8, stop8 := iter.Pull(s8)
+		defer stop8()
+
+		for v1 := range s1 {
+			v2, ok := next2()
+			if !ok {
+				return
+			}
+			v3, ok := next3()
+			if !ok {
+				return
+			}
+			v4, ok := next4()
+			if !ok {
+				return
+			}
+			v5, ok := next5()
+			if !ok {
+				return
+			}
+			v6, ok := next6()
+			if !ok {
+				return
+			}
+			v7, ok := next7()
+			if !ok {
+				return
+			}
+			v8, ok := next8()
+			if !ok {
+				return
+			}
+
+			if !yield(New8(v1, v2, v3, v4, v5, v6, v7, v8)) {
+				return
+			}
+		}
+	}
+}
+
+// ReadCSVSeq8 returns an iterator over the CSV records of r converted into tuples, the same way as ReadCSV8 does.
+// Records that fail to parse or convert are yielded along with their errors, and the iteration continues with the next record.
+// Other errors, such as read errors, are yielded once and stop the iteration.
+func ReadCSVSeq8[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8 any](r io.Reader, opts CSVOptions) iter.Seq2[T8[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8], error] {
+	return readCSVSeq(r, opts, func(t *T8[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8]) []any {
+		return []any{
+			&t.V1,
+			&t.V2,
+			&t.V3,
+			&t.V4,
+			&t.V5,
+			&t.V6,
+			&t.V7,
+			&t.V8,
+		}
+	})
+}
@@ -0,0 +1,89 @@
+//go:build go1.23
+
+package tuple
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestT8_All(t *testing.T) {
+	tup := New8("1", "2", "3", "4", "5", "6", "7", "8")
+
+	var indexes []int
+	var values []any
+	for index, value := range tup.All() {
+		indexes = append(indexes, index)
+		values = append(values, value)
+	}
+
+	require.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7}, indexes)
+	require.Equal(t, tup.Slice(), values)
+}
+
+func TestT8_All_Break(t *testing.T) {
+	tup := New8("1", "2", "3", "4", "5", "6", "7", "8")
+
+	var values []any
+	for _, value := range tup.All() {
+		values = append(values, value)
+		break
+	}
+
+	require.Equal(t, []any{"1"}, values)
+}
+
+func TestT8_ZipSeq(t *testing.T) {
+	want := []T8[int, int, int, int, int, int, int, int]{
+		New8(1, 2, 3, 4, 5, 6, 7, 8),
+		New8(2, 3, 4, 5, 6, 7, 8, 9),
+	}
+
+	require.Equal(t, want, slices.Collect(ZipSeq8(slices.Values([]int{1, 2}), slices.Values([]int{2, 3}), slices.Values([]int{3, 4}), slices.Values([]int{4, 5}), slices.Values([]int{5, 6}), slices.Values([]int{6, 7}), slices.Values([]int{7, 8}), slices.Values([]int{8, 9}))))
+	require.Equal(t, want[:1], slices.Collect(ZipSeq8(slices.Values([]int{1}), slices.Values([]int{2, 3}), slices.Values([]int{3, 4}), slices.Values([]int{4, 5}), slices.Values([]int{5, 6}), slices.Values([]int{6, 7}), slices.Values([]int{7, 8}), slices.Values([]int{8, 9}))))
+	require.Equal(t, want[:1], slices.Collect(ZipSeq8(slices.Values([]int{1, 2}), slices.Values([]int{2}), slices.Values([]int{3, 4}), slices.Values([]int{4, 5}), slices.Values([]int{5, 6}), slices.Values([]int{6, 7}), slices.Values([]int{7, 8}), slices.Values([]int{8, 9}))))
+	require.Equal(t, want[:1], slices.Collect(ZipSeq8(slices.Values([]int{1, 2}), slices.Values([]int{2, 3}), slices.Values([]int{3}), slices.Values([]int{4, 5}), slices.Values([]int{5, 6}), slices.Values([]int{6, 7}), slices.Values([]int{7, 8}), slices.Values([]int{8, 9}))))
+	require.Equal(t, want[:1], slices.Collect(ZipSeq8(slices.Values([]int{1, 2}), slices.Values([]int{2, 3}), slices.Values([]int{3, 4}), slices.Values([]int{4}), slices.Values([]int{5, 6}), slices.Values([]int{6, 7}), slices.Values([]int{7, 8}), slices.Values([]int{8, 9}))))
+	require.Equal(t, want[:1], slices.Collect(ZipSeq8(slices.Values([]int{1, 2}), slices.Values([]int{2, 3}), slices.Values([]int{3, 4}), slices.Values([]int{4, 5}), slices.Values([]int{5}), slices.Values([]int{6, 7}), slices.Values([]int{7, 8}), slices.Values([]int{8, 9}))))
+	require.Equal(t, want[:1], slices.Collect(ZipSeq8(slices.Values([]int{1, 2}), slices.Values([]int{2, 3}), slices.Values([]int{3, 4}), slices.Values([]int{4, 5}), slices.Values([]int{5, 6}), slices.Values([]int{6}), slices.Values([]int{7, 8}), slices.Values([]int{8, 9}))))
+	require.Equal(t, want[:1], slices.Collect(ZipSeq8(slices.Values([]int{1, 2}), slices.Values([]int{2, 3}), slices.Values([]int{3, 4}), slices.Values([]int{4, 5}), slices.Values([]int{5, 6}), slices.Values([]int{6, 7}), slices.Values([]int{7}), slices.Values([]int{8, 9}))))
+	require.Equal(t, want[:1], slices.Collect(ZipSeq8(slices.Values([]int{1, 2}), slices.Values([]int{2, 3}), slices.Values([]int{3, 4}), slices.Values([]int{4, 5}), slices.Values([]int{5, 6}), slices.Values([]int{6, 7}), slices.Values([]int{7, 8}), slices.Values([]int{8}))))
+}
+
+func TestT8_ZipSeq_Break(t *testing.T) {
+	var got []T8[int, int, int, int, int, int, int, int]
+	for tup := range ZipSeq8(slices.Values([]int{1, 2}), slices.Values([]int{2, 3}), slices.Values([]int{3, 4}), slices.Values([]int{4, 5}), slices.Values([]int{5, 6}), slices.Values([]int{6, 7}), slices.Values([]int{7, 8}), slices.Values([]int{8, 9})) {
+		got = append(got, tup)
+		break
+	}
+
+	require.Equal(t, []T8[int, int, int, int, int, int, int, int]{New8(1, 2, 3, 4, 5, 6, 7, 8)}, got)
+}
+
+func TestT8_ReadCSVSeq(t *testing.T) {
+	data := "1,2,3,4,5,6,7,8\nx,x,x,x,x,x,x,x\n2,3,4,5,6,7,8,9\n"
+
+	var got []T8[int, int, int, int, int, int, int, int]
+	var errs []error
+	for tup, err := range ReadCSVSeq8[int, int, int, int, int, int, int, int](strings.NewReader(data), CSVOptions{}) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		got = append(got, tup)
+	}
+
+	require.Equal(t, []T8[int, int, int, int, int, int, int, int]{
+		New8(1, 2, 3, 4, 5, 6, 7, 8),
+		New8(2, 3, 4, 5, 6, 7, 8, 9),
+	}, got)
+	require.Len(t, errs, 1)
+
+	var csvErr *CSVError
+	require.ErrorAs(t, errs[0], &csvErr)
+	require.Equal(t, 2, csvErr.Line)
+	require.Equal(t, 1, csvErr.Column)
+}
@@ -1,7 +1,17 @@
 package tuple
 
 import (
+	"database/sql/driver"
+	"encoding/binary"
 	"encoding/json"
+	"encoding/xml"
+	"hash/maphash"
+	"io"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -39,6 +49,206 @@ func TestT8_Values(t *testing.T) {
 	require.Equal(t, "8", v8)
 }
 
+func TestT8_MapAt(t *testing.T) {
+	tup := New8(1, 2, 3, 4, 5, 6, 7, 8)
+
+	require.Equal(t, New8("1", 2, 3, 4, 5, 6, 7, 8), Map8At1(tup, strconv.Itoa))
+	require.Equal(t, New8(1, "2", 3, 4, 5, 6, 7, 8), Map8At2(tup, strconv.Itoa))
+	require.Equal(t, New8(1, 2, "3", 4, 5, 6, 7, 8), Map8At3(tup, strconv.Itoa))
+	require.Equal(t, New8(1, 2, 3, "4", 5, 6, 7, 8), Map8At4(tup, strconv.Itoa))
+	require.Equal(t, New8(1, 2, 3, 4, "5", 6, 7, 8), Map8At5(tup, strconv.Itoa))
+	require.Equal(t, New8(1, 2, 3, 4, 5, "6", 7, 8), Map8At6(tup, strconv.Itoa))
+	require.Equal(t, New8(1, 2, 3, 4, 5, 6, "7", 8), Map8At7(tup, strconv.Itoa))
+	require.Equal(t, New8(1, 2, 3, 4, 5, 6, 7, "8"), Map8At8(tup, strconv.Itoa))
+}
+
+func TestT8_Map(t *testing.T) {
+	tup := New8(1, 2, 3, 4, 5, 6, 7, 8)
+	got := Map8(tup, strconv.Itoa, strconv.Itoa, strconv.Itoa, strconv.Itoa, strconv.Itoa, strconv.Itoa, strconv.Itoa, strconv.Itoa)
+	require.Equal(t, New8("1", "2", "3", "4", "5", "6", "7", "8"), got)
+}
+
+func TestT8_Append(t *testing.T) {
+	tup := New8("1", "2", "3", "4", "5", "6", "7", "8")
+	require.Equal(t, New9("1", "2", "3", "4", "5", "6", "7", "8", "9"), Append8(tup, "9"))
+}
+
+func TestT8_Prepend(t *testing.T) {
+	tup := New8("2", "3", "4", "5", "6", "7", "8", "9")
+	require.Equal(t, New9("1", "2", "3", "4", "5", "6", "7", "8", "9"), Prepend8(tup, "1"))
+}
+
+func TestT8_Concat(t *testing.T) {
+	tup := New8("1", "2", "3", "4", "5", "6", "7", "8")
+
+	require.Equal(t,
+		New9("1", "2", "3", "4", "5", "6", "7", "8", "9"),
+		Concat8_1(tup, New1("9")),
+	)
+}
+
+func TestT8_Take(t *testing.T) {
+	tup := New8("1", "2", "3", "4", "5", "6", "7", "8")
+
+	require.Equal(t, New1("1"), Take8_1(tup))
+	require.Equal(t, New2("1", "2"), Take8_2(tup))
+	require.Equal(t, New3("1", "2", "3"), Take8_3(tup))
+	require.Equal(t, New4("1", "2", "3", "4"), Take8_4(tup))
+	require.Equal(t, New5("1", "2", "3", "4", "5"), Take8_5(tup))
+	require.Equal(t, New6("1", "2", "3", "4", "5", "6"), Take8_6(tup))
+	require.Equal(t, New7("1", "2", "3", "4", "5", "6", "7"), Take8_7(tup))
+}
+
+func TestT8_Drop(t *testing.T) {
+	tup := New8("1", "2", "3", "4", "5", "6", "7", "8")
+
+	require.Equal(t, New7("2", "3", "4", "5", "6", "7", "8"), Drop8_1(tup))
+	require.Equal(t, New6("3", "4", "5", "6", "7", "8"), Drop8_2(tup))
+	require.Equal(t, New5("4", "5", "6", "7", "8"), Drop8_3(tup))
+	require.Equal(t, New4("5", "6", "7", "8"), Drop8_4(tup))
+	require.Equal(t, New3("6", "7", "8"), Drop8_5(tup))
+	require.Equal(t, New2("7", "8"), Drop8_6(tup))
+	require.Equal(t, New1("8"), Drop8_7(tup))
+}
+
+func TestT8_Split(t *testing.T) {
+	tup := New8("1", "2", "3", "4", "5", "6", "7", "8")
+
+	require.Equal(t,
+		New2(New1("1"), New7("2", "3", "4", "5", "6", "7", "8")),
+		Split8_1(tup),
+	)
+	require.Equal(t,
+		New2(New2("1", "2"), New6("3", "4", "5", "6", "7", "8")),
+		Split8_2(tup),
+	)
+	require.Equal(t,
+		New2(New3("1", "2", "3"), New5("4", "5", "6", "7", "8")),
+		Split8_3(tup),
+	)
+	require.Equal(t,
+		New2(New4("1", "2", "3", "4"), New4("5", "6", "7", "8")),
+		Split8_4(tup),
+	)
+	require.Equal(t,
+		New2(New5("1", "2", "3", "4", "5"), New3("6", "7", "8")),
+		Split8_5(tup),
+	)
+	require.Equal(t,
+		New2(New6("1", "2", "3", "4", "5", "6"), New2("7", "8")),
+		Split8_6(tup),
+	)
+	require.Equal(t,
+		New2(New7("1", "2", "3", "4", "5", "6", "7"), New1("8")),
+		Split8_7(tup),
+	)
+}
+
+func TestT8_Reverse(t *testing.T) {
+	tup := New8(1, 2, 3, 4, 5, 6, 7, 8)
+	require.Equal(t, New8(8, 7, 6, 5, 4, 3, 2, 1), Reverse8(tup))
+}
+
+func TestT8_Apply(t *testing.T) {
+	tup := New8("1", "2", "3", "4", "5", "6", "7", "8")
+	got := Apply8(tup, func(v1, v2, v3, v4, v5, v6, v7, v8 string) string {
+		return v1 + v2 + v3 + v4 + v5 + v6 + v7 + v8
+	})
+	require.Equal(t, "12345678", got)
+}
+
+func TestT8_Tupled(t *testing.T) {
+	tup := New8("1", "2", "3", "4", "5", "6", "7", "8")
+	f := Tupled8(func(v1, v2, v3, v4, v5, v6, v7, v8 string) string {
+		return v1 + v2 + v3 + v4 + v5 + v6 + v7 + v8
+	})
+	require.Equal(t, "12345678", f(tup))
+}
+
+func TestT8_Untupled(t *testing.T) {
+	f := Untupled8(func(tup T8[string, string, string, string, string, string, string, string]) T8[string, string, string, string, string, string, string, string] {
+		return tup
+	})
+	require.Equal(t, New8("1", "2", "3", "4", "5", "6", "7", "8"), f("1", "2", "3", "4", "5", "6", "7", "8"))
+}
+
+func TestT8_Zip(t *testing.T) {
+	want := []T8[int, int, int, int, int, int, int, int]{
+		New8(1, 2, 3, 4, 5, 6, 7, 8),
+		New8(2, 3, 4, 5, 6, 7, 8, 9),
+	}
+
+	require.Equal(t, want, Zip8([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8}, []int{8, 9}))
+	require.Equal(t, want[:1], Zip8([]int{1}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8}, []int{8, 9}))
+	require.Equal(t, want[:1], Zip8([]int{1, 2}, []int{2}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8}, []int{8, 9}))
+	require.Equal(t, want[:1], Zip8([]int{1, 2}, []int{2, 3}, []int{3}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8}, []int{8, 9}))
+	require.Equal(t, want[:1], Zip8([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4}, []int{5, 6}, []int{6, 7}, []int{7, 8}, []int{8, 9}))
+	require.Equal(t, want[:1], Zip8([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5}, []int{6, 7}, []int{7, 8}, []int{8, 9}))
+	require.Equal(t, want[:1], Zip8([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6}, []int{7, 8}, []int{8, 9}))
+	require.Equal(t, want[:1], Zip8([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7}, []int{8, 9}))
+	require.Equal(t, want[:1], Zip8([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8}, []int{8}))
+}
+
+func TestT8_ZipStrict(t *testing.T) {
+	want := []T8[int, int, int, int, int, int, int, int]{
+		New8(1, 2, 3, 4, 5, 6, 7, 8),
+		New8(2, 3, 4, 5, 6, 7, 8, 9),
+	}
+
+	got, err := Zip8Strict([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8}, []int{8, 9})
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	_, err = Zip8Strict([]int{1}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8}, []int{8, 9})
+	require.EqualError(t, err, "slice 2 length 2 must match slice 1 length 1")
+	var lengthErr1 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr1)
+	_, err = Zip8Strict([]int{1, 2}, []int{2}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8}, []int{8, 9})
+	require.EqualError(t, err, "slice 2 length 1 must match slice 1 length 2")
+	var lengthErr2 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr2)
+	_, err = Zip8Strict([]int{1, 2}, []int{2, 3}, []int{3}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8}, []int{8, 9})
+	require.EqualError(t, err, "slice 3 length 1 must match slice 1 length 2")
+	var lengthErr3 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr3)
+	_, err = Zip8Strict([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4}, []int{5, 6}, []int{6, 7}, []int{7, 8}, []int{8, 9})
+	require.EqualError(t, err, "slice 4 length 1 must match slice 1 length 2")
+	var lengthErr4 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr4)
+	_, err = Zip8Strict([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5}, []int{6, 7}, []int{7, 8}, []int{8, 9})
+	require.EqualError(t, err, "slice 5 length 1 must match slice 1 length 2")
+	var lengthErr5 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr5)
+	_, err = Zip8Strict([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6}, []int{7, 8}, []int{8, 9})
+	require.EqualError(t, err, "slice 6 length 1 must match slice 1 length 2")
+	var lengthErr6 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr6)
+	_, err = Zip8Strict([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7}, []int{8, 9})
+	require.EqualError(t, err, "slice 7 length 1 must match slice 1 length 2")
+	var lengthErr7 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr7)
+	_, err = Zip8Strict([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8}, []int{8})
+	require.EqualError(t, err, "slice 8 length 1 must match slice 1 length 2")
+	var lengthErr8 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr8)
+}
+
+func TestT8_Unzip(t *testing.T) {
+	s1, s2, s3, s4, s5, s6, s7, s8 := Unzip8([]T8[int, int, int, int, int, int, int, int]{
+		New8(1, 2, 3, 4, 5, 6, 7, 8),
+		New8(2, 3, 4, 5, 6, 7, 8, 9),
+	})
+
+	require.Equal(t, []int{1, 2}, s1)
+	require.Equal(t, []int{2, 3}, s2)
+	require.Equal(t, []int{3, 4}, s3)
+	require.Equal(t, []int{4, 5}, s4)
+	require.Equal(t, []int{5, 6}, s5)
+	require.Equal(t, []int{6, 7}, s6)
+	require.Equal(t, []int{7, 8}, s7)
+	require.Equal(t, []int{8, 9}, s8)
+}
+
 func TestT8_Compare(t *testing.T) {
 	lesser := New8(1, 2, 3, 4, 5, 6, 7, 8)
 	greater := New8(2, 3, 4, 5, 6, 7, 8, 9)
@@ -219,6 +429,184 @@ func TestT8_CompareC(t *testing.T) {
 	}
 }
 
+func TestT8_CmpFunc(t *testing.T) {
+	tups := []T8[int, int, int, int, int, int, int, int]{
+		New8(2, 3, 4, 5, 6, 7, 8, 9),
+		New8(1, 2, 3, 4, 5, 6, 7, 8),
+		New8(3, 4, 5, 6, 7, 8, 9, 10),
+	}
+
+	cmp := CmpFunc8[int, int, int, int, int, int, int, int]()
+	slices.SortFunc(tups, cmp)
+	require.Equal(t, []T8[int, int, int, int, int, int, int, int]{
+		New8(1, 2, 3, 4, 5, 6, 7, 8),
+		New8(2, 3, 4, 5, 6, 7, 8, 9),
+		New8(3, 4, 5, 6, 7, 8, 9, 10),
+	}, tups)
+
+	index, found := slices.BinarySearchFunc(tups, New8(2, 3, 4, 5, 6, 7, 8, 9), cmp)
+	require.True(t, found)
+	require.Equal(t, 1, index)
+}
+
+func TestT8_CmpFuncC(t *testing.T) {
+	tups := []T8[stringComparable, stringComparable, stringComparable, stringComparable, stringComparable, stringComparable, stringComparable, stringComparable]{
+		New8(stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5"), stringComparable("6"), stringComparable("7"), stringComparable("8"), stringComparable("9")),
+		New8(stringComparable("1"), stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5"), stringComparable("6"), stringComparable("7"), stringComparable("8")),
+	}
+
+	slices.SortFunc(tups, CmpFunc8C[stringComparable, stringComparable, stringComparable, stringComparable, stringComparable, stringComparable, stringComparable, stringComparable]())
+	require.Equal(t, []T8[stringComparable, stringComparable, stringComparable, stringComparable, stringComparable, stringComparable, stringComparable, stringComparable]{
+		New8(stringComparable("1"), stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5"), stringComparable("6"), stringComparable("7"), stringComparable("8")),
+		New8(stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5"), stringComparable("6"), stringComparable("7"), stringComparable("8"), stringComparable("9")),
+	}, tups)
+}
+
+func TestT8_Compare_NaN(t *testing.T) {
+	nan := New8(math.NaN(), math.NaN(), math.NaN(), math.NaN(), math.NaN(), math.NaN(), math.NaN(), math.NaN())
+	num := New8(1.0, 2.0, 3.0, 4.0, 5.0, 6.0, 7.0, 8.0)
+
+	require.True(t, Compare8(nan, nan).EQ())
+	require.True(t, Compare8(nan, num).LT())
+	require.True(t, Compare8(num, nan).GT())
+}
+
+func TestT8_Comparator(t *testing.T) {
+	compare := Comparator8(CompareOrdered[int], CompareOrdered[int], CompareOrdered[int], CompareOrdered[int], CompareOrdered[int], CompareOrdered[int], CompareOrdered[int], CompareOrdered[int])
+	lesser := New8(1, 2, 3, 4, 5, 6, 7, 8)
+
+	require.Equal(t, 0, compare(lesser, lesser))
+
+	greater1 := New8(2, 2, 3, 4, 5, 6, 7, 8)
+	require.Equal(t, -1, compare(lesser, greater1))
+	require.Equal(t, 1, compare(greater1, lesser))
+
+	greater2 := New8(1, 3, 3, 4, 5, 6, 7, 8)
+	require.Equal(t, -1, compare(lesser, greater2))
+	require.Equal(t, 1, compare(greater2, lesser))
+
+	greater3 := New8(1, 2, 4, 4, 5, 6, 7, 8)
+	require.Equal(t, -1, compare(lesser, greater3))
+	require.Equal(t, 1, compare(greater3, lesser))
+
+	greater4 := New8(1, 2, 3, 5, 5, 6, 7, 8)
+	require.Equal(t, -1, compare(lesser, greater4))
+	require.Equal(t, 1, compare(greater4, lesser))
+
+	greater5 := New8(1, 2, 3, 4, 6, 6, 7, 8)
+	require.Equal(t, -1, compare(lesser, greater5))
+	require.Equal(t, 1, compare(greater5, lesser))
+
+	greater6 := New8(1, 2, 3, 4, 5, 7, 7, 8)
+	require.Equal(t, -1, compare(lesser, greater6))
+	require.Equal(t, 1, compare(greater6, lesser))
+
+	greater7 := New8(1, 2, 3, 4, 5, 6, 8, 8)
+	require.Equal(t, -1, compare(lesser, greater7))
+	require.Equal(t, 1, compare(greater7, lesser))
+
+	greater8 := New8(1, 2, 3, 4, 5, 6, 7, 9)
+	require.Equal(t, -1, compare(lesser, greater8))
+	require.Equal(t, 1, compare(greater8, lesser))
+}
+
+func TestT8_Comparator_Comparable(t *testing.T) {
+	compare := Comparator8(CompareComparable[stringComparable], CompareComparable[stringComparable], CompareComparable[stringComparable], CompareComparable[stringComparable], CompareComparable[stringComparable], CompareComparable[stringComparable], CompareComparable[stringComparable], CompareComparable[stringComparable])
+	lesser := New8(stringComparable("1"), stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5"), stringComparable("6"), stringComparable("7"), stringComparable("8"))
+	greater := New8(stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5"), stringComparable("6"), stringComparable("7"), stringComparable("8"), stringComparable("9"))
+
+	require.Equal(t, -1, compare(lesser, greater))
+	require.Equal(t, 1, compare(greater, lesser))
+	require.Equal(t, 0, compare(lesser, lesser))
+}
+
+func TestT8_Comparator_ShortCircuit(t *testing.T) {
+	unreachable := func(a, b int) int {
+		t.Fatal("comparison function should not be called")
+		return 0
+	}
+
+	compare := Comparator8(CompareOrdered[int], unreachable, unreachable, unreachable, unreachable, unreachable, unreachable, unreachable)
+	require.Equal(t, -1, compare(New8(1, 2, 3, 4, 5, 6, 7, 8), New8(2, 3, 4, 5, 6, 7, 8, 9)))
+}
+
+func TestT8_OrderBy(t *testing.T) {
+	lesser := New8(1, 2, 3, 4, 5, 6, 7, 8)
+	asc := OrderBy8[int, int, int, int, int, int, int, int](Asc, Asc, Asc, Asc, Asc, Asc, Asc, Asc)
+
+	greater1 := New8(2, 2, 3, 4, 5, 6, 7, 8)
+	desc1 := OrderBy8[int, int, int, int, int, int, int, int](Desc, Asc, Asc, Asc, Asc, Asc, Asc, Asc)
+	require.Equal(t, -1, asc(lesser, greater1))
+	require.Equal(t, 1, desc1(lesser, greater1))
+	require.Equal(t, 0, desc1(lesser, lesser))
+
+	greater2 := New8(1, 3, 3, 4, 5, 6, 7, 8)
+	desc2 := OrderBy8[int, int, int, int, int, int, int, int](Asc, Desc, Asc, Asc, Asc, Asc, Asc, Asc)
+	require.Equal(t, -1, asc(lesser, greater2))
+	require.Equal(t, 1, desc2(lesser, greater2))
+	require.Equal(t, 0, desc2(lesser, lesser))
+
+	greater3 := New8(1, 2, 4, 4, 5, 6, 7, 8)
+	desc3 := OrderBy8[int, int, int, int, int, int, int, int](Asc, Asc, Desc, Asc, Asc, Asc, Asc, Asc)
+	require.Equal(t, -1, asc(lesser, greater3))
+	require.Equal(t, 1, desc3(lesser, greater3))
+	require.Equal(t, 0, desc3(lesser, lesser))
+
+	greater4 := New8(1, 2, 3, 5, 5, 6, 7, 8)
+	desc4 := OrderBy8[int, int, int, int, int, int, int, int](Asc, Asc, Asc, Desc, Asc, Asc, Asc, Asc)
+	require.Equal(t, -1, asc(lesser, greater4))
+	require.Equal(t, 1, desc4(lesser, greater4))
+	require.Equal(t, 0, desc4(lesser, lesser))
+
+	greater5 := New8(1, 2, 3, 4, 6, 6, 7, 8)
+	desc5 := OrderBy8[int, int, int, int, int, int, int, int](Asc, Asc, Asc, Asc, Desc, Asc, Asc, Asc)
+	require.Equal(t, -1, asc(lesser, greater5))
+	require.Equal(t, 1, desc5(lesser, greater5))
+	require.Equal(t, 0, desc5(lesser, lesser))
+
+	greater6 := New8(1, 2, 3, 4, 5, 7, 7, 8)
+	desc6 := OrderBy8[int, int, int, int, int, int, int, int](Asc, Asc, Asc, Asc, Asc, Desc, Asc, Asc)
+	require.Equal(t, -1, asc(lesser, greater6))
+	require.Equal(t, 1, desc6(lesser, greater6))
+	require.Equal(t, 0, desc6(lesser, lesser))
+
+	greater7 := New8(1, 2, 3, 4, 5, 6, 8, 8)
+	desc7 := OrderBy8[int, int, int, int, int, int, int, int](Asc, Asc, Asc, Asc, Asc, Asc, Desc, Asc)
+	require.Equal(t, -1, asc(lesser, greater7))
+	require.Equal(t, 1, desc7(lesser, greater7))
+	require.Equal(t, 0, desc7(lesser, lesser))
+
+	greater8 := New8(1, 2, 3, 4, 5, 6, 7, 9)
+	desc8 := OrderBy8[int, int, int, int, int, int, int, int](Asc, Asc, Asc, Asc, Asc, Asc, Asc, Desc)
+	require.Equal(t, -1, asc(lesser, greater8))
+	require.Equal(t, 1, desc8(lesser, greater8))
+	require.Equal(t, 0, desc8(lesser, lesser))
+}
+
+func TestT8_Comparator_OrderedPtrBy(t *testing.T) {
+	compare := Comparator8(
+		OrderedBy[int](Asc),
+		OrderedBy[int](Asc),
+		OrderedBy[int](Asc),
+		OrderedBy[int](Asc),
+		OrderedBy[int](Asc),
+		OrderedBy[int](Asc),
+		OrderedBy[int](Asc),
+		OrderedPtrBy[int](Desc.NilsFirst()),
+	)
+
+	tups := []T8[int, int, int, int, int, int, int, *int]{
+		New8(1, 2, 3, 4, 5, 6, 7, ptr(1)),
+		New8(1, 2, 3, 4, 5, 6, 7, ptr(2)),
+		New8(1, 2, 3, 4, 5, 6, 7, (*int)(nil)),
+	}
+	slices.SortFunc(tups, compare)
+
+	require.Nil(t, tups[0].V8)
+	require.Equal(t, 2, *tups[1].V8)
+	require.Equal(t, 1, *tups[2].V8)
+}
+
 func TestT8_EqualE(t *testing.T) {
 	a := New8(intEqualable(1), intEqualable(2), intEqualable(3), intEqualable(4), intEqualable(5), intEqualable(6), intEqualable(7), intEqualable(8))
 	b := New8(intEqualable(2), intEqualable(3), intEqualable(4), intEqualable(5), intEqualable(6), intEqualable(7), intEqualable(8), intEqualable(9))
@@ -227,6 +615,44 @@ func TestT8_EqualE(t *testing.T) {
 	require.True(t, Equal8E(a, a))
 }
 
+func TestT8_EqualFunc(t *testing.T) {
+	a := New8([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8})
+	b := New8([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8})
+
+	require.True(t, EqualFunc8(a, b, slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc8(a, New8([]int{2}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc8(a, New8([]int{1}, []int{3}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc8(a, New8([]int{1}, []int{2}, []int{4}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc8(a, New8([]int{1}, []int{2}, []int{3}, []int{5}, []int{5}, []int{6}, []int{7}, []int{8}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc8(a, New8([]int{1}, []int{2}, []int{3}, []int{4}, []int{6}, []int{6}, []int{7}, []int{8}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc8(a, New8([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{7}, []int{7}, []int{8}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc8(a, New8([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{8}, []int{8}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc8(a, New8([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{9}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+}
+
+func TestT8_EqualMethod(t *testing.T) {
+	a := New8([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8})
+
+	require.True(t, a.Equal(New8([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8})))
+	require.False(t, a.Equal(New8([]int{2}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8})))
+	require.False(t, a.Equal(New8([]int{1}, []int{3}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8})))
+	require.False(t, a.Equal(New8([]int{1}, []int{2}, []int{4}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8})))
+	require.False(t, a.Equal(New8([]int{1}, []int{2}, []int{3}, []int{5}, []int{5}, []int{6}, []int{7}, []int{8})))
+	require.False(t, a.Equal(New8([]int{1}, []int{2}, []int{3}, []int{4}, []int{6}, []int{6}, []int{7}, []int{8})))
+	require.False(t, a.Equal(New8([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{7}, []int{7}, []int{8})))
+	require.False(t, a.Equal(New8([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{8}, []int{8})))
+	require.False(t, a.Equal(New8([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{9})))
+}
+
+func TestT8_EqualMethod_Nested(t *testing.T) {
+	a := New8(New1(intEqualable(1)), New1(intEqualable(2)), New1(intEqualable(3)), New1(intEqualable(4)), New1(intEqualable(5)), New1(intEqualable(6)), New1(intEqualable(7)), New1(intEqualable(8)))
+	b := New8(New1(intEqualable(2)), New1(intEqualable(3)), New1(intEqualable(4)), New1(intEqualable(5)), New1(intEqualable(6)), New1(intEqualable(7)), New1(intEqualable(8)), New1(intEqualable(9)))
+
+	require.True(t, a.Equal(a))
+	require.False(t, a.Equal(b))
+	require.True(t, Equal8E(a, a))
+}
+
 func TestT8_String(t *testing.T) {
 	tup := New8("1", "2", "3", "4", "5", "6", "7", "8")
 	require.Equal(t, `["1" "2" "3" "4" "5" "6" "7" "8"]`, tup.String())
@@ -237,6 +663,31 @@ func TestT8_GoString(t *testing.T) {
 	require.Equal(t, `tuple.T8[string, string, string, string, string, string, string, string]{V1: "1", V2: "2", V3: "3", V4: "4", V5: "5", V6: "6", V7: "7", V8: "8"}`, tup.GoString())
 }
 
+func TestT8_Parse(t *testing.T) {
+	tup := New8("1", "2", "3", "4", "5", "6", "7", "8")
+
+	got, err := Parse8[string, string, string, string, string, string, string, string](tup.String())
+	require.NoError(t, err)
+	require.Equal(t, tup, got)
+}
+
+func TestT8_Parse_Mixed(t *testing.T) {
+	tup := New8(New2("1", 1.5), New2("2", 2.5), New2("3", 3.5), New2("4", 4.5), New2("5", 5.5), New2("6", 6.5), New2("7", 7.5), New2("8", 8.5))
+
+	got, err := Parse8[T2[string, float64], T2[string, float64], T2[string, float64], T2[string, float64], T2[string, float64], T2[string, float64], T2[string, float64], T2[string, float64]](tup.String())
+	require.NoError(t, err)
+	require.Equal(t, tup, got)
+}
+
+func TestT8_Parse_Invalid(t *testing.T) {
+	_, err := Parse8[int, int, int, int, int, int, int, int](`["1" "2" "3" "4" "5" "6" "7" "8"]`)
+	require.Error(t, err)
+
+	var parseErr *ParseError
+	require.ErrorAs(t, err, &parseErr)
+	require.Equal(t, 1, parseErr.Offset)
+}
+
 func TestT8_ToArray(t *testing.T) {
 	tup := New8("1", "2", "3", "4", "5", "6", "7", "8")
 	require.Equal(t, [8]any{
@@ -607,147 +1058,1227 @@ func TestT8_FromSlice(t *testing.T) {
 	}
 }
 
-func TestT8_MarshalJSON(t *testing.T) {
-	tup := New8("1", "2", "3", "4", "5", "6", "7", "8")
+func TestT8_FromSlice_Errors(t *testing.T) {
+	_, err := FromSlice8[string, string, string, string, string, string, string, string]([]any{})
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, &LengthMismatchError{Source: "slice", Expected: 8, Actual: 0}, lengthErr)
+	require.EqualError(t, err, "slice length 0 must match number of tuple values 8")
+
+	_, err = FromSlice8[string, string, string, string, string, string, string, string]([]any{1, "2", "3", "4", "5", "6", "7", "8"})
+	var typeErr1 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr1)
+	require.Equal(t, 0, typeErr1.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr1.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr1.Actual)
+	require.EqualError(t, err, "value at slice index 0 expected to have type string but has type int")
+
+	_, err = FromSlice8[string, string, string, string, string, string, string, string]([]any{"1", 2, "3", "4", "5", "6", "7", "8"})
+	var typeErr2 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr2)
+	require.Equal(t, 1, typeErr2.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr2.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr2.Actual)
+	require.EqualError(t, err, "value at slice index 1 expected to have type string but has type int")
+
+	_, err = FromSlice8[string, string, string, string, string, string, string, string]([]any{"1", "2", 3, "4", "5", "6", "7", "8"})
+	var typeErr3 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr3)
+	require.Equal(t, 2, typeErr3.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr3.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr3.Actual)
+	require.EqualError(t, err, "value at slice index 2 expected to have type string but has type int")
+
+	_, err = FromSlice8[string, string, string, string, string, string, string, string]([]any{"1", "2", "3", 4, "5", "6", "7", "8"})
+	var typeErr4 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr4)
+	require.Equal(t, 3, typeErr4.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr4.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr4.Actual)
+	require.EqualError(t, err, "value at slice index 3 expected to have type string but has type int")
+
+	_, err = FromSlice8[string, string, string, string, string, string, string, string]([]any{"1", "2", "3", "4", 5, "6", "7", "8"})
+	var typeErr5 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr5)
+	require.Equal(t, 4, typeErr5.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr5.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr5.Actual)
+	require.EqualError(t, err, "value at slice index 4 expected to have type string but has type int")
+
+	_, err = FromSlice8[string, string, string, string, string, string, string, string]([]any{"1", "2", "3", "4", "5", 6, "7", "8"})
+	var typeErr6 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr6)
+	require.Equal(t, 5, typeErr6.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr6.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr6.Actual)
+	require.EqualError(t, err, "value at slice index 5 expected to have type string but has type int")
+
+	_, err = FromSlice8[string, string, string, string, string, string, string, string]([]any{"1", "2", "3", "4", "5", "6", 7, "8"})
+	var typeErr7 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr7)
+	require.Equal(t, 6, typeErr7.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr7.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr7.Actual)
+	require.EqualError(t, err, "value at slice index 6 expected to have type string but has type int")
+
+	_, err = FromSlice8[string, string, string, string, string, string, string, string]([]any{"1", "2", "3", "4", "5", "6", "7", 8})
+	var typeErr8 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr8)
+	require.Equal(t, 7, typeErr8.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr8.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr8.Actual)
+	require.EqualError(t, err, "value at slice index 7 expected to have type string but has type int")
+
+	_, err = FromArray8[string, string, string, string, string, string, string, string]([8]any{})
+	var arrayTypeErr *ElementTypeError
+	require.ErrorAs(t, err, &arrayTypeErr)
+	require.Equal(t, &ElementTypeError{Source: "array", Index: 0, Expected: reflect.TypeOf("")}, arrayTypeErr)
+	require.EqualError(t, err, "value at array index 0 expected to have type string but has type <nil>")
+}
 
-	got, err := json.Marshal(tup)
-	require.NoError(t, err)
-	require.Equal(t, got, []byte(`["1","2","3","4","5","6","7","8"]`))
+func TestT8_UnmarshalJSON_Errors(t *testing.T) {
+	var tup T8[string, string, string, string, string, string, string, string]
+
+	err := json.Unmarshal([]byte(`[]`), &tup)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, 8, lengthErr.Expected)
+	require.Equal(t, 0, lengthErr.Actual)
+	require.EqualError(t, err, "unmarshalled json array length 0 must match number of tuple values 8")
+
+	err = tup.UnmarshalJSON([]byte(`["1","2","3","4","5","6","7",8]`))
+	var elementErr *ElementUnmarshalError
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, 7, elementErr.Index)
+	require.Equal(t, "8", elementErr.Value)
+	var typeErr *json.UnmarshalTypeError
+	require.ErrorAs(t, err, &typeErr)
+	require.ErrorContains(t, err, `value "8" at slice index 7 failed to unmarshal: `)
 }
 
-func TestT8_UnmarshalJSON(t *testing.T) {
+func TestT8_ConvertSlice(t *testing.T) {
 	tests := []struct {
 		name    string
-		data    []byte
-		want    T8[string, string, string, string, string, string, string, string]
+		slice   []any
+		want    T8[int, int, int, int, int, int, int, int]
 		wantErr bool
 	}{
 		{
-			name:    "nil data",
-			data:    nil,
+			name:  "all types match",
+			slice: []any{1, 2, 3, 4, 5, 6, 7, 8},
+			want:  New8(1, 2, 3, 4, 5, 6, 7, 8),
+		},
+		{
+			name:  "decoded json numbers",
+			slice: []any{float64(1), float64(2), float64(3), float64(4), float64(5), float64(6), float64(7), float64(8)},
+			want:  New8(1, 2, 3, 4, 5, 6, 7, 8),
+		},
+		{
+			name:  "json numbers",
+			slice: []any{json.Number("1"), json.Number("2"), json.Number("3"), json.Number("4"), json.Number("5"), json.Number("6"), json.Number("7"), json.Number("8")},
+			want:  New8(1, 2, 3, 4, 5, 6, 7, 8),
+		},
+		{
+			name:    "slice empty",
+			slice:   []any{},
 			wantErr: true,
 		},
 		{
-			name:    "empty data",
-			data:    []byte{},
+			name:    "index 1 bad type",
+			slice:   []any{"1", 2, 3, 4, 5, 6, 7, 8},
 			wantErr: true,
 		},
 		{
-			name:    "string data",
-			data:    []byte(`"hi"`),
+			name:    "index 1 fractional number",
+			slice:   []any{1.5, 2, 3, 4, 5, 6, 7, 8},
 			wantErr: true,
 		},
 		{
-			name:    "empty json array",
-			data:    []byte(`[]`),
+			name:    "index 2 bad type",
+			slice:   []any{1, "2", 3, 4, 5, 6, 7, 8},
 			wantErr: true,
 		},
 		{
-			name:    "longer json array",
-			data:    []byte(`["1", "2", "3", "4", "5", "6", "7", "8", "9", "10"]`),
+			name:    "index 2 fractional number",
+			slice:   []any{1, 2.5, 3, 4, 5, 6, 7, 8},
 			wantErr: true,
 		},
-
 		{
-			name:    "json array with invalid type at index 0",
-			data:    []byte(`[1,"2","3","4","5","6","7","8"]`),
+			name:    "index 3 bad type",
+			slice:   []any{1, 2, "3", 4, 5, 6, 7, 8},
 			wantErr: true,
 		},
 		{
-			name:    "json array with invalid type at index 1",
-			data:    []byte(`["1",2,"3","4","5","6","7","8"]`),
+			name:    "index 3 fractional number",
+			slice:   []any{1, 2, 3.5, 4, 5, 6, 7, 8},
 			wantErr: true,
 		},
 		{
-			name:    "json array with invalid type at index 2",
-			data:    []byte(`["1","2",3,"4","5","6","7","8"]`),
+			name:    "index 4 bad type",
+			slice:   []any{1, 2, 3, "4", 5, 6, 7, 8},
 			wantErr: true,
 		},
 		{
-			name:    "json array with invalid type at index 3",
-			data:    []byte(`["1","2","3",4,"5","6","7","8"]`),
+			name:    "index 4 fractional number",
+			slice:   []any{1, 2, 3, 4.5, 5, 6, 7, 8},
 			wantErr: true,
 		},
 		{
-			name:    "json array with invalid type at index 4",
-			data:    []byte(`["1","2","3","4",5,"6","7","8"]`),
+			name:    "index 5 bad type",
+			slice:   []any{1, 2, 3, 4, "5", 6, 7, 8},
 			wantErr: true,
 		},
 		{
-			name:    "json array with invalid type at index 5",
-			data:    []byte(`["1","2","3","4","5",6,"7","8"]`),
+			name:    "index 5 fractional number",
+			slice:   []any{1, 2, 3, 4, 5.5, 6, 7, 8},
 			wantErr: true,
 		},
 		{
-			name:    "json array with invalid type at index 6",
-			data:    []byte(`["1","2","3","4","5","6",7,"8"]`),
+			name:    "index 6 bad type",
+			slice:   []any{1, 2, 3, 4, 5, "6", 7, 8},
 			wantErr: true,
 		},
 		{
-			name:    "json array with invalid type at index 7",
-			data:    []byte(`["1","2","3","4","5","6","7",8]`),
+			name:    "index 6 fractional number",
+			slice:   []any{1, 2, 3, 4, 5, 6.5, 7, 8},
 			wantErr: true,
 		},
 		{
-			name:    "json array of valid types",
-			data:    []byte(`["1","2","3","4","5","6","7","8"]`),
-			want:    New8("1", "2", "3", "4", "5", "6", "7", "8"),
-			wantErr: false,
+			name:    "index 7 bad type",
+			slice:   []any{1, 2, 3, 4, 5, 6, "7", 8},
+			wantErr: true,
 		},
 		{
-			name:    "json object of valid types",
-			data:    []byte(`{"V1": "1","V2": "2","V3": "3","V4": "4","V5": "5","V6": "6","V7": "7","V8": "8"}`),
+			name:    "index 7 fractional number",
+			slice:   []any{1, 2, 3, 4, 5, 6, 7.5, 8},
+			wantErr: true,
+		},
+		{
+			name:    "index 8 bad type",
+			slice:   []any{1, 2, 3, 4, 5, 6, 7, "8"},
+			wantErr: true,
+		},
+		{
+			name:    "index 8 fractional number",
+			slice:   []any{1, 2, 3, 4, 5, 6, 7, 8.5},
 			wantErr: true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			var got T8[string, string, string, string, string, string, string, string]
-			err := json.Unmarshal(tt.data, &got)
+			tup, err := ConvertSlice8[int, int, int, int, int, int, int, int](tt.slice)
 			if tt.wantErr {
 				require.Error(t, err)
 				return
 			}
 
 			require.NoError(t, err)
-			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.want, tup)
 		})
 	}
 }
 
-func TestT8_Unmarshal_CustomStruct(t *testing.T) {
-	type Custom struct {
-		Name string `json:"name"`
-		Age  int    `json:"age"`
-	}
+func TestT8_ConvertSlice_Errors(t *testing.T) {
+	_, err := ConvertSlice8[int8, int8, int8, int8, int8, int8, int8, int8]([]any{})
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+
+	_, err = ConvertSlice8[int8, int8, int8, int8, int8, int8, int8, int8]([]any{1, 2, 3, 4, 5, 6, 7, true})
+	var typeErr *ElementTypeError
+	require.ErrorAs(t, err, &typeErr)
+	require.Equal(t, 7, typeErr.Index)
+
+	_, err = ConvertSlice8[int8, int8, int8, int8, int8, int8, int8, int8]([]any{1, 2, 3, 4, 5, 6, 7, float64(128)})
+	var conversionErr *ElementConversionError
+	require.ErrorAs(t, err, &conversionErr)
+	require.Equal(t, 7, conversionErr.Index)
+	require.Equal(t, reflect.TypeOf(int8(0)), conversionErr.Expected)
+}
 
-	want := New8(Custom{Name: "1", Age: 1}, Custom{Name: "2", Age: 2}, Custom{Name: "3", Age: 3}, Custom{Name: "4", Age: 4}, Custom{Name: "5", Age: 5}, Custom{Name: "6", Age: 6}, Custom{Name: "7", Age: 7}, Custom{Name: "8", Age: 8})
-	var got T8[Custom, Custom, Custom, Custom, Custom, Custom, Custom, Custom]
-	err := json.Unmarshal([]byte(`[
-		{ "name": "1", "age": 1 },
-		{ "name": "2", "age": 2 },
-		{ "name": "3", "age": 3 },
-		{ "name": "4", "age": 4 },
-		{ "name": "5", "age": 5 },
-		{ "name": "6", "age": 6 },
-		{ "name": "7", "age": 7 },
-		{ "name": "8", "age": 8 }
-	]`), &got)
+func TestT8_ScanRow(t *testing.T) {
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{"c1", "c2", "c3", "c4", "c5", "c6", "c7", "c8"},
+		rows: [][]driver.Value{
+			{int64(1), int64(2), int64(3), int64(4), int64(5), int64(6), int64(7), int64(8)},
+		},
+	})
 
+	require.True(t, rows.Next())
+	got, err := ScanRow8[string, int, int, int, int, int, int, int](rows)
 	require.NoError(t, err)
-	require.Equal(t, want, got)
+	require.Equal(t, New8("1", 2, 3, 4, 5, 6, 7, 8), got)
 }
 
-func TestT8_Marshal_Unmarshal(t *testing.T) {
-	tup := New8("1", "2", "3", "4", "5", "6", "7", "8")
+func TestT8_ScanRow_ColumnsMismatch(t *testing.T) {
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{"c1", "c2", "c3", "c4", "c5", "c6", "c7", "c8", "extra"},
+		rows: [][]driver.Value{
+			{int64(1), int64(2), int64(3), int64(4), int64(5), int64(6), int64(7), int64(8), int64(0)},
+		},
+	})
 
-	marshalled, err := json.Marshal(tup)
+	require.True(t, rows.Next())
+	_, err := ScanRow8[int, int, int, int, int, int, int, int](rows)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.EqualError(t, err, "row columns count 9 must match number of tuple values 8")
+}
+
+func TestT8_CollectRows(t *testing.T) {
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{"c1", "c2", "c3", "c4", "c5", "c6", "c7", "c8"},
+		rows: [][]driver.Value{
+			{int64(1), int64(2), int64(3), int64(4), int64(5), int64(6), int64(7), int64(8)},
+			{int64(2), int64(3), int64(4), int64(5), int64(6), int64(7), int64(8), int64(9)},
+		},
+	})
+
+	got, err := CollectRows8[int, int, int, int, int, int, int, int](rows)
 	require.NoError(t, err)
+	require.Equal(t, []T8[int, int, int, int, int, int, int, int]{
+		New8(1, 2, 3, 4, 5, 6, 7, 8),
+		New8(2, 3, 4, 5, 6, 7, 8, 9),
+	}, got)
 
-	var unmarshalled T8[string, string, string, string, string, string, string, string]
-	err = json.Unmarshal(marshalled, &unmarshalled)
+	rows = fakeQuery(t, fakeResult{columns: []string{"c1", "c2", "c3", "c4", "c5", "c6", "c7", "c8"}})
+	got, err = CollectRows8[int, int, int, int, int, int, int, int](rows)
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
+
+func TestT8_ReadCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		opts    CSVOptions
+		want    []T8[int, int, int, int, int, int, int, int]
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			data: "",
+			want: nil,
+		},
+		{
+			name: "records",
+			data: "1,2,3,4,5,6,7,8\n2,3,4,5,6,7,8,9\n",
+			want: []T8[int, int, int, int, int, int, int, int]{
+				New8(1, 2, 3, 4, 5, 6, 7, 8),
+				New8(2, 3, 4, 5, 6, 7, 8, 9),
+			},
+		},
+		{
+			name: "header",
+			data: "v1,v2,v3,v4,v5,v6,v7,v8\n1,2,3,4,5,6,7,8\n",
+			opts: CSVOptions{Header: []string{"v1", "v2", "v3", "v4", "v5", "v6", "v7", "v8"}},
+			want: []T8[int, int, int, int, int, int, int, int]{New8(1, 2, 3, 4, 5, 6, 7, 8)},
+		},
+		{
+			name: "skip header",
+			data: "c1,c2,c3,c4,c5,c6,c7,c8\n1,2,3,4,5,6,7,8\n",
+			opts: CSVOptions{SkipHeader: true},
+			want: []T8[int, int, int, int, int, int, int, int]{New8(1, 2, 3, 4, 5, 6, 7, 8)},
+		},
+		{
+			name:    "mismatching header",
+			data:    "c1,c2,c3,c4,c5,c6,c7,c8\n1,2,3,4,5,6,7,8\n",
+			opts:    CSVOptions{Header: []string{"v1", "v2", "v3", "v4", "v5", "v6", "v7", "v8"}},
+			wantErr: true,
+		},
+		{
+			name: "semicolon delimiter",
+			data: "1;2;3;4;5;6;7;8\n",
+			opts: CSVOptions{Comma: ';'},
+			want: []T8[int, int, int, int, int, int, int, int]{New8(1, 2, 3, 4, 5, 6, 7, 8)},
+		},
+		{
+			name:    "too many fields",
+			data:    "1,2,3,4,5,6,7,8,0\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid field",
+			data:    "1,2,3,4,5,6,7,x\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadCSV8[int, int, int, int, int, int, int, int](strings.NewReader(tt.data), tt.opts)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT8_ReadCSV_Error(t *testing.T) {
+	data := "1,2,3,4,5,6,7,8\n1,2,3,4,5,6,7,x\n"
 
+	_, err := ReadCSV8[int, int, int, int, int, int, int, int](strings.NewReader(data), CSVOptions{})
+	var csvErr *CSVError
+	require.ErrorAs(t, err, &csvErr)
+	require.Equal(t, 2, csvErr.Line)
+	require.Equal(t, 8, csvErr.Column)
+
+	var elementErr *ElementUnmarshalError
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, "x", elementErr.Value)
+}
+
+func TestT8_WriteCSV(t *testing.T) {
+	tups := []T8[string, string, string, string, string, string, string, string]{
+		New8("1", "2", "3", "4", "5", "6", "7", "8"),
+		New8("1,\"1\"", "2,\"2\"", "3,\"3\"", "4,\"4\"", "5,\"5\"", "6,\"6\"", "7,\"7\"", "8,\"8\""),
+	}
+
+	var buf strings.Builder
+	err := WriteCSV8(&buf, tups, CSVOptions{Header: []string{"v1", "v2", "v3", "v4", "v5", "v6", "v7", "v8"}})
 	require.NoError(t, err)
-	require.Equal(t, tup, unmarshalled)
+	require.Equal(t, "v1,v2,v3,v4,v5,v6,v7,v8\n1,2,3,4,5,6,7,8\n\"1,\"\"1\"\"\",\"2,\"\"2\"\"\",\"3,\"\"3\"\"\",\"4,\"\"4\"\"\",\"5,\"\"5\"\"\",\"6,\"\"6\"\"\",\"7,\"\"7\"\"\",\"8,\"\"8\"\"\"\n", buf.String())
+
+	got, err := ReadCSV8[string, string, string, string, string, string, string, string](strings.NewReader(buf.String()), CSVOptions{SkipHeader: true})
+	require.NoError(t, err)
+	require.Equal(t, tups, got)
+}
+
+func TestT8_WriteCSV_Error(t *testing.T) {
+	tups := []T8[string, string, string, string, string, string, string, []int]{
+		{V1: "a\nb", V2: "a\nb", V3: "a\nb", V4: "a\nb", V5: "a\nb", V6: "a\nb", V7: "a\nb", V8: []int{8}},
+	}
+
+	var buf strings.Builder
+	err := WriteCSV8(&buf, tups, CSVOptions{Header: []string{"v1", "v2", "v3", "v4", "v5", "v6", "v7", "v8"}})
+	var csvErr *CSVError
+	require.ErrorAs(t, err, &csvErr)
+	require.Equal(t, 2, csvErr.Line)
+	require.Equal(t, 8, csvErr.Column)
+
+	err = WriteCSV8(&buf, tups, CSVOptions{Header: []string{"v1", "v2", "v3", "v4", "v5", "v6", "v7", "v8", "extra"}})
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+}
+
+func TestT8_Hash(t *testing.T) {
+	seed := maphash.MakeSeed()
+	tup := New8("1", "2", "3", "4", "5", "6", "7", "8")
+
+	require.Equal(t, tup.Hash(seed), New8("1", "2", "3", "4", "5", "6", "7", "8").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New8("2", "2", "3", "4", "5", "6", "7", "8").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New8("1", "3", "3", "4", "5", "6", "7", "8").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New8("1", "2", "4", "4", "5", "6", "7", "8").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New8("1", "2", "3", "5", "5", "6", "7", "8").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New8("1", "2", "3", "4", "6", "6", "7", "8").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New8("1", "2", "3", "4", "5", "7", "7", "8").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New8("1", "2", "3", "4", "5", "6", "8", "8").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New8("1", "2", "3", "4", "5", "6", "7", "9").Hash(seed))
+}
+
+func TestT8_Hash_Nested(t *testing.T) {
+	seed := maphash.MakeSeed()
+	tup := New8(New2("1", 1), New2("2", 2), New2("3", 3), New2("4", 4), New2("5", 5), New2("6", 6), New2("7", 7), New2("8", 8))
+
+	require.Equal(t, tup.Hash(seed), New8(New2("1", 1), New2("2", 2), New2("3", 3), New2("4", 4), New2("5", 5), New2("6", 6), New2("7", 7), New2("8", 8)).Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New8(New2("1", 2), New2("2", 3), New2("3", 4), New2("4", 5), New2("5", 6), New2("6", 7), New2("7", 8), New2("8", 9)).Hash(seed))
+}
+
+func TestT8_Hash_Unsupported(t *testing.T) {
+	seed := maphash.MakeSeed()
+	tup := New8([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8})
+	require.NotPanics(t, func() {
+		tup.Hash(seed)
+	})
+	require.Equal(t, tup.Hash(seed), New8([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8}).Hash(seed))
+
+	var nilTup T8[any, any, any, any, any, any, any, any]
+	require.NotPanics(t, func() {
+		nilTup.Hash(seed)
+	})
+	require.Panics(t, func() {
+		HashValue(seed, tup.V1)
+	})
+}
+
+func TestT8_HashFunc(t *testing.T) {
+	seed := maphash.MakeSeed()
+	hashSlice := func(seed maphash.Seed, v []int) uint64 {
+		return HashValue(seed, len(v))
+	}
+
+	hash := HashFunc8(hashSlice, hashSlice, hashSlice, hashSlice, hashSlice, hashSlice, hashSlice, hashSlice)
+	tup := New8([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8})
+
+	require.Equal(t, hash(seed, tup), hash(seed, New8([]int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8}, []int{9})))
+	require.NotEqual(t, hash(seed, tup), hash(seed, New8([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8}, []int{8, 9})))
+}
+
+func TestT8_MarshalJSON(t *testing.T) {
+	tup := New8("1", "2", "3", "4", "5", "6", "7", "8")
+
+	got, err := json.Marshal(tup)
+	require.NoError(t, err)
+	require.Equal(t, got, []byte(`["1","2","3","4","5","6","7","8"]`))
+}
+
+func TestT8_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		want    T8[string, string, string, string, string, string, string, string]
+		wantErr bool
+	}{
+		{
+			name:    "nil data",
+			data:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "empty data",
+			data:    []byte{},
+			wantErr: true,
+		},
+		{
+			name:    "string data",
+			data:    []byte(`"hi"`),
+			wantErr: true,
+		},
+		{
+			name:    "empty json array",
+			data:    []byte(`[]`),
+			wantErr: true,
+		},
+		{
+			name:    "longer json array",
+			data:    []byte(`["1", "2", "3", "4", "5", "6", "7", "8", "9", "10"]`),
+			wantErr: true,
+		},
+
+		{
+			name:    "json array with invalid type at index 0",
+			data:    []byte(`[1,"2","3","4","5","6","7","8"]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array with invalid type at index 1",
+			data:    []byte(`["1",2,"3","4","5","6","7","8"]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array with invalid type at index 2",
+			data:    []byte(`["1","2",3,"4","5","6","7","8"]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array with invalid type at index 3",
+			data:    []byte(`["1","2","3",4,"5","6","7","8"]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array with invalid type at index 4",
+			data:    []byte(`["1","2","3","4",5,"6","7","8"]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array with invalid type at index 5",
+			data:    []byte(`["1","2","3","4","5",6,"7","8"]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array with invalid type at index 6",
+			data:    []byte(`["1","2","3","4","5","6",7,"8"]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array with invalid type at index 7",
+			data:    []byte(`["1","2","3","4","5","6","7",8]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array of valid types",
+			data:    []byte(`["1","2","3","4","5","6","7","8"]`),
+			want:    New8("1", "2", "3", "4", "5", "6", "7", "8"),
+			wantErr: false,
+		},
+		{
+			name:    "json object of valid types",
+			data:    []byte(`{"V1": "1","V2": "2","V3": "3","V4": "4","V5": "5","V6": "6","V7": "7","V8": "8"}`),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T8[string, string, string, string, string, string, string, string]
+			err := json.Unmarshal(tt.data, &got)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT8_Unmarshal_CustomStruct(t *testing.T) {
+	type Custom struct {
+		Name string `json:"name"`
+		Age  int    `json:"age"`
+	}
+
+	want := New8(Custom{Name: "1", Age: 1}, Custom{Name: "2", Age: 2}, Custom{Name: "3", Age: 3}, Custom{Name: "4", Age: 4}, Custom{Name: "5", Age: 5}, Custom{Name: "6", Age: 6}, Custom{Name: "7", Age: 7}, Custom{Name: "8", Age: 8})
+	var got T8[Custom, Custom, Custom, Custom, Custom, Custom, Custom, Custom]
+	err := json.Unmarshal([]byte(`[
+		{ "name": "1", "age": 1 },
+		{ "name": "2", "age": 2 },
+		{ "name": "3", "age": 3 },
+		{ "name": "4", "age": 4 },
+		{ "name": "5", "age": 5 },
+		{ "name": "6", "age": 6 },
+		{ "name": "7", "age": 7 },
+		{ "name": "8", "age": 8 }
+	]`), &got)
+
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+func TestT8_Marshal_Unmarshal(t *testing.T) {
+	tup := New8("1", "2", "3", "4", "5", "6", "7", "8")
+
+	marshalled, err := json.Marshal(tup)
+	require.NoError(t, err)
+
+	var unmarshalled T8[string, string, string, string, string, string, string, string]
+	err = json.Unmarshal(marshalled, &unmarshalled)
+
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT8_UnmarshalJSONWith(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		opts    JSONDecodeOptions
+		want    T8[string, string, string, string, string, string, string, string]
+		wantErr bool
+	}{
+		{
+			name:    "empty json array",
+			data:    `[]`,
+			wantErr: true,
+		},
+		{
+			name: "allow shorter empty json array",
+			data: `[]`,
+			opts: JSONDecodeOptions{AllowShorter: true},
+			want: T8[string, string, string, string, string, string, string, string]{},
+		},
+		{
+			name: "allow shorter partial json array",
+			data: `["1"]`,
+			opts: JSONDecodeOptions{AllowShorter: true},
+			want: T8[string, string, string, string, string, string, string, string]{V1: "1"},
+		},
+		{
+			name:    "allow shorter longer json array",
+			data:    `["1","2","3","4","5","6","7","8","10"]`,
+			opts:    JSONDecodeOptions{AllowShorter: true},
+			wantErr: true,
+		},
+		{
+			name: "json array with null values",
+			data: `[null,null,null,null,null,null,null,null]`,
+			want: T8[string, string, string, string, string, string, string, string]{},
+		},
+		{
+			name:    "disallow null json array with null values",
+			data:    `[null,null,null,null,null,null,null,null]`,
+			opts:    JSONDecodeOptions{DisallowNull: true},
+			wantErr: true,
+		},
+		{
+			name: "disallow null json array of valid types",
+			data: `["1","2","3","4","5","6","7","8"]`,
+			opts: JSONDecodeOptions{DisallowNull: true},
+			want: New8("1", "2", "3", "4", "5", "6", "7", "8"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T8[string, string, string, string, string, string, string, string]
+			err := got.UnmarshalJSONWith([]byte(tt.data), tt.opts)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT8_UnmarshalJSONWith_Nested(t *testing.T) {
+	type Custom struct {
+		Name string `json:"name"`
+	}
+
+	data := []byte(`[[1, {"name": "1", "age": 1}],[2, {"name": "2", "age": 2}],[3, {"name": "3", "age": 3}],[4, {"name": "4", "age": 4}],[5, {"name": "5", "age": 5}],[6, {"name": "6", "age": 6}],[7, {"name": "7", "age": 7}],[8, {"name": "8", "age": 8}]]`)
+
+	var got T8[T2[any, Custom], T2[any, Custom], T2[any, Custom], T2[any, Custom], T2[any, Custom], T2[any, Custom], T2[any, Custom], T2[any, Custom]]
+	err := got.UnmarshalJSONWith(data, JSONDecodeOptions{UseNumber: true})
+	require.NoError(t, err)
+	require.Equal(t, New8(New2[any](json.Number("1"), Custom{Name: "1"}), New2[any](json.Number("2"), Custom{Name: "2"}), New2[any](json.Number("3"), Custom{Name: "3"}), New2[any](json.Number("4"), Custom{Name: "4"}), New2[any](json.Number("5"), Custom{Name: "5"}), New2[any](json.Number("6"), Custom{Name: "6"}), New2[any](json.Number("7"), Custom{Name: "7"}), New2[any](json.Number("8"), Custom{Name: "8"})), got)
+
+	err = got.UnmarshalJSONWith(data, JSONDecodeOptions{DisallowUnknownFields: true})
+	require.Error(t, err)
+}
+
+func TestT8_MarshalJSONLabeled(t *testing.T) {
+	tup := New8(1, 2, 3, 4, 5, 6, 7, 8)
+	labels := WithLabels("v1", "v2", "v3", "v4", "v5", "v6", "v7", "v8")
+
+	got, err := tup.MarshalJSONLabeled(labels)
+	require.NoError(t, err)
+	require.Equal(t, `{"v1":1,"v2":2,"v3":3,"v4":4,"v5":5,"v6":6,"v7":7,"v8":8}`, string(got))
+
+	_, err = tup.MarshalJSONLabeled(WithLabels("v1", "v2", "v3", "v4", "v5", "v6", "v7", "v8", "extra"))
+	require.Error(t, err)
+}
+
+func TestT8_UnmarshalJSONLabeled(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		labels  Labels
+		want    T8[int, int, int, int, int, int, int, int]
+		wantErr bool
+	}{
+		{
+			name:   "all keys",
+			data:   `{"v1":1,"v2":2,"v3":3,"v4":4,"v5":5,"v6":6,"v7":7,"v8":8}`,
+			labels: WithLabels("v1", "v2", "v3", "v4", "v5", "v6", "v7", "v8"),
+			want:   New8(1, 2, 3, 4, 5, 6, 7, 8),
+		},
+		{
+			name:   "missing keys",
+			data:   `{}`,
+			labels: WithLabels("v1", "v2", "v3", "v4", "v5", "v6", "v7", "v8"),
+			want:   T8[int, int, int, int, int, int, int, int]{},
+		},
+		{
+			name:    "strict missing keys",
+			data:    `{}`,
+			labels:  WithLabels("v1", "v2", "v3", "v4", "v5", "v6", "v7", "v8").Strict(),
+			wantErr: true,
+		},
+		{
+			name:   "unknown keys",
+			data:   `{"v1":1,"v2":2,"v3":3,"v4":4,"v5":5,"v6":6,"v7":7,"v8":8,"extra":0}`,
+			labels: WithLabels("v1", "v2", "v3", "v4", "v5", "v6", "v7", "v8"),
+			want:   New8(1, 2, 3, 4, 5, 6, 7, 8),
+		},
+		{
+			name:    "strict unknown keys",
+			data:    `{"v1":1,"v2":2,"v3":3,"v4":4,"v5":5,"v6":6,"v7":7,"v8":8,"extra":0}`,
+			labels:  WithLabels("v1", "v2", "v3", "v4", "v5", "v6", "v7", "v8").Strict(),
+			wantErr: true,
+		},
+		{
+			name:    "json array",
+			data:    `[1,2,3,4,5,6,7,8]`,
+			labels:  WithLabels("v1", "v2", "v3", "v4", "v5", "v6", "v7", "v8"),
+			wantErr: true,
+		},
+		{
+			name:    "labels count mismatch",
+			data:    `{}`,
+			labels:  WithLabels("v1", "v2", "v3", "v4", "v5", "v6", "v7", "v8", "extra"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T8[int, int, int, int, int, int, int, int]
+			err := got.UnmarshalJSONLabeled([]byte(tt.data), tt.labels)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT8_MarshalText(t *testing.T) {
+	tup := New8("1", "2", "3", "4", "5", "6", "7", "8")
+
+	got, err := tup.MarshalText()
+	require.NoError(t, err)
+	require.Equal(t, `1,2,3,4,5,6,7,8`, string(got))
+}
+
+func TestT8_MarshalText_Unsupported(t *testing.T) {
+	tup := New8([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8})
+
+	_, err := tup.MarshalText()
+	require.Error(t, err)
+}
+
+func TestT8_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    T8[string, string, string, string, string, string, string, string]
+		wantErr bool
+	}{
+		{
+			name: "valid values",
+			data: `1,2,3,4,5,6,7,8`,
+			want: New8("1", "2", "3", "4", "5", "6", "7", "8"),
+		},
+		{
+			name: "escaped values",
+			data: `a\,1\\,a\,2\\,a\,3\\,a\,4\\,a\,5\\,a\,6\\,a\,7\\,a\,8\\`,
+			want: New8(`a,1\`, `a,2\`, `a,3\`, `a,4\`, `a,5\`, `a,6\`, `a,7\`, `a,8\`),
+		},
+		{
+			name:    "too few values",
+			data:    `2,3,4,5,6,7,8`,
+			wantErr: true,
+		},
+		{
+			name:    "too many values",
+			data:    `1,2,3,4,5,6,7,8,extra`,
+			wantErr: true,
+		},
+		{
+			name:    "dangling escape",
+			data:    `1,2,3,4,5,6,7,8\`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T8[string, string, string, string, string, string, string, string]
+			err := got.UnmarshalText([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT8_MarshalText_UnmarshalText(t *testing.T) {
+	tup := New8(New2("a,1\\\\", 1), New2("a,2\\\\", 2), New2("a,3\\\\", 3), New2("a,4\\\\", 4), New2("a,5\\\\", 5), New2("a,6\\\\", 6), New2("a,7\\\\", 7), New2("a,8\\\\", 8))
+
+	marshalled, err := tup.MarshalText()
+	require.NoError(t, err)
+
+	var unmarshalled T8[T2[string, int], T2[string, int], T2[string, int], T2[string, int], T2[string, int], T2[string, int], T2[string, int], T2[string, int]]
+	err = unmarshalled.UnmarshalText(marshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT8_GobEncode_GobDecode(t *testing.T) {
+	t.Run("strings", func(t *testing.T) {
+		requireGobRoundTrip(t, New8("1", "2", "3", "4", "5", "6", "7", "8"))
+	})
+
+	t.Run("zero values", func(t *testing.T) {
+		requireGobRoundTrip(t, T8[int, int, int, int, int, int, int, int]{})
+	})
+
+	t.Run("nested tuples", func(t *testing.T) {
+		requireGobRoundTrip(t, New8(New2("1", 1), New2("2", 2), New2("3", 3), New2("4", 4), New2("5", 5), New2("6", 6), New2("7", 7), New2("8", 8)))
+	})
+
+	t.Run("pointers", func(t *testing.T) {
+		requireGobRoundTrip(t, New8(ptr(1), ptr(2), ptr(3), ptr(4), ptr(5), ptr(6), ptr(7), ptr(8)))
+	})
+
+	t.Run("pointers to zero values", func(t *testing.T) {
+		requireGobRoundTrip(t, New8(ptr(0), ptr(0), ptr(0), ptr(0), ptr(0), ptr(0), ptr(0), ptr(0)))
+	})
+
+	t.Run("nil pointers", func(t *testing.T) {
+		requireGobRoundTrip(t, T8[*int, *int, *int, *int, *int, *int, *int, *int]{})
+	})
+
+	t.Run("nested tuple pointers", func(t *testing.T) {
+		requireGobRoundTrip(t, New8((*T2[string, *int])(nil), &T2[string, *int]{V1: "2", V2: ptr(2)}, &T2[string, *int]{V1: "3", V2: ptr(3)}, &T2[string, *int]{V1: "4", V2: ptr(4)}, &T2[string, *int]{V1: "5", V2: ptr(5)}, &T2[string, *int]{V1: "6", V2: ptr(6)}, &T2[string, *int]{V1: "7", V2: ptr(7)}, &T2[string, *int]{V1: "8", V2: ptr(8)}))
+	})
+
+	t.Run("interface values", func(t *testing.T) {
+		requireGobRoundTrip(t, T8[any, any, any, any, any, any, any, any]{V1: nil, V2: "2", V3: 3, V4: 4, V5: 5, V6: 6, V7: 7, V8: 8})
+	})
+
+	t.Run("struct field", func(t *testing.T) {
+		type Custom struct {
+			Tup T8[int, int, int, int, int, int, int, int]
+		}
+
+		requireGobRoundTrip(t, Custom{Tup: New8(1, 2, 3, 4, 5, 6, 7, 8)})
+	})
+}
+
+func TestT8_GobDecode_Invalid(t *testing.T) {
+	encoded, err := New1("1").GobEncode()
+	require.NoError(t, err)
+
+	var tup T8[string, string, string, string, string, string, string, string]
+	err = tup.GobDecode(encoded)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, 8, lengthErr.Expected)
+
+	encoded, err = New8("1", "2", "3", "4", "5", "6", "7", "8").GobEncode()
+	require.NoError(t, err)
+
+	var mismatched T8[int, int, int, int, int, int, int, int]
+	require.Error(t, mismatched.GobDecode(encoded))
+	require.Error(t, tup.GobDecode(nil))
+}
+
+func TestT8_MarshalComposite(t *testing.T) {
+	got, err := New8("foo bar", 2, 3, 4, 5, 6, 7, 8).MarshalComposite()
+	require.NoError(t, err)
+	require.Equal(t, `("foo bar",2,3,4,5,6,7,8)`, string(got))
+
+	got, err = T8[*int, *int, *int, *int, *int, *int, *int, *int]{}.MarshalComposite()
+	require.NoError(t, err)
+	require.Equal(t, `(,,,,,,,)`, string(got))
+
+	got, err = New8(New2("1", 1), New2("2", 2), New2("3", 3), New2("4", 4), New2("5", 5), New2("6", 6), New2("7", 7), New2("8", 8)).MarshalComposite()
+	require.NoError(t, err)
+	require.Equal(t, `("(1,1)","(2,2)","(3,3)","(4,4)","(5,5)","(6,6)","(7,7)","(8,8)")`, string(got))
+}
+
+func TestT8_UnmarshalComposite(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    T8[string, string, string, string, string, string, string, string]
+		wantErr bool
+	}{
+		{
+			name: "unquoted values",
+			data: `(1,2,3,4,5,6,7,8)`,
+			want: New8("1", "2", "3", "4", "5", "6", "7", "8"),
+		},
+		{
+			name: "quoted values",
+			data: `("1, \\""","2, \\""","3, \\""","4, \\""","5, \\""","6, \\""","7, \\""","8, \\""")`,
+			want: New8("1, \\\"", "2, \\\"", "3, \\\"", "4, \\\"", "5, \\\"", "6, \\\"", "7, \\\"", "8, \\\""),
+		},
+		{
+			name: "empty values",
+			data: `("","","","","","","","")`,
+			want: T8[string, string, string, string, string, string, string, string]{},
+		},
+		{
+			name:    "null values",
+			data:    `(,,,,,,,)`,
+			wantErr: true,
+		},
+		{
+			name:    "too many fields",
+			data:    `(1,2,3,4,5,6,7,8,0)`,
+			wantErr: true,
+		},
+		{
+			name:    "missing parentheses",
+			data:    `1,2,3,4,5,6,7,8`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T8[string, string, string, string, string, string, string, string]
+			err := got.UnmarshalComposite([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT8_MarshalComposite_UnmarshalComposite(t *testing.T) {
+	tup := New8((*T2[string, *int])(nil), &T2[string, *int]{V1: "(2, \"2\\\")", V2: ptr(2)}, &T2[string, *int]{V1: "(3, \"3\\\")", V2: ptr(3)}, &T2[string, *int]{V1: "(4, \"4\\\")", V2: ptr(4)}, &T2[string, *int]{V1: "(5, \"5\\\")", V2: ptr(5)}, &T2[string, *int]{V1: "(6, \"6\\\")", V2: ptr(6)}, &T2[string, *int]{V1: "(7, \"7\\\")", V2: ptr(7)}, &T2[string, *int]{V1: "(8, \"8\\\")", V2: ptr(8)})
+
+	marshalled, err := tup.MarshalComposite()
+	require.NoError(t, err)
+
+	var unmarshalled T8[*T2[string, *int], *T2[string, *int], *T2[string, *int], *T2[string, *int], *T2[string, *int], *T2[string, *int], *T2[string, *int], *T2[string, *int]]
+	err = unmarshalled.UnmarshalComposite(marshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT8_MarshalXML(t *testing.T) {
+	got, err := xml.Marshal(New8(1, 2, 3, 4, 5, 6, 7, 8))
+	require.NoError(t, err)
+	require.Equal(t, `<T8><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6><v7>7</v7><v8>8</v8></T8>`, string(got))
+
+	type Custom struct {
+		XMLName xml.Name                                                           `xml:"custom"`
+		Tup     T8[string, string, string, string, string, string, string, string] `xml:"tup"`
+	}
+
+	got, err = xml.Marshal(Custom{Tup: New8("<1>", "<2>", "<3>", "<4>", "<5>", "<6>", "<7>", "<8>")})
+	require.NoError(t, err)
+	require.Equal(t, `<custom><tup><v1>&lt;1&gt;</v1><v2>&lt;2&gt;</v2><v3>&lt;3&gt;</v3><v4>&lt;4&gt;</v4><v5>&lt;5&gt;</v5><v6>&lt;6&gt;</v6><v7>&lt;7&gt;</v7><v8>&lt;8&gt;</v8></tup></custom>`, string(got))
+}
+
+func TestT8_UnmarshalXML(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    T8[int, int, int, int, int, int, int, int]
+		wantErr bool
+	}{
+		{
+			name: "all elements",
+			data: `<T8><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6><v7>7</v7><v8>8</v8></T8>`,
+			want: New8(1, 2, 3, 4, 5, 6, 7, 8),
+		},
+		{
+			name: "whitespace and comments",
+			data: `<T8>
+				<!-- v1 --> <v1> 1 </v1>
+				<!-- v2 --> <v2> 2 </v2>
+				<!-- v3 --> <v3> 3 </v3>
+				<!-- v4 --> <v4> 4 </v4>
+				<!-- v5 --> <v5> 5 </v5>
+				<!-- v6 --> <v6> 6 </v6>
+				<!-- v7 --> <v7> 7 </v7>
+				<!-- v8 --> <v8> 8 </v8>
+			</T8>`,
+			want: New8(1, 2, 3, 4, 5, 6, 7, 8),
+		},
+		{
+			name:    "no elements",
+			data:    `<T8></T8>`,
+			wantErr: true,
+		},
+		{
+			name:    "too many elements",
+			data:    `<T8><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6><v7>7</v7><v8>8</v8><v0>0</v0></T8>`,
+			wantErr: true,
+		},
+		{
+			name:    "wrong element names",
+			data:    `<T8><V1>1</V1><V2>2</V2><V3>3</V3><V4>4</V4><V5>5</V5><V6>6</V6><V7>7</V7><V8>8</V8></T8>`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid value",
+			data:    `<T8><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6><v7>7</v7><v8>x</v8></T8>`,
+			wantErr: true,
+		},
+		{
+			name:    "unterminated",
+			data:    `<T8><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6><v7>7</v7><v8>8</v8>`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T8[int, int, int, int, int, int, int, int]
+			err := xml.Unmarshal([]byte(tt.data), &got)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT8_UnmarshalXML_Errors(t *testing.T) {
+	var tup T8[int, int, int, int, int, int, int, int]
+
+	err := xml.Unmarshal([]byte(`<T8><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6><v7>7</v7></T8>`), &tup)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.EqualError(t, err, "unmarshalled xml elements count 7 must match number of tuple values 8")
+
+	err = xml.Unmarshal([]byte(`<T8><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6><v7>7</v7><v8>8</v8><v0>0</v0><v0>0</v0></T8>`), &tup)
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, 10, lengthErr.Actual)
+
+	err = xml.Unmarshal([]byte(`<T8><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6><v7>7</v7><v8>x</v8></T8>`), &tup)
+	var elementErr *ElementUnmarshalError
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, 7, elementErr.Index)
+	require.Equal(t, "v8", elementErr.Label)
+}
+
+func TestT8_MarshalXML_UnmarshalXML_NilPointers(t *testing.T) {
+	tup := New8((*int)(nil), ptr(2), ptr(3), ptr(4), ptr(5), ptr(6), ptr(7), ptr(8))
+
+	got, err := xml.Marshal(tup)
+	require.NoError(t, err)
+	require.Equal(t, `<T8><v1 nil="true"></v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6><v7>7</v7><v8>8</v8></T8>`, string(got))
+
+	unmarshalled := New8(ptr(0), ptr(0), ptr(0), ptr(0), ptr(0), ptr(0), ptr(0), ptr(0))
+	err = xml.Unmarshal(got, &unmarshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT8_MarshalXML_UnmarshalXML_Slices(t *testing.T) {
+	tup := New8([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8}, []int{8, 9})
+
+	got, err := xml.Marshal(tup)
+	require.NoError(t, err)
+	require.Equal(t, `<T8><v1><item>1</item><item>2</item></v1><v2><item>2</item><item>3</item></v2><v3><item>3</item><item>4</item></v3><v4><item>4</item><item>5</item></v4><v5><item>5</item><item>6</item></v5><v6><item>6</item><item>7</item></v6><v7><item>7</item><item>8</item></v7><v8><item>8</item><item>9</item></v8></T8>`, string(got))
+
+	var unmarshalled T8[[]int, []int, []int, []int, []int, []int, []int, []int]
+	err = xml.Unmarshal(got, &unmarshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT8_MarshalXMLLabeled_UnmarshalXMLLabeled(t *testing.T) {
+	tup := New8(New2("1", ptr(1)), New2("2", ptr(2)), New2("3", ptr(3)), New2("4", ptr(4)), New2("5", ptr(5)), New2("6", ptr(6)), New2("7", ptr(7)), New2("8", ptr(8)))
+	labels := WithLabels("item1", "item2", "item3", "item4", "item5", "item6", "item7", "item8")
+
+	var buf strings.Builder
+	encoder := xml.NewEncoder(&buf)
+	err := tup.MarshalXMLLabeled(encoder, xml.StartElement{Name: xml.Name{Local: "items"}}, labels)
+	require.NoError(t, err)
+	require.NoError(t, encoder.Flush())
+	require.Equal(t, `<items><item1><v1>1</v1><v2>1</v2></item1><item2><v1>2</v1><v2>2</v2></item2><item3><v1>3</v1><v2>3</v2></item3><item4><v1>4</v1><v2>4</v2></item4><item5><v1>5</v1><v2>5</v2></item5><item6><v1>6</v1><v2>6</v2></item6><item7><v1>7</v1><v2>7</v2></item7><item8><v1>8</v1><v2>8</v2></item8></items>`, buf.String())
+
+	decoder := xml.NewDecoder(strings.NewReader(buf.String()))
+	start, err := decoder.Token()
+	require.NoError(t, err)
+
+	var unmarshalled T8[T2[string, *int], T2[string, *int], T2[string, *int], T2[string, *int], T2[string, *int], T2[string, *int], T2[string, *int], T2[string, *int]]
+	err = unmarshalled.UnmarshalXMLLabeled(decoder, start.(xml.StartElement), labels)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+
+	err = tup.MarshalXMLLabeled(encoder, xml.StartElement{Name: xml.Name{Local: "items"}}, WithLabels("item1", "item2", "item3", "item4", "item5", "item6", "item7", "item8", "extra"))
+	require.Error(t, err)
+}
+
+func TestT8_MarshalBinary(t *testing.T) {
+	tup := New8(uint16(1), uint16(2), uint16(3), uint16(4), uint16(5), uint16(6), uint16(7), uint16(8))
+
+	got, err := tup.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 0, 2, 0, 3, 0, 4, 0, 5, 0, 6, 0, 7, 0, 8, 0}, got)
+
+	got, err = tup.AppendBinaryOrder([]byte{0xff}, binary.BigEndian)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0xff, 0, 1, 0, 2, 0, 3, 0, 4, 0, 5, 0, 6, 0, 7, 0, 8}, got)
+
+	_, err = New8(1, 2, 3, 4, 5, 6, 7, []int{8}).MarshalBinary()
+	require.Error(t, err)
+}
+
+func TestT8_MarshalBinary_UnmarshalBinary(t *testing.T) {
+	tup := New8(
+		int64(-1),
+		"foo2",
+		true,
+		[]byte("bar4"),
+		New2(float32(5.5), "baz"),
+		float64(6.5),
+		float64(7.5),
+		float64(8.5),
+	)
+
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		marshalled, err := tup.AppendBinaryOrder(nil, order.(binary.AppendByteOrder))
+		require.NoError(t, err)
+
+		var unmarshalled T8[int64, string, bool, []byte, T2[float32, string], float64, float64, float64]
+		err = unmarshalled.UnmarshalBinaryOrder(marshalled, order)
+		require.NoError(t, err)
+		require.Equal(t, tup, unmarshalled)
+	}
+}
+
+func TestT8_UnmarshalBinary_Invalid(t *testing.T) {
+	marshalled, err := New8(uint16(1), uint16(2), uint16(3), uint16(4), uint16(5), uint16(6), uint16(7), uint16(8)).MarshalBinary()
+	require.NoError(t, err)
+
+	var tup T8[uint16, uint16, uint16, uint16, uint16, uint16, uint16, uint16]
+	err = tup.UnmarshalBinary(marshalled[:len(marshalled)-1])
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	require.EqualError(t, err, "value at index 7 failed to unmarshal: unexpected EOF")
+
+	err = tup.UnmarshalBinary(append(marshalled, 0))
+	require.EqualError(t, err, "binary data has 1 trailing bytes")
+}
+
+func TestT8_BinarySlice(t *testing.T) {
+	tups := []T8[int, int, int, int, int, int, int, int]{
+		New8(1, 2, 3, 4, 5, 6, 7, 8),
+		New8(-1, -2, -3, -4, -5, -6, -7, -8),
+	}
+
+	marshalled, err := AppendBinarySlice8(nil, binary.LittleEndian, tups)
+	require.NoError(t, err)
+	require.Len(t, marshalled, 1+2*8*8)
+
+	got, err := UnmarshalBinarySlice8[int, int, int, int, int, int, int, int](marshalled, binary.LittleEndian, nil)
+	require.NoError(t, err)
+	require.Equal(t, tups, got)
+
+	got, err = UnmarshalBinarySlice8(marshalled, binary.LittleEndian, got)
+	require.NoError(t, err)
+	require.Equal(t, append(tups, tups...), got)
+
+	empty, err := AppendBinarySlice8[int, int, int, int, int, int, int, int](nil, binary.LittleEndian, nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0}, empty)
+
+	_, err = AppendBinarySlice8(nil, binary.LittleEndian, []T8[[]int, []int, []int, []int, []int, []int, []int, []int]{{}})
+	require.Error(t, err)
+}
+
+func TestT8_MarshalJSON_MapKey(t *testing.T) {
+	m := map[T8[string, string, string, string, string, string, string, string]]int{
+		New8("1", "2", "3", "4", "5", "6", "7", "8"): 1,
+	}
+
+	marshalled, err := json.Marshal(m)
+	require.NoError(t, err)
+	require.Equal(t, `{"1,2,3,4,5,6,7,8":1}`, string(marshalled))
+
+	var unmarshalled map[T8[string, string, string, string, string, string, string, string]]int
+	err = json.Unmarshal(marshalled, &unmarshalled)
+	require.NoError(t, err)
+	require.Equal(t, m, unmarshalled)
 }
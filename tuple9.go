@@ -1,10 +1,14 @@
 package tuple
 
 import (
+	"cmp"
+	"database/sql"
+	"encoding/binary"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
-
-	"golang.org/x/exp/constraints"
+	"hash/maphash"
+	"io"
 )
 
 // T9 is a tuple type holding 9 generic values.
@@ -81,39 +85,39 @@ func New9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](v1 Ty1, v2 Ty2, v3 Ty
 func FromArray9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](arr [9]any) (T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9], error) {
 	v1, ok := arr[0].(Ty1)
 	if !ok {
-		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, fmt.Errorf("value at array index 0 expected to have type %s but has type %T", typeName[Ty1](), arr[0])
+		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, newElementTypeError[Ty1]("array", 0, arr[0])
 	}
 	v2, ok := arr[1].(Ty2)
 	if !ok {
-		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, fmt.Errorf("value at array index 1 expected to have type %s but has type %T", typeName[Ty2](), arr[1])
+		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, newElementTypeError[Ty2]("array", 1, arr[1])
 	}
 	v3, ok := arr[2].(Ty3)
 	if !ok {
-		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, fmt.Errorf("value at array index 2 expected to have type %s but has type %T", typeName[Ty3](), arr[2])
+		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, newElementTypeError[Ty3]("array", 2, arr[2])
 	}
 	v4, ok := arr[3].(Ty4)
 	if !ok {
-		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, fmt.Errorf("value at array index 3 expected to have type %s but has type %T", typeName[Ty4](), arr[3])
+		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, newElementTypeError[Ty4]("array", 3, arr[3])
 	}
 	v5, ok := arr[4].(Ty5)
 	if !ok {
-		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, fmt.Errorf("value at array index 4 expected to have type %s but has type %T", typeName[Ty5](), arr[4])
+		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, newElementTypeError[Ty5]("array", 4, arr[4])
 	}
 	v6, ok := arr[5].(Ty6)
 	if !ok {
-		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, fmt.Errorf("value at array index 5 expected to have type %s but has type %T", typeName[Ty6](), arr[5])
+		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, newElementTypeError[Ty6]("array", 5, arr[5])
 	}
 	v7, ok := arr[6].(Ty7)
 	if !ok {
-		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, fmt.Errorf("value at array index 6 expected to have type %s but has type %T", typeName[Ty7](), arr[6])
+		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, newElementTypeError[Ty7]("array", 6, arr[6])
 	}
 	v8, ok := arr[7].(Ty8)
 	if !ok {
-		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, fmt.Errorf("value at array index 7 expected to have type %s but has type %T", typeName[Ty8](), arr[7])
+		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, newElementTypeError[Ty8]("array", 7, arr[7])
 	}
 	v9, ok := arr[8].(Ty9)
 	if !ok {
-		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, fmt.Errorf("value at array index 8 expected to have type %s but has type %T", typeName[Ty9](), arr[8])
+		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, newElementTypeError[Ty9]("array", 8, arr[8])
 	}
 
 	return New9(v1, v2, v3, v4, v5, v6, v7, v8, v9), nil
@@ -129,44 +133,44 @@ func FromArray9X[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](arr [9]any) T9
 // If the length of the slice doesn't match, or any of the values can not be converted to the generic type, an error is returned.
 func FromSlice9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](values []any) (T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9], error) {
 	if len(values) != 9 {
-		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, fmt.Errorf("slice length %d must match number of tuple values 9", len(values))
+		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, &LengthMismatchError{Source: "slice", Expected: 9, Actual: len(values)}
 	}
 
 	v1, ok := values[0].(Ty1)
 	if !ok {
-		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, fmt.Errorf("value at slice index 0 expected to have type %s but has type %T", typeName[Ty1](), values[0])
+		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, newElementTypeError[Ty1]("slice", 0, values[0])
 	}
 	v2, ok := values[1].(Ty2)
 	if !ok {
-		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, fmt.Errorf("value at slice index 1 expected to have type %s but has type %T", typeName[Ty2](), values[1])
+		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, newElementTypeError[Ty2]("slice", 1, values[1])
 	}
 	v3, ok := values[2].(Ty3)
 	if !ok {
-		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, fmt.Errorf("value at slice index 2 expected to have type %s but has type %T", typeName[Ty3](), values[2])
+		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, newElementTypeError[Ty3]("slice", 2, values[2])
 	}
 	v4, ok := values[3].(Ty4)
 	if !ok {
-		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, fmt.Errorf("value at slice index 3 expected to have type %s but has type %T", typeName[Ty4](), values[3])
+		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, newElementTypeError[Ty4]("slice", 3, values[3])
 	}
 	v5, ok := values[4].(Ty5)
 	if !ok {
-		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, fmt.Errorf("value at slice index 4 expected to have type %s but has type %T", typeName[Ty5](), values[4])
+		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, newElementTypeError[Ty5]("slice", 4, values[4])
 	}
 	v6, ok := values[5].(Ty6)
 	if !ok {
-		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, fmt.Errorf("value at slice index 5 expected to have type %s but has type %T", typeName[Ty6](), values[5])
+		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, newElementTypeError[Ty6]("slice", 5, values[5])
 	}
 	v7, ok := values[6].(Ty7)
 	if !ok {
-		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, fmt.Errorf("value at slice index 6 expected to have type %s but has type %T", typeName[Ty7](), values[6])
+		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, newElementTypeError[Ty7]("slice", 6, values[6])
 	}
 	v8, ok := values[7].(Ty8)
 	if !ok {
-		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, fmt.Errorf("value at slice index 7 expected to have type %s but has type %T", typeName[Ty8](), values[7])
+		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, newElementTypeError[Ty8]("slice", 7, values[7])
 	}
 	v9, ok := values[8].(Ty9)
 	if !ok {
-		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, fmt.Errorf("value at slice index 8 expected to have type %s but has type %T", typeName[Ty9](), values[8])
+		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, newElementTypeError[Ty9]("slice", 8, values[8])
 	}
 
 	return New9(v1, v2, v3, v4, v5, v6, v7, v8, v9), nil
@@ -176,7 +180,7 @@ func FromSlice9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](values []any) (
 // If the length of the slice doesn't match, or any of the values can not be converted to the generic type, the function panics.
 func FromSlice9X[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](values []any) T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9] {
 	if len(values) != 9 {
-		panic(fmt.Errorf("slice length %d must match number of tuple values 9", len(values)))
+		panic(&LengthMismatchError{Source: "slice", Expected: 9, Actual: len(values)})
 	}
 
 	v1 := values[0].(Ty1)
@@ -192,11 +196,629 @@ func FromSlice9X[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](values []any)
 	return New9(v1, v2, v3, v4, v5, v6, v7, v8, v9)
 }
 
+// ConvertSlice9 returns a tuple from a slice of length 9, converting the values to the generic types when needed.
+// Unlike FromSlice9, numeric values are converted between numeric types as long as they fit the generic type,
+// json.Number values are parsed into numeric types, strings and byte slices are converted to each other,
+// and strings and byte slices are unmarshalled into types implementing encoding.TextUnmarshaler.
+// This enables creating tuples from slices decoded by encoding/json, which hold float64 values for numbers.
+// If the length of the slice doesn't match, a *LengthMismatchError is returned.
+// If any of the values can not be converted to the generic type, a *ElementTypeError is returned.
+// If the conversion of any of the values fails, for example when it overflows the generic type, a *ElementConversionError is returned.
+func ConvertSlice9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](values []any) (T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9], error) {
+	if len(values) != 9 {
+		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, &LengthMismatchError{Source: "slice", Expected: 9, Actual: len(values)}
+	}
+
+	var t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]
+	if err := convertSliceValue(0, values[0], &t.V1); err != nil {
+		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, err
+	}
+	if err := convertSliceValue(1, values[1], &t.V2); err != nil {
+		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, err
+	}
+	if err := convertSliceValue(2, values[2], &t.V3); err != nil {
+		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, err
+	}
+	if err := convertSliceValue(3, values[3], &t.V4); err != nil {
+		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, err
+	}
+	if err := convertSliceValue(4, values[4], &t.V5); err != nil {
+		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, err
+	}
+	if err := convertSliceValue(5, values[5], &t.V6); err != nil {
+		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, err
+	}
+	if err := convertSliceValue(6, values[6], &t.V7); err != nil {
+		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, err
+	}
+	if err := convertSliceValue(7, values[7], &t.V8); err != nil {
+		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, err
+	}
+	if err := convertSliceValue(8, values[8], &t.V9); err != nil {
+		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, err
+	}
+
+	return t, nil
+}
+
+// ScanRow9 scans the current row of rows into a tuple, with each of the columns scanned into the matching tuple value.
+// If the number of columns doesn't match the number of tuple values, a *LengthMismatchError is returned.
+func ScanRow9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](rows *sql.Rows) (T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9], error) {
+	var t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]
+	if err := scanRow(rows, []any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+		&t.V5,
+		&t.V6,
+		&t.V7,
+		&t.V8,
+		&t.V9,
+	}); err != nil {
+		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, err
+	}
+
+	return t, nil
+}
+
+// CollectRows9 scans all the rows into a slice of tuples using ScanRow9, and closes the rows.
+func CollectRows9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](rows *sql.Rows) ([]T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9], error) {
+	return collectRows(rows, ScanRow9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9])
+}
+
+// ReadCSV9 reads all the CSV records of r into tuples, with each of the fields converted to the matching tuple value.
+// Fields are converted using the UnmarshalText method of values implementing encoding.TextUnmarshaler,
+// and otherwise must be of boolean, numeric or string kinds.
+// If a record fails to convert, a *CSVError holding its line and column numbers is returned.
+// If a record fails to parse, including records with a number of fields that doesn't match, a *csv.ParseError is returned.
+func ReadCSV9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](r io.Reader, opts CSVOptions) ([]T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9], error) {
+	return readCSV(r, opts, func(t *T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) []any {
+		return []any{
+			&t.V1,
+			&t.V2,
+			&t.V3,
+			&t.V4,
+			&t.V5,
+			&t.V6,
+			&t.V7,
+			&t.V8,
+			&t.V9,
+		}
+	})
+}
+
+// WriteCSV9 writes the tuples into w as CSV records, with each of the tuple values converted to the matching field.
+// Values are converted the same way as MarshalText does.
+// If a tuple fails to convert, a *CSVError holding the line and column numbers of its record is returned.
+func WriteCSV9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](w io.Writer, tuples []T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9], opts CSVOptions) error {
+	return writeCSV(w, tuples, opts, T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9].Slice)
+}
+
+// Parse9 returns a tuple from its string representation, as returned by the String method.
+// Supported values are booleans, numbers, quoted strings and nested tuples.
+// If the string fails to parse, a *ParseError holding the offset of the failure is returned.
+func Parse9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](s string) (T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9], error) {
+	var t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]
+	if err := parseTuple(s, &t); err != nil {
+		return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{}, err
+	}
+
+	return t, nil
+}
+
+// Map9At1 returns a new tuple with the function f applied to the value at position 1 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map9At1[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9, R any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9], f func(Ty1) R) T9[R, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9] {
+	return T9[R, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{
+		V1: f(t.V1),
+		V2: t.V2,
+		V3: t.V3,
+		V4: t.V4,
+		V5: t.V5,
+		V6: t.V6,
+		V7: t.V7,
+		V8: t.V8,
+		V9: t.V9,
+	}
+}
+
+// Map9At2 returns a new tuple with the function f applied to the value at position 2 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map9At2[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9, R any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9], f func(Ty2) R) T9[Ty1, R, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9] {
+	return T9[Ty1, R, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{
+		V1: t.V1,
+		V2: f(t.V2),
+		V3: t.V3,
+		V4: t.V4,
+		V5: t.V5,
+		V6: t.V6,
+		V7: t.V7,
+		V8: t.V8,
+		V9: t.V9,
+	}
+}
+
+// Map9At3 returns a new tuple with the function f applied to the value at position 3 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map9At3[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9, R any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9], f func(Ty3) R) T9[Ty1, Ty2, R, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9] {
+	return T9[Ty1, Ty2, R, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: f(t.V3),
+		V4: t.V4,
+		V5: t.V5,
+		V6: t.V6,
+		V7: t.V7,
+		V8: t.V8,
+		V9: t.V9,
+	}
+}
+
+// Map9At4 returns a new tuple with the function f applied to the value at position 4 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map9At4[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9, R any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9], f func(Ty4) R) T9[Ty1, Ty2, Ty3, R, Ty5, Ty6, Ty7, Ty8, Ty9] {
+	return T9[Ty1, Ty2, Ty3, R, Ty5, Ty6, Ty7, Ty8, Ty9]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+		V4: f(t.V4),
+		V5: t.V5,
+		V6: t.V6,
+		V7: t.V7,
+		V8: t.V8,
+		V9: t.V9,
+	}
+}
+
+// Map9At5 returns a new tuple with the function f applied to the value at position 5 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map9At5[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9, R any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9], f func(Ty5) R) T9[Ty1, Ty2, Ty3, Ty4, R, Ty6, Ty7, Ty8, Ty9] {
+	return T9[Ty1, Ty2, Ty3, Ty4, R, Ty6, Ty7, Ty8, Ty9]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+		V4: t.V4,
+		V5: f(t.V5),
+		V6: t.V6,
+		V7: t.V7,
+		V8: t.V8,
+		V9: t.V9,
+	}
+}
+
+// Map9At6 returns a new tuple with the function f applied to the value at position 6 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map9At6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9, R any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9], f func(Ty6) R) T9[Ty1, Ty2, Ty3, Ty4, Ty5, R, Ty7, Ty8, Ty9] {
+	return T9[Ty1, Ty2, Ty3, Ty4, Ty5, R, Ty7, Ty8, Ty9]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+		V4: t.V4,
+		V5: t.V5,
+		V6: f(t.V6),
+		V7: t.V7,
+		V8: t.V8,
+		V9: t.V9,
+	}
+}
+
+// Map9At7 returns a new tuple with the function f applied to the value at position 7 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map9At7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9, R any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9], f func(Ty7) R) T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, R, Ty8, Ty9] {
+	return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, R, Ty8, Ty9]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+		V4: t.V4,
+		V5: t.V5,
+		V6: t.V6,
+		V7: f(t.V7),
+		V8: t.V8,
+		V9: t.V9,
+	}
+}
+
+// Map9At8 returns a new tuple with the function f applied to the value at position 8 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map9At8[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9, R any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9], f func(Ty8) R) T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, R, Ty9] {
+	return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, R, Ty9]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+		V4: t.V4,
+		V5: t.V5,
+		V6: t.V6,
+		V7: t.V7,
+		V8: f(t.V8),
+		V9: t.V9,
+	}
+}
+
+// Map9At9 returns a new tuple with the function f applied to the value at position 9 of the tuple.
+// The rest of the tuple values are kept as they are.
+func Map9At9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9, R any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9], f func(Ty9) R) T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, R] {
+	return T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, R]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+		V4: t.V4,
+		V5: t.V5,
+		V6: t.V6,
+		V7: t.V7,
+		V8: t.V8,
+		V9: f(t.V9),
+	}
+}
+
+// Map9 returns a new tuple with each of the functions applied to the tuple value at the matching position.
+func Map9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9, R1, R2, R3, R4, R5, R6, R7, R8, R9 any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9], f1 func(Ty1) R1, f2 func(Ty2) R2, f3 func(Ty3) R3, f4 func(Ty4) R4, f5 func(Ty5) R5, f6 func(Ty6) R6, f7 func(Ty7) R7, f8 func(Ty8) R8, f9 func(Ty9) R9) T9[R1, R2, R3, R4, R5, R6, R7, R8, R9] {
+	return T9[R1, R2, R3, R4, R5, R6, R7, R8, R9]{
+		V1: f1(t.V1),
+		V2: f2(t.V2),
+		V3: f3(t.V3),
+		V4: f4(t.V4),
+		V5: f5(t.V5),
+		V6: f6(t.V6),
+		V7: f7(t.V7),
+		V8: f8(t.V8),
+		V9: f9(t.V9),
+	}
+}
+
+// Take9_1 returns a new tuple holding the first 1 values of the tuple.
+func Take9_1[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) T1[Ty1] {
+	return T1[Ty1]{
+		V1: t.V1,
+	}
+}
+
+// Drop9_1 returns a new tuple holding the values of the tuple without the first 1 values.
+func Drop9_1[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) T8[Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9] {
+	return T8[Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{
+		V1: t.V2,
+		V2: t.V3,
+		V3: t.V4,
+		V4: t.V5,
+		V5: t.V6,
+		V6: t.V7,
+		V7: t.V8,
+		V8: t.V9,
+	}
+}
+
+// Split9_1 splits the tuple into a tuple of its first 1 values and a tuple of the rest of its values.
+func Split9_1[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) T2[T1[Ty1], T8[Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]] {
+	return T2[T1[Ty1], T8[Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]]{
+		V1: Take9_1(t),
+		V2: Drop9_1(t),
+	}
+}
+
+// Take9_2 returns a new tuple holding the first 2 values of the tuple.
+func Take9_2[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) T2[Ty1, Ty2] {
+	return T2[Ty1, Ty2]{
+		V1: t.V1,
+		V2: t.V2,
+	}
+}
+
+// Drop9_2 returns a new tuple holding the values of the tuple without the first 2 values.
+func Drop9_2[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) T7[Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9] {
+	return T7[Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{
+		V1: t.V3,
+		V2: t.V4,
+		V3: t.V5,
+		V4: t.V6,
+		V5: t.V7,
+		V6: t.V8,
+		V7: t.V9,
+	}
+}
+
+// Split9_2 splits the tuple into a tuple of its first 2 values and a tuple of the rest of its values.
+func Split9_2[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) T2[T2[Ty1, Ty2], T7[Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]] {
+	return T2[T2[Ty1, Ty2], T7[Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]]{
+		V1: Take9_2(t),
+		V2: Drop9_2(t),
+	}
+}
+
+// Take9_3 returns a new tuple holding the first 3 values of the tuple.
+func Take9_3[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) T3[Ty1, Ty2, Ty3] {
+	return T3[Ty1, Ty2, Ty3]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+	}
+}
+
+// Drop9_3 returns a new tuple holding the values of the tuple without the first 3 values.
+func Drop9_3[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) T6[Ty4, Ty5, Ty6, Ty7, Ty8, Ty9] {
+	return T6[Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{
+		V1: t.V4,
+		V2: t.V5,
+		V3: t.V6,
+		V4: t.V7,
+		V5: t.V8,
+		V6: t.V9,
+	}
+}
+
+// Split9_3 splits the tuple into a tuple of its first 3 values and a tuple of the rest of its values.
+func Split9_3[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) T2[T3[Ty1, Ty2, Ty3], T6[Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]] {
+	return T2[T3[Ty1, Ty2, Ty3], T6[Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]]{
+		V1: Take9_3(t),
+		V2: Drop9_3(t),
+	}
+}
+
+// Take9_4 returns a new tuple holding the first 4 values of the tuple.
+func Take9_4[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) T4[Ty1, Ty2, Ty3, Ty4] {
+	return T4[Ty1, Ty2, Ty3, Ty4]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+		V4: t.V4,
+	}
+}
+
+// Drop9_4 returns a new tuple holding the values of the tuple without the first 4 values.
+func Drop9_4[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) T5[Ty5, Ty6, Ty7, Ty8, Ty9] {
+	return T5[Ty5, Ty6, Ty7, Ty8, Ty9]{
+		V1: t.V5,
+		V2: t.V6,
+		V3: t.V7,
+		V4: t.V8,
+		V5: t.V9,
+	}
+}
+
+// Split9_4 splits the tuple into a tuple of its first 4 values and a tuple of the rest of its values.
+func Split9_4[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) T2[T4[Ty1, Ty2, Ty3, Ty4], T5[Ty5, Ty6, Ty7, Ty8, Ty9]] {
+	return T2[T4[Ty1, Ty2, Ty3, Ty4], T5[Ty5, Ty6, Ty7, Ty8, Ty9]]{
+		V1: Take9_4(t),
+		V2: Drop9_4(t),
+	}
+}
+
+// Take9_5 returns a new tuple holding the first 5 values of the tuple.
+func Take9_5[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) T5[Ty1, Ty2, Ty3, Ty4, Ty5] {
+	return T5[Ty1, Ty2, Ty3, Ty4, Ty5]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+		V4: t.V4,
+		V5: t.V5,
+	}
+}
+
+// Drop9_5 returns a new tuple holding the values of the tuple without the first 5 values.
+func Drop9_5[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) T4[Ty6, Ty7, Ty8, Ty9] {
+	return T4[Ty6, Ty7, Ty8, Ty9]{
+		V1: t.V6,
+		V2: t.V7,
+		V3: t.V8,
+		V4: t.V9,
+	}
+}
+
+// Split9_5 splits the tuple into a tuple of its first 5 values and a tuple of the rest of its values.
+func Split9_5[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) T2[T5[Ty1, Ty2, Ty3, Ty4, Ty5], T4[Ty6, Ty7, Ty8, Ty9]] {
+	return T2[T5[Ty1, Ty2, Ty3, Ty4, Ty5], T4[Ty6, Ty7, Ty8, Ty9]]{
+		V1: Take9_5(t),
+		V2: Drop9_5(t),
+	}
+}
+
+// Take9_6 returns a new tuple holding the first 6 values of the tuple.
+func Take9_6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6] {
+	return T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+		V4: t.V4,
+		V5: t.V5,
+		V6: t.V6,
+	}
+}
+
+// Drop9_6 returns a new tuple holding the values of the tuple without the first 6 values.
+func Drop9_6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) T3[Ty7, Ty8, Ty9] {
+	return T3[Ty7, Ty8, Ty9]{
+		V1: t.V7,
+		V2: t.V8,
+		V3: t.V9,
+	}
+}
+
+// Split9_6 splits the tuple into a tuple of its first 6 values and a tuple of the rest of its values.
+func Split9_6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) T2[T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6], T3[Ty7, Ty8, Ty9]] {
+	return T2[T6[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6], T3[Ty7, Ty8, Ty9]]{
+		V1: Take9_6(t),
+		V2: Drop9_6(t),
+	}
+}
+
+// Take9_7 returns a new tuple holding the first 7 values of the tuple.
+func Take9_7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7] {
+	return T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+		V4: t.V4,
+		V5: t.V5,
+		V6: t.V6,
+		V7: t.V7,
+	}
+}
+
+// Drop9_7 returns a new tuple holding the values of the tuple without the first 7 values.
+func Drop9_7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) T2[Ty8, Ty9] {
+	return T2[Ty8, Ty9]{
+		V1: t.V8,
+		V2: t.V9,
+	}
+}
+
+// Split9_7 splits the tuple into a tuple of its first 7 values and a tuple of the rest of its values.
+func Split9_7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) T2[T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7], T2[Ty8, Ty9]] {
+	return T2[T7[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7], T2[Ty8, Ty9]]{
+		V1: Take9_7(t),
+		V2: Drop9_7(t),
+	}
+}
+
+// Take9_8 returns a new tuple holding the first 8 values of the tuple.
+func Take9_8[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) T8[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8] {
+	return T8[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8]{
+		V1: t.V1,
+		V2: t.V2,
+		V3: t.V3,
+		V4: t.V4,
+		V5: t.V5,
+		V6: t.V6,
+		V7: t.V7,
+		V8: t.V8,
+	}
+}
+
+// Drop9_8 returns a new tuple holding the values of the tuple without the first 8 values.
+func Drop9_8[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) T1[Ty9] {
+	return T1[Ty9]{
+		V1: t.V9,
+	}
+}
+
+// Split9_8 splits the tuple into a tuple of its first 8 values and a tuple of the rest of its values.
+func Split9_8[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) T2[T8[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8], T1[Ty9]] {
+	return T2[T8[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8], T1[Ty9]]{
+		V1: Take9_8(t),
+		V2: Drop9_8(t),
+	}
+}
+
+// Reverse9 returns a new tuple holding the tuple values in reverse order.
+func Reverse9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) T9[Ty9, Ty8, Ty7, Ty6, Ty5, Ty4, Ty3, Ty2, Ty1] {
+	return T9[Ty9, Ty8, Ty7, Ty6, Ty5, Ty4, Ty3, Ty2, Ty1]{
+		V1: t.V9,
+		V2: t.V8,
+		V3: t.V7,
+		V4: t.V6,
+		V5: t.V5,
+		V6: t.V4,
+		V7: t.V3,
+		V8: t.V2,
+		V9: t.V1,
+	}
+}
+
+// Apply9 calls the function f with the tuple values as arguments and returns its result.
+func Apply9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9, R any](t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9], f func(Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9) R) R {
+	return f(t.Values())
+}
+
+// Tupled9 returns a function that accepts a tuple and calls f with the tuple values as arguments.
+func Tupled9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9, R any](f func(Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9) R) func(T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) R {
+	return func(t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) R {
+		return f(t.Values())
+	}
+}
+
+// Untupled9 returns a function that accepts 9 arguments and calls g with a tuple holding them.
+func Untupled9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9, R any](g func(T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) R) func(Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9) R {
+	return func(v1 Ty1, v2 Ty2, v3 Ty3, v4 Ty4, v5 Ty5, v6 Ty6, v7 Ty7, v8 Ty8, v9 Ty9) R {
+		return g(New9(v1, v2, v3, v4, v5, v6, v7, v8, v9))
+	}
+}
+
+// Zip9 returns a slice of tuples, where each tuple holds the values at the same index of each of the given slices.
+// If the slices have different lengths, the returned slice is truncated to the length of the shortest slice.
+// To require all slices to have the same length, use the Zip9Strict function.
+func Zip9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](s1 []Ty1, s2 []Ty2, s3 []Ty3, s4 []Ty4, s5 []Ty5, s6 []Ty6, s7 []Ty7, s8 []Ty8, s9 []Ty9) []T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9] {
+	length := minLen(len(s1), len(s2), len(s3), len(s4), len(s5), len(s6), len(s7), len(s8), len(s9))
+
+	zipped := make([]T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9], length)
+	for i := range zipped {
+		zipped[i] = T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]{
+			V1: s1[i],
+			V2: s2[i],
+			V3: s3[i],
+			V4: s4[i],
+			V5: s5[i],
+			V6: s6[i],
+			V7: s7[i],
+			V8: s8[i],
+			V9: s9[i],
+		}
+	}
+
+	return zipped
+}
+
+// Zip9Strict returns a slice of tuples, where each tuple holds the values at the same index of each of the given slices.
+// If the slices have different lengths, an error is returned.
+// To truncate the result to the length of the shortest slice instead, use the Zip9 function.
+func Zip9Strict[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](s1 []Ty1, s2 []Ty2, s3 []Ty3, s4 []Ty4, s5 []Ty5, s6 []Ty6, s7 []Ty7, s8 []Ty8, s9 []Ty9) ([]T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9], error) {
+	if len(s2) != len(s1) {
+		return nil, &LengthMismatchError{Source: "slice 2", Expected: len(s1), Actual: len(s2), expectedSource: "slice 1"}
+	}
+	if len(s3) != len(s1) {
+		return nil, &LengthMismatchError{Source: "slice 3", Expected: len(s1), Actual: len(s3), expectedSource: "slice 1"}
+	}
+	if len(s4) != len(s1) {
+		return nil, &LengthMismatchError{Source: "slice 4", Expected: len(s1), Actual: len(s4), expectedSource: "slice 1"}
+	}
+	if len(s5) != len(s1) {
+		return nil, &LengthMismatchError{Source: "slice 5", Expected: len(s1), Actual: len(s5), expectedSource: "slice 1"}
+	}
+	if len(s6) != len(s1) {
+		return nil, &LengthMismatchError{Source: "slice 6", Expected: len(s1), Actual: len(s6), expectedSource: "slice 1"}
+	}
+	if len(s7) != len(s1) {
+		return nil, &LengthMismatchError{Source: "slice 7", Expected: len(s1), Actual: len(s7), expectedSource: "slice 1"}
+	}
+	if len(s8) != len(s1) {
+		return nil, &LengthMismatchError{Source: "slice 8", Expected: len(s1), Actual: len(s8), expectedSource: "slice 1"}
+	}
+	if len(s9) != len(s1) {
+		return nil, &LengthMismatchError{Source: "slice 9", Expected: len(s1), Actual: len(s9), expectedSource: "slice 1"}
+	}
+
+	return Zip9(s1, s2, s3, s4, s5, s6, s7, s8, s9), nil
+}
+
+// Unzip9 returns 9 slices, where each slice holds the tuple values at the matching position of each of the given tuples.
+func Unzip9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](ts []T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) ([]Ty1, []Ty2, []Ty3, []Ty4, []Ty5, []Ty6, []Ty7, []Ty8, []Ty9) {
+	s1 := make([]Ty1, len(ts))
+	s2 := make([]Ty2, len(ts))
+	s3 := make([]Ty3, len(ts))
+	s4 := make([]Ty4, len(ts))
+	s5 := make([]Ty5, len(ts))
+	s6 := make([]Ty6, len(ts))
+	s7 := make([]Ty7, len(ts))
+	s8 := make([]Ty8, len(ts))
+	s9 := make([]Ty9, len(ts))
+	for i, t := range ts {
+		s1[i] = t.V1
+		s2[i] = t.V2
+		s3[i] = t.V3
+		s4[i] = t.V4
+		s5[i] = t.V5
+		s6[i] = t.V6
+		s7[i] = t.V7
+		s8[i] = t.V8
+		s9[i] = t.V9
+	}
+
+	return s1, s2, s3, s4, s5, s6, s7, s8, s9
+}
+
 // Equal9 returns whether the host tuple is equal to the other tuple.
 // All tuple elements of the host and guest parameters must match the "comparable" built-in constraint.
 // To test equality of tuples that hold custom Equalable values, use the Equal9E function.
 // To test equality of tuples that hold custom Comparable values, use the Equal9C function.
-// Otherwise, use Equal or reflect.DeepEqual to test tuples of any types.
+// Otherwise, use the Equal method or the EqualFunc9 function to test tuples of any types.
 func Equal9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 comparable](host, guest T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) bool {
 	return host.V1 == guest.V1 && host.V2 == guest.V2 && host.V3 == guest.V3 && host.V4 == guest.V4 && host.V5 == guest.V5 && host.V6 == guest.V6 && host.V7 == guest.V7 && host.V8 == guest.V8 && host.V9 == guest.V9
 }
@@ -205,7 +827,7 @@ func Equal9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 comparable](host, guest
 // All tuple elements of the host and guest parameters must match the Equalable constraint.
 // To test equality of tuples that hold built-in "comparable" values, use the Equal9 function.
 // To test equality of tuples that hold custom Comparable values, use the Equal9C function.
-// Otherwise, use Equal or reflect.DeepEqual to test tuples of any types.
+// Otherwise, use the Equal method or the EqualFunc9 function to test tuples of any types.
 func Equal9E[Ty1 Equalable[Ty1], Ty2 Equalable[Ty2], Ty3 Equalable[Ty3], Ty4 Equalable[Ty4], Ty5 Equalable[Ty5], Ty6 Equalable[Ty6], Ty7 Equalable[Ty7], Ty8 Equalable[Ty8], Ty9 Equalable[Ty9]](host, guest T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) bool {
 	return host.V1.Equal(guest.V1) && host.V2.Equal(guest.V2) && host.V3.Equal(guest.V3) && host.V4.Equal(guest.V4) && host.V5.Equal(guest.V5) && host.V6.Equal(guest.V6) && host.V7.Equal(guest.V7) && host.V8.Equal(guest.V8) && host.V9.Equal(guest.V9)
 }
@@ -214,15 +836,28 @@ func Equal9E[Ty1 Equalable[Ty1], Ty2 Equalable[Ty2], Ty3 Equalable[Ty3], Ty4 Equ
 // All tuple elements of the host and guest parameters must match the Comparable constraint.
 // To test equality of tuples that hold built-in "comparable" values, use the Equal9 function.
 // To test equality of tuples that hold custom Equalable values, use the Equal9E function.
-// Otherwise, use Equal or reflect.DeepEqual to test tuples of any types.
+// Otherwise, use the Equal method or the EqualFunc9 function to test tuples of any types.
 func Equal9C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[Ty3], Ty4 Comparable[Ty4], Ty5 Comparable[Ty5], Ty6 Comparable[Ty6], Ty7 Comparable[Ty7], Ty8 Comparable[Ty8], Ty9 Comparable[Ty9]](host, guest T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) bool {
 	return host.V1.CompareTo(guest.V1).EQ() && host.V2.CompareTo(guest.V2).EQ() && host.V3.CompareTo(guest.V3).EQ() && host.V4.CompareTo(guest.V4).EQ() && host.V5.CompareTo(guest.V5).EQ() && host.V6.CompareTo(guest.V6).EQ() && host.V7.CompareTo(guest.V7).EQ() && host.V8.CompareTo(guest.V8).EQ() && host.V9.CompareTo(guest.V9).EQ()
 }
 
+// EqualFunc9 returns whether the host tuple is equal to the guest tuple, using the given equality function for each element.
+// EqualFunc9 short-circuits once one of the elements is not equal, and the rest of the equality functions are not called.
+func EqualFunc9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](host, guest T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9], eq1 func(a, b Ty1) bool, eq2 func(a, b Ty2) bool, eq3 func(a, b Ty3) bool, eq4 func(a, b Ty4) bool, eq5 func(a, b Ty5) bool, eq6 func(a, b Ty6) bool, eq7 func(a, b Ty7) bool, eq8 func(a, b Ty8) bool, eq9 func(a, b Ty9) bool) bool {
+	return eq1(host.V1, guest.V1) && eq2(host.V2, guest.V2) && eq3(host.V3, guest.V3) && eq4(host.V4, guest.V4) && eq5(host.V5, guest.V5) && eq6(host.V6, guest.V6) && eq7(host.V7, guest.V7) && eq8(host.V8, guest.V8) && eq9(host.V9, guest.V9)
+}
+
+// Equal returns whether the tuple is equal to the guest tuple.
+// Each element is tested using the Equalable constraint if it is implemented, then the Comparable constraint,
+// then the "==" operator if the element is comparable, and otherwise using reflect.DeepEqual.
+func (t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) Equal(guest T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) bool {
+	return equalValues(t.V1, guest.V1) && equalValues(t.V2, guest.V2) && equalValues(t.V3, guest.V3) && equalValues(t.V4, guest.V4) && equalValues(t.V5, guest.V5) && equalValues(t.V6, guest.V6) && equalValues(t.V7, guest.V7) && equalValues(t.V8, guest.V8) && equalValues(t.V9, guest.V9)
+}
+
 // Compare9 returns whether the host tuple is semantically less than, equal to, or greater than the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the Compare9C function.
-func Compare9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 constraints.Ordered](host, guest T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) OrderedComparisonResult {
+func Compare9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 cmp.Ordered](host, guest T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) OrderedComparisonResult {
 	return multiCompare(
 		func() OrderedComparisonResult { return compareOrdered(host.V1, guest.V1) },
 
@@ -269,10 +904,68 @@ func Compare9C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[Ty3], Ty
 	)
 }
 
+// CmpFunc9 returns a comparison function of tuples, which returns a negative number when a < b, a positive number when a > b,
+// and zero when a == b. The returned function can be used with slices.SortFunc, slices.BinarySearchFunc and similar functions.
+// All tuple elements must match the "Ordered" constraint.
+// To compare tuples that hold custom comparable values, use the CmpFunc9C function.
+func CmpFunc9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 cmp.Ordered]() func(a, b T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) int {
+	return func(a, b T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) int {
+		return int(Compare9(a, b))
+	}
+}
+
+// CmpFunc9C returns a comparison function of tuples, which returns a negative number when a < b, a positive number when a > b,
+// and zero when a == b. The returned function can be used with slices.SortFunc, slices.BinarySearchFunc and similar functions.
+// All tuple elements must match the Comparable constraint.
+// To compare tuples that hold built-in "Ordered" values, use the CmpFunc9 function.
+func CmpFunc9C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[Ty3], Ty4 Comparable[Ty4], Ty5 Comparable[Ty5], Ty6 Comparable[Ty6], Ty7 Comparable[Ty7], Ty8 Comparable[Ty8], Ty9 Comparable[Ty9]]() func(a, b T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) int {
+	return func(a, b T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) int {
+		return int(Compare9C(a, b))
+	}
+}
+
+// Comparator9 returns a comparison function of tuples, which compares the tuple elements by order using the given
+// element comparison functions. Each element comparison function must return a negative number when a < b, a positive number
+// when a > b, and zero when a == b, such as cmp.Compare, CompareOrdered and CompareComparable.
+// The returned function short-circuits once one of the elements is not equal, and the rest of the element comparison functions
+// are not called.
+func Comparator9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](c1 func(a, b Ty1) int, c2 func(a, b Ty2) int, c3 func(a, b Ty3) int, c4 func(a, b Ty4) int, c5 func(a, b Ty5) int, c6 func(a, b Ty6) int, c7 func(a, b Ty7) int, c8 func(a, b Ty8) int, c9 func(a, b Ty9) int) func(a, b T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) int {
+	return func(a, b T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) int {
+		return int(multiCompare(
+			func() OrderedComparisonResult { return OrderedComparisonResult(c1(a.V1, b.V1)) },
+
+			func() OrderedComparisonResult { return OrderedComparisonResult(c2(a.V2, b.V2)) },
+
+			func() OrderedComparisonResult { return OrderedComparisonResult(c3(a.V3, b.V3)) },
+
+			func() OrderedComparisonResult { return OrderedComparisonResult(c4(a.V4, b.V4)) },
+
+			func() OrderedComparisonResult { return OrderedComparisonResult(c5(a.V5, b.V5)) },
+
+			func() OrderedComparisonResult { return OrderedComparisonResult(c6(a.V6, b.V6)) },
+
+			func() OrderedComparisonResult { return OrderedComparisonResult(c7(a.V7, b.V7)) },
+
+			func() OrderedComparisonResult { return OrderedComparisonResult(c8(a.V8, b.V8)) },
+
+			func() OrderedComparisonResult { return OrderedComparisonResult(c9(a.V9, b.V9)) },
+		))
+	}
+}
+
+// OrderBy9 returns a comparison function of tuples, which compares the tuple elements by order according to the
+// sort order given for each element, such as OrderBy9(Asc, Desc, ...).
+// All tuple elements must match the "Ordered" constraint.
+// To order tuples that hold other element types, such as pointers, use the Comparator9 function with
+// the OrderFunc and OrderPtrFunc element comparison functions.
+func OrderBy9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 cmp.Ordered](o1, o2, o3, o4, o5, o6, o7, o8, o9 SortOrder) func(a, b T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) int {
+	return Comparator9(OrderedBy[Ty1](o1), OrderedBy[Ty2](o2), OrderedBy[Ty3](o3), OrderedBy[Ty4](o4), OrderedBy[Ty5](o5), OrderedBy[Ty6](o6), OrderedBy[Ty7](o7), OrderedBy[Ty8](o8), OrderedBy[Ty9](o9))
+}
+
 // LessThan9 returns whether the host tuple is semantically less than the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the LessThan9C function.
-func LessThan9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 constraints.Ordered](host, guest T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) bool {
+func LessThan9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 cmp.Ordered](host, guest T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) bool {
 	return Compare9(host, guest).LT()
 }
 
@@ -286,7 +979,7 @@ func LessThan9C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[Ty3], T
 // LessOrEqual9 returns whether the host tuple is semantically less than or equal to the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the LessOrEqual9C function.
-func LessOrEqual9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 constraints.Ordered](host, guest T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) bool {
+func LessOrEqual9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 cmp.Ordered](host, guest T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) bool {
 	return Compare9(host, guest).LE()
 }
 
@@ -300,7 +993,7 @@ func LessOrEqual9C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[Ty3]
 // GreaterThan9 returns whether the host tuple is semantically greater than the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the GreaterThan9C function.
-func GreaterThan9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 constraints.Ordered](host, guest T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) bool {
+func GreaterThan9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 cmp.Ordered](host, guest T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) bool {
 	return Compare9(host, guest).GT()
 }
 
@@ -314,7 +1007,7 @@ func GreaterThan9C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[Ty3]
 // GreaterOrEqual9 returns whether the host tuple is semantically greater than or equal to the guest tuple.
 // All tuple elements of the host and guest parameters must match the "Ordered" constraint.
 // To compare tuples that hold custom comparable values, use the GreaterOrEqual9C function.
-func GreaterOrEqual9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 constraints.Ordered](host, guest T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) bool {
+func GreaterOrEqual9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 cmp.Ordered](host, guest T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) bool {
 	return Compare9(host, guest).GE()
 }
 
@@ -325,6 +1018,45 @@ func GreaterOrEqual9C[Ty1 Comparable[Ty1], Ty2 Comparable[Ty2], Ty3 Comparable[T
 	return Compare9C(host, guest).GE()
 }
 
+// Hash returns a hash of the tuple values using the given seed.
+// Equal tuples return equal hashes for the same seed.
+// Elements supported by the HashValue function are hashed by their values. Other elements, such as slices, maps,
+// functions, nil pointers and nil interfaces, are hashed by their type only, so tuples differing only in these elements collide.
+// To hash tuples holding other element types by their values, use the HashFunc9 function.
+func (t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) Hash(seed maphash.Seed) uint64 {
+	var h maphash.Hash
+	h.SetSeed(seed)
+	writeHashElement(&h, seed, t.V1)
+	writeHashElement(&h, seed, t.V2)
+	writeHashElement(&h, seed, t.V3)
+	writeHashElement(&h, seed, t.V4)
+	writeHashElement(&h, seed, t.V5)
+	writeHashElement(&h, seed, t.V6)
+	writeHashElement(&h, seed, t.V7)
+	writeHashElement(&h, seed, t.V8)
+	writeHashElement(&h, seed, t.V9)
+	return h.Sum64()
+}
+
+// HashFunc9 returns a hash function of tuples, which hashes the tuple values using the given element hash functions.
+// Each element hash function must return equal hashes for equal values and the same seed, such as HashValue.
+func HashFunc9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](h1 func(seed maphash.Seed, v Ty1) uint64, h2 func(seed maphash.Seed, v Ty2) uint64, h3 func(seed maphash.Seed, v Ty3) uint64, h4 func(seed maphash.Seed, v Ty4) uint64, h5 func(seed maphash.Seed, v Ty5) uint64, h6 func(seed maphash.Seed, v Ty6) uint64, h7 func(seed maphash.Seed, v Ty7) uint64, h8 func(seed maphash.Seed, v Ty8) uint64, h9 func(seed maphash.Seed, v Ty9) uint64) func(seed maphash.Seed, t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) uint64 {
+	return func(seed maphash.Seed, t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) uint64 {
+		var h maphash.Hash
+		h.SetSeed(seed)
+		writeHashUint64(&h, h1(seed, t.V1))
+		writeHashUint64(&h, h2(seed, t.V2))
+		writeHashUint64(&h, h3(seed, t.V3))
+		writeHashUint64(&h, h4(seed, t.V4))
+		writeHashUint64(&h, h5(seed, t.V5))
+		writeHashUint64(&h, h6(seed, t.V6))
+		writeHashUint64(&h, h7(seed, t.V7))
+		writeHashUint64(&h, h8(seed, t.V8))
+		writeHashUint64(&h, h9(seed, t.V9))
+		return h.Sum64()
+	}
+}
+
 // MarshalJSON marshals the tuple into a JSON array.
 func (t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) MarshalJSON() ([]byte, error) {
 	return json.Marshal(t.Slice())
@@ -332,49 +1064,333 @@ func (t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) MarshalJSON() ([]byte,
 
 // MarshalJSON unmarshals the tuple from a JSON array.
 func (t *T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) UnmarshalJSON(data []byte) error {
-	// Working with json.RawMessage instead of any enables custom struct support.
-	var slice []json.RawMessage
-	if err := json.Unmarshal(data, &slice); err != nil {
-		return fmt.Errorf("unable to unmarshal json array for tuple: %w", err)
+	return t.UnmarshalJSONWith(data, JSONDecodeOptions{})
+}
+
+// UnmarshalJSONWith unmarshals the tuple from a JSON array using the given decode options.
+// The options are applied to nested tuples as well, including tuples within slices, arrays, maps, pointers and struct fields.
+func (t *T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) UnmarshalJSONWith(data []byte, opts JSONDecodeOptions) error {
+	return unmarshalJSONArray(data, opts, []any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+		&t.V5,
+		&t.V6,
+		&t.V7,
+		&t.V8,
+		&t.V9,
+	})
+}
+
+// MarshalJSONLabeled marshals the tuple into a JSON object, where each of the tuple values is keyed by its matching label.
+// The number of labels must match the number of tuple values.
+func (t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) MarshalJSONLabeled(labels Labels) ([]byte, error) {
+	return marshalJSONLabeled(labels, t.Slice())
+}
+
+// UnmarshalJSONLabeled unmarshals the tuple from a JSON object, where each of the tuple values is keyed by its matching label.
+// The number of labels must match the number of tuple values.
+// Unless the labels are strict, missing keys leave the matching tuple values unchanged, and unknown keys are ignored.
+func (t *T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) UnmarshalJSONLabeled(data []byte, labels Labels) error {
+	return unmarshalJSONLabeled(data, labels, []any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+		&t.V5,
+		&t.V6,
+		&t.V7,
+		&t.V8,
+		&t.V9,
+	})
+}
+
+// MarshalText marshals the tuple into a text of comma separated values.
+// Commas and backslashes within the values are escaped by a backslash.
+// Values implementing encoding.TextMarshaler are marshalled using their MarshalText method.
+// Otherwise, the values must be of boolean, numeric or string kinds.
+func (t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) MarshalText() ([]byte, error) {
+	var values [9]string
+	var err error
+	if values[0], err = marshalTextValue(t.V1); err != nil {
+		return nil, fmt.Errorf("value at index 0 failed to marshal: %w", err)
+	}
+	if values[1], err = marshalTextValue(t.V2); err != nil {
+		return nil, fmt.Errorf("value at index 1 failed to marshal: %w", err)
+	}
+	if values[2], err = marshalTextValue(t.V3); err != nil {
+		return nil, fmt.Errorf("value at index 2 failed to marshal: %w", err)
+	}
+	if values[3], err = marshalTextValue(t.V4); err != nil {
+		return nil, fmt.Errorf("value at index 3 failed to marshal: %w", err)
+	}
+	if values[4], err = marshalTextValue(t.V5); err != nil {
+		return nil, fmt.Errorf("value at index 4 failed to marshal: %w", err)
+	}
+	if values[5], err = marshalTextValue(t.V6); err != nil {
+		return nil, fmt.Errorf("value at index 5 failed to marshal: %w", err)
+	}
+	if values[6], err = marshalTextValue(t.V7); err != nil {
+		return nil, fmt.Errorf("value at index 6 failed to marshal: %w", err)
+	}
+	if values[7], err = marshalTextValue(t.V8); err != nil {
+		return nil, fmt.Errorf("value at index 7 failed to marshal: %w", err)
+	}
+	if values[8], err = marshalTextValue(t.V9); err != nil {
+		return nil, fmt.Errorf("value at index 8 failed to marshal: %w", err)
 	}
 
-	if len(slice) != 9 {
-		return fmt.Errorf("unmarshalled json array length %d must match number of tuple values 9", len(slice))
+	return []byte(joinText(values[:])), nil
+}
+
+// UnmarshalText unmarshals the tuple from a text of comma separated values, as created by MarshalText.
+func (t *T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) UnmarshalText(data []byte) error {
+	values, err := splitText(string(data))
+	if err != nil {
+		return fmt.Errorf("unable to unmarshal text for tuple: %w", err)
+	}
+
+	if len(values) != 9 {
+		return &LengthMismatchError{Source: "unmarshalled text values", Expected: 9, Actual: len(values), count: true}
 	}
-	if err := json.Unmarshal(slice[0], &t.V1); err != nil {
-		return fmt.Errorf("value %q at slice index 0 failed to unmarshal: %w", string(slice[0]), err)
+	if err := unmarshalTextValue(values[0], &t.V1); err != nil {
+		return &ElementUnmarshalError{Index: 0, Value: values[0], Err: err, location: locationIndex}
 	}
 
-	if err := json.Unmarshal(slice[1], &t.V2); err != nil {
-		return fmt.Errorf("value %q at slice index 1 failed to unmarshal: %w", string(slice[1]), err)
+	if err := unmarshalTextValue(values[1], &t.V2); err != nil {
+		return &ElementUnmarshalError{Index: 1, Value: values[1], Err: err, location: locationIndex}
 	}
 
-	if err := json.Unmarshal(slice[2], &t.V3); err != nil {
-		return fmt.Errorf("value %q at slice index 2 failed to unmarshal: %w", string(slice[2]), err)
+	if err := unmarshalTextValue(values[2], &t.V3); err != nil {
+		return &ElementUnmarshalError{Index: 2, Value: values[2], Err: err, location: locationIndex}
 	}
 
-	if err := json.Unmarshal(slice[3], &t.V4); err != nil {
-		return fmt.Errorf("value %q at slice index 3 failed to unmarshal: %w", string(slice[3]), err)
+	if err := unmarshalTextValue(values[3], &t.V4); err != nil {
+		return &ElementUnmarshalError{Index: 3, Value: values[3], Err: err, location: locationIndex}
 	}
 
-	if err := json.Unmarshal(slice[4], &t.V5); err != nil {
-		return fmt.Errorf("value %q at slice index 4 failed to unmarshal: %w", string(slice[4]), err)
+	if err := unmarshalTextValue(values[4], &t.V5); err != nil {
+		return &ElementUnmarshalError{Index: 4, Value: values[4], Err: err, location: locationIndex}
 	}
 
-	if err := json.Unmarshal(slice[5], &t.V6); err != nil {
-		return fmt.Errorf("value %q at slice index 5 failed to unmarshal: %w", string(slice[5]), err)
+	if err := unmarshalTextValue(values[5], &t.V6); err != nil {
+		return &ElementUnmarshalError{Index: 5, Value: values[5], Err: err, location: locationIndex}
 	}
 
-	if err := json.Unmarshal(slice[6], &t.V7); err != nil {
-		return fmt.Errorf("value %q at slice index 6 failed to unmarshal: %w", string(slice[6]), err)
+	if err := unmarshalTextValue(values[6], &t.V7); err != nil {
+		return &ElementUnmarshalError{Index: 6, Value: values[6], Err: err, location: locationIndex}
 	}
 
-	if err := json.Unmarshal(slice[7], &t.V8); err != nil {
-		return fmt.Errorf("value %q at slice index 7 failed to unmarshal: %w", string(slice[7]), err)
+	if err := unmarshalTextValue(values[7], &t.V8); err != nil {
+		return &ElementUnmarshalError{Index: 7, Value: values[7], Err: err, location: locationIndex}
 	}
 
-	if err := json.Unmarshal(slice[8], &t.V9); err != nil {
-		return fmt.Errorf("value %q at slice index 8 failed to unmarshal: %w", string(slice[8]), err)
+	if err := unmarshalTextValue(values[8], &t.V9); err != nil {
+		return &ElementUnmarshalError{Index: 8, Value: values[8], Err: err, location: locationIndex}
 	}
 	return nil
 }
+
+// GobEncode encodes the tuple for encoding/gob, implementing the gob.GobEncoder interface.
+// Nil pointer and interface values are preserved as nil, and pointers to zero values are preserved as non-nil.
+// See the package documentation for the wire format.
+func (t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) GobEncode() ([]byte, error) {
+	return gobEncodeValues([]any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+		&t.V5,
+		&t.V6,
+		&t.V7,
+		&t.V8,
+		&t.V9,
+	})
+}
+
+// GobDecode decodes the tuple from encoding/gob, as encoded by GobEncode, implementing the gob.GobDecoder interface.
+// If the number of encoded values doesn't match, a *LengthMismatchError is returned.
+func (t *T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) GobDecode(data []byte) error {
+	return gobDecodeValues(data, []any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+		&t.V5,
+		&t.V6,
+		&t.V7,
+		&t.V8,
+		&t.V9,
+	})
+}
+
+// MarshalComposite marshals the tuple into a PostgreSQL composite literal, such as (1,"foo bar",).
+// Values are quoted and escaped the same way PostgreSQL does, and nil values and pointers are marshalled as NULL.
+// Nested tuples are marshalled as nested composite values, and other values are marshalled the same way as MarshalText does.
+func (t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) MarshalComposite() ([]byte, error) {
+	return marshalComposite(t.Slice())
+}
+
+// UnmarshalComposite unmarshals the tuple from a PostgreSQL composite literal, as created by MarshalComposite or by PostgreSQL.
+// NULL values can only be unmarshalled into pointers, interfaces, slices and maps, which are set to nil.
+// If the number of composite fields doesn't match, a *LengthMismatchError is returned.
+func (t *T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) UnmarshalComposite(data []byte) error {
+	return unmarshalComposite(data, []any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+		&t.V5,
+		&t.V6,
+		&t.V7,
+		&t.V8,
+		&t.V9,
+	})
+}
+
+// MarshalXML marshals the tuple into a sequence of xml child elements named v1 to v9, implementing the xml.Marshaler interface.
+// Nil values are marshalled as empty child elements with a nil="true" attribute,
+// and slice values are marshalled as child elements holding an item element for each of the slice items.
+// Use MarshalXMLLabeled to name the child elements differently.
+func (t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return t.MarshalXMLLabeled(e, start, indexLabels(9))
+}
+
+// UnmarshalXML unmarshals the tuple from a sequence of xml child elements named v1 to v9, implementing the xml.Unmarshaler interface.
+// The number of child elements must match the number of tuple values, the same way as UnmarshalJSON requires.
+func (t *T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return t.UnmarshalXMLLabeled(d, start, indexLabels(9))
+}
+
+// MarshalXMLLabeled marshals the tuple into a sequence of xml child elements, named by the labels matching each of the tuple values.
+// The number of labels must match the number of tuple values.
+func (t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) MarshalXMLLabeled(e *xml.Encoder, start xml.StartElement, labels Labels) error {
+	return marshalXML(e, start, labels, t.Slice())
+}
+
+// UnmarshalXMLLabeled unmarshals the tuple from a sequence of xml child elements, named by the labels matching each of the tuple values.
+// The child elements must be named by the labels in the same order, and their number must match the number of tuple values.
+// If the number of child elements doesn't match, a *LengthMismatchError is returned.
+func (t *T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) UnmarshalXMLLabeled(d *xml.Decoder, start xml.StartElement, labels Labels) error {
+	return unmarshalXML(d, start, labels, []any{
+		&t.V1,
+		&t.V2,
+		&t.V3,
+		&t.V4,
+		&t.V5,
+		&t.V6,
+		&t.V7,
+		&t.V8,
+		&t.V9,
+	})
+}
+
+// MarshalBinary marshals the tuple into its binary encoding using little-endian byte order,
+// implementing the encoding.BinaryMarshaler interface.
+// The tuple values must be of boolean, fixed-size numeric, string or byte slice kinds, or nested tuples.
+// See the package documentation for the binary encoding.
+func (t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) MarshalBinary() ([]byte, error) {
+	return t.AppendBinary(nil)
+}
+
+// AppendBinary appends the binary encoding of the tuple to b using little-endian byte order,
+// the same way as MarshalBinary does.
+func (t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) AppendBinary(b []byte) ([]byte, error) {
+	return t.AppendBinaryOrder(b, binary.LittleEndian)
+}
+
+// AppendBinaryOrder appends the binary encoding of the tuple to b using the given byte order.
+// Values of common types are encoded without allocations, other than the ones needed to grow b.
+func (t T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) AppendBinaryOrder(b []byte, order binary.AppendByteOrder) ([]byte, error) {
+	var err error
+	if b, err = appendBinaryValue(b, order, t.V1); err != nil {
+		return nil, fmt.Errorf("value at index 0 failed to marshal: %w", err)
+	}
+	if b, err = appendBinaryValue(b, order, t.V2); err != nil {
+		return nil, fmt.Errorf("value at index 1 failed to marshal: %w", err)
+	}
+	if b, err = appendBinaryValue(b, order, t.V3); err != nil {
+		return nil, fmt.Errorf("value at index 2 failed to marshal: %w", err)
+	}
+	if b, err = appendBinaryValue(b, order, t.V4); err != nil {
+		return nil, fmt.Errorf("value at index 3 failed to marshal: %w", err)
+	}
+	if b, err = appendBinaryValue(b, order, t.V5); err != nil {
+		return nil, fmt.Errorf("value at index 4 failed to marshal: %w", err)
+	}
+	if b, err = appendBinaryValue(b, order, t.V6); err != nil {
+		return nil, fmt.Errorf("value at index 5 failed to marshal: %w", err)
+	}
+	if b, err = appendBinaryValue(b, order, t.V7); err != nil {
+		return nil, fmt.Errorf("value at index 6 failed to marshal: %w", err)
+	}
+	if b, err = appendBinaryValue(b, order, t.V8); err != nil {
+		return nil, fmt.Errorf("value at index 7 failed to marshal: %w", err)
+	}
+	if b, err = appendBinaryValue(b, order, t.V9); err != nil {
+		return nil, fmt.Errorf("value at index 8 failed to marshal: %w", err)
+	}
+
+	return b, nil
+}
+
+// UnmarshalBinary unmarshals the tuple from its binary encoding using little-endian byte order,
+// implementing the encoding.BinaryUnmarshaler interface.
+func (t *T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) UnmarshalBinary(data []byte) error {
+	return t.UnmarshalBinaryOrder(data, binary.LittleEndian)
+}
+
+// UnmarshalBinaryOrder unmarshals the tuple from its binary encoding using the given byte order.
+// The data must hold exactly the binary encoding of the tuple.
+func (t *T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) UnmarshalBinaryOrder(data []byte, order binary.ByteOrder) error {
+	return unmarshalBinary(data, order, t)
+}
+
+// decodeBinary decodes the tuple from the beginning of data using the given byte order, and returns the rest of the data.
+func (t *T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) decodeBinary(data []byte, order binary.ByteOrder) ([]byte, error) {
+	var err error
+	if data, err = decodeBinaryValue(data, order, &t.V1); err != nil {
+		return nil, fmt.Errorf("value at index 0 failed to unmarshal: %w", err)
+	}
+	if data, err = decodeBinaryValue(data, order, &t.V2); err != nil {
+		return nil, fmt.Errorf("value at index 1 failed to unmarshal: %w", err)
+	}
+	if data, err = decodeBinaryValue(data, order, &t.V3); err != nil {
+		return nil, fmt.Errorf("value at index 2 failed to unmarshal: %w", err)
+	}
+	if data, err = decodeBinaryValue(data, order, &t.V4); err != nil {
+		return nil, fmt.Errorf("value at index 3 failed to unmarshal: %w", err)
+	}
+	if data, err = decodeBinaryValue(data, order, &t.V5); err != nil {
+		return nil, fmt.Errorf("value at index 4 failed to unmarshal: %w", err)
+	}
+	if data, err = decodeBinaryValue(data, order, &t.V6); err != nil {
+		return nil, fmt.Errorf("value at index 5 failed to unmarshal: %w", err)
+	}
+	if data, err = decodeBinaryValue(data, order, &t.V7); err != nil {
+		return nil, fmt.Errorf("value at index 6 failed to unmarshal: %w", err)
+	}
+	if data, err = decodeBinaryValue(data, order, &t.V8); err != nil {
+		return nil, fmt.Errorf("value at index 7 failed to unmarshal: %w", err)
+	}
+	if data, err = decodeBinaryValue(data, order, &t.V9); err != nil {
+		return nil, fmt.Errorf("value at index 8 failed to unmarshal: %w", err)
+	}
+
+	return data, nil
+}
+
+// AppendBinarySlice9 appends the binary encoding of a slice of tuples to b using the given byte order.
+// The slice is encoded as its length followed by the binary encoding of each of the tuples.
+func AppendBinarySlice9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](b []byte, order binary.AppendByteOrder, tuples []T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) ([]byte, error) {
+	return appendBinarySlice(b, order, tuples)
+}
+
+// UnmarshalBinarySlice9 unmarshals a slice of tuples from its binary encoding using the given byte order,
+// as encoded by AppendBinarySlice9, and appends the tuples to dst.
+// Tuples are decoded in place, so decoding into a dst with enough capacity, such as a previously decoded slice truncated to zero length,
+// doesn't allocate unless the tuples hold strings, or byte slices longer than the ones previously held in that capacity.
+func UnmarshalBinarySlice9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9 any](data []byte, order binary.ByteOrder, dst []T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9]) ([]T9[Ty1, Ty2, Ty3, Ty4, Ty5, Ty6, Ty7, Ty8, Ty9], error) {
+	return unmarshalBinarySlice(data, order, dst)
+}
@@ -1,7 +1,17 @@
 package tuple
 
 import (
+	"database/sql/driver"
+	"encoding/binary"
 	"encoding/json"
+	"encoding/xml"
+	"hash/maphash"
+	"io"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -41,6 +51,200 @@ func TestT9_Values(t *testing.T) {
 	require.Equal(t, "9", v9)
 }
 
+func TestT9_MapAt(t *testing.T) {
+	tup := New9(1, 2, 3, 4, 5, 6, 7, 8, 9)
+
+	require.Equal(t, New9("1", 2, 3, 4, 5, 6, 7, 8, 9), Map9At1(tup, strconv.Itoa))
+	require.Equal(t, New9(1, "2", 3, 4, 5, 6, 7, 8, 9), Map9At2(tup, strconv.Itoa))
+	require.Equal(t, New9(1, 2, "3", 4, 5, 6, 7, 8, 9), Map9At3(tup, strconv.Itoa))
+	require.Equal(t, New9(1, 2, 3, "4", 5, 6, 7, 8, 9), Map9At4(tup, strconv.Itoa))
+	require.Equal(t, New9(1, 2, 3, 4, "5", 6, 7, 8, 9), Map9At5(tup, strconv.Itoa))
+	require.Equal(t, New9(1, 2, 3, 4, 5, "6", 7, 8, 9), Map9At6(tup, strconv.Itoa))
+	require.Equal(t, New9(1, 2, 3, 4, 5, 6, "7", 8, 9), Map9At7(tup, strconv.Itoa))
+	require.Equal(t, New9(1, 2, 3, 4, 5, 6, 7, "8", 9), Map9At8(tup, strconv.Itoa))
+	require.Equal(t, New9(1, 2, 3, 4, 5, 6, 7, 8, "9"), Map9At9(tup, strconv.Itoa))
+}
+
+func TestT9_Map(t *testing.T) {
+	tup := New9(1, 2, 3, 4, 5, 6, 7, 8, 9)
+	got := Map9(tup, strconv.Itoa, strconv.Itoa, strconv.Itoa, strconv.Itoa, strconv.Itoa, strconv.Itoa, strconv.Itoa, strconv.Itoa, strconv.Itoa)
+	require.Equal(t, New9("1", "2", "3", "4", "5", "6", "7", "8", "9"), got)
+}
+
+func TestT9_Take(t *testing.T) {
+	tup := New9("1", "2", "3", "4", "5", "6", "7", "8", "9")
+
+	require.Equal(t, New1("1"), Take9_1(tup))
+	require.Equal(t, New2("1", "2"), Take9_2(tup))
+	require.Equal(t, New3("1", "2", "3"), Take9_3(tup))
+	require.Equal(t, New4("1", "2", "3", "4"), Take9_4(tup))
+	require.Equal(t, New5("1", "2", "3", "4", "5"), Take9_5(tup))
+	require.Equal(t, New6("1", "2", "3", "4", "5", "6"), Take9_6(tup))
+	require.Equal(t, New7("1", "2", "3", "4", "5", "6", "7"), Take9_7(tup))
+	require.Equal(t, New8("1", "2", "3", "4", "5", "6", "7", "8"), Take9_8(tup))
+}
+
+func TestT9_Drop(t *testing.T) {
+	tup := New9("1", "2", "3", "4", "5", "6", "7", "8", "9")
+
+	require.Equal(t, New8("2", "3", "4", "5", "6", "7", "8", "9"), Drop9_1(tup))
+	require.Equal(t, New7("3", "4", "5", "6", "7", "8", "9"), Drop9_2(tup))
+	require.Equal(t, New6("4", "5", "6", "7", "8", "9"), Drop9_3(tup))
+	require.Equal(t, New5("5", "6", "7", "8", "9"), Drop9_4(tup))
+	require.Equal(t, New4("6", "7", "8", "9"), Drop9_5(tup))
+	require.Equal(t, New3("7", "8", "9"), Drop9_6(tup))
+	require.Equal(t, New2("8", "9"), Drop9_7(tup))
+	require.Equal(t, New1("9"), Drop9_8(tup))
+}
+
+func TestT9_Split(t *testing.T) {
+	tup := New9("1", "2", "3", "4", "5", "6", "7", "8", "9")
+
+	require.Equal(t,
+		New2(New1("1"), New8("2", "3", "4", "5", "6", "7", "8", "9")),
+		Split9_1(tup),
+	)
+	require.Equal(t,
+		New2(New2("1", "2"), New7("3", "4", "5", "6", "7", "8", "9")),
+		Split9_2(tup),
+	)
+	require.Equal(t,
+		New2(New3("1", "2", "3"), New6("4", "5", "6", "7", "8", "9")),
+		Split9_3(tup),
+	)
+	require.Equal(t,
+		New2(New4("1", "2", "3", "4"), New5("5", "6", "7", "8", "9")),
+		Split9_4(tup),
+	)
+	require.Equal(t,
+		New2(New5("1", "2", "3", "4", "5"), New4("6", "7", "8", "9")),
+		Split9_5(tup),
+	)
+	require.Equal(t,
+		New2(New6("1", "2", "3", "4", "5", "6"), New3("7", "8", "9")),
+		Split9_6(tup),
+	)
+	require.Equal(t,
+		New2(New7("1", "2", "3", "4", "5", "6", "7"), New2("8", "9")),
+		Split9_7(tup),
+	)
+	require.Equal(t,
+		New2(New8("1", "2", "3", "4", "5", "6", "7", "8"), New1("9")),
+		Split9_8(tup),
+	)
+}
+
+func TestT9_Reverse(t *testing.T) {
+	tup := New9(1, 2, 3, 4, 5, 6, 7, 8, 9)
+	require.Equal(t, New9(9, 8, 7, 6, 5, 4, 3, 2, 1), Reverse9(tup))
+}
+
+func TestT9_Apply(t *testing.T) {
+	tup := New9("1", "2", "3", "4", "5", "6", "7", "8", "9")
+	got := Apply9(tup, func(v1, v2, v3, v4, v5, v6, v7, v8, v9 string) string {
+		return v1 + v2 + v3 + v4 + v5 + v6 + v7 + v8 + v9
+	})
+	require.Equal(t, "123456789", got)
+}
+
+func TestT9_Tupled(t *testing.T) {
+	tup := New9("1", "2", "3", "4", "5", "6", "7", "8", "9")
+	f := Tupled9(func(v1, v2, v3, v4, v5, v6, v7, v8, v9 string) string {
+		return v1 + v2 + v3 + v4 + v5 + v6 + v7 + v8 + v9
+	})
+	require.Equal(t, "123456789", f(tup))
+}
+
+func TestT9_Untupled(t *testing.T) {
+	f := Untupled9(func(tup T9[string, string, string, string, string, string, string, string, string]) T9[string, string, string, string, string, string, string, string, string] {
+		return tup
+	})
+	require.Equal(t, New9("1", "2", "3", "4", "5", "6", "7", "8", "9"), f("1", "2", "3", "4", "5", "6", "7", "8", "9"))
+}
+
+func TestT9_Zip(t *testing.T) {
+	want := []T9[int, int, int, int, int, int, int, int, int]{
+		New9(1, 2, 3, 4, 5, 6, 7, 8, 9),
+		New9(2, 3, 4, 5, 6, 7, 8, 9, 10),
+	}
+
+	require.Equal(t, want, Zip9([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8}, []int{8, 9}, []int{9, 10}))
+	require.Equal(t, want[:1], Zip9([]int{1}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8}, []int{8, 9}, []int{9, 10}))
+	require.Equal(t, want[:1], Zip9([]int{1, 2}, []int{2}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8}, []int{8, 9}, []int{9, 10}))
+	require.Equal(t, want[:1], Zip9([]int{1, 2}, []int{2, 3}, []int{3}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8}, []int{8, 9}, []int{9, 10}))
+	require.Equal(t, want[:1], Zip9([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4}, []int{5, 6}, []int{6, 7}, []int{7, 8}, []int{8, 9}, []int{9, 10}))
+	require.Equal(t, want[:1], Zip9([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5}, []int{6, 7}, []int{7, 8}, []int{8, 9}, []int{9, 10}))
+	require.Equal(t, want[:1], Zip9([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6}, []int{7, 8}, []int{8, 9}, []int{9, 10}))
+	require.Equal(t, want[:1], Zip9([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7}, []int{8, 9}, []int{9, 10}))
+	require.Equal(t, want[:1], Zip9([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8}, []int{8}, []int{9, 10}))
+	require.Equal(t, want[:1], Zip9([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8}, []int{8, 9}, []int{9}))
+}
+
+func TestT9_ZipStrict(t *testing.T) {
+	want := []T9[int, int, int, int, int, int, int, int, int]{
+		New9(1, 2, 3, 4, 5, 6, 7, 8, 9),
+		New9(2, 3, 4, 5, 6, 7, 8, 9, 10),
+	}
+
+	got, err := Zip9Strict([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8}, []int{8, 9}, []int{9, 10})
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	_, err = Zip9Strict([]int{1}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8}, []int{8, 9}, []int{9, 10})
+	require.EqualError(t, err, "slice 2 length 2 must match slice 1 length 1")
+	var lengthErr1 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr1)
+	_, err = Zip9Strict([]int{1, 2}, []int{2}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8}, []int{8, 9}, []int{9, 10})
+	require.EqualError(t, err, "slice 2 length 1 must match slice 1 length 2")
+	var lengthErr2 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr2)
+	_, err = Zip9Strict([]int{1, 2}, []int{2, 3}, []int{3}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8}, []int{8, 9}, []int{9, 10})
+	require.EqualError(t, err, "slice 3 length 1 must match slice 1 length 2")
+	var lengthErr3 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr3)
+	_, err = Zip9Strict([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4}, []int{5, 6}, []int{6, 7}, []int{7, 8}, []int{8, 9}, []int{9, 10})
+	require.EqualError(t, err, "slice 4 length 1 must match slice 1 length 2")
+	var lengthErr4 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr4)
+	_, err = Zip9Strict([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5}, []int{6, 7}, []int{7, 8}, []int{8, 9}, []int{9, 10})
+	require.EqualError(t, err, "slice 5 length 1 must match slice 1 length 2")
+	var lengthErr5 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr5)
+	_, err = Zip9Strict([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6}, []int{7, 8}, []int{8, 9}, []int{9, 10})
+	require.EqualError(t, err, "slice 6 length 1 must match slice 1 length 2")
+	var lengthErr6 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr6)
+	_, err = Zip9Strict([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7}, []int{8, 9}, []int{9, 10})
+	require.EqualError(t, err, "slice 7 length 1 must match slice 1 length 2")
+	var lengthErr7 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr7)
+	_, err = Zip9Strict([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8}, []int{8}, []int{9, 10})
+	require.EqualError(t, err, "slice 8 length 1 must match slice 1 length 2")
+	var lengthErr8 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr8)
+	_, err = Zip9Strict([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8}, []int{8, 9}, []int{9})
+	require.EqualError(t, err, "slice 9 length 1 must match slice 1 length 2")
+	var lengthErr9 *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr9)
+}
+
+func TestT9_Unzip(t *testing.T) {
+	s1, s2, s3, s4, s5, s6, s7, s8, s9 := Unzip9([]T9[int, int, int, int, int, int, int, int, int]{
+		New9(1, 2, 3, 4, 5, 6, 7, 8, 9),
+		New9(2, 3, 4, 5, 6, 7, 8, 9, 10),
+	})
+
+	require.Equal(t, []int{1, 2}, s1)
+	require.Equal(t, []int{2, 3}, s2)
+	require.Equal(t, []int{3, 4}, s3)
+	require.Equal(t, []int{4, 5}, s4)
+	require.Equal(t, []int{5, 6}, s5)
+	require.Equal(t, []int{6, 7}, s6)
+	require.Equal(t, []int{7, 8}, s7)
+	require.Equal(t, []int{8, 9}, s8)
+	require.Equal(t, []int{9, 10}, s9)
+}
+
 func TestT9_Compare(t *testing.T) {
 	lesser := New9(1, 2, 3, 4, 5, 6, 7, 8, 9)
 	greater := New9(2, 3, 4, 5, 6, 7, 8, 9, 10)
@@ -221,6 +425,195 @@ func TestT9_CompareC(t *testing.T) {
 	}
 }
 
+func TestT9_CmpFunc(t *testing.T) {
+	tups := []T9[int, int, int, int, int, int, int, int, int]{
+		New9(2, 3, 4, 5, 6, 7, 8, 9, 10),
+		New9(1, 2, 3, 4, 5, 6, 7, 8, 9),
+		New9(3, 4, 5, 6, 7, 8, 9, 10, 11),
+	}
+
+	cmp := CmpFunc9[int, int, int, int, int, int, int, int, int]()
+	slices.SortFunc(tups, cmp)
+	require.Equal(t, []T9[int, int, int, int, int, int, int, int, int]{
+		New9(1, 2, 3, 4, 5, 6, 7, 8, 9),
+		New9(2, 3, 4, 5, 6, 7, 8, 9, 10),
+		New9(3, 4, 5, 6, 7, 8, 9, 10, 11),
+	}, tups)
+
+	index, found := slices.BinarySearchFunc(tups, New9(2, 3, 4, 5, 6, 7, 8, 9, 10), cmp)
+	require.True(t, found)
+	require.Equal(t, 1, index)
+}
+
+func TestT9_CmpFuncC(t *testing.T) {
+	tups := []T9[stringComparable, stringComparable, stringComparable, stringComparable, stringComparable, stringComparable, stringComparable, stringComparable, stringComparable]{
+		New9(stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5"), stringComparable("6"), stringComparable("7"), stringComparable("8"), stringComparable("9"), stringComparable("10")),
+		New9(stringComparable("1"), stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5"), stringComparable("6"), stringComparable("7"), stringComparable("8"), stringComparable("9")),
+	}
+
+	slices.SortFunc(tups, CmpFunc9C[stringComparable, stringComparable, stringComparable, stringComparable, stringComparable, stringComparable, stringComparable, stringComparable, stringComparable]())
+	require.Equal(t, []T9[stringComparable, stringComparable, stringComparable, stringComparable, stringComparable, stringComparable, stringComparable, stringComparable, stringComparable]{
+		New9(stringComparable("1"), stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5"), stringComparable("6"), stringComparable("7"), stringComparable("8"), stringComparable("9")),
+		New9(stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5"), stringComparable("6"), stringComparable("7"), stringComparable("8"), stringComparable("9"), stringComparable("10")),
+	}, tups)
+}
+
+func TestT9_Compare_NaN(t *testing.T) {
+	nan := New9(math.NaN(), math.NaN(), math.NaN(), math.NaN(), math.NaN(), math.NaN(), math.NaN(), math.NaN(), math.NaN())
+	num := New9(1.0, 2.0, 3.0, 4.0, 5.0, 6.0, 7.0, 8.0, 9.0)
+
+	require.True(t, Compare9(nan, nan).EQ())
+	require.True(t, Compare9(nan, num).LT())
+	require.True(t, Compare9(num, nan).GT())
+}
+
+func TestT9_Comparator(t *testing.T) {
+	compare := Comparator9(CompareOrdered[int], CompareOrdered[int], CompareOrdered[int], CompareOrdered[int], CompareOrdered[int], CompareOrdered[int], CompareOrdered[int], CompareOrdered[int], CompareOrdered[int])
+	lesser := New9(1, 2, 3, 4, 5, 6, 7, 8, 9)
+
+	require.Equal(t, 0, compare(lesser, lesser))
+
+	greater1 := New9(2, 2, 3, 4, 5, 6, 7, 8, 9)
+	require.Equal(t, -1, compare(lesser, greater1))
+	require.Equal(t, 1, compare(greater1, lesser))
+
+	greater2 := New9(1, 3, 3, 4, 5, 6, 7, 8, 9)
+	require.Equal(t, -1, compare(lesser, greater2))
+	require.Equal(t, 1, compare(greater2, lesser))
+
+	greater3 := New9(1, 2, 4, 4, 5, 6, 7, 8, 9)
+	require.Equal(t, -1, compare(lesser, greater3))
+	require.Equal(t, 1, compare(greater3, lesser))
+
+	greater4 := New9(1, 2, 3, 5, 5, 6, 7, 8, 9)
+	require.Equal(t, -1, compare(lesser, greater4))
+	require.Equal(t, 1, compare(greater4, lesser))
+
+	greater5 := New9(1, 2, 3, 4, 6, 6, 7, 8, 9)
+	require.Equal(t, -1, compare(lesser, greater5))
+	require.Equal(t, 1, compare(greater5, lesser))
+
+	greater6 := New9(1, 2, 3, 4, 5, 7, 7, 8, 9)
+	require.Equal(t, -1, compare(lesser, greater6))
+	require.Equal(t, 1, compare(greater6, lesser))
+
+	greater7 := New9(1, 2, 3, 4, 5, 6, 8, 8, 9)
+	require.Equal(t, -1, compare(lesser, greater7))
+	require.Equal(t, 1, compare(greater7, lesser))
+
+	greater8 := New9(1, 2, 3, 4, 5, 6, 7, 9, 9)
+	require.Equal(t, -1, compare(lesser, greater8))
+	require.Equal(t, 1, compare(greater8, lesser))
+
+	greater9 := New9(1, 2, 3, 4, 5, 6, 7, 8, 10)
+	require.Equal(t, -1, compare(lesser, greater9))
+	require.Equal(t, 1, compare(greater9, lesser))
+}
+
+func TestT9_Comparator_Comparable(t *testing.T) {
+	compare := Comparator9(CompareComparable[stringComparable], CompareComparable[stringComparable], CompareComparable[stringComparable], CompareComparable[stringComparable], CompareComparable[stringComparable], CompareComparable[stringComparable], CompareComparable[stringComparable], CompareComparable[stringComparable], CompareComparable[stringComparable])
+	lesser := New9(stringComparable("1"), stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5"), stringComparable("6"), stringComparable("7"), stringComparable("8"), stringComparable("9"))
+	greater := New9(stringComparable("2"), stringComparable("3"), stringComparable("4"), stringComparable("5"), stringComparable("6"), stringComparable("7"), stringComparable("8"), stringComparable("9"), stringComparable("10"))
+
+	require.Equal(t, -1, compare(lesser, greater))
+	require.Equal(t, 1, compare(greater, lesser))
+	require.Equal(t, 0, compare(lesser, lesser))
+}
+
+func TestT9_Comparator_ShortCircuit(t *testing.T) {
+	unreachable := func(a, b int) int {
+		t.Fatal("comparison function should not be called")
+		return 0
+	}
+
+	compare := Comparator9(CompareOrdered[int], unreachable, unreachable, unreachable, unreachable, unreachable, unreachable, unreachable, unreachable)
+	require.Equal(t, -1, compare(New9(1, 2, 3, 4, 5, 6, 7, 8, 9), New9(2, 3, 4, 5, 6, 7, 8, 9, 10)))
+}
+
+func TestT9_OrderBy(t *testing.T) {
+	lesser := New9(1, 2, 3, 4, 5, 6, 7, 8, 9)
+	asc := OrderBy9[int, int, int, int, int, int, int, int, int](Asc, Asc, Asc, Asc, Asc, Asc, Asc, Asc, Asc)
+
+	greater1 := New9(2, 2, 3, 4, 5, 6, 7, 8, 9)
+	desc1 := OrderBy9[int, int, int, int, int, int, int, int, int](Desc, Asc, Asc, Asc, Asc, Asc, Asc, Asc, Asc)
+	require.Equal(t, -1, asc(lesser, greater1))
+	require.Equal(t, 1, desc1(lesser, greater1))
+	require.Equal(t, 0, desc1(lesser, lesser))
+
+	greater2 := New9(1, 3, 3, 4, 5, 6, 7, 8, 9)
+	desc2 := OrderBy9[int, int, int, int, int, int, int, int, int](Asc, Desc, Asc, Asc, Asc, Asc, Asc, Asc, Asc)
+	require.Equal(t, -1, asc(lesser, greater2))
+	require.Equal(t, 1, desc2(lesser, greater2))
+	require.Equal(t, 0, desc2(lesser, lesser))
+
+	greater3 := New9(1, 2, 4, 4, 5, 6, 7, 8, 9)
+	desc3 := OrderBy9[int, int, int, int, int, int, int, int, int](Asc, Asc, Desc, Asc, Asc, Asc, Asc, Asc, Asc)
+	require.Equal(t, -1, asc(lesser, greater3))
+	require.Equal(t, 1, desc3(lesser, greater3))
+	require.Equal(t, 0, desc3(lesser, lesser))
+
+	greater4 := New9(1, 2, 3, 5, 5, 6, 7, 8, 9)
+	desc4 := OrderBy9[int, int, int, int, int, int, int, int, int](Asc, Asc, Asc, Desc, Asc, Asc, Asc, Asc, Asc)
+	require.Equal(t, -1, asc(lesser, greater4))
+	require.Equal(t, 1, desc4(lesser, greater4))
+	require.Equal(t, 0, desc4(lesser, lesser))
+
+	greater5 := New9(1, 2, 3, 4, 6, 6, 7, 8, 9)
+	desc5 := OrderBy9[int, int, int, int, int, int, int, int, int](Asc, Asc, Asc, Asc, Desc, Asc, Asc, Asc, Asc)
+	require.Equal(t, -1, asc(lesser, greater5))
+	require.Equal(t, 1, desc5(lesser, greater5))
+	require.Equal(t, 0, desc5(lesser, lesser))
+
+	greater6 := New9(1, 2, 3, 4, 5, 7, 7, 8, 9)
+	desc6 := OrderBy9[int, int, int, int, int, int, int, int, int](Asc, Asc, Asc, Asc, Asc, Desc, Asc, Asc, Asc)
+	require.Equal(t, -1, asc(lesser, greater6))
+	require.Equal(t, 1, desc6(lesser, greater6))
+	require.Equal(t, 0, desc6(lesser, lesser))
+
+	greater7 := New9(1, 2, 3, 4, 5, 6, 8, 8, 9)
+	desc7 := OrderBy9[int, int, int, int, int, int, int, int, int](Asc, Asc, Asc, Asc, Asc, Asc, Desc, Asc, Asc)
+	require.Equal(t, -1, asc(lesser, greater7))
+	require.Equal(t, 1, desc7(lesser, greater7))
+	require.Equal(t, 0, desc7(lesser, lesser))
+
+	greater8 := New9(1, 2, 3, 4, 5, 6, 7, 9, 9)
+	desc8 := OrderBy9[int, int, int, int, int, int, int, int, int](Asc, Asc, Asc, Asc, Asc, Asc, Asc, Desc, Asc)
+	require.Equal(t, -1, asc(lesser, greater8))
+	require.Equal(t, 1, desc8(lesser, greater8))
+	require.Equal(t, 0, desc8(lesser, lesser))
+
+	greater9 := New9(1, 2, 3, 4, 5, 6, 7, 8, 10)
+	desc9 := OrderBy9[int, int, int, int, int, int, int, int, int](Asc, Asc, Asc, Asc, Asc, Asc, Asc, Asc, Desc)
+	require.Equal(t, -1, asc(lesser, greater9))
+	require.Equal(t, 1, desc9(lesser, greater9))
+	require.Equal(t, 0, desc9(lesser, lesser))
+}
+
+func TestT9_Comparator_OrderedPtrBy(t *testing.T) {
+	compare := Comparator9(
+		OrderedBy[int](Asc),
+		OrderedBy[int](Asc),
+		OrderedBy[int](Asc),
+		OrderedBy[int](Asc),
+		OrderedBy[int](Asc),
+		OrderedBy[int](Asc),
+		OrderedBy[int](Asc),
+		OrderedBy[int](Asc),
+		OrderedPtrBy[int](Desc.NilsFirst()),
+	)
+
+	tups := []T9[int, int, int, int, int, int, int, int, *int]{
+		New9(1, 2, 3, 4, 5, 6, 7, 8, ptr(1)),
+		New9(1, 2, 3, 4, 5, 6, 7, 8, ptr(2)),
+		New9(1, 2, 3, 4, 5, 6, 7, 8, (*int)(nil)),
+	}
+	slices.SortFunc(tups, compare)
+
+	require.Nil(t, tups[0].V9)
+	require.Equal(t, 2, *tups[1].V9)
+	require.Equal(t, 1, *tups[2].V9)
+}
+
 func TestT9_EqualE(t *testing.T) {
 	a := New9(intEqualable(1), intEqualable(2), intEqualable(3), intEqualable(4), intEqualable(5), intEqualable(6), intEqualable(7), intEqualable(8), intEqualable(9))
 	b := New9(intEqualable(2), intEqualable(3), intEqualable(4), intEqualable(5), intEqualable(6), intEqualable(7), intEqualable(8), intEqualable(9), intEqualable(10))
@@ -229,6 +622,46 @@ func TestT9_EqualE(t *testing.T) {
 	require.True(t, Equal9E(a, a))
 }
 
+func TestT9_EqualFunc(t *testing.T) {
+	a := New9([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8}, []int{9})
+	b := New9([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8}, []int{9})
+
+	require.True(t, EqualFunc9(a, b, slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc9(a, New9([]int{2}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8}, []int{9}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc9(a, New9([]int{1}, []int{3}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8}, []int{9}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc9(a, New9([]int{1}, []int{2}, []int{4}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8}, []int{9}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc9(a, New9([]int{1}, []int{2}, []int{3}, []int{5}, []int{5}, []int{6}, []int{7}, []int{8}, []int{9}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc9(a, New9([]int{1}, []int{2}, []int{3}, []int{4}, []int{6}, []int{6}, []int{7}, []int{8}, []int{9}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc9(a, New9([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{7}, []int{7}, []int{8}, []int{9}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc9(a, New9([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{8}, []int{8}, []int{9}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc9(a, New9([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{9}, []int{9}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+	require.False(t, EqualFunc9(a, New9([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8}, []int{10}), slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int], slices.Equal[[]int]))
+}
+
+func TestT9_EqualMethod(t *testing.T) {
+	a := New9([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8}, []int{9})
+
+	require.True(t, a.Equal(New9([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8}, []int{9})))
+	require.False(t, a.Equal(New9([]int{2}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8}, []int{9})))
+	require.False(t, a.Equal(New9([]int{1}, []int{3}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8}, []int{9})))
+	require.False(t, a.Equal(New9([]int{1}, []int{2}, []int{4}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8}, []int{9})))
+	require.False(t, a.Equal(New9([]int{1}, []int{2}, []int{3}, []int{5}, []int{5}, []int{6}, []int{7}, []int{8}, []int{9})))
+	require.False(t, a.Equal(New9([]int{1}, []int{2}, []int{3}, []int{4}, []int{6}, []int{6}, []int{7}, []int{8}, []int{9})))
+	require.False(t, a.Equal(New9([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{7}, []int{7}, []int{8}, []int{9})))
+	require.False(t, a.Equal(New9([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{8}, []int{8}, []int{9})))
+	require.False(t, a.Equal(New9([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{9}, []int{9})))
+	require.False(t, a.Equal(New9([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8}, []int{10})))
+}
+
+func TestT9_EqualMethod_Nested(t *testing.T) {
+	a := New9(New1(intEqualable(1)), New1(intEqualable(2)), New1(intEqualable(3)), New1(intEqualable(4)), New1(intEqualable(5)), New1(intEqualable(6)), New1(intEqualable(7)), New1(intEqualable(8)), New1(intEqualable(9)))
+	b := New9(New1(intEqualable(2)), New1(intEqualable(3)), New1(intEqualable(4)), New1(intEqualable(5)), New1(intEqualable(6)), New1(intEqualable(7)), New1(intEqualable(8)), New1(intEqualable(9)), New1(intEqualable(10)))
+
+	require.True(t, a.Equal(a))
+	require.False(t, a.Equal(b))
+	require.True(t, Equal9E(a, a))
+}
+
 func TestT9_String(t *testing.T) {
 	tup := New9("1", "2", "3", "4", "5", "6", "7", "8", "9")
 	require.Equal(t, `["1" "2" "3" "4" "5" "6" "7" "8" "9"]`, tup.String())
@@ -239,6 +672,31 @@ func TestT9_GoString(t *testing.T) {
 	require.Equal(t, `tuple.T9[string, string, string, string, string, string, string, string, string]{V1: "1", V2: "2", V3: "3", V4: "4", V5: "5", V6: "6", V7: "7", V8: "8", V9: "9"}`, tup.GoString())
 }
 
+func TestT9_Parse(t *testing.T) {
+	tup := New9("1", "2", "3", "4", "5", "6", "7", "8", "9")
+
+	got, err := Parse9[string, string, string, string, string, string, string, string, string](tup.String())
+	require.NoError(t, err)
+	require.Equal(t, tup, got)
+}
+
+func TestT9_Parse_Mixed(t *testing.T) {
+	tup := New9(New2("1", 1.5), New2("2", 2.5), New2("3", 3.5), New2("4", 4.5), New2("5", 5.5), New2("6", 6.5), New2("7", 7.5), New2("8", 8.5), New2("9", 9.5))
+
+	got, err := Parse9[T2[string, float64], T2[string, float64], T2[string, float64], T2[string, float64], T2[string, float64], T2[string, float64], T2[string, float64], T2[string, float64], T2[string, float64]](tup.String())
+	require.NoError(t, err)
+	require.Equal(t, tup, got)
+}
+
+func TestT9_Parse_Invalid(t *testing.T) {
+	_, err := Parse9[int, int, int, int, int, int, int, int, int](`["1" "2" "3" "4" "5" "6" "7" "8" "9"]`)
+	require.Error(t, err)
+
+	var parseErr *ParseError
+	require.ErrorAs(t, err, &parseErr)
+	require.Equal(t, 1, parseErr.Offset)
+}
+
 func TestT9_ToArray(t *testing.T) {
 	tup := New9("1", "2", "3", "4", "5", "6", "7", "8", "9")
 	require.Equal(t, [9]any{
@@ -633,153 +1091,1254 @@ func TestT9_FromSlice(t *testing.T) {
 	}
 }
 
-func TestT9_MarshalJSON(t *testing.T) {
-	tup := New9("1", "2", "3", "4", "5", "6", "7", "8", "9")
+func TestT9_FromSlice_Errors(t *testing.T) {
+	_, err := FromSlice9[string, string, string, string, string, string, string, string, string]([]any{})
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, &LengthMismatchError{Source: "slice", Expected: 9, Actual: 0}, lengthErr)
+	require.EqualError(t, err, "slice length 0 must match number of tuple values 9")
+
+	_, err = FromSlice9[string, string, string, string, string, string, string, string, string]([]any{1, "2", "3", "4", "5", "6", "7", "8", "9"})
+	var typeErr1 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr1)
+	require.Equal(t, 0, typeErr1.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr1.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr1.Actual)
+	require.EqualError(t, err, "value at slice index 0 expected to have type string but has type int")
+
+	_, err = FromSlice9[string, string, string, string, string, string, string, string, string]([]any{"1", 2, "3", "4", "5", "6", "7", "8", "9"})
+	var typeErr2 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr2)
+	require.Equal(t, 1, typeErr2.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr2.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr2.Actual)
+	require.EqualError(t, err, "value at slice index 1 expected to have type string but has type int")
+
+	_, err = FromSlice9[string, string, string, string, string, string, string, string, string]([]any{"1", "2", 3, "4", "5", "6", "7", "8", "9"})
+	var typeErr3 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr3)
+	require.Equal(t, 2, typeErr3.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr3.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr3.Actual)
+	require.EqualError(t, err, "value at slice index 2 expected to have type string but has type int")
+
+	_, err = FromSlice9[string, string, string, string, string, string, string, string, string]([]any{"1", "2", "3", 4, "5", "6", "7", "8", "9"})
+	var typeErr4 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr4)
+	require.Equal(t, 3, typeErr4.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr4.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr4.Actual)
+	require.EqualError(t, err, "value at slice index 3 expected to have type string but has type int")
+
+	_, err = FromSlice9[string, string, string, string, string, string, string, string, string]([]any{"1", "2", "3", "4", 5, "6", "7", "8", "9"})
+	var typeErr5 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr5)
+	require.Equal(t, 4, typeErr5.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr5.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr5.Actual)
+	require.EqualError(t, err, "value at slice index 4 expected to have type string but has type int")
+
+	_, err = FromSlice9[string, string, string, string, string, string, string, string, string]([]any{"1", "2", "3", "4", "5", 6, "7", "8", "9"})
+	var typeErr6 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr6)
+	require.Equal(t, 5, typeErr6.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr6.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr6.Actual)
+	require.EqualError(t, err, "value at slice index 5 expected to have type string but has type int")
+
+	_, err = FromSlice9[string, string, string, string, string, string, string, string, string]([]any{"1", "2", "3", "4", "5", "6", 7, "8", "9"})
+	var typeErr7 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr7)
+	require.Equal(t, 6, typeErr7.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr7.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr7.Actual)
+	require.EqualError(t, err, "value at slice index 6 expected to have type string but has type int")
+
+	_, err = FromSlice9[string, string, string, string, string, string, string, string, string]([]any{"1", "2", "3", "4", "5", "6", "7", 8, "9"})
+	var typeErr8 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr8)
+	require.Equal(t, 7, typeErr8.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr8.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr8.Actual)
+	require.EqualError(t, err, "value at slice index 7 expected to have type string but has type int")
+
+	_, err = FromSlice9[string, string, string, string, string, string, string, string, string]([]any{"1", "2", "3", "4", "5", "6", "7", "8", 9})
+	var typeErr9 *ElementTypeError
+	require.ErrorAs(t, err, &typeErr9)
+	require.Equal(t, 8, typeErr9.Index)
+	require.Equal(t, reflect.TypeOf(""), typeErr9.Expected)
+	require.Equal(t, reflect.TypeOf(0), typeErr9.Actual)
+	require.EqualError(t, err, "value at slice index 8 expected to have type string but has type int")
+
+	_, err = FromArray9[string, string, string, string, string, string, string, string, string]([9]any{})
+	var arrayTypeErr *ElementTypeError
+	require.ErrorAs(t, err, &arrayTypeErr)
+	require.Equal(t, &ElementTypeError{Source: "array", Index: 0, Expected: reflect.TypeOf("")}, arrayTypeErr)
+	require.EqualError(t, err, "value at array index 0 expected to have type string but has type <nil>")
+}
 
-	got, err := json.Marshal(tup)
-	require.NoError(t, err)
-	require.Equal(t, got, []byte(`["1","2","3","4","5","6","7","8","9"]`))
+func TestT9_UnmarshalJSON_Errors(t *testing.T) {
+	var tup T9[string, string, string, string, string, string, string, string, string]
+
+	err := json.Unmarshal([]byte(`[]`), &tup)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, 9, lengthErr.Expected)
+	require.Equal(t, 0, lengthErr.Actual)
+	require.EqualError(t, err, "unmarshalled json array length 0 must match number of tuple values 9")
+
+	err = tup.UnmarshalJSON([]byte(`["1","2","3","4","5","6","7","8",9]`))
+	var elementErr *ElementUnmarshalError
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, 8, elementErr.Index)
+	require.Equal(t, "9", elementErr.Value)
+	var typeErr *json.UnmarshalTypeError
+	require.ErrorAs(t, err, &typeErr)
+	require.ErrorContains(t, err, `value "9" at slice index 8 failed to unmarshal: `)
 }
 
-func TestT9_UnmarshalJSON(t *testing.T) {
+func TestT9_ConvertSlice(t *testing.T) {
 	tests := []struct {
 		name    string
-		data    []byte
-		want    T9[string, string, string, string, string, string, string, string, string]
+		slice   []any
+		want    T9[int, int, int, int, int, int, int, int, int]
 		wantErr bool
 	}{
 		{
-			name:    "nil data",
-			data:    nil,
+			name:  "all types match",
+			slice: []any{1, 2, 3, 4, 5, 6, 7, 8, 9},
+			want:  New9(1, 2, 3, 4, 5, 6, 7, 8, 9),
+		},
+		{
+			name:  "decoded json numbers",
+			slice: []any{float64(1), float64(2), float64(3), float64(4), float64(5), float64(6), float64(7), float64(8), float64(9)},
+			want:  New9(1, 2, 3, 4, 5, 6, 7, 8, 9),
+		},
+		{
+			name:  "json numbers",
+			slice: []any{json.Number("1"), json.Number("2"), json.Number("3"), json.Number("4"), json.Number("5"), json.Number("6"), json.Number("7"), json.Number("8"), json.Number("9")},
+			want:  New9(1, 2, 3, 4, 5, 6, 7, 8, 9),
+		},
+		{
+			name:    "slice empty",
+			slice:   []any{},
 			wantErr: true,
 		},
 		{
-			name:    "empty data",
-			data:    []byte{},
+			name:    "index 1 bad type",
+			slice:   []any{"1", 2, 3, 4, 5, 6, 7, 8, 9},
 			wantErr: true,
 		},
 		{
-			name:    "string data",
-			data:    []byte(`"hi"`),
+			name:    "index 1 fractional number",
+			slice:   []any{1.5, 2, 3, 4, 5, 6, 7, 8, 9},
 			wantErr: true,
 		},
 		{
-			name:    "empty json array",
-			data:    []byte(`[]`),
+			name:    "index 2 bad type",
+			slice:   []any{1, "2", 3, 4, 5, 6, 7, 8, 9},
 			wantErr: true,
 		},
 		{
-			name:    "longer json array",
-			data:    []byte(`["1", "2", "3", "4", "5", "6", "7", "8", "9", "10"]`),
+			name:    "index 2 fractional number",
+			slice:   []any{1, 2.5, 3, 4, 5, 6, 7, 8, 9},
 			wantErr: true,
 		},
-
 		{
-			name:    "json array with invalid type at index 0",
-			data:    []byte(`[1,"2","3","4","5","6","7","8","9"]`),
+			name:    "index 3 bad type",
+			slice:   []any{1, 2, "3", 4, 5, 6, 7, 8, 9},
 			wantErr: true,
 		},
 		{
-			name:    "json array with invalid type at index 1",
-			data:    []byte(`["1",2,"3","4","5","6","7","8","9"]`),
+			name:    "index 3 fractional number",
+			slice:   []any{1, 2, 3.5, 4, 5, 6, 7, 8, 9},
 			wantErr: true,
 		},
 		{
-			name:    "json array with invalid type at index 2",
-			data:    []byte(`["1","2",3,"4","5","6","7","8","9"]`),
+			name:    "index 4 bad type",
+			slice:   []any{1, 2, 3, "4", 5, 6, 7, 8, 9},
 			wantErr: true,
 		},
 		{
-			name:    "json array with invalid type at index 3",
-			data:    []byte(`["1","2","3",4,"5","6","7","8","9"]`),
+			name:    "index 4 fractional number",
+			slice:   []any{1, 2, 3, 4.5, 5, 6, 7, 8, 9},
 			wantErr: true,
 		},
 		{
-			name:    "json array with invalid type at index 4",
-			data:    []byte(`["1","2","3","4",5,"6","7","8","9"]`),
+			name:    "index 5 bad type",
+			slice:   []any{1, 2, 3, 4, "5", 6, 7, 8, 9},
 			wantErr: true,
 		},
 		{
-			name:    "json array with invalid type at index 5",
-			data:    []byte(`["1","2","3","4","5",6,"7","8","9"]`),
+			name:    "index 5 fractional number",
+			slice:   []any{1, 2, 3, 4, 5.5, 6, 7, 8, 9},
 			wantErr: true,
 		},
 		{
-			name:    "json array with invalid type at index 6",
-			data:    []byte(`["1","2","3","4","5","6",7,"8","9"]`),
+			name:    "index 6 bad type",
+			slice:   []any{1, 2, 3, 4, 5, "6", 7, 8, 9},
 			wantErr: true,
 		},
 		{
-			name:    "json array with invalid type at index 7",
-			data:    []byte(`["1","2","3","4","5","6","7",8,"9"]`),
+			name:    "index 6 fractional number",
+			slice:   []any{1, 2, 3, 4, 5, 6.5, 7, 8, 9},
 			wantErr: true,
 		},
 		{
-			name:    "json array with invalid type at index 8",
-			data:    []byte(`["1","2","3","4","5","6","7","8",9]`),
+			name:    "index 7 bad type",
+			slice:   []any{1, 2, 3, 4, 5, 6, "7", 8, 9},
 			wantErr: true,
 		},
 		{
-			name:    "json array of valid types",
-			data:    []byte(`["1","2","3","4","5","6","7","8","9"]`),
-			want:    New9("1", "2", "3", "4", "5", "6", "7", "8", "9"),
-			wantErr: false,
+			name:    "index 7 fractional number",
+			slice:   []any{1, 2, 3, 4, 5, 6, 7.5, 8, 9},
+			wantErr: true,
 		},
 		{
-			name:    "json object of valid types",
-			data:    []byte(`{"V1": "1","V2": "2","V3": "3","V4": "4","V5": "5","V6": "6","V7": "7","V8": "8","V9": "9"}`),
+			name:    "index 8 bad type",
+			slice:   []any{1, 2, 3, 4, 5, 6, 7, "8", 9},
+			wantErr: true,
+		},
+		{
+			name:    "index 8 fractional number",
+			slice:   []any{1, 2, 3, 4, 5, 6, 7, 8.5, 9},
+			wantErr: true,
+		},
+		{
+			name:    "index 9 bad type",
+			slice:   []any{1, 2, 3, 4, 5, 6, 7, 8, "9"},
+			wantErr: true,
+		},
+		{
+			name:    "index 9 fractional number",
+			slice:   []any{1, 2, 3, 4, 5, 6, 7, 8, 9.5},
 			wantErr: true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			var got T9[string, string, string, string, string, string, string, string, string]
-			err := json.Unmarshal(tt.data, &got)
+			tup, err := ConvertSlice9[int, int, int, int, int, int, int, int, int](tt.slice)
 			if tt.wantErr {
 				require.Error(t, err)
 				return
 			}
 
 			require.NoError(t, err)
-			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.want, tup)
 		})
 	}
 }
 
-func TestT9_Unmarshal_CustomStruct(t *testing.T) {
-	type Custom struct {
-		Name string `json:"name"`
-		Age  int    `json:"age"`
-	}
+func TestT9_ConvertSlice_Errors(t *testing.T) {
+	_, err := ConvertSlice9[int8, int8, int8, int8, int8, int8, int8, int8, int8]([]any{})
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+
+	_, err = ConvertSlice9[int8, int8, int8, int8, int8, int8, int8, int8, int8]([]any{1, 2, 3, 4, 5, 6, 7, 8, true})
+	var typeErr *ElementTypeError
+	require.ErrorAs(t, err, &typeErr)
+	require.Equal(t, 8, typeErr.Index)
+
+	_, err = ConvertSlice9[int8, int8, int8, int8, int8, int8, int8, int8, int8]([]any{1, 2, 3, 4, 5, 6, 7, 8, float64(128)})
+	var conversionErr *ElementConversionError
+	require.ErrorAs(t, err, &conversionErr)
+	require.Equal(t, 8, conversionErr.Index)
+	require.Equal(t, reflect.TypeOf(int8(0)), conversionErr.Expected)
+}
 
-	want := New9(Custom{Name: "1", Age: 1}, Custom{Name: "2", Age: 2}, Custom{Name: "3", Age: 3}, Custom{Name: "4", Age: 4}, Custom{Name: "5", Age: 5}, Custom{Name: "6", Age: 6}, Custom{Name: "7", Age: 7}, Custom{Name: "8", Age: 8}, Custom{Name: "9", Age: 9})
-	var got T9[Custom, Custom, Custom, Custom, Custom, Custom, Custom, Custom, Custom]
-	err := json.Unmarshal([]byte(`[
-		{ "name": "1", "age": 1 },
-		{ "name": "2", "age": 2 },
-		{ "name": "3", "age": 3 },
-		{ "name": "4", "age": 4 },
-		{ "name": "5", "age": 5 },
-		{ "name": "6", "age": 6 },
-		{ "name": "7", "age": 7 },
-		{ "name": "8", "age": 8 },
-		{ "name": "9", "age": 9 }
-	]`), &got)
+func TestT9_ScanRow(t *testing.T) {
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{"c1", "c2", "c3", "c4", "c5", "c6", "c7", "c8", "c9"},
+		rows: [][]driver.Value{
+			{int64(1), int64(2), int64(3), int64(4), int64(5), int64(6), int64(7), int64(8), int64(9)},
+		},
+	})
 
+	require.True(t, rows.Next())
+	got, err := ScanRow9[string, int, int, int, int, int, int, int, int](rows)
 	require.NoError(t, err)
-	require.Equal(t, want, got)
+	require.Equal(t, New9("1", 2, 3, 4, 5, 6, 7, 8, 9), got)
 }
 
-func TestT9_Marshal_Unmarshal(t *testing.T) {
-	tup := New9("1", "2", "3", "4", "5", "6", "7", "8", "9")
+func TestT9_ScanRow_ColumnsMismatch(t *testing.T) {
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{"c1", "c2", "c3", "c4", "c5", "c6", "c7", "c8", "c9", "extra"},
+		rows: [][]driver.Value{
+			{int64(1), int64(2), int64(3), int64(4), int64(5), int64(6), int64(7), int64(8), int64(9), int64(0)},
+		},
+	})
 
-	marshalled, err := json.Marshal(tup)
+	require.True(t, rows.Next())
+	_, err := ScanRow9[int, int, int, int, int, int, int, int, int](rows)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.EqualError(t, err, "row columns count 10 must match number of tuple values 9")
+}
+
+func TestT9_CollectRows(t *testing.T) {
+	rows := fakeQuery(t, fakeResult{
+		columns: []string{"c1", "c2", "c3", "c4", "c5", "c6", "c7", "c8", "c9"},
+		rows: [][]driver.Value{
+			{int64(1), int64(2), int64(3), int64(4), int64(5), int64(6), int64(7), int64(8), int64(9)},
+			{int64(2), int64(3), int64(4), int64(5), int64(6), int64(7), int64(8), int64(9), int64(10)},
+		},
+	})
+
+	got, err := CollectRows9[int, int, int, int, int, int, int, int, int](rows)
 	require.NoError(t, err)
+	require.Equal(t, []T9[int, int, int, int, int, int, int, int, int]{
+		New9(1, 2, 3, 4, 5, 6, 7, 8, 9),
+		New9(2, 3, 4, 5, 6, 7, 8, 9, 10),
+	}, got)
 
-	var unmarshalled T9[string, string, string, string, string, string, string, string, string]
-	err = json.Unmarshal(marshalled, &unmarshalled)
+	rows = fakeQuery(t, fakeResult{columns: []string{"c1", "c2", "c3", "c4", "c5", "c6", "c7", "c8", "c9"}})
+	got, err = CollectRows9[int, int, int, int, int, int, int, int, int](rows)
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
+
+func TestT9_ReadCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		opts    CSVOptions
+		want    []T9[int, int, int, int, int, int, int, int, int]
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			data: "",
+			want: nil,
+		},
+		{
+			name: "records",
+			data: "1,2,3,4,5,6,7,8,9\n2,3,4,5,6,7,8,9,10\n",
+			want: []T9[int, int, int, int, int, int, int, int, int]{
+				New9(1, 2, 3, 4, 5, 6, 7, 8, 9),
+				New9(2, 3, 4, 5, 6, 7, 8, 9, 10),
+			},
+		},
+		{
+			name: "header",
+			data: "v1,v2,v3,v4,v5,v6,v7,v8,v9\n1,2,3,4,5,6,7,8,9\n",
+			opts: CSVOptions{Header: []string{"v1", "v2", "v3", "v4", "v5", "v6", "v7", "v8", "v9"}},
+			want: []T9[int, int, int, int, int, int, int, int, int]{New9(1, 2, 3, 4, 5, 6, 7, 8, 9)},
+		},
+		{
+			name: "skip header",
+			data: "c1,c2,c3,c4,c5,c6,c7,c8,c9\n1,2,3,4,5,6,7,8,9\n",
+			opts: CSVOptions{SkipHeader: true},
+			want: []T9[int, int, int, int, int, int, int, int, int]{New9(1, 2, 3, 4, 5, 6, 7, 8, 9)},
+		},
+		{
+			name:    "mismatching header",
+			data:    "c1,c2,c3,c4,c5,c6,c7,c8,c9\n1,2,3,4,5,6,7,8,9\n",
+			opts:    CSVOptions{Header: []string{"v1", "v2", "v3", "v4", "v5", "v6", "v7", "v8", "v9"}},
+			wantErr: true,
+		},
+		{
+			name: "semicolon delimiter",
+			data: "1;2;3;4;5;6;7;8;9\n",
+			opts: CSVOptions{Comma: ';'},
+			want: []T9[int, int, int, int, int, int, int, int, int]{New9(1, 2, 3, 4, 5, 6, 7, 8, 9)},
+		},
+		{
+			name:    "too many fields",
+			data:    "1,2,3,4,5,6,7,8,9,0\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid field",
+			data:    "1,2,3,4,5,6,7,8,x\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadCSV9[int, int, int, int, int, int, int, int, int](strings.NewReader(tt.data), tt.opts)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
 
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT9_ReadCSV_Error(t *testing.T) {
+	data := "1,2,3,4,5,6,7,8,9\n1,2,3,4,5,6,7,8,x\n"
+
+	_, err := ReadCSV9[int, int, int, int, int, int, int, int, int](strings.NewReader(data), CSVOptions{})
+	var csvErr *CSVError
+	require.ErrorAs(t, err, &csvErr)
+	require.Equal(t, 2, csvErr.Line)
+	require.Equal(t, 9, csvErr.Column)
+
+	var elementErr *ElementUnmarshalError
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, "x", elementErr.Value)
+}
+
+func TestT9_WriteCSV(t *testing.T) {
+	tups := []T9[string, string, string, string, string, string, string, string, string]{
+		New9("1", "2", "3", "4", "5", "6", "7", "8", "9"),
+		New9("1,\"1\"", "2,\"2\"", "3,\"3\"", "4,\"4\"", "5,\"5\"", "6,\"6\"", "7,\"7\"", "8,\"8\"", "9,\"9\""),
+	}
+
+	var buf strings.Builder
+	err := WriteCSV9(&buf, tups, CSVOptions{Header: []string{"v1", "v2", "v3", "v4", "v5", "v6", "v7", "v8", "v9"}})
 	require.NoError(t, err)
-	require.Equal(t, tup, unmarshalled)
+	require.Equal(t, "v1,v2,v3,v4,v5,v6,v7,v8,v9\n1,2,3,4,5,6,7,8,9\n\"1,\"\"1\"\"\",\"2,\"\"2\"\"\",\"3,\"\"3\"\"\",\"4,\"\"4\"\"\",\"5,\"\"5\"\"\",\"6,\"\"6\"\"\",\"7,\"\"7\"\"\",\"8,\"\"8\"\"\",\"9,\"\"9\"\"\"\n", buf.String())
+
+	got, err := ReadCSV9[string, string, string, string, string, string, string, string, string](strings.NewReader(buf.String()), CSVOptions{SkipHeader: true})
+	require.NoError(t, err)
+	require.Equal(t, tups, got)
+}
+
+func TestT9_WriteCSV_Error(t *testing.T) {
+	tups := []T9[string, string, string, string, string, string, string, string, []int]{
+		{V1: "a\nb", V2: "a\nb", V3: "a\nb", V4: "a\nb", V5: "a\nb", V6: "a\nb", V7: "a\nb", V8: "a\nb", V9: []int{9}},
+	}
+
+	var buf strings.Builder
+	err := WriteCSV9(&buf, tups, CSVOptions{Header: []string{"v1", "v2", "v3", "v4", "v5", "v6", "v7", "v8", "v9"}})
+	var csvErr *CSVError
+	require.ErrorAs(t, err, &csvErr)
+	require.Equal(t, 2, csvErr.Line)
+	require.Equal(t, 9, csvErr.Column)
+
+	err = WriteCSV9(&buf, tups, CSVOptions{Header: []string{"v1", "v2", "v3", "v4", "v5", "v6", "v7", "v8", "v9", "extra"}})
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+}
+
+func TestT9_Hash(t *testing.T) {
+	seed := maphash.MakeSeed()
+	tup := New9("1", "2", "3", "4", "5", "6", "7", "8", "9")
+
+	require.Equal(t, tup.Hash(seed), New9("1", "2", "3", "4", "5", "6", "7", "8", "9").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New9("2", "2", "3", "4", "5", "6", "7", "8", "9").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New9("1", "3", "3", "4", "5", "6", "7", "8", "9").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New9("1", "2", "4", "4", "5", "6", "7", "8", "9").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New9("1", "2", "3", "5", "5", "6", "7", "8", "9").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New9("1", "2", "3", "4", "6", "6", "7", "8", "9").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New9("1", "2", "3", "4", "5", "7", "7", "8", "9").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New9("1", "2", "3", "4", "5", "6", "8", "8", "9").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New9("1", "2", "3", "4", "5", "6", "7", "9", "9").Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New9("1", "2", "3", "4", "5", "6", "7", "8", "10").Hash(seed))
+}
+
+func TestT9_Hash_Nested(t *testing.T) {
+	seed := maphash.MakeSeed()
+	tup := New9(New2("1", 1), New2("2", 2), New2("3", 3), New2("4", 4), New2("5", 5), New2("6", 6), New2("7", 7), New2("8", 8), New2("9", 9))
+
+	require.Equal(t, tup.Hash(seed), New9(New2("1", 1), New2("2", 2), New2("3", 3), New2("4", 4), New2("5", 5), New2("6", 6), New2("7", 7), New2("8", 8), New2("9", 9)).Hash(seed))
+	require.NotEqual(t, tup.Hash(seed), New9(New2("1", 2), New2("2", 3), New2("3", 4), New2("4", 5), New2("5", 6), New2("6", 7), New2("7", 8), New2("8", 9), New2("9", 10)).Hash(seed))
+}
+
+func TestT9_Hash_Unsupported(t *testing.T) {
+	seed := maphash.MakeSeed()
+	tup := New9([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8}, []int{9})
+	require.NotPanics(t, func() {
+		tup.Hash(seed)
+	})
+	require.Equal(t, tup.Hash(seed), New9([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8}, []int{9}).Hash(seed))
+
+	var nilTup T9[any, any, any, any, any, any, any, any, any]
+	require.NotPanics(t, func() {
+		nilTup.Hash(seed)
+	})
+	require.Panics(t, func() {
+		HashValue(seed, tup.V1)
+	})
+}
+
+func TestT9_HashFunc(t *testing.T) {
+	seed := maphash.MakeSeed()
+	hashSlice := func(seed maphash.Seed, v []int) uint64 {
+		return HashValue(seed, len(v))
+	}
+
+	hash := HashFunc9(hashSlice, hashSlice, hashSlice, hashSlice, hashSlice, hashSlice, hashSlice, hashSlice, hashSlice)
+	tup := New9([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8}, []int{9})
+
+	require.Equal(t, hash(seed, tup), hash(seed, New9([]int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8}, []int{9}, []int{10})))
+	require.NotEqual(t, hash(seed, tup), hash(seed, New9([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8}, []int{8, 9}, []int{9, 10})))
+}
+
+func TestT9_MarshalJSON(t *testing.T) {
+	tup := New9("1", "2", "3", "4", "5", "6", "7", "8", "9")
+
+	got, err := json.Marshal(tup)
+	require.NoError(t, err)
+	require.Equal(t, got, []byte(`["1","2","3","4","5","6","7","8","9"]`))
+}
+
+func TestT9_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		want    T9[string, string, string, string, string, string, string, string, string]
+		wantErr bool
+	}{
+		{
+			name:    "nil data",
+			data:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "empty data",
+			data:    []byte{},
+			wantErr: true,
+		},
+		{
+			name:    "string data",
+			data:    []byte(`"hi"`),
+			wantErr: true,
+		},
+		{
+			name:    "empty json array",
+			data:    []byte(`[]`),
+			wantErr: true,
+		},
+		{
+			name:    "longer json array",
+			data:    []byte(`["1", "2", "3", "4", "5", "6", "7", "8", "9", "10"]`),
+			wantErr: true,
+		},
+
+		{
+			name:    "json array with invalid type at index 0",
+			data:    []byte(`[1,"2","3","4","5","6","7","8","9"]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array with invalid type at index 1",
+			data:    []byte(`["1",2,"3","4","5","6","7","8","9"]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array with invalid type at index 2",
+			data:    []byte(`["1","2",3,"4","5","6","7","8","9"]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array with invalid type at index 3",
+			data:    []byte(`["1","2","3",4,"5","6","7","8","9"]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array with invalid type at index 4",
+			data:    []byte(`["1","2","3","4",5,"6","7","8","9"]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array with invalid type at index 5",
+			data:    []byte(`["1","2","3","4","5",6,"7","8","9"]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array with invalid type at index 6",
+			data:    []byte(`["1","2","3","4","5","6",7,"8","9"]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array with invalid type at index 7",
+			data:    []byte(`["1","2","3","4","5","6","7",8,"9"]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array with invalid type at index 8",
+			data:    []byte(`["1","2","3","4","5","6","7","8",9]`),
+			wantErr: true,
+		},
+		{
+			name:    "json array of valid types",
+			data:    []byte(`["1","2","3","4","5","6","7","8","9"]`),
+			want:    New9("1", "2", "3", "4", "5", "6", "7", "8", "9"),
+			wantErr: false,
+		},
+		{
+			name:    "json object of valid types",
+			data:    []byte(`{"V1": "1","V2": "2","V3": "3","V4": "4","V5": "5","V6": "6","V7": "7","V8": "8","V9": "9"}`),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T9[string, string, string, string, string, string, string, string, string]
+			err := json.Unmarshal(tt.data, &got)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT9_Unmarshal_CustomStruct(t *testing.T) {
+	type Custom struct {
+		Name string `json:"name"`
+		Age  int    `json:"age"`
+	}
+
+	want := New9(Custom{Name: "1", Age: 1}, Custom{Name: "2", Age: 2}, Custom{Name: "3", Age: 3}, Custom{Name: "4", Age: 4}, Custom{Name: "5", Age: 5}, Custom{Name: "6", Age: 6}, Custom{Name: "7", Age: 7}, Custom{Name: "8", Age: 8}, Custom{Name: "9", Age: 9})
+	var got T9[Custom, Custom, Custom, Custom, Custom, Custom, Custom, Custom, Custom]
+	err := json.Unmarshal([]byte(`[
+		{ "name": "1", "age": 1 },
+		{ "name": "2", "age": 2 },
+		{ "name": "3", "age": 3 },
+		{ "name": "4", "age": 4 },
+		{ "name": "5", "age": 5 },
+		{ "name": "6", "age": 6 },
+		{ "name": "7", "age": 7 },
+		{ "name": "8", "age": 8 },
+		{ "name": "9", "age": 9 }
+	]`), &got)
+
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+func TestT9_Marshal_Unmarshal(t *testing.T) {
+	tup := New9("1", "2", "3", "4", "5", "6", "7", "8", "9")
+
+	marshalled, err := json.Marshal(tup)
+	require.NoError(t, err)
+
+	var unmarshalled T9[string, string, string, string, string, string, string, string, string]
+	err = json.Unmarshal(marshalled, &unmarshalled)
+
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT9_UnmarshalJSONWith(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		opts    JSONDecodeOptions
+		want    T9[string, string, string, string, string, string, string, string, string]
+		wantErr bool
+	}{
+		{
+			name:    "empty json array",
+			data:    `[]`,
+			wantErr: true,
+		},
+		{
+			name: "allow shorter empty json array",
+			data: `[]`,
+			opts: JSONDecodeOptions{AllowShorter: true},
+			want: T9[string, string, string, string, string, string, string, string, string]{},
+		},
+		{
+			name: "allow shorter partial json array",
+			data: `["1"]`,
+			opts: JSONDecodeOptions{AllowShorter: true},
+			want: T9[string, string, string, string, string, string, string, string, string]{V1: "1"},
+		},
+		{
+			name:    "allow shorter longer json array",
+			data:    `["1","2","3","4","5","6","7","8","9","10"]`,
+			opts:    JSONDecodeOptions{AllowShorter: true},
+			wantErr: true,
+		},
+		{
+			name: "json array with null values",
+			data: `[null,null,null,null,null,null,null,null,null]`,
+			want: T9[string, string, string, string, string, string, string, string, string]{},
+		},
+		{
+			name:    "disallow null json array with null values",
+			data:    `[null,null,null,null,null,null,null,null,null]`,
+			opts:    JSONDecodeOptions{DisallowNull: true},
+			wantErr: true,
+		},
+		{
+			name: "disallow null json array of valid types",
+			data: `["1","2","3","4","5","6","7","8","9"]`,
+			opts: JSONDecodeOptions{DisallowNull: true},
+			want: New9("1", "2", "3", "4", "5", "6", "7", "8", "9"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T9[string, string, string, string, string, string, string, string, string]
+			err := got.UnmarshalJSONWith([]byte(tt.data), tt.opts)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT9_UnmarshalJSONWith_Nested(t *testing.T) {
+	type Custom struct {
+		Name string `json:"name"`
+	}
+
+	data := []byte(`[[1, {"name": "1", "age": 1}],[2, {"name": "2", "age": 2}],[3, {"name": "3", "age": 3}],[4, {"name": "4", "age": 4}],[5, {"name": "5", "age": 5}],[6, {"name": "6", "age": 6}],[7, {"name": "7", "age": 7}],[8, {"name": "8", "age": 8}],[9, {"name": "9", "age": 9}]]`)
+
+	var got T9[T2[any, Custom], T2[any, Custom], T2[any, Custom], T2[any, Custom], T2[any, Custom], T2[any, Custom], T2[any, Custom], T2[any, Custom], T2[any, Custom]]
+	err := got.UnmarshalJSONWith(data, JSONDecodeOptions{UseNumber: true})
+	require.NoError(t, err)
+	require.Equal(t, New9(New2[any](json.Number("1"), Custom{Name: "1"}), New2[any](json.Number("2"), Custom{Name: "2"}), New2[any](json.Number("3"), Custom{Name: "3"}), New2[any](json.Number("4"), Custom{Name: "4"}), New2[any](json.Number("5"), Custom{Name: "5"}), New2[any](json.Number("6"), Custom{Name: "6"}), New2[any](json.Number("7"), Custom{Name: "7"}), New2[any](json.Number("8"), Custom{Name: "8"}), New2[any](json.Number("9"), Custom{Name: "9"})), got)
+
+	err = got.UnmarshalJSONWith(data, JSONDecodeOptions{DisallowUnknownFields: true})
+	require.Error(t, err)
+}
+
+func TestT9_MarshalJSONLabeled(t *testing.T) {
+	tup := New9(1, 2, 3, 4, 5, 6, 7, 8, 9)
+	labels := WithLabels("v1", "v2", "v3", "v4", "v5", "v6", "v7", "v8", "v9")
+
+	got, err := tup.MarshalJSONLabeled(labels)
+	require.NoError(t, err)
+	require.Equal(t, `{"v1":1,"v2":2,"v3":3,"v4":4,"v5":5,"v6":6,"v7":7,"v8":8,"v9":9}`, string(got))
+
+	_, err = tup.MarshalJSONLabeled(WithLabels("v1", "v2", "v3", "v4", "v5", "v6", "v7", "v8", "v9", "extra"))
+	require.Error(t, err)
+}
+
+func TestT9_UnmarshalJSONLabeled(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		labels  Labels
+		want    T9[int, int, int, int, int, int, int, int, int]
+		wantErr bool
+	}{
+		{
+			name:   "all keys",
+			data:   `{"v1":1,"v2":2,"v3":3,"v4":4,"v5":5,"v6":6,"v7":7,"v8":8,"v9":9}`,
+			labels: WithLabels("v1", "v2", "v3", "v4", "v5", "v6", "v7", "v8", "v9"),
+			want:   New9(1, 2, 3, 4, 5, 6, 7, 8, 9),
+		},
+		{
+			name:   "missing keys",
+			data:   `{}`,
+			labels: WithLabels("v1", "v2", "v3", "v4", "v5", "v6", "v7", "v8", "v9"),
+			want:   T9[int, int, int, int, int, int, int, int, int]{},
+		},
+		{
+			name:    "strict missing keys",
+			data:    `{}`,
+			labels:  WithLabels("v1", "v2", "v3", "v4", "v5", "v6", "v7", "v8", "v9").Strict(),
+			wantErr: true,
+		},
+		{
+			name:   "unknown keys",
+			data:   `{"v1":1,"v2":2,"v3":3,"v4":4,"v5":5,"v6":6,"v7":7,"v8":8,"v9":9,"extra":0}`,
+			labels: WithLabels("v1", "v2", "v3", "v4", "v5", "v6", "v7", "v8", "v9"),
+			want:   New9(1, 2, 3, 4, 5, 6, 7, 8, 9),
+		},
+		{
+			name:    "strict unknown keys",
+			data:    `{"v1":1,"v2":2,"v3":3,"v4":4,"v5":5,"v6":6,"v7":7,"v8":8,"v9":9,"extra":0}`,
+			labels:  WithLabels("v1", "v2", "v3", "v4", "v5", "v6", "v7", "v8", "v9").Strict(),
+			wantErr: true,
+		},
+		{
+			name:    "json array",
+			data:    `[1,2,3,4,5,6,7,8,9]`,
+			labels:  WithLabels("v1", "v2", "v3", "v4", "v5", "v6", "v7", "v8", "v9"),
+			wantErr: true,
+		},
+		{
+			name:    "labels count mismatch",
+			data:    `{}`,
+			labels:  WithLabels("v1", "v2", "v3", "v4", "v5", "v6", "v7", "v8", "v9", "extra"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T9[int, int, int, int, int, int, int, int, int]
+			err := got.UnmarshalJSONLabeled([]byte(tt.data), tt.labels)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT9_MarshalText(t *testing.T) {
+	tup := New9("1", "2", "3", "4", "5", "6", "7", "8", "9")
+
+	got, err := tup.MarshalText()
+	require.NoError(t, err)
+	require.Equal(t, `1,2,3,4,5,6,7,8,9`, string(got))
+}
+
+func TestT9_MarshalText_Unsupported(t *testing.T) {
+	tup := New9([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8}, []int{9})
+
+	_, err := tup.MarshalText()
+	require.Error(t, err)
+}
+
+func TestT9_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    T9[string, string, string, string, string, string, string, string, string]
+		wantErr bool
+	}{
+		{
+			name: "valid values",
+			data: `1,2,3,4,5,6,7,8,9`,
+			want: New9("1", "2", "3", "4", "5", "6", "7", "8", "9"),
+		},
+		{
+			name: "escaped values",
+			data: `a\,1\\,a\,2\\,a\,3\\,a\,4\\,a\,5\\,a\,6\\,a\,7\\,a\,8\\,a\,9\\`,
+			want: New9(`a,1\`, `a,2\`, `a,3\`, `a,4\`, `a,5\`, `a,6\`, `a,7\`, `a,8\`, `a,9\`),
+		},
+		{
+			name:    "too few values",
+			data:    `2,3,4,5,6,7,8,9`,
+			wantErr: true,
+		},
+		{
+			name:    "too many values",
+			data:    `1,2,3,4,5,6,7,8,9,extra`,
+			wantErr: true,
+		},
+		{
+			name:    "dangling escape",
+			data:    `1,2,3,4,5,6,7,8,9\`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T9[string, string, string, string, string, string, string, string, string]
+			err := got.UnmarshalText([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT9_MarshalText_UnmarshalText(t *testing.T) {
+	tup := New9(New2("a,1\\\\", 1), New2("a,2\\\\", 2), New2("a,3\\\\", 3), New2("a,4\\\\", 4), New2("a,5\\\\", 5), New2("a,6\\\\", 6), New2("a,7\\\\", 7), New2("a,8\\\\", 8), New2("a,9\\\\", 9))
+
+	marshalled, err := tup.MarshalText()
+	require.NoError(t, err)
+
+	var unmarshalled T9[T2[string, int], T2[string, int], T2[string, int], T2[string, int], T2[string, int], T2[string, int], T2[string, int], T2[string, int], T2[string, int]]
+	err = unmarshalled.UnmarshalText(marshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT9_GobEncode_GobDecode(t *testing.T) {
+	t.Run("strings", func(t *testing.T) {
+		requireGobRoundTrip(t, New9("1", "2", "3", "4", "5", "6", "7", "8", "9"))
+	})
+
+	t.Run("zero values", func(t *testing.T) {
+		requireGobRoundTrip(t, T9[int, int, int, int, int, int, int, int, int]{})
+	})
+
+	t.Run("nested tuples", func(t *testing.T) {
+		requireGobRoundTrip(t, New9(New2("1", 1), New2("2", 2), New2("3", 3), New2("4", 4), New2("5", 5), New2("6", 6), New2("7", 7), New2("8", 8), New2("9", 9)))
+	})
+
+	t.Run("pointers", func(t *testing.T) {
+		requireGobRoundTrip(t, New9(ptr(1), ptr(2), ptr(3), ptr(4), ptr(5), ptr(6), ptr(7), ptr(8), ptr(9)))
+	})
+
+	t.Run("pointers to zero values", func(t *testing.T) {
+		requireGobRoundTrip(t, New9(ptr(0), ptr(0), ptr(0), ptr(0), ptr(0), ptr(0), ptr(0), ptr(0), ptr(0)))
+	})
+
+	t.Run("nil pointers", func(t *testing.T) {
+		requireGobRoundTrip(t, T9[*int, *int, *int, *int, *int, *int, *int, *int, *int]{})
+	})
+
+	t.Run("nested tuple pointers", func(t *testing.T) {
+		requireGobRoundTrip(t, New9((*T2[string, *int])(nil), &T2[string, *int]{V1: "2", V2: ptr(2)}, &T2[string, *int]{V1: "3", V2: ptr(3)}, &T2[string, *int]{V1: "4", V2: ptr(4)}, &T2[string, *int]{V1: "5", V2: ptr(5)}, &T2[string, *int]{V1: "6", V2: ptr(6)}, &T2[string, *int]{V1: "7", V2: ptr(7)}, &T2[string, *int]{V1: "8", V2: ptr(8)}, &T2[string, *int]{V1: "9", V2: ptr(9)}))
+	})
+
+	t.Run("interface values", func(t *testing.T) {
+		requireGobRoundTrip(t, T9[any, any, any, any, any, any, any, any, any]{V1: nil, V2: "2", V3: 3, V4: 4, V5: 5, V6: 6, V7: 7, V8: 8, V9: 9})
+	})
+
+	t.Run("struct field", func(t *testing.T) {
+		type Custom struct {
+			Tup T9[int, int, int, int, int, int, int, int, int]
+		}
+
+		requireGobRoundTrip(t, Custom{Tup: New9(1, 2, 3, 4, 5, 6, 7, 8, 9)})
+	})
+}
+
+func TestT9_GobDecode_Invalid(t *testing.T) {
+	encoded, err := New1("1").GobEncode()
+	require.NoError(t, err)
+
+	var tup T9[string, string, string, string, string, string, string, string, string]
+	err = tup.GobDecode(encoded)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, 9, lengthErr.Expected)
+
+	encoded, err = New9("1", "2", "3", "4", "5", "6", "7", "8", "9").GobEncode()
+	require.NoError(t, err)
+
+	var mismatched T9[int, int, int, int, int, int, int, int, int]
+	require.Error(t, mismatched.GobDecode(encoded))
+	require.Error(t, tup.GobDecode(nil))
+}
+
+func TestT9_MarshalComposite(t *testing.T) {
+	got, err := New9("foo bar", 2, 3, 4, 5, 6, 7, 8, 9).MarshalComposite()
+	require.NoError(t, err)
+	require.Equal(t, `("foo bar",2,3,4,5,6,7,8,9)`, string(got))
+
+	got, err = T9[*int, *int, *int, *int, *int, *int, *int, *int, *int]{}.MarshalComposite()
+	require.NoError(t, err)
+	require.Equal(t, `(,,,,,,,,)`, string(got))
+
+	got, err = New9(New2("1", 1), New2("2", 2), New2("3", 3), New2("4", 4), New2("5", 5), New2("6", 6), New2("7", 7), New2("8", 8), New2("9", 9)).MarshalComposite()
+	require.NoError(t, err)
+	require.Equal(t, `("(1,1)","(2,2)","(3,3)","(4,4)","(5,5)","(6,6)","(7,7)","(8,8)","(9,9)")`, string(got))
+}
+
+func TestT9_UnmarshalComposite(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    T9[string, string, string, string, string, string, string, string, string]
+		wantErr bool
+	}{
+		{
+			name: "unquoted values",
+			data: `(1,2,3,4,5,6,7,8,9)`,
+			want: New9("1", "2", "3", "4", "5", "6", "7", "8", "9"),
+		},
+		{
+			name: "quoted values",
+			data: `("1, \\""","2, \\""","3, \\""","4, \\""","5, \\""","6, \\""","7, \\""","8, \\""","9, \\""")`,
+			want: New9("1, \\\"", "2, \\\"", "3, \\\"", "4, \\\"", "5, \\\"", "6, \\\"", "7, \\\"", "8, \\\"", "9, \\\""),
+		},
+		{
+			name: "empty values",
+			data: `("","","","","","","","","")`,
+			want: T9[string, string, string, string, string, string, string, string, string]{},
+		},
+		{
+			name:    "null values",
+			data:    `(,,,,,,,,)`,
+			wantErr: true,
+		},
+		{
+			name:    "too many fields",
+			data:    `(1,2,3,4,5,6,7,8,9,0)`,
+			wantErr: true,
+		},
+		{
+			name:    "missing parentheses",
+			data:    `1,2,3,4,5,6,7,8,9`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T9[string, string, string, string, string, string, string, string, string]
+			err := got.UnmarshalComposite([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT9_MarshalComposite_UnmarshalComposite(t *testing.T) {
+	tup := New9((*T2[string, *int])(nil), &T2[string, *int]{V1: "(2, \"2\\\")", V2: ptr(2)}, &T2[string, *int]{V1: "(3, \"3\\\")", V2: ptr(3)}, &T2[string, *int]{V1: "(4, \"4\\\")", V2: ptr(4)}, &T2[string, *int]{V1: "(5, \"5\\\")", V2: ptr(5)}, &T2[string, *int]{V1: "(6, \"6\\\")", V2: ptr(6)}, &T2[string, *int]{V1: "(7, \"7\\\")", V2: ptr(7)}, &T2[string, *int]{V1: "(8, \"8\\\")", V2: ptr(8)}, &T2[string, *int]{V1: "(9, \"9\\\")", V2: ptr(9)})
+
+	marshalled, err := tup.MarshalComposite()
+	require.NoError(t, err)
+
+	var unmarshalled T9[*T2[string, *int], *T2[string, *int], *T2[string, *int], *T2[string, *int], *T2[string, *int], *T2[string, *int], *T2[string, *int], *T2[string, *int], *T2[string, *int]]
+	err = unmarshalled.UnmarshalComposite(marshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT9_MarshalXML(t *testing.T) {
+	got, err := xml.Marshal(New9(1, 2, 3, 4, 5, 6, 7, 8, 9))
+	require.NoError(t, err)
+	require.Equal(t, `<T9><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6><v7>7</v7><v8>8</v8><v9>9</v9></T9>`, string(got))
+
+	type Custom struct {
+		XMLName xml.Name                                                                   `xml:"custom"`
+		Tup     T9[string, string, string, string, string, string, string, string, string] `xml:"tup"`
+	}
+
+	got, err = xml.Marshal(Custom{Tup: New9("<1>", "<2>", "<3>", "<4>", "<5>", "<6>", "<7>", "<8>", "<9>")})
+	require.NoError(t, err)
+	require.Equal(t, `<custom><tup><v1>&lt;1&gt;</v1><v2>&lt;2&gt;</v2><v3>&lt;3&gt;</v3><v4>&lt;4&gt;</v4><v5>&lt;5&gt;</v5><v6>&lt;6&gt;</v6><v7>&lt;7&gt;</v7><v8>&lt;8&gt;</v8><v9>&lt;9&gt;</v9></tup></custom>`, string(got))
+}
+
+func TestT9_UnmarshalXML(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    T9[int, int, int, int, int, int, int, int, int]
+		wantErr bool
+	}{
+		{
+			name: "all elements",
+			data: `<T9><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6><v7>7</v7><v8>8</v8><v9>9</v9></T9>`,
+			want: New9(1, 2, 3, 4, 5, 6, 7, 8, 9),
+		},
+		{
+			name: "whitespace and comments",
+			data: `<T9>
+				<!-- v1 --> <v1> 1 </v1>
+				<!-- v2 --> <v2> 2 </v2>
+				<!-- v3 --> <v3> 3 </v3>
+				<!-- v4 --> <v4> 4 </v4>
+				<!-- v5 --> <v5> 5 </v5>
+				<!-- v6 --> <v6> 6 </v6>
+				<!-- v7 --> <v7> 7 </v7>
+				<!-- v8 --> <v8> 8 </v8>
+				<!-- v9 --> <v9> 9 </v9>
+			</T9>`,
+			want: New9(1, 2, 3, 4, 5, 6, 7, 8, 9),
+		},
+		{
+			name:    "no elements",
+			data:    `<T9></T9>`,
+			wantErr: true,
+		},
+		{
+			name:    "too many elements",
+			data:    `<T9><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6><v7>7</v7><v8>8</v8><v9>9</v9><v0>0</v0></T9>`,
+			wantErr: true,
+		},
+		{
+			name:    "wrong element names",
+			data:    `<T9><V1>1</V1><V2>2</V2><V3>3</V3><V4>4</V4><V5>5</V5><V6>6</V6><V7>7</V7><V8>8</V8><V9>9</V9></T9>`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid value",
+			data:    `<T9><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6><v7>7</v7><v8>8</v8><v9>x</v9></T9>`,
+			wantErr: true,
+		},
+		{
+			name:    "unterminated",
+			data:    `<T9><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6><v7>7</v7><v8>8</v8><v9>9</v9>`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got T9[int, int, int, int, int, int, int, int, int]
+			err := xml.Unmarshal([]byte(tt.data), &got)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestT9_UnmarshalXML_Errors(t *testing.T) {
+	var tup T9[int, int, int, int, int, int, int, int, int]
+
+	err := xml.Unmarshal([]byte(`<T9><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6><v7>7</v7><v8>8</v8></T9>`), &tup)
+	var lengthErr *LengthMismatchError
+	require.ErrorAs(t, err, &lengthErr)
+	require.EqualError(t, err, "unmarshalled xml elements count 8 must match number of tuple values 9")
+
+	err = xml.Unmarshal([]byte(`<T9><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6><v7>7</v7><v8>8</v8><v9>9</v9><v0>0</v0><v0>0</v0></T9>`), &tup)
+	require.ErrorAs(t, err, &lengthErr)
+	require.Equal(t, 11, lengthErr.Actual)
+
+	err = xml.Unmarshal([]byte(`<T9><v1>1</v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6><v7>7</v7><v8>8</v8><v9>x</v9></T9>`), &tup)
+	var elementErr *ElementUnmarshalError
+	require.ErrorAs(t, err, &elementErr)
+	require.Equal(t, 8, elementErr.Index)
+	require.Equal(t, "v9", elementErr.Label)
+}
+
+func TestT9_MarshalXML_UnmarshalXML_NilPointers(t *testing.T) {
+	tup := New9((*int)(nil), ptr(2), ptr(3), ptr(4), ptr(5), ptr(6), ptr(7), ptr(8), ptr(9))
+
+	got, err := xml.Marshal(tup)
+	require.NoError(t, err)
+	require.Equal(t, `<T9><v1 nil="true"></v1><v2>2</v2><v3>3</v3><v4>4</v4><v5>5</v5><v6>6</v6><v7>7</v7><v8>8</v8><v9>9</v9></T9>`, string(got))
+
+	unmarshalled := New9(ptr(0), ptr(0), ptr(0), ptr(0), ptr(0), ptr(0), ptr(0), ptr(0), ptr(0))
+	err = xml.Unmarshal(got, &unmarshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT9_MarshalXML_UnmarshalXML_Slices(t *testing.T) {
+	tup := New9([]int{1, 2}, []int{2, 3}, []int{3, 4}, []int{4, 5}, []int{5, 6}, []int{6, 7}, []int{7, 8}, []int{8, 9}, []int{9, 10})
+
+	got, err := xml.Marshal(tup)
+	require.NoError(t, err)
+	require.Equal(t, `<T9><v1><item>1</item><item>2</item></v1><v2><item>2</item><item>3</item></v2><v3><item>3</item><item>4</item></v3><v4><item>4</item><item>5</item></v4><v5><item>5</item><item>6</item></v5><v6><item>6</item><item>7</item></v6><v7><item>7</item><item>8</item></v7><v8><item>8</item><item>9</item></v8><v9><item>9</item><item>10</item></v9></T9>`, string(got))
+
+	var unmarshalled T9[[]int, []int, []int, []int, []int, []int, []int, []int, []int]
+	err = xml.Unmarshal(got, &unmarshalled)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+}
+
+func TestT9_MarshalXMLLabeled_UnmarshalXMLLabeled(t *testing.T) {
+	tup := New9(New2("1", ptr(1)), New2("2", ptr(2)), New2("3", ptr(3)), New2("4", ptr(4)), New2("5", ptr(5)), New2("6", ptr(6)), New2("7", ptr(7)), New2("8", ptr(8)), New2("9", ptr(9)))
+	labels := WithLabels("item1", "item2", "item3", "item4", "item5", "item6", "item7", "item8", "item9")
+
+	var buf strings.Builder
+	encoder := xml.NewEncoder(&buf)
+	err := tup.MarshalXMLLabeled(encoder, xml.StartElement{Name: xml.Name{Local: "items"}}, labels)
+	require.NoError(t, err)
+	require.NoError(t, encoder.Flush())
+	require.Equal(t, `<items><item1><v1>1</v1><v2>1</v2></item1><item2><v1>2</v1><v2>2</v2></item2><item3><v1>3</v1><v2>3</v2></item3><item4><v1>4</v1><v2>4</v2></item4><item5><v1>5</v1><v2>5</v2></item5><item6><v1>6</v1><v2>6</v2></item6><item7><v1>7</v1><v2>7</v2></item7><item8><v1>8</v1><v2>8</v2></item8><item9><v1>9</v1><v2>9</v2></item9></items>`, buf.String())
+
+	decoder := xml.NewDecoder(strings.NewReader(buf.String()))
+	start, err := decoder.Token()
+	require.NoError(t, err)
+
+	var unmarshalled T9[T2[string, *int], T2[string, *int], T2[string, *int], T2[string, *int], T2[string, *int], T2[string, *int], T2[string, *int], T2[string, *int], T2[string, *int]]
+	err = unmarshalled.UnmarshalXMLLabeled(decoder, start.(xml.StartElement), labels)
+	require.NoError(t, err)
+	require.Equal(t, tup, unmarshalled)
+
+	err = tup.MarshalXMLLabeled(encoder, xml.StartElement{Name: xml.Name{Local: "items"}}, WithLabels("item1", "item2", "item3", "item4", "item5", "item6", "item7", "item8", "item9", "extra"))
+	require.Error(t, err)
+}
+
+func TestT9_MarshalBinary(t *testing.T) {
+	tup := New9(uint16(1), uint16(2), uint16(3), uint16(4), uint16(5), uint16(6), uint16(7), uint16(8), uint16(9))
+
+	got, err := tup.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 0, 2, 0, 3, 0, 4, 0, 5, 0, 6, 0, 7, 0, 8, 0, 9, 0}, got)
+
+	got, err = tup.AppendBinaryOrder([]byte{0xff}, binary.BigEndian)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0xff, 0, 1, 0, 2, 0, 3, 0, 4, 0, 5, 0, 6, 0, 7, 0, 8, 0, 9}, got)
+
+	_, err = New9(1, 2, 3, 4, 5, 6, 7, 8, []int{9}).MarshalBinary()
+	require.Error(t, err)
+}
+
+func TestT9_MarshalBinary_UnmarshalBinary(t *testing.T) {
+	tup := New9(
+		int64(-1),
+		"foo2",
+		true,
+		[]byte("bar4"),
+		New2(float32(5.5), "baz"),
+		float64(6.5),
+		float64(7.5),
+		float64(8.5),
+		float64(9.5),
+	)
+
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		marshalled, err := tup.AppendBinaryOrder(nil, order.(binary.AppendByteOrder))
+		require.NoError(t, err)
+
+		var unmarshalled T9[int64, string, bool, []byte, T2[float32, string], float64, float64, float64, float64]
+		err = unmarshalled.UnmarshalBinaryOrder(marshalled, order)
+		require.NoError(t, err)
+		require.Equal(t, tup, unmarshalled)
+	}
+}
+
+func TestT9_UnmarshalBinary_Invalid(t *testing.T) {
+	marshalled, err := New9(uint16(1), uint16(2), uint16(3), uint16(4), uint16(5), uint16(6), uint16(7), uint16(8), uint16(9)).MarshalBinary()
+	require.NoError(t, err)
+
+	var tup T9[uint16, uint16, uint16, uint16, uint16, uint16, uint16, uint16, uint16]
+	err = tup.UnmarshalBinary(marshalled[:len(marshalled)-1])
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	require.EqualError(t, err, "value at index 8 failed to unmarshal: unexpected EOF")
+
+	err = tup.UnmarshalBinary(append(marshalled, 0))
+	require.EqualError(t, err, "binary data has 1 trailing bytes")
+}
+
+func TestT9_BinarySlice(t *testing.T) {
+	tups := []T9[int, int, int, int, int, int, int, int, int]{
+		New9(1, 2, 3, 4, 5, 6, 7, 8, 9),
+		New9(-1, -2, -3, -4, -5, -6, -7, -8, -9),
+	}
+
+	marshalled, err := AppendBinarySlice9(nil, binary.LittleEndian, tups)
+	require.NoError(t, err)
+	require.Len(t, marshalled, 1+2*9*8)
+
+	got, err := UnmarshalBinarySlice9[int, int, int, int, int, int, int, int, int](marshalled, binary.LittleEndian, nil)
+	require.NoError(t, err)
+	require.Equal(t, tups, got)
+
+	got, err = UnmarshalBinarySlice9(marshalled, binary.LittleEndian, got)
+	require.NoError(t, err)
+	require.Equal(t, append(tups, tups...), got)
+
+	empty, err := AppendBinarySlice9[int, int, int, int, int, int, int, int, int](nil, binary.LittleEndian, nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0}, empty)
+
+	_, err = AppendBinarySlice9(nil, binary.LittleEndian, []T9[[]int, []int, []int, []int, []int, []int, []int, []int, []int]{{}})
+	require.Error(t, err)
+}
+
+func TestT9_MarshalJSON_MapKey(t *testing.T) {
+	m := map[T9[string, string, string, string, string, string, string, string, string]]int{
+		New9("1", "2", "3", "4", "5", "6", "7", "8", "9"): 1,
+	}
+
+	marshalled, err := json.Marshal(m)
+	require.NoError(t, err)
+	require.Equal(t, `{"1,2,3,4,5,6,7,8,9":1}`, string(marshalled))
+
+	var unmarshalled map[T9[string, string, string, string, string, string, string, string, string]]int
+	err = json.Unmarshal(marshalled, &unmarshalled)
+	require.NoError(t, err)
+	require.Equal(t, m, unmarshalled)
 }
@@ -6,11 +6,15 @@ import (
 	"strings"
 )
 
+// typeOf returns the reflection type of the type parameter.
+func typeOf[T any]() reflect.Type {
+	var val T
+	return reflect.TypeOf(&val).Elem()
+}
+
 // typeName returns the name of the type parameters.
 func typeName[T any]() string {
-	var val T
-	ty := reflect.TypeOf(&val).Elem()
-	return ty.String()
+	return typeOf[T]().String()
 }
 
 // tupString returns a string representation of the tuple values.
@@ -25,6 +29,11 @@ func tupString(values []any) string {
 
 // tupGoString returns a Go-syntax representation of a tuple holding the given values.
 func tupGoString(values []any) string {
+	return namedTupGoString(fmt.Sprintf("T%d", len(values)), values)
+}
+
+// namedTupGoString returns a Go-syntax representation of a tuple-like type with the given name holding the given values.
+func namedTupGoString(name string, values []any) string {
 	types := make([]string, len(values))
 	for i, val := range values {
 		types[i] = fmt.Sprintf("%T", val)
@@ -35,9 +44,25 @@ func tupGoString(values []any) string {
 		fields[i] = fmt.Sprintf("V%d: %#v", i+1, val)
 	}
 
-	return fmt.Sprintf("tuple.T%d[%s]{%s}",
-		len(values),
+	return fmt.Sprintf("tuple.%s[%s]{%s}",
+		name,
 		strings.Join(types, ", "),
 		strings.Join(fields, ", "),
 	)
 }
+
+// minLen returns the minimal length out of the given lengths.
+func minLen(lengths ...int) int {
+	if len(lengths) == 0 {
+		return 0
+	}
+
+	shortest := lengths[0]
+	for _, length := range lengths[1:] {
+		if length < shortest {
+			shortest = length
+		}
+	}
+
+	return shortest
+}
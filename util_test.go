@@ -37,3 +37,9 @@ func Test_typeName_channel(t *testing.T) {
 	type dummy struct{}
 	require.Equal(t, "chan tuple.dummy", typeName[chan dummy]())
 }
+
+func Test_minLen(t *testing.T) {
+	require.Equal(t, 0, minLen())
+	require.Equal(t, 3, minLen(3))
+	require.Equal(t, 1, minLen(3, 1, 2))
+}
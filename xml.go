@@ -0,0 +1,175 @@
+package tuple
+
+import (
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// xmlNilAttr is the attribute marking the xml elements of nil tuple values, such as <v1 nil="true"></v1>.
+// Without it, encoding/xml omits the elements of nil values altogether.
+var xmlNilAttr = xml.Attr{Name: xml.Name{Local: "nil"}, Value: "true"}
+
+// indexLabels returns labels named v1 to vN, used as the default xml element names of a tuple of length n.
+func indexLabels(n int) Labels {
+	names := make([]string, n)
+	for i := range names {
+		names[i] = "v" + strconv.Itoa(i+1)
+	}
+
+	return WithLabels(names...)
+}
+
+// marshalXML marshals the values into a sequence of xml child elements of start, named by the labels in the same order.
+func marshalXML(e *xml.Encoder, start xml.StartElement, labels Labels, values []any) error {
+	if err := labels.validate(len(values)); err != nil {
+		return err
+	}
+
+	// Top-level tuples are named after their generic type, such as T2[int,string], which is not a valid xml name.
+	if i := strings.IndexByte(start.Name.Local, '['); i >= 0 {
+		start.Name.Local = start.Name.Local[:i]
+	}
+
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	for index, value := range values {
+		element := xml.StartElement{Name: xml.Name{Local: labels.names[index]}}
+		if value == nil || isNilValue(reflect.ValueOf(value)) {
+			element.Attr = []xml.Attr{xmlNilAttr}
+			if err := e.EncodeToken(element); err != nil {
+				return err
+			}
+			if err := e.EncodeToken(element.End()); err != nil {
+				return err
+			}
+			continue
+		}
+
+		value, err := xmlElementValue(reflect.ValueOf(value))
+		if err != nil {
+			return fmt.Errorf("value at index %d failed to marshal: %w", index, err)
+		}
+		if err := e.EncodeElement(value, element); err != nil {
+			return fmt.Errorf("value at index %d failed to marshal: %w", index, err)
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+// unmarshalXML unmarshals the xml child elements of start into the values pointed by ptrs in the same order.
+// The child elements must be named by the labels in the same order, and their number must match the number of values.
+// Child elements marked as nil set the matching values to their zero values.
+func unmarshalXML(d *xml.Decoder, start xml.StartElement, labels Labels, ptrs []any) error {
+	if err := labels.validate(len(ptrs)); err != nil {
+		return err
+	}
+
+	count := 0
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return fmt.Errorf("unable to unmarshal xml for tuple: %w", err)
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			if count >= len(ptrs) {
+				// Keep counting the elements in order to report the actual number of elements.
+				if err := d.Skip(); err != nil {
+					return fmt.Errorf("unable to unmarshal xml for tuple: %w", err)
+				}
+				count++
+				continue
+			}
+
+			name := labels.names[count]
+			if token.Name.Local != name {
+				return fmt.Errorf("xml element %q at index %d must be named %q", token.Name.Local, count, name)
+			}
+
+			if isXMLNil(token) {
+				value := reflect.ValueOf(ptrs[count]).Elem()
+				value.Set(reflect.Zero(value.Type()))
+				if err := d.Skip(); err != nil {
+					return fmt.Errorf("unable to unmarshal xml for tuple: %w", err)
+				}
+				count++
+				continue
+			}
+
+			if err := decodeXMLElement(d, &token, reflect.ValueOf(ptrs[count]).Elem()); err != nil {
+				return &ElementUnmarshalError{Index: count, Label: name, Err: err, location: locationXMLElement}
+			}
+			count++
+		case xml.EndElement:
+			if count != len(ptrs) {
+				return &LengthMismatchError{Source: "unmarshalled xml elements", Expected: len(ptrs), Actual: count, count: true}
+			}
+			return nil
+		}
+	}
+}
+
+// isXMLNil returns whether the xml element is marked as nil.
+func isXMLNil(element xml.StartElement) bool {
+	for _, attr := range element.Attr {
+		if attr.Name == xmlNilAttr.Name {
+			return attr.Value == xmlNilAttr.Value
+		}
+	}
+
+	return false
+}
+
+// xmlListType returns the type wrapping the items of a slice type in a single xml element, or nil if the type is not a list.
+// Without it, encoding/xml marshals each of the slice items as a separate element, which can't be told apart from
+// the elements of the following tuple values. Byte slices are marshalled as text, and therefore are not lists.
+func xmlListType(t reflect.Type) reflect.Type {
+	if t.Kind() != reflect.Slice || isBytesType(t) {
+		return nil
+	}
+
+	return reflect.StructOf([]reflect.StructField{{Name: "Items", Type: t, Tag: `xml:"item"`}})
+}
+
+// xmlElementValue returns the value to marshal as the xml element of a tuple value,
+// wrapping slice items in item child elements, such as <v1><item>1</item><item>2</item></v1>.
+// Arrays and pointers to slices and arrays are not supported, as they can't be unmarshalled back.
+func xmlElementValue(v reflect.Value) (any, error) {
+	if listType := xmlListType(v.Type()); listType != nil {
+		list := reflect.New(listType).Elem()
+		list.Field(0).Set(v)
+		return list.Interface(), nil
+	}
+
+	t := v.Type()
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() == reflect.Array || (t != v.Type() && t.Kind() == reflect.Slice && !isBytesType(t)) {
+		return nil, fmt.Errorf("unable to marshal value of type %s to xml", v.Type())
+	}
+
+	return v.Interface(), nil
+}
+
+// decodeXMLElement decodes the xml element start into the value v, unwrapping slice items from their item child elements.
+func decodeXMLElement(d *xml.Decoder, start *xml.StartElement, v reflect.Value) error {
+	listType := xmlListType(v.Type())
+	if listType == nil {
+		return d.DecodeElement(v.Addr().Interface(), start)
+	}
+
+	list := reflect.New(listType)
+	if err := d.DecodeElement(list.Interface(), start); err != nil {
+		return err
+	}
+	v.Set(list.Elem().Field(0))
+	return nil
+}
@@ -0,0 +1,132 @@
+package tuple
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_indexLabels(t *testing.T) {
+	require.Equal(t, WithLabels("v1", "v2", "v3"), indexLabels(3))
+}
+
+func Test_marshalXML(t *testing.T) {
+	var buf strings.Builder
+	encoder := xml.NewEncoder(&buf)
+
+	start := xml.StartElement{Name: xml.Name{Local: "T2[int,string]"}}
+	err := marshalXML(encoder, start, WithLabels("id", "name"), []any{1, "foo"})
+	require.NoError(t, err)
+	require.NoError(t, encoder.Flush())
+	require.Equal(t, `<T2><id>1</id><name>foo</name></T2>`, buf.String())
+
+	err = marshalXML(encoder, start, WithLabels("id"), []any{1, "foo"})
+	require.EqualError(t, err, "labels count 1 must match number of tuple values 2")
+}
+
+func Test_unmarshalXML(t *testing.T) {
+	unmarshal := func(data string, labels Labels, ptrs ...any) error {
+		decoder := xml.NewDecoder(strings.NewReader(data))
+		start, err := decoder.Token()
+		require.NoError(t, err)
+		return unmarshalXML(decoder, start.(xml.StartElement), labels, ptrs)
+	}
+
+	var id int
+	var name string
+	labels := WithLabels("id", "name")
+
+	require.NoError(t, unmarshal(`<user><id>1</id><name>foo</name></user>`, labels, &id, &name))
+	require.Equal(t, 1, id)
+	require.Equal(t, "foo", name)
+
+	err := unmarshal(`<user><name>foo</name><id>1</id></user>`, labels, &id, &name)
+	require.EqualError(t, err, `xml element "name" at index 0 must be named "id"`)
+
+	err = unmarshal(`<user><id>1</id></user>`, labels, &id, &name)
+	require.EqualError(t, err, "unmarshalled xml elements count 1 must match number of tuple values 2")
+
+	err = unmarshal(`<user><id>1</id><name>foo</name><age>5</age></user>`, labels, &id, &name)
+	require.EqualError(t, err, "unmarshalled xml elements count 3 must match number of tuple values 2")
+
+	err = unmarshal(`<user><id>x</id><name>foo</name></user>`, labels, &id, &name)
+	require.ErrorContains(t, err, `xml element "id" at index 0 failed to unmarshal: `)
+
+	err = unmarshal(`<user><id>1</id>`, labels, &id, &name)
+	require.Error(t, err)
+
+	err = unmarshal(`<user><id>1</id></user>`, WithLabels("id", "id"), &id, &name)
+	require.EqualError(t, err, `label "id" is used more than once`)
+}
+
+func Test_marshalXML_unmarshalXML_Nil(t *testing.T) {
+	var buf strings.Builder
+	encoder := xml.NewEncoder(&buf)
+
+	start := xml.StartElement{Name: xml.Name{Local: "T3[*int,string,interface {}]"}}
+	err := marshalXML(encoder, start, indexLabels(3), []any{(*int)(nil), "a", nil})
+	require.NoError(t, err)
+	require.NoError(t, encoder.Flush())
+	require.Equal(t, `<T3><v1 nil="true"></v1><v2>a</v2><v3 nil="true"></v3></T3>`, buf.String())
+
+	decoder := xml.NewDecoder(strings.NewReader(buf.String()))
+	token, err := decoder.Token()
+	require.NoError(t, err)
+
+	num := ptr(5)
+	var str string
+	var iface any = "unchanged"
+	err = unmarshalXML(decoder, token.(xml.StartElement), indexLabels(3), []any{&num, &str, &iface})
+	require.NoError(t, err)
+	require.Nil(t, num)
+	require.Equal(t, "a", str)
+	require.Nil(t, iface)
+}
+
+func Test_isXMLNil(t *testing.T) {
+	element := func(attrs ...xml.Attr) xml.StartElement {
+		return xml.StartElement{Name: xml.Name{Local: "v1"}, Attr: attrs}
+	}
+
+	require.True(t, isXMLNil(element(xmlNilAttr)))
+	require.False(t, isXMLNil(element()))
+	require.False(t, isXMLNil(element(xml.Attr{Name: xml.Name{Local: "nil"}, Value: "false"})))
+	require.False(t, isXMLNil(element(xml.Attr{Name: xml.Name{Local: "id"}, Value: "true"})))
+}
+
+func Test_marshalXML_unmarshalXML_Slices(t *testing.T) {
+	var buf strings.Builder
+	encoder := xml.NewEncoder(&buf)
+
+	start := xml.StartElement{Name: xml.Name{Local: "T3"}}
+	err := marshalXML(encoder, start, indexLabels(3), []any{[]int{1, 2}, []byte("ab"), []string{"x"}})
+	require.NoError(t, err)
+	require.NoError(t, encoder.Flush())
+	require.Equal(t, `<T3><v1><item>1</item><item>2</item></v1><v2>ab</v2><v3><item>x</item></v3></T3>`, buf.String())
+
+	decoder := xml.NewDecoder(strings.NewReader(buf.String()))
+	token, err := decoder.Token()
+	require.NoError(t, err)
+
+	var ints []int
+	var bytes []byte
+	var strs []string
+	err = unmarshalXML(decoder, token.(xml.StartElement), indexLabels(3), []any{&ints, &bytes, &strs})
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2}, ints)
+	require.Equal(t, []byte("ab"), bytes)
+	require.Equal(t, []string{"x"}, strs)
+}
+
+func Test_marshalXML_Unsupported(t *testing.T) {
+	encoder := xml.NewEncoder(&strings.Builder{})
+	start := xml.StartElement{Name: xml.Name{Local: "T1"}}
+
+	err := marshalXML(encoder, start, indexLabels(1), []any{[2]int{1, 2}})
+	require.EqualError(t, err, "value at index 0 failed to marshal: unable to marshal value of type [2]int to xml")
+
+	err = marshalXML(encoder, start, indexLabels(1), []any{&[]int{1}})
+	require.EqualError(t, err, "value at index 0 failed to marshal: unable to marshal value of type *[]int to xml")
+}